@@ -0,0 +1,630 @@
+// Package adx generates the API documentation from the source code of several
+// languages. The generators parse the sources into the Class model, which is
+// rendered as HTML, PDF, Markdown or the adx XML and JSON interchange format.
+package adx
+
+import (
+	"bytes"
+	"encoding/json"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"html"
+	"html/template"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"gopkg.in/yaml.v2"
+)
+
+// OnCommand is called with the external commands (e.g. doxygen or jsdoc)
+// before running them, the command line tool prints them in the verbose mode
+var OnCommand func(command string)
+
+func newCmd(name string, args ...string) *exec.Cmd {
+	if OnCommand != nil {
+		OnCommand(strings.TrimSpace(name + " " + strings.Join(args, " ")))
+	}
+
+	// #nosec
+	cmd := exec.Command(name, args...)
+	cmd.Stderr = os.Stderr
+	return cmd
+}
+
+// Returns info for method
+type Returns struct {
+	Type        template.HTML `xml:"type" json:"type,omitempty"`
+	Description template.HTML `xml:"description" json:"description,omitempty"`
+	Skip        bool          `xml:"-" json:"-"`
+}
+
+// Exception is the exception (or the error condition) thrown by method
+type Exception struct {
+	Type        template.HTML `xml:"type" json:"type,omitempty"`
+	Description string        `xml:"description" json:"description,omitempty"`
+}
+
+// Example is the code example of class or method; the language is the one of
+// the class if not set
+type Example struct {
+	Title    string `xml:"title" json:"title,omitempty"`
+	Language string `xml:"language" json:"language,omitempty"`
+	Code     string `xml:"code" json:"code"`
+}
+
+// Block is the paragraph (the default kind), the list (list or ordered-list,
+// the blocks are the items), the list item (item, the blocks are its
+// paragraphs and nested lists) or the code block (code) of the rich description
+type Block struct {
+	Kind     string  `xml:"kind" json:"kind,omitempty"`
+	Spans    []Span  `xml:"spans" json:"spans,omitempty"`
+	Blocks   []Block `xml:"blocks" json:"blocks,omitempty"`
+	Language string  `xml:"language" json:"language,omitempty"`
+	Code     string  `xml:"code" json:"code,omitempty"`
+}
+
+// Span is the text of the paragraph: the plain text (the default kind), code,
+// emphasis, strong or link (the URL is the class anchor, e.g. #ref, or the
+// external link); the nested markup is flattened
+type Span struct {
+	Kind string `xml:"kind" json:"kind,omitempty"`
+	Text string `xml:"text" json:"text"`
+	URL  string `xml:"url" json:"url,omitempty"`
+}
+
+// Parameter of method
+type Parameter struct {
+	Name        string        `xml:"name" json:"name"`
+	Type        template.HTML `xml:"type" json:"type,omitempty"`
+	Description string        `xml:"description" json:"description,omitempty"`
+	Default     string        `xml:"default" json:"default,omitempty"`
+	Optional    string        `xml:"optional" json:"optional,omitempty"`
+	Nullable    string        `xml:"nullable" json:"nullable,omitempty"`
+}
+
+// Label is the parameter name with the default value (or the optional marker)
+func (p Parameter) Label() string {
+	switch {
+	case p.Default != "":
+		return p.Name + " = " + p.Default
+	case p.Optional == "true":
+		return p.Name + "?"
+	}
+	return p.Name
+}
+
+// Property of class; the deprecated field is the deprecation note (true if
+// there is none), since is the version the property appeared in, and the
+// stability is experimental or stable. The details are the rich description
+// (the outputs render it instead of the plain text description, if any).
+// The file (relative to the source root, see Generator.SetSourceRoot) and
+// the line locate the declaration, and the source URL is the link to it
+// (see LinkSources)
+type Property struct {
+	Name        string        `xml:"name" json:"name"`
+	Description string        `xml:"description" json:"description,omitempty"`
+	Details     []Block       `xml:"details" json:"details,omitempty"`
+	Access      string        `xml:"access" json:"access,omitempty"`
+	Virtual     string        `xml:"virtual" json:"virtual,omitempty"`
+	Type        template.HTML `xml:"type" json:"type,omitempty"`
+	Deprecated  string        `xml:"deprecated" json:"deprecated,omitempty"`
+	Since       string        `xml:"since" json:"since,omitempty"`
+	Stability   string        `xml:"stability" json:"stability,omitempty"`
+	File        string        `xml:"file" json:"file,omitempty"`
+	Line        int           `xml:"line" json:"line,omitempty"`
+	Anchor      string        `xml:"-" json:"-"`
+	SourceURL   string        `xml:"-" json:"-"`
+}
+
+// EnumValue is the named value of the enum
+type EnumValue struct {
+	Name        string `xml:"name" json:"name"`
+	Description string `xml:"description" json:"description,omitempty"`
+	Value       string `xml:"value" json:"value,omitempty"`
+	Anchor      string `xml:"-" json:"-"`
+}
+
+// Method of class (the details, deprecated, since, stability, file, line and
+// source URL fields are the ones of the properties)
+type Method struct {
+	Name        string      `xml:"name" json:"name"`
+	Description string      `xml:"description" json:"description,omitempty"`
+	Details     []Block     `xml:"details" json:"details,omitempty"`
+	Access      string      `xml:"access" json:"access,omitempty"`
+	Virtual     string      `xml:"virtual" json:"virtual,omitempty"`
+	Parameters  []Parameter `xml:"parameters" json:"parameters,omitempty"`
+	Returns     Returns     `xml:"returns" json:"returns"`
+	Throws      []Exception `xml:"throws" json:"throws,omitempty"`
+	Deprecated  string      `xml:"deprecated" json:"deprecated,omitempty"`
+	Since       string      `xml:"since" json:"since,omitempty"`
+	Stability   string      `xml:"stability" json:"stability,omitempty"`
+	Examples    []Example   `xml:"examples" json:"examples,omitempty"`
+	File        string      `xml:"file" json:"file,omitempty"`
+	Line        int         `xml:"line" json:"line,omitempty"`
+	IsCtor      bool        `xml:"-" json:"-"`
+	Anchor      string      `xml:"-" json:"-"`
+	SourceURL   string      `xml:"-" json:"-"`
+}
+
+// Class info; the kind of the type is class (if empty), interface, struct,
+// enum, protocol or trait (the details, deprecated, since, stability, file,
+// line and source URL fields are the ones of the properties)
+type Class struct {
+	Name         string      `xml:"name" json:"name"`
+	Kind         string      `xml:"kind" json:"kind,omitempty"`
+	Description  string      `xml:"description" json:"description,omitempty"`
+	Details      []Block     `xml:"details" json:"details,omitempty"`
+	Access       string      `xml:"access" json:"access,omitempty"`
+	Virtual      string      `xml:"virtual" json:"virtual,omitempty"`
+	Deprecated   string      `xml:"deprecated" json:"deprecated,omitempty"`
+	Since        string      `xml:"since" json:"since,omitempty"`
+	Stability    string      `xml:"stability" json:"stability,omitempty"`
+	Fires        string      `xml:"fires" json:"fires,omitempty"`
+	Examples     []Example   `xml:"examples" json:"examples,omitempty"`
+	Constructors []Method    `xml:"constructor" json:"constructors,omitempty"`
+	Methods      []Method    `xml:"functions" json:"methods,omitempty"`
+	Properties   []Property  `xml:"properties" json:"properties,omitempty"`
+	Values       []EnumValue `xml:"values" json:"values,omitempty"`
+	Ref          string      `xml:"ref" json:"ref,omitempty"`
+	Language     string      `xml:"language" json:"language,omitempty"`
+	File         string      `xml:"file" json:"file,omitempty"`
+	Line         int         `xml:"line" json:"line,omitempty"`
+	// The names of the base classes and the implemented interfaces (as in
+	// the sources, e.g. Shape, geo.Shape or geo::Shape<T>)
+	Extends    []string `xml:"extends" json:"extends,omitempty"`
+	Implements []string `xml:"implements" json:"implements,omitempty"`
+	Namespace  string   `xml:"-" json:"-"`
+	SourceURL  string   `xml:"-" json:"-"`
+	// The hierarchy resolved by Normalize, and the inherited members
+	// added by InheritMembers
+	Bases      []ClassRef         `xml:"-" json:"-"`
+	Interfaces []ClassRef         `xml:"-" json:"-"`
+	Derived    []ClassRef         `xml:"-" json:"-"`
+	Inherited  []InheritedMembers `xml:"-" json:"-"`
+}
+
+// Namespace has the members outside of the classes (e.g. the package-level
+// functions, constants and variables); the name has the :: separators as the
+// class names, and the details are the rich description (as the ones of the
+// properties)
+type Namespace struct {
+	Name        string     `xml:"name" json:"name"`
+	Description string     `xml:"description" json:"description,omitempty"`
+	Details     []Block    `xml:"details" json:"details,omitempty"`
+	Functions   []Method   `xml:"functions" json:"functions,omitempty"`
+	Constants   []Property `xml:"constants" json:"constants,omitempty"`
+	Variables   []Property `xml:"variables" json:"variables,omitempty"`
+	Language    string     `xml:"language" json:"language,omitempty"`
+	Anchor      string     `xml:"-" json:"-"`
+}
+
+// Generator parses the source code of a language: the sources dirs are converted
+// into the intermediate content (e.g. the Doxygen XML), combined and then parsed
+type Generator interface {
+	GenIntermediate(srcDir string) ([]byte, error)
+	CombineIntermediate(a []byte, b []byte) ([]byte, error)
+	GenClasses(content []byte) ([]Class, error)
+	SetConf(conf string)
+	SetExcludes(patterns []string)
+	// SetSourceRoot makes the source paths relative to the dir (the working
+	// directory if empty)
+	SetSourceRoot(dir string)
+	// SetErrorHandler makes the recoverable errors (e.g. the unreadable source
+	// files) reported to the handler instead of failing the generation
+	SetErrorHandler(handler func(error))
+}
+
+// NamespaceGenerator is the generator parsing the namespace-level members too
+type NamespaceGenerator interface {
+	Generator
+	GenNamespaces(content []byte) ([]Namespace, error)
+}
+
+var tagRe = regexp.MustCompile("<[^>]*>")
+
+// plainText strips the markup from the HTML fragments of the model
+func plainText(raw string) string {
+	return strings.TrimSpace(html.UnescapeString(tagRe.ReplaceAllString(raw, "")))
+}
+
+// escapedHTML escapes the plain text (e.g. the type the identifiers of which
+// are linked afterwards)
+func escapedHTML(text string) template.HTML {
+	// #nosec
+	return template.HTML(html.EscapeString(text))
+}
+
+func createDir(dir string) error {
+	return os.MkdirAll(dir, 0700)
+}
+
+// adxContent implements the generator methods for the intermediate content
+// in the adx JSON format (used by the generators parsing the sources natively)
+type adxContent struct{}
+
+func (a adxContent) CombineIntermediate(first []byte, second []byte) ([]byte, error) {
+	var firstContent, secondContent AdxResult
+	if err := json.Unmarshal(first, &firstContent); err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(second, &secondContent); err != nil {
+		return nil, err
+	}
+	firstContent.Classes = append(firstContent.Classes, secondContent.Classes...)
+	firstContent.Namespaces = append(firstContent.Namespaces, secondContent.Namespaces...)
+	return json.Marshal(firstContent)
+}
+
+func (a adxContent) read(content []byte) (AdxResult, error) {
+	var v AdxResult
+	if content == nil {
+		return v, nil
+	}
+	err := json.Unmarshal(content, &v)
+	return v, err
+}
+
+func (a adxContent) GenClasses(content []byte) ([]Class, error) {
+	v, err := a.read(content)
+	return v.Classes, err
+}
+
+func (a adxContent) GenNamespaces(content []byte) ([]Namespace, error) {
+	v, err := a.read(content)
+	return v.Namespaces, err
+}
+
+// The built-in generators
+var generators = map[string]func() Generator{
+	"c":      func() Generator { return &doxygen{doxyfile: "data/cpp.doxyfile", c: true} },
+	"cpp":    func() Generator { return &doxygen{doxyfile: "data/cpp.doxyfile"} },
+	"go":     func() Generator { return new(golang) },
+	"java":   func() Generator { return &doxygen{doxyfile: "data/java.doxyfile"} },
+	"js":     func() Generator { return new(js) },
+	"python": func() Generator { return new(python) },
+	"ts":     func() Generator { return new(typescript) },
+}
+
+// GeneratorNames lists the built-in generators
+func GeneratorNames() []string {
+	var names []string
+	for name := range generators {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// RenderHTML writes the single-page HTML document; the members are the
+// normalized namespace members (see NormalizeNamespaces)
+func RenderHTML(opts RenderOptions, namespaces map[string][]Class, members map[string]Namespace) ([]byte, error) {
+	t, err := parseTemplate(opts, nil, "data/default.html", "data/partials.html")
+	if err != nil {
+		return nil, err
+	}
+	style, err := opts.asset("data/style.css")
+	if err != nil {
+		return nil, err
+	}
+	script, err := opts.asset("data/search.js")
+	if err != nil {
+		return nil, err
+	}
+	return executeTemplate(t, struct {
+		Title        string
+		Style        template.CSS
+		Names        []string
+		Namespaces   map[string][]Class
+		Members      map[string]Namespace
+		Languages    []string
+		Hierarchy    []ClassNode
+		SearchIndex  []searchEntry
+		SearchScript template.JS
+	}{
+		opts.Title,
+		// #nosec
+		template.CSS(style),
+		namespaceNames(namespaces, members),
+		namespaces,
+		members,
+		languages(namespaces),
+		ClassHierarchy(namespaces),
+		buildSearchIndex(namespaces, members, func(cls Class) string { return "" }, func(ns string) string { return "" }),
+		// #nosec
+		template.JS(script),
+	})
+}
+
+func save(content []byte, out string) error {
+	return os.WriteFile(out, content, 0644)
+}
+
+// Normalize groups the classes by their namespaces and adds the rendering info
+func Normalize(classes []Class) map[string][]Class {
+	namespaces := map[string][]Class{}
+	for _, cls := range classes {
+		subs := strings.Split(cls.Name, "::")
+		ns := "Global"
+		if len(subs) > 1 {
+			ns = strings.Join(subs[:len(subs)-1], ".")
+			cls.Name = subs[len(subs)-1]
+		}
+		cls.Namespace = ns
+		if cls.Ref == "" {
+			cls.Ref = ns + cls.Name
+		}
+		// The members are copied as the rendering info is added to them
+		cls.Properties = normalizeProperties(cls.Properties, cls.Ref)
+		cls.Values = append([]EnumValue(nil), cls.Values...)
+		for i, value := range cls.Values {
+			cls.Values[i].Anchor = cls.Ref + "-" + value.Name
+		}
+		cls.Examples = exampleLanguage(cls.Examples, cls.Language)
+		cls.Constructors = append([]Method(nil), cls.Constructors...)
+		for i, ctor := range cls.Constructors {
+			if ctor.Name == "" {
+				cls.Constructors[i].Name = cls.Name
+			}
+			cls.Constructors[i].Examples = exampleLanguage(ctor.Examples, cls.Language)
+		}
+		methodAnchors(cls.Constructors, cls.Ref)
+		cls.Methods = normalizeMethods(cls.Methods, cls.Ref, cls.Language)
+		namespaces[ns] = append(namespaces[ns], cls)
+	}
+	linkHierarchy(namespaces)
+	return namespaces
+}
+
+// normalizeMethods copies the methods adding their anchors, the return info
+// and the languages of the examples
+func normalizeMethods(methods []Method, ref string, language string) []Method {
+	methods = append([]Method(nil), methods...)
+	for i, method := range methods {
+		returnType := method.Returns.Type
+		returnDesc := method.Returns.Description
+		noReturnInfo := returnType == "" && returnDesc == ""
+		methods[i].Returns.Skip = returnType == "void" || noReturnInfo
+		methods[i].Examples = exampleLanguage(method.Examples, language)
+	}
+	methodAnchors(methods, ref)
+	return methods
+}
+
+// methodAnchors sets the anchors of the methods; the overloads following the
+// first one have the numbered suffixes (e.g. Box-add and Box-add-2)
+func methodAnchors(methods []Method, ref string) {
+	overloads := map[string]int{}
+	for i, method := range methods {
+		overloads[method.Name]++
+		methods[i].Anchor = ref + "-" + method.Name
+		if n := overloads[method.Name]; n > 1 {
+			methods[i].Anchor += "-" + strconv.Itoa(n)
+		}
+	}
+}
+
+// exampleLanguage copies the examples setting the missing languages
+func exampleLanguage(examples []Example, language string) []Example {
+	examples = append([]Example(nil), examples...)
+	for i, example := range examples {
+		if example.Language == "" {
+			examples[i].Language = language
+		}
+	}
+	return examples
+}
+
+// normalizeProperties copies the properties adding their anchors
+func normalizeProperties(props []Property, ref string) []Property {
+	props = append([]Property(nil), props...)
+	for i, prop := range props {
+		props[i].Anchor = ref + "-" + prop.Name
+	}
+	return props
+}
+
+// NormalizeNamespaces merges the namespace members by the namespace names
+// (with the . separators as the keys of Normalize) and adds the rendering info
+func NormalizeNamespaces(list []Namespace) map[string]Namespace {
+	members := map[string]Namespace{}
+	for _, ns := range list {
+		name := strings.ReplaceAll(ns.Name, "::", ".")
+		if name == "" {
+			name = "Global"
+		}
+		merged, ok := members[name]
+		if !ok {
+			merged = Namespace{Name: name, Language: ns.Language, Anchor: "ns-" + name}
+		}
+		if merged.Description == "" {
+			merged.Description, merged.Details = ns.Description, ns.Details
+		}
+		merged.Functions = append(merged.Functions, normalizeMethods(ns.Functions, merged.Anchor, ns.Language)...)
+		merged.Constants = append(merged.Constants, normalizeProperties(ns.Constants, merged.Anchor)...)
+		merged.Variables = append(merged.Variables, normalizeProperties(ns.Variables, merged.Anchor)...)
+		// The overloads may come from the different inputs
+		methodAnchors(merged.Functions, merged.Anchor)
+		members[name] = merged
+	}
+	return members
+}
+
+// namespaceNames lists the namespaces of the classes and the members
+func namespaceNames(namespaces map[string][]Class, members map[string]Namespace) []string {
+	names := sortedNamespaces(namespaces)
+	for name := range members {
+		if _, ok := namespaces[name]; !ok {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	return names
+}
+
+// languages lists the source languages of the classes
+func languages(namespaces map[string][]Class) []string {
+	found := map[string]bool{}
+	var result []string
+	for _, classes := range namespaces {
+		for _, cls := range classes {
+			if cls.Language != "" && !found[cls.Language] {
+				found[cls.Language] = true
+				result = append(result, cls.Language)
+			}
+		}
+	}
+	sort.Strings(result)
+	return result
+}
+
+func sortedNamespaces(namespaces map[string][]Class) []string {
+	var names []string
+	for ns := range namespaces {
+		names = append(names, ns)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// IntermediateContent generates and combines the intermediate content of the source dirs
+func IntermediateContent(srcDirs []string, gen Generator) ([]byte, error) {
+	var intermediateContent []byte
+	for _, srcDir := range srcDirs {
+		content, err := gen.GenIntermediate(srcDir)
+		if err != nil {
+			return nil, withContext(err, PhaseSource, srcDir)
+		}
+		if intermediateContent != nil {
+			intermediateContent, err = gen.CombineIntermediate(intermediateContent, content)
+			if err != nil {
+				return nil, withContext(err, PhaseParse, srcDir)
+			}
+		} else {
+			intermediateContent = content
+		}
+	}
+	return intermediateContent, nil
+}
+
+// FormatVersion is the version of the adx interchange format (XML and JSON), see schema/
+const FormatVersion = 2
+
+// AdxResult XML struct
+type AdxResult struct {
+	XMLName    xml.Name    `xml:"adx" json:"-"`
+	Version    int         `xml:"version,attr" json:"version"`
+	Classes    []Class     `xml:"classes" json:"classes"`
+	Namespaces []Namespace `xml:"namespaces" json:"namespaces,omitempty"`
+}
+
+// ReadAdx validates the adx document (in the format based on the file
+// extension), migrating it from the older versions
+func ReadAdx(inFile string) (AdxResult, error) {
+	var v AdxResult
+	// #nosec
+	content, err := os.ReadFile(inFile)
+	if err != nil {
+		return v, withContext(err, PhaseInput, inFile)
+	}
+
+	var version int
+	if filepath.Ext(inFile) == ".json" {
+		version, err = validateJSON(content)
+		if err == nil && version <= FormatVersion {
+			err = json.Unmarshal(content, &v)
+		}
+		var syntaxErr *json.SyntaxError
+		if errors.As(err, &syntaxErr) {
+			line := bytes.Count(content[:syntaxErr.Offset], []byte("\n")) + 1
+			err = &Error{Phase: PhaseInput, Line: line, Err: err}
+		}
+	} else {
+		version, err = xmlVersion(content)
+		if err == nil && version < FormatVersion {
+			content, err = migrateXML(content, version)
+		}
+		if err == nil && version <= FormatVersion {
+			err = validateXML(content)
+		}
+		if err == nil {
+			err = xml.Unmarshal(content, &v)
+		}
+	}
+	if err == nil && version > FormatVersion {
+		err = fmt.Errorf("unsupported format version %d (the latest is %d)",
+			version, FormatVersion)
+	}
+	if err != nil {
+		return v, withContext(err, PhaseInput, inFile)
+	}
+	v.Version = FormatVersion
+	return v, nil
+}
+
+// RenderXML writes the document in the adx XML interchange format
+func RenderXML(v AdxResult) ([]byte, error) {
+	v.Version = FormatVersion
+	return xml.MarshalIndent(v, "", "  ")
+}
+
+// RenderJSON writes the document in the adx JSON interchange format
+func RenderJSON(v AdxResult) ([]byte, error) {
+	v.Version = FormatVersion
+	var buf bytes.Buffer
+	encoder := json.NewEncoder(&buf)
+	encoder.SetEscapeHTML(false)
+	encoder.SetIndent("", "  ")
+	if err := encoder.Encode(v); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// LoadLanguages reads the custom languages configuration file
+func LoadLanguages(conf string) (map[string]Language, error) {
+	// #nosec
+	data, err := os.ReadFile(conf)
+	if err != nil {
+		return nil, withContext(err, PhaseConfig, conf)
+	}
+	var config map[string]Language
+	if err = yaml.Unmarshal(data, &config); err != nil {
+		return nil, withContext(err, PhaseConfig, conf)
+	}
+	return config, nil
+}
+
+// LookupGenerator prefers the custom languages over the built-in generators
+func LookupGenerator(languages map[string]Language, lang string) (Generator, error) {
+	langConfig, ok := languages[lang]
+	if ok {
+		return NewCustomGenerator(langConfig), nil
+	}
+	newGen, ok := generators[lang]
+	if !ok {
+		return nil, &Error{
+			Phase: PhaseConfig,
+			Err:   fmt.Errorf("can't find a documentation generator for %q", lang),
+		}
+	}
+	return newGen(), nil
+}
+
+// FindGenerator looks up the generator in the custom languages configuration
+// file (if any) and the built-in generators
+func FindGenerator(conf string, lang string) (Generator, error) {
+	var languages map[string]Language
+	if conf != "" {
+		var err error
+		if languages, err = LoadLanguages(conf); err != nil {
+			return nil, err
+		}
+	}
+	return LookupGenerator(languages, lang)
+}
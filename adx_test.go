@@ -0,0 +1,1058 @@
+package adx
+
+import (
+	"errors"
+	"fmt"
+	"html/template"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"testing"
+)
+
+// parseFixtures parses the fixtures with the custom language
+func parseFixtures(t *testing.T, lang string) []Class {
+	gen, err := FindGenerator("fixtures/config.yaml", lang)
+	if err != nil {
+		t.Fatal(err)
+	}
+	intermediateContent, err := IntermediateContent([]string{"fixtures/"}, gen)
+	if err != nil {
+		t.Fatal(err)
+	}
+	classes, err := gen.GenClasses(intermediateContent)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return classes
+}
+
+// combine merges the classes with the adx documents
+func combine(t *testing.T, classes []Class, inFiles ...string) []Class {
+	doc, err := Project{In: inFiles}.Parse()
+	if err != nil {
+		t.Fatal(err)
+	}
+	return append(classes, doc.Classes...)
+}
+
+// must fails the test on the rendering error, e.g. must(t)(RenderXML(doc))
+func must(t *testing.T) func(content []byte, err error) string {
+	return func(content []byte, err error) string {
+		t.Helper()
+		if err != nil {
+			t.Fatal(err)
+		}
+		return string(content)
+	}
+}
+
+func TestKotlin(t *testing.T) {
+	classes := parseFixtures(t, "kotlin")
+	xml := must(t)(RenderXML(AdxResult{Classes: classes}))
+	data, err := os.ReadFile("fixtures/Foo.xml")
+	if err != nil {
+		t.Fatal(err)
+	}
+	str := string(data)
+	if xml != str {
+		t.Fatalf("XML output doesn't match. Expected:\n%s\nGot:\n%s\n", xml, str)
+	}
+}
+
+func TestSwift(t *testing.T) {
+	classes := parseFixtures(t, "swift")
+	xml := must(t)(RenderXML(AdxResult{Classes: classes}))
+	data, err := os.ReadFile("fixtures/Bar.xml")
+	if err != nil {
+		t.Fatal(err)
+	}
+	str := string(data)
+	if xml != str {
+		t.Fatalf("XML output doesn't match. Expected:\n%s\nGot:\n%s\n", xml, str)
+	}
+}
+
+func TestCombineXML(t *testing.T) {
+	classes := parseFixtures(t, "cpp")
+	combined := combine(t, classes, "fixtures/Foo.xml")
+	xml := must(t)(RenderXML(AdxResult{Classes: combined}))
+	data, err := os.ReadFile("fixtures/Combined.xml")
+	if err != nil {
+		t.Fatal(err)
+	}
+	str := string(data)
+	if xml != str {
+		t.Fatalf("XML output doesn't match. Expected:\n%s\nGot:\n%s\n", xml, str)
+	}
+}
+
+func TestGo(t *testing.T) {
+	p := Project{Inputs: []ProjectInput{{Lang: "go", Src: []string{"fixtures/_go"}}}}
+	doc, err := p.Parse()
+	if err != nil {
+		t.Fatal(err)
+	}
+	xml := must(t)(RenderXML(doc))
+	data, err := os.ReadFile("fixtures/Go.xml")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if xml != string(data) {
+		t.Fatalf("XML output doesn't match. Expected:\n%s\nGot:\n%s\n", data, xml)
+	}
+	rect := Normalize(doc.Classes)["geometry"][2]
+	if rect.Name != "Rect" || rect.Constructors[0].Name != "NewRect" {
+		t.Fatalf("Wrong constructor: %s.%s", rect.Name, rect.Constructors[0].Name)
+	}
+	// The deprecation paragraph is the annotation
+	description, deprecated := goDoc("Total sums.\n\nDeprecated: Use\nSum instead.\n")
+	if description != "Total sums." || deprecated != "Use Sum instead." {
+		t.Fatalf("Wrong deprecation: %q, %q", description, deprecated)
+	}
+	// The adx documents keep the namespace members
+	merged, err := Project{In: []string{"fixtures/Go.xml"}}.Parse()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(merged.Namespaces) != len(doc.Namespaces) || merged.Namespaces[0].Functions == nil {
+		t.Fatalf("Wrong merged namespaces: %v", merged.Namespaces)
+	}
+}
+
+func TestPython(t *testing.T) {
+	p := Project{Inputs: []ProjectInput{{Lang: "python", Src: []string{"fixtures/_py"}}}}
+	doc, err := p.Parse()
+	if err != nil {
+		t.Fatal(err)
+	}
+	xml := must(t)(RenderXML(doc))
+	data, err := os.ReadFile("fixtures/Python.xml")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if xml != string(data) {
+		t.Fatalf("XML output doesn't match. Expected:\n%s\nGot:\n%s\n", data, xml)
+	}
+
+	_, _, err = genPyModule("def f():\n    \"\"\"Unterminated\n", "broken")
+	var e *Error
+	if !errors.As(err, &e) || e.Phase != PhaseParse || e.Line != 2 {
+		t.Fatalf("Wrong syntax error: %v", err)
+	}
+}
+
+func TestTypeScript(t *testing.T) {
+	p := Project{Inputs: []ProjectInput{{Lang: "ts", Src: []string{"fixtures/_ts", "fixtures/_typedoc"}}}}
+	doc, err := p.Parse()
+	if err != nil {
+		t.Fatal(err)
+	}
+	xml := must(t)(RenderXML(doc))
+	data, err := os.ReadFile("fixtures/TypeScript.xml")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if xml != string(data) {
+		t.Fatalf("XML output doesn't match. Expected:\n%s\nGot:\n%s\n", data, xml)
+	}
+	find := Normalize(doc.Classes)["shapes"][1].Methods[1].Parameters[1]
+	if find.Label() != "from = 0" || find.Optional != "true" {
+		t.Fatalf("Wrong optional parameter: %v", find)
+	}
+
+	var result AdxResult
+	err = genTSDeclarations("/** The class.\n\ndeclare class Foo {}\n", "broken", &result)
+	var e *Error
+	if !errors.As(err, &e) || e.Phase != PhaseParse || e.Line != 1 {
+		t.Fatalf("Wrong syntax error: %v", err)
+	}
+	result = AdxResult{}
+	err = genTSDeclarations("export declare class Shape {}\ndeclare global {\n  /** The window. */\n  interface Window { shape: Shape; }\n}\n", "a", &result)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(result.Classes) != 2 || result.Classes[0].Name != "a::Shape" ||
+		result.Classes[1].Name != "Window" || result.Classes[1].Ref != "Window" {
+		t.Fatalf("Wrong global augmentation: %v", result.Classes)
+	}
+	result = AdxResult{}
+	err = genTSDeclarations("/**\n * The old class.\n * @deprecated\n * @since 1.0\n * @stable\n */\nexport declare class Old {}\n", "a", &result)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if old := result.Classes[0]; old.Deprecated != "true" || old.Since != "1.0" || old.Stability != "stable" {
+		t.Fatalf("Wrong annotations: %q, %q, %q", old.Deprecated, old.Since, old.Stability)
+	}
+
+	// The unbalanced closing brackets are skipped
+	dir := t.TempDir()
+	err = os.WriteFile(filepath.Join(dir, "broken.d.ts"), []byte("declare const x: number;\n)\n]\ndeclare const y: string;\n"), 0600)
+	if err != nil {
+		t.Fatal(err)
+	}
+	p = Project{Inputs: []ProjectInput{{Lang: "ts", Src: []string{dir}}}}
+	doc, err = p.Parse()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if constants := doc.Namespaces[0].Constants; len(constants) != 2 || constants[1].Name != "y" {
+		t.Fatalf("Wrong constants after the unbalanced brackets: %v", constants)
+	}
+}
+
+// doxygenFixture parses the Doxygen XML dir with the generator of the language
+func doxygenFixture(t *testing.T, lang string, dir string) AdxResult {
+	content, err := ReadDoxygenXML(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	gen := generators[lang]().(NamespaceGenerator)
+	classes, err := gen.GenClasses(content)
+	if err != nil {
+		t.Fatal(err)
+	}
+	namespaces, err := gen.GenNamespaces(content)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return AdxResult{Classes: classes, Namespaces: namespaces}
+}
+
+func TestCpp(t *testing.T) {
+	xml := must(t)(RenderXML(doxygenFixture(t, "cpp", "fixtures/_cpp/xml")))
+	data, err := os.ReadFile("fixtures/Cpp.xml")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if xml != string(data) {
+		t.Fatalf("XML output doesn't match. Expected:\n%s\nGot:\n%s\n", data, xml)
+	}
+
+	// The custom languages take precedence over the built-in ones
+	gen, err := FindGenerator("fixtures/config.yaml", "cpp")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := gen.(*doxygen); ok {
+		t.Fatal("The custom cpp language is not used")
+	}
+	// Only the const values and constexpr are the constants
+	for raw, expected := range map[string]bool{
+		"const double":     true,
+		"constexpr double": true,
+		"const <ref refid=\"structgeo_1_1Point\" kindref=\"compound\">Point</ref>": true,
+		"const char *":  false,
+		"constexpr_t":   false,
+		"my_const_iter": false,
+		"int":           false,
+	} {
+		if doxyConstant(raw) != expected {
+			t.Fatalf("Wrong constant type %s: %v", raw, !expected)
+		}
+	}
+	// The overloads have the distinct anchors
+	namespaces := Normalize(doxygenFixture(t, "cpp", "fixtures/_cpp/xml").Classes)
+	var anchors []string
+	for _, cls := range namespaces["geo"] {
+		for _, method := range cls.Methods {
+			if method.Name == "add" {
+				anchors = append(anchors, method.Anchor)
+			}
+		}
+	}
+	if strings.Join(anchors, ",") != "classgeo_1_1Box-add,classgeo_1_1Box-add-2" {
+		t.Fatalf("Wrong anchors of the overloads: %v", anchors)
+	}
+	// The constructor overloads too, distinct from the class anchor
+	anchors = nil
+	for _, cls := range namespaces["geo"] {
+		for _, ctor := range cls.Constructors {
+			anchors = append(anchors, ctor.Anchor)
+		}
+	}
+	if strings.Join(anchors, ",") != "classgeo_1_1Box-Box,classgeo_1_1Box-Box-2" {
+		t.Fatalf("Wrong anchors of the constructors: %v", anchors)
+	}
+	html := must(t)(RenderHTML(RenderOptions{}, namespaces, nil))
+	for _, anchor := range []string{`<h2 id="classgeo_1_1Box-add-2">`, `<h2 id="classgeo_1_1Box-Box-2">Constructor`} {
+		if !strings.Contains(html, anchor) {
+			t.Fatalf("HTML output doesn't have the anchor of the overload %s:\n%s", anchor, html)
+		}
+	}
+}
+
+func TestJSDoc(t *testing.T) {
+	p := Project{Inputs: []ProjectInput{{Lang: "js", JSDocJSON: []string{"fixtures/_js/doclets.json"}}}}
+	doc, err := p.Parse()
+	if err != nil {
+		t.Fatal(err)
+	}
+	xml := must(t)(RenderXML(doc))
+	data, err := os.ReadFile("fixtures/JS.xml")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if xml != string(data) {
+		t.Fatalf("XML output doesn't match. Expected:\n%s\nGot:\n%s\n", data, xml)
+	}
+
+	// The doclets are the JSON array
+	_, err = ReadJSDocJSON("fixtures/Foo.json")
+	var e *Error
+	if !errors.As(err, &e) || e.Phase != PhaseParse {
+		t.Fatalf("Expected the parse error, got %v", err)
+	}
+}
+
+func TestDoxygenXML(t *testing.T) {
+	p := Project{Inputs: []ProjectInput{{Lang: "cpp", DoxygenXML: []string{"fixtures/_cpp/xml"}}}}
+	doc, err := p.Parse()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(doc.Classes) != 4 || len(doc.Namespaces) != 1 || doc.Classes[0].Language != "cpp" {
+		t.Fatalf("Unexpected Doxygen XML result: %d classes, %d namespaces", len(doc.Classes), len(doc.Namespaces))
+	}
+
+	// Only the Doxygen-backed languages read the Doxygen XML
+	p.Inputs[0].Lang = "go"
+	_, err = p.Parse()
+	var e *Error
+	if !errors.As(err, &e) || e.Phase != PhaseConfig {
+		t.Fatalf("Expected the config error, got %v", err)
+	}
+
+	// The missing compound files are reported with the file
+	_, err = ReadDoxygenXML("fixtures")
+	if !errors.As(err, &e) || e.Phase != PhaseSource || e.File != filepath.Join("fixtures", "index.xml") {
+		t.Fatalf("Expected the source error, got %v", err)
+	}
+}
+
+func TestEmptyIntermediate(t *testing.T) {
+	gen, err := FindGenerator("fixtures/config.yaml", "kotlin")
+	if err != nil {
+		t.Fatal(err)
+	}
+	gens := []Generator{gen}
+	for _, name := range GeneratorNames() {
+		gens = append(gens, generators[name]())
+	}
+	for _, gen := range gens {
+		if _, err = gen.GenClasses(nil); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+func TestHierarchy(t *testing.T) {
+	gen, err := FindGenerator("fixtures/config.yaml", "kotlin")
+	if err != nil {
+		t.Fatal(err)
+	}
+	classes, err := gen.GenClasses([]byte(`
+/**
+ * Class: geo::Shape
+ */
+/**
+ * Method: area
+ */
+/**
+ * Class: geo::Rect
+ * @extends Shape
+ * @implements Drawable, Serializable
+ */
+/**
+ * Method: draw
+ */
+/**
+ * Class: Drawable
+ */
+/**
+ * Method: draw
+ */
+/**
+ * Method: paint
+ */
+`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	rect := classes[1]
+	if strings.Join(rect.Extends, ",") != "Shape" || strings.Join(rect.Implements, ",") != "Drawable,Serializable" {
+		t.Fatalf("Unexpected bases: %v %v", rect.Extends, rect.Implements)
+	}
+
+	namespaces := Normalize(classes)
+	InheritMembers(namespaces)
+	shape, rect, drawable := namespaces["geo"][0], namespaces["geo"][1], namespaces["Global"][0]
+	if rect.Bases[0] != (ClassRef{"Shape", shape.Ref}) || rect.Interfaces[1] != (ClassRef{Name: "Serializable"}) {
+		t.Fatalf("Unexpected resolved bases: %v %v", rect.Bases, rect.Interfaces)
+	}
+	if len(shape.Derived) != 1 || shape.Derived[0].Ref != rect.Ref {
+		t.Fatalf("Unexpected derived classes: %v", shape.Derived)
+	}
+	inherited := fmt.Sprint(rect.Inherited)
+	if inherited != "[{{Shape geoShape} [] [area]} {{Drawable GlobalDrawable} [] [paint]}]" {
+		t.Fatalf("Unexpected inherited members: %s", inherited)
+	}
+
+	hierarchy := ClassHierarchy(namespaces)
+	if len(hierarchy) != 2 || hierarchy[0].Class.Ref != drawable.Ref || hierarchy[1].Children[0].Class.Ref != rect.Ref {
+		t.Fatalf("Unexpected hierarchy: %v", hierarchy)
+	}
+	html := must(t)(RenderHTML(RenderOptions{}, namespaces, nil))
+	for _, expected := range []string{
+		"<h1>Class Hierarchy</h1>",
+		"<p>Extends: <a href=\"#geoShape\">Shape</a></p>",
+		"<p>Implements: <a href=\"#GlobalDrawable\">Drawable</a>, Serializable</p>",
+		"<h2>Inherited from <a href=\"#GlobalDrawable\">Drawable</a></h2>",
+	} {
+		if !strings.Contains(html, expected) {
+			t.Fatalf("HTML output doesn't have %s", expected)
+		}
+	}
+	md := must(t)(RenderMarkdown("API", namespaces, nil, false))
+	if !strings.Contains(md, "Derived classes: [Rect](#geoRect)") {
+		t.Fatalf("Markdown output doesn't have the derived classes:\n%s", md)
+	}
+}
+
+func TestKinds(t *testing.T) {
+	namespaces := Normalize(parseFixtures(t, "swift"))
+	var titles []string
+	for _, group := range groupByKind(append(namespaces["Global"], Class{Name: "Node", Kind: "union"})) {
+		titles = append(titles, group.Title)
+	}
+	if strings.Join(titles, ",") != "Classes,Protocols,Enums,Unions" {
+		t.Fatalf("Wrong kind groups: %v", titles)
+	}
+
+	html := must(t)(RenderHTML(RenderOptions{}, namespaces, nil))
+	for _, expected := range []string{
+		"<h3>Protocols</h3>",
+		"<h1 id=\"GlobalDirection\">Enum Direction</h1>",
+		"<tr id=\"GlobalDirection-north\">\n  <td>north</td>\n  <td>&#34;N&#34;</td>",
+	} {
+		if !strings.Contains(html, expected) {
+			t.Fatalf("HTML output doesn't have %s", expected)
+		}
+	}
+	md := must(t)(RenderMarkdown("API", namespaces, nil, false))
+	if !strings.Contains(md, "### Protocol Drawable") || !strings.Contains(md, "| south |  |  |") {
+		t.Fatalf("Markdown output doesn't have the kinds:\n%s", md)
+	}
+}
+
+func TestNamespaceMembers(t *testing.T) {
+	p := Project{Conf: "fixtures/config.yaml", Inputs: []ProjectInput{{Lang: "swift", Src: []string{"fixtures/"}}}}
+	doc, err := p.Parse()
+	if err != nil {
+		t.Fatal(err)
+	}
+	direction := doc.Classes[len(doc.Classes)-1]
+	if direction.Name != "Direction" || len(direction.Values) != 2 {
+		t.Fatalf("The function doesn't end the class: %v", direction)
+	}
+	ns := doc.Namespaces[0]
+	if ns.Name != "Global" || ns.Language != "swift" || len(ns.Functions) != 1 || len(ns.Constants) != 1 {
+		t.Fatalf("Wrong namespace members: %v", doc.Namespaces)
+	}
+	if param := ns.Functions[0].Parameters[0]; param.Name != "value" || ns.Functions[0].Description != "Creates the bar." {
+		t.Fatalf("Wrong function: %v", ns.Functions[0])
+	}
+
+	members := NormalizeNamespaces(append(doc.Namespaces, Namespace{
+		Name:      "util::io",
+		Variables: []Property{{Name: "verbose", Type: "bool"}},
+	}))
+	namespaces := Normalize(doc.Classes)
+	html := must(t)(RenderHTML(RenderOptions{}, namespaces, members))
+	for _, expected := range []string{
+		"<h1 id=\"ns-Global\">Global namespace</h1>",
+		"<h2 id=\"ns-Global-makeBar\">Function makeBar(value)</h2>",
+		"<tr id=\"ns-util.io-verbose\">",
+		"<h2>util.io namespace</h2>",
+	} {
+		if !strings.Contains(html, expected) {
+			t.Fatalf("HTML output doesn't have %s", expected)
+		}
+	}
+	md := must(t)(RenderMarkdown("API", namespaces, members, false))
+	if !strings.Contains(md, "### Function makeBar(value)") || !strings.Contains(md, "### Variables") {
+		t.Fatalf("Markdown output doesn't have the namespace members:\n%s", md)
+	}
+	pdf := must(t)(RenderPDF("API", namespaces, members))
+	if !strings.Contains(pdf, "/Title (util.io namespace)") {
+		t.Fatal("PDF output doesn't have the members-only namespace")
+	}
+	dir := t.TempDir()
+	if err = RenderSite(RenderOptions{}, namespaces, members, dir); err != nil {
+		t.Fatal(err)
+	}
+	page, err := os.ReadFile(filepath.Join(dir, namespacePage("util.io")))
+	if err != nil || !strings.Contains(string(page), "verbose") {
+		t.Fatalf("Site doesn't have the namespace members page: %v", err)
+	}
+}
+
+func TestThrows(t *testing.T) {
+	doc := doxygenFixture(t, "cpp", "fixtures/_cpp/xml")
+	namespaces := Normalize(doc.Classes)
+	box := namespaces["geo"][2]
+	throws := box.Constructors[1].Throws
+	if box.Name != "Box<T>" || len(throws) != 1 || throws[0].Type != "std::invalid_argument" {
+		t.Fatalf("Wrong Doxygen exceptions: %v", box.Constructors)
+	}
+	html := must(t)(RenderHTML(RenderOptions{}, namespaces, nil))
+	if !strings.Contains(html, "<h3>Throws</h3>") || !strings.Contains(html, "If the capacity is negative.") {
+		t.Fatal("HTML output doesn't have the exceptions")
+	}
+	md := must(t)(RenderMarkdown("API", namespaces, nil, false))
+	if !strings.Contains(md, "| std::invalid_argument | If the capacity is negative. |") {
+		t.Fatalf("Markdown output doesn't have the exceptions:\n%s", md)
+	}
+
+	classes := parseFixtures(t, "swift")
+	method := classes[0].Methods[1]
+	if len(method.Throws) != 1 || method.Throws[0].Type != "BarError" || method.Throws[0].Description != "If the value is empty." {
+		t.Fatalf("Wrong custom exceptions: %v", method)
+	}
+}
+
+func TestExamples(t *testing.T) {
+	p := Project{Conf: "fixtures/config.yaml", Inputs: []ProjectInput{{Lang: "swift", Src: []string{"fixtures/"}}}}
+	doc, err := p.Parse()
+	if err != nil {
+		t.Fatal(err)
+	}
+	method := doc.Classes[0].Methods[1]
+	if len(method.Examples) != 1 || method.Examples[0].Language != "swift" ||
+		!strings.Contains(method.Examples[0].Code, "{\n    print(text)\n}") || method.Description != "Instance method." {
+		t.Fatalf("Wrong custom examples: %v", method)
+	}
+	namespaces := Normalize(doc.Classes)
+	html := must(t)(RenderHTML(RenderOptions{}, namespaces, nil))
+	if !strings.Contains(html, "<span class=\"adx-keyword\">let</span> text") {
+		t.Fatal("HTML output doesn't have the highlighted examples")
+	}
+	md := must(t)(RenderMarkdown("API", namespaces, nil, false))
+	if !strings.Contains(md, "```swift\nif let text") {
+		t.Fatalf("Markdown output doesn't have the examples:\n%s", md)
+	}
+
+	cpp := doxygenFixture(t, "cpp", "fixtures/_cpp/xml")
+	examples := cpp.Classes[2].Methods[0].Examples
+	if len(examples) != 1 || examples[0].Language != "cpp" || examples[0].Code != "Box<int> box;\nint index = box.add(42); // 0" {
+		t.Fatalf("Wrong Doxygen examples: %v", examples)
+	}
+	code := highlight(examples[0].Code, examples[0].Language)
+	if code != "Box&lt;<span class=\"adx-keyword\">int</span>&gt; box;\n<span class=\"adx-keyword\">int</span> index = "+
+		"box.add(<span class=\"adx-number\">42</span>); <span class=\"adx-comment\">// 0</span>" {
+		t.Fatalf("Wrong highlighting: %s", code)
+	}
+}
+
+func TestAnnotations(t *testing.T) {
+	p := Project{Conf: "fixtures/config.yaml", Inputs: []ProjectInput{{Lang: "swift", Src: []string{"fixtures/"}}}}
+	doc, err := p.Parse()
+	if err != nil {
+		t.Fatal(err)
+	}
+	bar := doc.Classes[0]
+	if bar.Properties[0].Deprecated != "Use defaultValue." || bar.Properties[0].Description != "Static property." {
+		t.Fatalf("Wrong deprecated property: %v", bar.Properties[0])
+	}
+	if bar.Methods[0].Since != "1.2" || doc.Classes[1].Stability != "experimental" {
+		t.Fatalf("Wrong since and stability: %v, %v", bar.Methods[0], doc.Classes[1])
+	}
+	html := must(t)(RenderHTML(RenderOptions{}, Normalize(doc.Classes), nil))
+	for _, expected := range []string{
+		"<td>STATIC_PROP <span class=\"adx-badge adx-deprecated\">deprecated</span></td>",
+		"<p class=\"adx-deprecated\">Deprecated: Use defaultValue.</p>",
+		"<span class=\"adx-badge adx-since\">since 1.2</span>",
+		"<span class=\"adx-badge adx-experimental\">experimental</span>",
+	} {
+		if !strings.Contains(html, expected) {
+			t.Fatalf("HTML output doesn't have %s", expected)
+		}
+	}
+
+	cpp := doxygenFixture(t, "cpp", "fixtures/_cpp/xml")
+	if cpp.Classes[0].Since != "1.1" || cpp.Classes[2].Methods[3].Deprecated != "Use the size of the box." {
+		t.Fatalf("Wrong Doxygen annotations: %v", cpp.Classes)
+	}
+	hidden := HideDeprecated(doc)
+	if len(hidden.Classes[0].Properties) != 0 || len(hidden.Classes[0].Methods) != 2 {
+		t.Fatalf("Deprecated members aren't hidden: %v", hidden.Classes[0])
+	}
+	// Only the rendered outputs hide them
+	p.HideDeprecated = true
+	dir := t.TempDir()
+	for _, output := range []string{"api.xml", "api.md"} {
+		if err = p.Render(doc, ProjectOutput{Path: filepath.Join(dir, output)}); err != nil {
+			t.Fatal(err)
+		}
+		content, err := os.ReadFile(filepath.Join(dir, output))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if strings.Contains(string(content), "STATIC_PROP") != (output == "api.xml") {
+			t.Fatalf("Wrong deprecated members in %s:\n%s", output, content)
+		}
+	}
+}
+
+func TestSources(t *testing.T) {
+	p := Project{Conf: "fixtures/config.yaml", Inputs: []ProjectInput{{Lang: "swift", Src: []string{"fixtures/"}}}}
+	doc, err := p.Parse()
+	if err != nil {
+		t.Fatal(err)
+	}
+	bar := doc.Classes[0]
+	if bar.File != "fixtures/Bar.swift" || bar.Line != 5 || bar.Methods[1].Line != 40 {
+		t.Fatalf("Wrong custom source locations: %v", bar)
+	}
+	cpp := doxygenFixture(t, "cpp", "fixtures/_cpp/xml")
+	if box := cpp.Classes[2]; box.File != "fixtures/_cpp/geometry.hpp" || box.Line != 31 || box.Methods[0].Line != 51 {
+		t.Fatalf("Wrong Doxygen source locations: %v", box)
+	}
+
+	namespaces := Normalize(doc.Classes)
+	LinkSources(namespaces, nil, "https://git.example/{path}#L{line}")
+	html := must(t)(RenderHTML(RenderOptions{}, namespaces, nil))
+	for _, expected := range []string{
+		"Class Bar <a class=\"adx-source\" href=\"https://git.example/fixtures/Bar.swift#L5\">source</a></h1>",
+		"<td>STATIC_PROP <span class=\"adx-badge adx-deprecated\">deprecated</span> <a class=\"adx-source\" href=\"https://git.example/fixtures/Bar.swift#L12\">source</a></td>",
+	} {
+		if !strings.Contains(html, expected) {
+			t.Fatalf("HTML output doesn't have %s", expected)
+		}
+	}
+	md := must(t)(RenderMarkdown("API", namespaces, nil, false))
+	if !strings.Contains(md, "[source](https://git.example/fixtures/Bar.swift#L40)") {
+		t.Fatalf("Markdown output doesn't have the source links:\n%s", md)
+	}
+	// The source paths are relative to the source root, the ones outside of it are absolute
+	gen := generators["cpp"]()
+	gen.SetSourceRoot("fixtures/_cpp")
+	content, err := ReadDoxygenXML("fixtures/_cpp/xml")
+	if err != nil {
+		t.Fatal(err)
+	}
+	classes, err := gen.GenClasses(content)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if classes[2].File != "geometry.hpp" {
+		t.Fatalf("Wrong source path relative to the root: %s", classes[2].File)
+	}
+	abs, err := filepath.Abs("fixtures/Foo.kt")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if path := sourcePath("fixtures/_go", "fixtures/Foo.kt"); path != filepath.ToSlash(abs) {
+		t.Fatalf("The source path outside of the root isn't absolute: %s", path)
+	}
+	if url := sourceURL("https://git.example/{path}#L{line}", "Foo.kt", 0); url != "https://git.example/Foo.kt" {
+		t.Fatalf("Wrong source URL without the line: %s", url)
+	}
+}
+
+func TestPDF(t *testing.T) {
+	classes := parseFixtures(t, "kotlin")
+	pdf := must(t)(RenderPDF("Kotlin", Normalize(classes), nil))
+	if !strings.HasPrefix(pdf, "%PDF-1.4") || !strings.HasSuffix(pdf, "%%EOF\n") {
+		t.Fatal("PDF output is malformed")
+	}
+	for _, bookmark := range []string{"/Title (Global namespace)", "/Title (Foo)", "/Title (FooA)"} {
+		if !strings.Contains(pdf, bookmark) {
+			t.Fatalf("PDF output doesn't have the %s bookmark", bookmark)
+		}
+	}
+	// The document information is the indirect object
+	info := regexp.MustCompile(`/Info (\d+) 0 R >>\nstartxref`).FindStringSubmatch(pdf)
+	if info == nil || !strings.Contains(pdf, info[1]+" 0 obj\n<< /Title (Kotlin) /Producer (adx) >>\nendobj\n") {
+		t.Fatal("PDF output doesn't have the document information object")
+	}
+}
+
+func TestSiteLinks(t *testing.T) {
+	namespaces := map[string][]Class{
+		"com.example": {{Name: "Foo", Ref: "classFoo"}},
+	}
+	resolve := siteFuncs(namespaces)["resolve"].(func(template.HTML) template.HTML)
+	link := resolve(getText("<ref refid=\"classFoo\" kindref=\"compound\">Foo</ref>"))
+	if link != "<a href=\"classFoo.html\">Foo</a>" {
+		t.Fatalf("Class link isn't resolved: %s", link)
+	}
+	link = resolve(getText("<ref refid=\"classBar\" kindref=\"compound\">Bar</ref>"))
+	if link != "<a href=\"#classBar\">Bar</a>" {
+		t.Fatalf("Unknown class link is changed: %s", link)
+	}
+}
+
+func TestSearchIndex(t *testing.T) {
+	classes := parseFixtures(t, "swift")
+	index := buildSearchIndex(Normalize(classes), nil, classPage, namespacePage)
+	expected := map[string]string{
+		"Bar":            "class",
+		"STATIC_PROP":    "property",
+		"staticMethod":   "method",
+		"instanceMethod": "method",
+		"value":          "parameter",
+		"Drawable":       "protocol",
+		"Direction":      "enum",
+		"north":          "value",
+	}
+	for _, entry := range index {
+		if kind, ok := expected[entry.Name]; ok && kind == entry.Kind {
+			delete(expected, entry.Name)
+		}
+	}
+	if len(expected) != 0 {
+		t.Fatalf("Search index doesn't have the entries: %v", expected)
+	}
+	if index[0].URL != "GlobalBar.html#GlobalBar" {
+		t.Fatalf("Wrong class URL: %s", index[0].URL)
+	}
+
+	// The namespace members link to the namespace page
+	p := Project{Inputs: []ProjectInput{{Lang: "go", Src: []string{"fixtures/_go"}}}}
+	doc, err := p.Parse()
+	if err != nil {
+		t.Fatal(err)
+	}
+	urls := map[string]string{}
+	for _, entry := range buildSearchIndex(Normalize(doc.Classes), NormalizeNamespaces(doc.Namespaces), classPage, namespacePage) {
+		urls[entry.Kind+" "+entry.Name] = entry.URL
+	}
+	for entry, url := range map[string]string{
+		"function Sum":    "ns-geometry.html#ns-geometry-Sum",
+		"constant Metric": "ns-geometry.html#ns-geometry-Metric",
+	} {
+		if urls[entry] != url {
+			t.Fatalf("Wrong URL of %s: %s", entry, urls[entry])
+		}
+	}
+}
+
+func TestMarkdown(t *testing.T) {
+	namespaces := Normalize([]Class{{
+		Name: "Shape",
+	}, {
+		Name:        "Rectangle",
+		Description: "Rectangle | square",
+		Methods: []Method{{
+			Name:    "bounds",
+			Returns: Returns{Type: "<a href=\"#GlobalShape\">Shape</a>"},
+		}},
+	}})
+	md := must(t)(RenderMarkdown("Shapes", namespaces, nil, true))
+	for _, expected := range []string{
+		"---\ntitle: \"Shapes\"\n---\n",
+		"| [Rectangle](#GlobalRectangle) | class | Rectangle \\| square |",
+		"#### Method [Shape](#GlobalShape) bounds()",
+		"| [Shape](#GlobalShape) |  |",
+	} {
+		if !strings.Contains(md, expected) {
+			t.Fatalf("Markdown output doesn't contain %q:\n%s", expected, md)
+		}
+	}
+}
+
+func TestJSON(t *testing.T) {
+	classes := parseFixtures(t, "kotlin")
+	json := must(t)(RenderJSON(AdxResult{Classes: classes}))
+	data, err := os.ReadFile("fixtures/Foo.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if json != string(data) {
+		t.Fatalf("JSON output doesn't match. Expected:\n%s\nGot:\n%s\n", data, json)
+	}
+
+	combined := combine(t, nil, "fixtures/Foo.json")
+	xml := must(t)(RenderXML(AdxResult{Classes: combined}))
+	data, err = os.ReadFile("fixtures/Foo.xml")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if xml != string(data) {
+		t.Fatalf("XML output from JSON doesn't match. Expected:\n%s\nGot:\n%s\n", data, xml)
+	}
+}
+
+func TestLegacyXML(t *testing.T) {
+	combined := combine(t, nil, "fixtures/Legacy.xml")
+	xml := must(t)(RenderXML(AdxResult{Classes: combined}))
+	data, err := os.ReadFile("fixtures/Foo.xml")
+	if err != nil {
+		t.Fatal(err)
+	}
+	// The legacy documents have no source locations
+	data = regexp.MustCompile(`<file>.*</file>`).ReplaceAll(data, []byte("<file></file>"))
+	data = regexp.MustCompile(`<line>\d+</line>`).ReplaceAll(data, []byte("<line>0</line>"))
+	if xml != string(data) {
+		t.Fatalf("Migrated XML output doesn't match. Expected:\n%s\nGot:\n%s\n", data, xml)
+	}
+}
+
+func TestSchema(t *testing.T) {
+	for file, schema := range map[string][]byte{
+		"schema/adx.xsd":         RenderXSD(),
+		"schema/adx.schema.json": RenderJSONSchema(),
+	} {
+		data, err := os.ReadFile(file)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if string(data) != string(schema) {
+			t.Fatalf("%s is outdated, please regenerate it with the -schema flag", file)
+		}
+	}
+}
+
+func TestValidation(t *testing.T) {
+	for doc, expected := range map[string]string{
+		"<adx version=\"2\">\n<classes><title>Foo</title></classes></adx>":    "[input] line 2: unknown element <title> in <classes>",
+		"<adx version=\"2\"><classes><name><b>Foo</b></name></classes></adx>": "[input] line 1: unexpected element <b> in the text element <name>",
+		"<adx version=\"2\"><classes>Foo</classes></adx>":                     "[input] line 1: unexpected text in <classes>",
+	} {
+		if err := validateXML([]byte(doc)); err == nil || err.Error() != expected {
+			t.Fatalf("Expected the validation error %q, got %v", expected, err)
+		}
+	}
+	for doc, expected := range map[string]string{
+		"{\"classes\": []}": "no version field",
+		"{\"version\": 2, \"classes\": [{\"name\": \"Foo\", \"methods\": [{\"returns\": []}]}]}": "$.classes[0].methods[0].returns: expected an object",
+		"{\"version\": 2, \"classes\": [{\"name\": 1}]}":                                         "$.classes[0].name: expected string",
+	} {
+		if _, err := validateJSON([]byte(doc)); err == nil || err.Error() != expected {
+			t.Fatalf("Expected the validation error %q, got %v", expected, err)
+		}
+	}
+}
+
+func TestTemplateOverrides(t *testing.T) {
+	classes := parseFixtures(t, "kotlin")
+	opts := RenderOptions{
+		Title:    "Kotlin",
+		Template: "fixtures/theme",
+		Vars:     map[string]string{"footer": "(C) Example"},
+	}
+	html := must(t)(RenderHTML(opts, Normalize(classes), nil))
+	for _, expected := range []string{
+		"<style>body { color: #333; }",
+		"<h2 class=\"method\">METHOD1</h2>",
+		"(C) Example",
+	} {
+		if !strings.Contains(html, expected) {
+			t.Fatalf("HTML output doesn't contain %q", expected)
+		}
+	}
+}
+
+func TestProject(t *testing.T) {
+	p, err := LoadProject("fixtures/adx.yaml")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if p.Conf != "fixtures/config.yaml" || p.Outputs[0].Path != "fixtures/build/api.html" {
+		t.Fatalf("Project paths aren't resolved: %s, %s", p.Conf, p.Outputs[0].Path)
+	}
+	if p.Outputs[1].Format != "md" {
+		t.Fatalf("Wrong output format: %s", p.Outputs[1].Format)
+	}
+	// The format chooses between the file and the directory
+	for _, output := range []struct {
+		ProjectOutput
+		expected string
+	}{
+		{ProjectOutput{Path: "api.PDF"}, "pdf"},
+		{ProjectOutput{Path: "docs"}, "html dir"},
+		{ProjectOutput{Path: "docs.v2", Format: "html"}, "html dir"},
+		{ProjectOutput{Path: "api.md", Format: "md"}, "md"},
+		{ProjectOutput{Path: "docs.v2", Format: "md"}, "md dir"},
+		{ProjectOutput{Path: "api", Format: "json"}, "json"},
+	} {
+		format, dir, err := output.format()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if dir {
+			format += " dir"
+		}
+		if format != output.expected {
+			t.Fatalf("Wrong format of %s: %s", output.Path, format)
+		}
+	}
+	_, _, err = ProjectOutput{Path: "report.htm"}.format()
+	var e *Error
+	if !errors.As(err, &e) || e.Phase != PhaseConfig {
+		t.Fatalf("Expected the config error, got %v", err)
+	}
+	file := filepath.Join(t.TempDir(), "adx.yaml")
+	if err = os.WriteFile(file, []byte("outputs:\n  - path: api.pdf\n    format: pdff\n"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	_, err = LoadProject(file)
+	if !errors.As(err, &e) || e.Phase != PhaseConfig || e.File != file {
+		t.Fatalf("Expected the config error, got %v", err)
+	}
+	doc, err := p.Parse()
+	if err != nil {
+		t.Fatal(err)
+	}
+	var names []string
+	for _, cls := range doc.Classes {
+		names = append(names, cls.Name)
+	}
+	if strings.Join(names, ",") != "Foo,FooA,Bar,Drawable,Direction" {
+		t.Fatalf("Wrong project classes: %v", names)
+	}
+	// The source paths are relative to the project file dir
+	if p.SourceRoot != "fixtures" || doc.Classes[0].File != "Foo.kt" {
+		t.Fatalf("Wrong source path: %s, %s", p.SourceRoot, doc.Classes[0].File)
+	}
+}
+
+func TestMixedLanguages(t *testing.T) {
+	p := Project{Conf: "fixtures/config.yaml", Inputs: []ProjectInput{
+		{Lang: "kotlin", Src: []string{"fixtures/"}, Exclude: []string{"theme"}},
+		{Lang: "swift", Src: []string{"fixtures/"}},
+	}}
+	doc, err := p.Parse()
+	if err != nil {
+		t.Fatal(err)
+	}
+	var tags []string
+	for _, cls := range doc.Classes {
+		tags = append(tags, cls.Name+":"+cls.Language)
+	}
+	if strings.Join(tags, ",") != "Foo:kotlin,FooA:kotlin,Bar:swift,Drawable:swift,Direction:swift" {
+		t.Fatalf("Wrong class languages: %v", tags)
+	}
+	if langs := languages(Normalize(doc.Classes)); strings.Join(langs, ",") != "kotlin,swift" {
+		t.Fatalf("Wrong languages: %v", langs)
+	}
+}
+
+func TestKeepGoing(t *testing.T) {
+	p := Project{In: []string{"fixtures/Invalid.xml", "fixtures/Missing.xml", "fixtures/Bar.xml"}}
+	_, err := p.Parse()
+	var e *Error
+	if !errors.As(err, &e) || e.Phase != PhaseInput || e.File != "fixtures/Invalid.xml" || e.Line != 3 {
+		t.Fatalf("Wrong input error: %v", err)
+	}
+
+	var reported []string
+	p.KeepGoing = true
+	p.Report = func(err error) {
+		reported = append(reported, err.Error())
+	}
+	doc, err := p.Parse()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(doc.Classes) != 3 || len(reported) != 2 {
+		t.Fatalf("Wrong keep-going result: %v, %v", doc.Classes, reported)
+	}
+	if reported[0] != "[input] fixtures/Invalid.xml:3: unknown element <title> in <classes>" {
+		t.Fatalf("Wrong reported error: %s", reported[0])
+	}
+
+	p = Project{Inputs: []ProjectInput{{Lang: "cobol"}}}
+	if _, err = p.Parse(); !errors.As(err, &e) || e.Phase != PhaseConfig {
+		t.Fatalf("Wrong unknown language error: %v", err)
+	}
+
+	// The structured errors are copied with the wrapping messages
+	inner := &Error{Phase: PhaseParse, Line: 3, Err: errors.New("boom")}
+	err = withContext(fmt.Errorf("reading the module: %w", inner), PhaseSource, "a.py")
+	if inner.File != "" || err.Error() != "[parse] a.py:3: reading the module: boom" || !errors.Is(err, inner) {
+		t.Fatalf("Wrong error context: %v", err)
+	}
+}
+
+func TestExcludes(t *testing.T) {
+	for rel, expected := range map[string]bool{
+		"src/test/Foo.kt":    true,
+		"src/main/Foo.kt":    false,
+		"src/main/Foo.swift": true,
+		"gen/Foo.kt":         true,
+		"src/gen/Foo.kt":     false,
+	} {
+		if isExcluded(rel, []string{"test", "*.swift", "gen/*"}) != expected {
+			t.Fatalf("Wrong exclusion of %s", rel)
+		}
+	}
+}
+
+func TestRichDescriptions(t *testing.T) {
+	blocks := parseMarkdown("The `code` and **strong**\ntext.\n\n1. *first*\n2. [link](https://example.com)\n\n```go\n  x := 1\n```")
+	if len(blocks) != 3 || blocks[1].Kind != "ordered-list" || len(blocks[1].Blocks) != 2 || blocks[2].Code != "x := 1" {
+		t.Fatalf("Wrong Markdown blocks: %v", blocks)
+	}
+	if spans := blocks[0].Spans; len(spans) != 5 || spans[1].Kind != "code" || spans[3].Kind != "strong" || spans[4].Text != "\ntext." {
+		t.Fatalf("Wrong Markdown spans: %v", spans)
+	}
+	if text := blocksText(blocks); text != "The code and strong\ntext.\n\n1. first\n2. link\n\nx := 1" {
+		t.Fatalf("Wrong plain text: %q", text)
+	}
+	unsafe := richHTML(parseMarkup(`<p>The <a href="javascript:alert(1)">link</a> &amp; <b>text</b></p>`, nil))
+	if unsafe != "<p>The link &amp; <strong>text</strong></p>" {
+		t.Fatalf("Wrong HTML of the unsafe link: %s", unsafe)
+	}
+	for _, href := range []string{" javascript:alert(1)", "java&#9;script:alert(1)", "\x01JavaScript:alert(1)"} {
+		if link := richHTML(parseMarkup(`<a href="`+href+`">link</a>`, nil)); link != "<p>link</p>" {
+			t.Fatalf("Wrong HTML of the unsafe link %q: %s", href, link)
+		}
+	}
+	if md := newMarkdown(nil, nil).spans([]Span{{Kind: "link", Text: "link", URL: "\tjavascript:alert(1)"}}); md != "link" {
+		t.Fatalf("Wrong Markdown of the unsafe link: %s", md)
+	}
+
+	p := Project{Conf: "fixtures/config.yaml", Inputs: []ProjectInput{{Lang: "swift", Src: []string{"fixtures/"}}}}
+	doc, err := p.Parse()
+	if err != nil {
+		t.Fatal(err)
+	}
+	draw := doc.Classes[1].Methods[0]
+	if len(draw.Details) != 3 || draw.Details[2].Kind != "list" || !strings.HasPrefix(draw.Description, "Draws the type.\n\nUses the context") {
+		t.Fatalf("Wrong custom rich description: %v", draw)
+	}
+	if doc.Classes[0].Details != nil {
+		t.Fatalf("The plain descriptions should have no details: %v", doc.Classes[0].Details)
+	}
+	cpp := doxygenFixture(t, "cpp", "fixtures/_cpp/xml")
+	distance := cpp.Namespaces[0].Functions[0]
+	if len(distance.Details) != 4 || distance.Description != "Computes the distance." ||
+		distance.Details[3].Spans[1].URL != "#structgeo_1_1Point" {
+		t.Fatalf("Wrong Doxygen rich description: %v", distance.Details)
+	}
+	js, err := Project{Inputs: []ProjectInput{{Lang: "js", JSDocJSON: []string{"fixtures/_js/doclets.json"}}}}.Parse()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if details := js.Namespaces[0].Functions[0].Details; len(details) != 3 || details[1].Spans[1].Text != "Math.hypot" {
+		t.Fatalf("Wrong JSDoc rich description: %v", details)
+	}
+
+	namespaces := Normalize(doc.Classes)
+	html := must(t)(RenderHTML(RenderOptions{}, namespaces, nil))
+	if !strings.Contains(html, "<p>Uses the <code>context</code> of the <strong>current</strong> view:</p><ul><li>the <em>bounds</em> of the <a href=\"#GlobalBar\">Bar</a>,</li>") {
+		t.Fatalf("HTML output doesn't have the rich description:\n%s", html)
+	}
+	md := must(t)(RenderMarkdown("API", namespaces, nil, false))
+	if !strings.Contains(md, "Uses the `context` of the **current** view:\n\n- the *bounds* of the [Bar](#GlobalBar),\n") {
+		t.Fatalf("Markdown output doesn't have the rich description:\n%s", md)
+	}
+	pdf := must(t)(RenderPDF("API", namespaces, nil))
+	if !strings.Contains(pdf, "/S /URI /URI (https://developer.apple.com/documentation/coregraphics)") ||
+		!strings.Contains(pdf, "/BaseFont /Helvetica-Oblique") {
+		t.Fatalf("PDF output doesn't have the rich description")
+	}
+}
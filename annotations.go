@@ -0,0 +1,82 @@
+package adx
+
+import "strings"
+
+// annotationText describes the deprecation, the since version and the
+// stability in the plain text (e.g. Deprecated: use area. Since 1.2.)
+func annotationText(deprecated string, since string, stability string) string {
+	var texts []string
+	switch deprecated {
+	case "":
+	case "true":
+		texts = append(texts, "Deprecated.")
+	default:
+		texts = append(texts, "Deprecated: "+strings.TrimSuffix(deprecated, ".")+".")
+	}
+	if since != "" {
+		texts = append(texts, "Since "+since+".")
+	}
+	if stability != "" {
+		texts = append(texts, "Stability: "+stability+".")
+	}
+	return strings.Join(texts, " ")
+}
+
+// annotationFields are the deprecated, since and stability fields of the
+// class, method or property
+type annotationFields struct {
+	deprecated, since, stability *string
+}
+
+func classAnnotations(cls *Class) *annotationFields {
+	return &annotationFields{&cls.Deprecated, &cls.Since, &cls.Stability}
+}
+
+func methodAnnotations(method *Method) *annotationFields {
+	return &annotationFields{&method.Deprecated, &method.Since, &method.Stability}
+}
+
+func propertyAnnotations(property *Property) *annotationFields {
+	return &annotationFields{&property.Deprecated, &property.Since, &property.Stability}
+}
+
+func hideDeprecatedMethods(methods []Method) []Method {
+	var result []Method
+	for _, method := range methods {
+		if method.Deprecated == "" {
+			result = append(result, method)
+		}
+	}
+	return result
+}
+
+func hideDeprecatedProperties(props []Property) []Property {
+	var result []Property
+	for _, prop := range props {
+		if prop.Deprecated == "" {
+			result = append(result, prop)
+		}
+	}
+	return result
+}
+
+// HideDeprecated removes the deprecated classes, members and namespace members
+func HideDeprecated(doc AdxResult) AdxResult {
+	result := AdxResult{Version: doc.Version}
+	for _, cls := range doc.Classes {
+		if cls.Deprecated != "" {
+			continue
+		}
+		cls.Constructors = hideDeprecatedMethods(cls.Constructors)
+		cls.Methods = hideDeprecatedMethods(cls.Methods)
+		cls.Properties = hideDeprecatedProperties(cls.Properties)
+		result.Classes = append(result.Classes, cls)
+	}
+	for _, ns := range doc.Namespaces {
+		ns.Functions = hideDeprecatedMethods(ns.Functions)
+		ns.Constants = hideDeprecatedProperties(ns.Constants)
+		ns.Variables = hideDeprecatedProperties(ns.Variables)
+		result.Namespaces = append(result.Namespaces, ns)
+	}
+	return result
+}
@@ -1,8 +1,16 @@
-// Code generated for package main by go-bindata DO NOT EDIT. (@generated)
+// Code generated for package adx by go-bindata DO NOT EDIT. (@generated)
 // sources:
+// data/cpp.doxyfile
 // data/default.html
 // data/java.doxyfile
-package main
+// data/partials.html
+// data/search.js
+// data/site/class.html
+// data/site/index.html
+// data/site/layout.html
+// data/site/namespace.html
+// data/style.css
+package adx
 
 import (
 	"bytes"
@@ -78,7 +86,27 @@ func (fi bindataFileInfo) Sys() interface{} {
 	return nil
 }
 
-var _dataDefaultHtml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x56\x41\x4f\xe3\x3a\x10\xbe\xf3\x2b\xe6\x85\x1e\x40\x6a\x6b\x15\x2e\x4f\xc5\xcd\xa5\xbc\xe3\xdb\x45\x2c\x97\x3d\xba\xf5\x84\x44\x9b\xd8\x91\x3d\x48\xa0\x2a\xff\x7d\x65\xc7\x4e\xdc\xd2\x22\xd0\x1e\xd8\x95\xf6\xd4\x78\x66\xfc\xcd\x37\xdf\x7c\x89\xca\xff\xb9\xfd\xba\x7e\xf8\x7e\xf7\x1f\x94\xd4\xd4\xf9\x19\x77\x3f\x50\x0b\xf5\xb8\xca\x50\x65\xf9\x19\x00\x2f\x51\x48\xf7\x00\xc0\x1b\x24\x01\xdb\x52\x18\x8b\xb4\xca\x9e\xa8\x98\xfd\x9b\x01\x0b\x49\xaa\xa8\xc6\x7c\xb7\x83\xf9\x83\x7b\x82\xae\xe3\xac\x8f\xf5\x79\x4b\x2f\xf1\x19\x80\xca\x29\x90\x84\x1d\xb4\x42\xca\x4a\x3d\xce\x6a\x2c\x68\x09\x0b\x6c\x6e\x60\xa3\x8d\x44\x33\xdb\x68\x22\xdd\x2c\x61\xd1\x3e\x83\xd5\x75\x25\xe1\x5c\x4a\x79\x03\x5d\x0f\xc7\x06\x3c\xce\x22\x45\xbe\xd1\xf2\x25\xb4\x2b\x17\xf9\xba\x16\xd6\xa2\xe5\xac\x5c\xf4\xc1\xdd\x0e\x8c\x50\x8f\x08\x13\x65\xa7\x30\xd9\xf6\x79\x58\xae\x60\xfe\x45\x34\x68\x5b\xb1\x45\x0b\x5d\xe8\x50\x5e\xb9\x69\x26\xca\x45\x40\xc5\x3c\x67\xe5\x55\x68\x21\xeb\x43\xd8\x88\x18\x21\x24\xe5\x5c\x40\x69\xb0\x58\x65\xe7\x4e\x9a\x7b\x2c\xa0\xeb\x32\x2f\x93\x6b\xe9\x55\x12\x39\x67\x92\x22\xa8\xf4\xc9\x5b\xb4\x5b\x53\xb5\x54\x69\xe5\x6b\xa4\x1c\x7a\xa1\x92\x43\x03\x96\x70\x08\xf1\x8f\x4f\x7a\x92\xbf\x1b\xdf\x87\x3c\xd5\x78\x79\x14\xc8\x0c\x5a\x43\x25\x57\x59\x3a\xa0\xd7\xfe\x00\xc0\xcd\x11\x57\xc1\xdb\x7c\x20\xb2\x84\x41\x67\xce\xda\x21\x7f\x4c\x86\x36\x1f\xe6\xab\x0a\x98\xdf\x19\xdd\xa2\xa1\x6a\x7f\x6b\x63\x34\x59\x16\x89\xcd\x68\x3f\x4e\xde\x32\x9c\x4c\xce\xa9\xf4\x4c\x38\xa3\xd2\x1f\x1e\x5e\xda\xf1\x90\xf4\xef\x63\xcc\x5d\x61\x34\xbe\x14\x0e\x6c\xb4\xdd\x9e\x9c\x47\xe8\xf5\xf5\x66\x2c\x76\x47\xb9\x6f\x07\x92\xc7\xd2\x8e\xd6\x1b\xe9\x43\xa1\xd2\x2a\x4f\x3a\xa5\x97\x38\xc8\x67\x93\xd7\x86\x25\x3a\x9d\xf2\xd4\x7c\xad\x95\x25\xf3\xb4\x25\x6d\xf6\x84\x4f\xe2\xaf\x56\x7f\x11\x30\x66\xd1\x6b\x95\x92\xf8\x3c\x85\x09\xd6\xd8\xa0\x22\x6f\xaf\x3b\x61\x44\x83\x84\x1e\xb6\xdf\x71\x5f\x07\x5d\x37\x1d\xf9\x38\xec\x70\x2d\xca\x16\x72\xb3\xc0\xe6\x32\xd9\xfc\x49\x27\xa5\x46\x4a\x1b\x87\x79\xae\xf3\x31\xca\x59\x79\xfd\x99\x46\x7a\x45\xef\x0f\x35\xd2\x9b\xb6\xfa\x1f\xa9\xd4\x72\xcf\x51\x7d\x28\x2c\xe9\x1e\xe9\xc9\x28\x1b\x27\x80\xfe\x7b\xb3\x17\x1b\xb0\x21\x51\xe2\x37\xb4\xde\x5f\xef\x7d\xde\x47\xac\x2a\x40\x69\x1a\xad\xf3\xed\x47\xd5\xa6\xca\x87\xf8\x3b\x65\xff\x65\xa5\x4f\x28\x79\x60\xec\x53\x92\xc5\xb2\xf7\x4a\xf7\x11\xa1\x8e\x1f\x0f\x4f\x9c\x45\x40\x5e\x68\x4d\x18\x5a\x5d\xac\x2f\xa1\x11\x96\xd0\x6c\x85\x91\xbe\x2e\xa6\x39\xeb\xff\x6d\xfe\x0c\x00\x00\xff\xff\x43\x58\x50\x81\x7e\x0a\x00\x00")
+var _dataCppDoxyfile = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xe4\xbd\x6d\x77\x1b\x37\x96\x3f\xf8\x9e\x9f\x02\x1b\xf6\x9e\x48\x19\x8a\xb2\x9d\xa4\x4f\xe2\x19\xcf\x36\x4d\xd1\x36\xbb\x25\x52\x21\x29\x27\x9e\xbf\xff\xcd\x03\xb2\x40\x12\xe3\x62\x15\x53\xa8\x92\xcc\xee\x93\xfd\xec\x7b\x7e\x17\x17\x28\x54\xb1\x28\xc9\x8e\xd3\x73\x76\xd7\x2f\x2c\x89\xac\x02\x70\x1f\x70\x71\x9f\xd1\x16\x17\xe9\xc7\xfd\x4a\xc7\x4a\x3c\xed\xfe\xd0\x7d\xfa\x63\xab\xd5\x16\xb3\x8d\x36\x82\x3e\x8b\x94\x59\x66\x7a\xa1\x8c\xc8\x37\x4a\x18\x95\xe7\x3a\x59\x1b\x91\xa7\x62\xa1\x44\x61\x54\x24\x16\x7b\xfa\x2a\x4a\x97\xc5\x56\x25\xb9\xcc\x75\x9a\x08\xb3\x37\xb9\xda\xb6\xda\x22\x4a\x3f\xee\xd7\x2a\x11\x27\x77\x77\x77\x5d\xfe\xa3\x9b\x66\xeb\x53\xb1\x4a\x33\x21\xc5\x2e\x4b\xff\x5b\x2d\xf3\x6e\xab\xdd\x6a\x8b\x5e\x1c\x8b\x5c\x7d\xcc\x85\x5c\xe5\x0a\xdf\x46\x69\xb1\x88\x95\xd8\x48\xb3\x11\x27\xed\xf6\xa9\xd0\x46\x2c\xd3\xc4\xe8\x48\x65\x2a\x12\x52\x2c\xd3\x2d\x26\x15\x32\x89\xf0\xdd\x2e\x96\x4b\x15\x09\x9d\xb4\xda\x62\x95\xa5\x49\x2e\xd2\x15\xad\x6e\xd6\x7b\x2d\x74\x4e\x8f\x64\x6a\xa9\x22\x9d\xac\x8f\x4c\x69\x74\xb2\x2e\xa7\xbc\x7f\xc6\x3b\x1d\xc7\xc0\x83\x5e\x27\x69\xa6\xa2\x2e\x61\x4e\x01\xb2\xad\xc4\x64\xcf\xf1\x41\xef\xb5\x78\x21\x6e\x65\x5c\x28\xf1\xbf\xe8\x47\x47\x74\xbb\xdd\xff\xdd\x6a\x8b\x57\x69\x26\x62\x6d\x72\xd3\x11\x3a\x57\x5b\x23\x96\x32\x11\x32\x36\x84\x5b\xb9\xdb\xa9\x24\x52\x91\x28\xb0\x22\x37\xd2\xbf\x1d\x19\xea\x2d\xfe\x02\x8d\x64\x8e\xe5\xe6\x52\x27\xc2\xec\xe4\x52\x19\x61\x36\x69\x11\x47\x18\x92\xb1\xb3\x50\xf9\x9d\x52\x89\xf8\xb5\x48\x73\x65\xc4\xc9\xfb\xaf\xc4\xfb\xaf\x4e\xbb\xad\x56\xfb\xec\xcb\xfd\x6b\xb5\xc5\xb5\x25\xad\xc8\x54\x2c\x73\x15\x61\x5d\x2b\xbd\x2e\x32\xcb\x21\xe9\x0e\x3f\xcc\x97\x9d\xd4\xb1\x6e\x2e\xd7\xc2\xec\xd4\x52\xaf\x34\x73\xae\x4a\x96\x29\xa8\x6e\x79\x96\x78\x2f\x8e\xc5\x72\x23\x33\xb9\xcc\x55\x66\x84\x4e\xe8\xb9\xca\x22\xc1\x45\xd8\x04\x84\xd6\x55\x1a\xc7\xe9\x5d\x97\x28\x1c\xa9\x95\x2c\x62\x90\x58\xdc\xcc\x5e\x9d\xfd\x20\xee\x36\x7a\xb9\xc1\x9f\x44\xbe\xa3\x13\xb6\xda\x96\xbf\x17\x6a\x95\x66\x18\x57\x89\x95\xce\x4c\x2e\xd2\xe5\xb2\xc8\x32\x95\x2c\x95\xe5\x58\x0b\x43\x97\xf6\x26\xb6\x4f\x61\x94\x11\xb1\x5e\xe8\x65\x9a\xdc\x8a\x93\x34\xc3\xbb\xad\xb6\xb0\x7f\x2f\x0a\x8d\xc5\x24\x79\x8a\x67\x96\x76\x6f\x61\xf0\x3c\x93\x89\xb1\xeb\xe8\x8a\xa9\x52\xad\xb6\xd8\xe4\xf9\xce\x3c\x3f\x3f\xc7\x7e\x5c\x27\x05\xf6\xe2\xb9\x49\x57\xf9\x9d\xcc\xd4\xb9\x9b\xe1\xdc\x8f\x00\xfe\xc4\x92\x76\xa9\x31\x7a\x11\x97\x70\x19\xc7\xed\x0e\x17\x96\x2f\xb5\x79\x6e\x51\xd2\x6d\xb5\x2e\xc6\xbf\xbc\x7b\x35\xbc\x1c\xcc\x07\xa3\xfe\xf8\x62\x38\x7a\x2d\xe8\xdf\x0b\xfb\x40\x8b\xdf\xbf\x9e\x8c\xff\x3a\xe8\xcf\xe6\xa3\xde\xd5\x00\x40\x13\x16\xdd\x36\xbc\x4b\xb3\x88\xc0\x95\xc2\xa8\x5f\x0b\x87\x20\x7c\x6c\x84\x29\xb2\x2c\x2d\x68\x93\x2c\xf6\x24\x6b\x20\x2d\xce\x2c\x5f\x77\x44\x91\xc4\xca\x18\xb1\x4f\x0b\x21\x33\x08\x2b\x90\x1f\x08\xbd\xd3\xff\x90\x59\x74\x6a\x77\x0b\x6f\x0f\x1d\xa9\x24\xd7\xab\x3d\xe3\x95\xc5\x12\xa1\xc1\xd2\xf6\x50\xc6\x69\x23\xd6\x2a\x51\x19\x98\x1b\x6c\xa1\x8d\x48\xe4\x16\x28\xb0\x34\xb7\x1c\x05\x92\xeb\x3c\x26\xba\x6e\x53\x93\x97\xef\x88\x9d\x5c\x2b\x63\x45\x57\x22\xa4\x58\xa9\x3b\x91\xe6\x1b\x95\xd9\x8d\x7a\x0f\x82\xaf\xf6\x6e\x73\x75\x5b\xad\x0a\xfe\xca\x7f\x2f\x0e\xf0\x7b\x73\xf5\x72\x30\x21\x0c\x43\xce\x38\xf1\x9d\xa7\x42\x25\xb9\x0a\x64\xb1\x48\x33\x91\xa9\x5b\x6d\x00\x64\x52\x6c\x17\x2a\xb3\xe0\xb5\xda\x62\xe9\x64\xc9\x46\x26\xd1\x9e\xd0\x23\xb3\xe5\x46\xdf\x02\xb7\x40\x51\x09\x5d\x15\x59\x69\x26\xf4\x4a\x98\x74\xab\xc4\xad\xca\x30\x34\x8d\x96\xe4\x59\x1a\xf3\x69\xe1\x10\x17\xc2\x64\xd7\xec\xfe\x11\x4c\x37\xc6\xcd\xe5\x9e\x7a\x39\x19\x0e\x5e\x11\x60\x69\xa2\x48\x88\xee\xb2\xf4\x56\x47\x4a\x48\x27\x67\x64\x4c\xdf\xc5\x3a\x71\x47\xda\xce\x6d\xf0\xf0\x18\xb2\x2c\x01\xd1\x2b\x33\x23\x64\x4e\xd3\xe4\xe9\x0e\xc4\x53\x72\xb9\x21\x92\x11\xc5\x98\x6d\xd6\xfa\x56\x89\x5b\xad\xee\x80\xc0\x56\x5b\xfc\x5a\xe8\xe5\x07\xa1\x23\x25\x85\x5c\xa4\x85\x1d\x60\x57\x64\xbb\xd4\xf0\xce\x56\x6e\xae\xae\xf8\x9b\x52\x3b\xfa\x24\x58\x11\xc4\x75\x16\x92\xd5\x02\xe7\xff\x11\x0a\x7e\xd6\xf9\xa6\x82\x81\xcb\xf1\xeb\x71\x05\x01\x56\xf8\xed\x85\x14\x71\xba\x4e\x41\x51\x99\x90\xb0\xb0\x10\x6a\xc8\xbb\x65\x5c\x44\x2a\x82\x10\x49\x0e\xb9\x1b\x14\x57\x62\x2b\x3f\xea\x6d\xb1\x15\x1b\xa5\xd7\x1b\x7f\x98\xd2\x90\x8c\x80\x24\xcd\x85\xfa\xb8\x54\x2a\x12\xdf\x7f\xdf\x6a\x8b\x9d\xfe\xa8\x62\xcb\xd4\x79\x30\xc0\x9d\x8e\xf2\x4d\xc3\x3b\xcf\x9e\x3c\xe1\x57\x4a\x59\x47\xa7\xea\x32\xdd\x61\x4b\xfb\xe9\xf2\x94\xa6\x4e\x8b\x7c\x57\xe4\x22\xd2\x99\x5a\xe6\x69\xb6\x0f\x10\x45\x38\xa8\x21\x0a\x30\x8c\x6f\x66\xd7\x37\xb3\xf9\xc5\x70\x32\xe8\xcf\xc6\x93\x77\x4e\xc6\x38\xee\x77\xa8\xc2\xf0\x27\x74\x56\x81\xa6\xc0\xd8\xc2\xa4\x71\x91\xab\x53\xb1\x93\xf9\x86\xf0\x94\xa7\x2c\xe9\xef\x63\x75\xa7\x15\xdc\x65\x3a\xcf\x55\xd2\x15\xc3\x95\x90\xc2\x8f\x8c\xc1\x04\x6d\x26\xda\x77\x2a\xc2\xe9\xef\x5f\xf2\x8f\x31\xc0\x71\xba\xe4\x51\x37\x2a\x53\x5e\x9d\xba\x93\x46\x98\x5c\x66\x24\x7a\x86\xab\x56\x5b\xc4\x6a\x95\x8b\x45\x2c\x93\x0f\xf4\x9e\x3d\x4e\x02\x4c\xf9\x19\x78\x8b\x1d\xa0\x85\xd1\x46\x87\x49\x74\x1e\xa5\x4b\x03\x56\x1b\xe2\x34\x52\xa2\x3f\x19\xf4\x66\x83\xf9\xf4\xe6\xe5\xc5\x70\x32\x75\x28\x34\x2a\x07\x06\xdf\x0d\xa6\x78\x28\x29\x57\x87\xa9\x96\x99\x92\xb9\x12\xdf\x3d\xf9\xf1\xcf\xc2\x14\x8b\xb3\x56\xdb\x2f\x06\x87\xf2\x89\x4e\xc4\x33\x11\xab\x5b\x15\x9b\x53\x01\x29\x9e\x35\x52\xd8\x6f\x3d\xfe\x82\x95\x2b\x99\x80\x73\x09\xa6\x48\x9b\x3c\xd3\x8b\x22\x57\x35\x09\x04\x05\xd6\x88\xf4\xd6\x8e\x6c\x54\x38\x7f\x57\x0c\x12\xb9\x88\xad\x28\x21\x6a\xa4\x76\xff\x95\xb2\x71\x55\xc4\xe2\x0e\x60\xad\x14\x29\x8c\x1e\x3c\x29\x36\xc5\x5a\x09\xb9\x4d\x8b\x84\x36\x85\x49\x8b\x6c\x89\xa3\x3c\xc6\xb9\x43\x94\xc2\x56\x28\x48\x59\x16\x32\x8e\x0f\x16\xc5\x5b\xce\xc8\x6d\xb9\xaa\xbd\xb8\xa3\x2d\x45\x67\xc0\x9d\x36\x10\x65\x38\xf7\x31\x94\xca\x08\x6e\x1c\x7f\xbb\x2c\x5d\xc4\xd0\x16\xdd\x11\x8d\x69\x59\x84\x1e\x3f\x32\x46\xe3\x6e\xab\x55\xa3\xa2\xdf\x2a\x62\x34\x0e\x68\xdd\xbb\xbc\x1c\xff\x3c\xbf\x19\x0d\xfb\xe3\x8b\x01\x1d\xcb\x0d\x04\xef\x54\x89\x2d\xa1\x1b\x89\x24\x4d\xce\x7a\xd3\xfe\x70\xd8\x6a\x87\xaa\x55\x9e\xb2\x48\x75\x92\x06\xc7\xa4\x01\xe2\x6a\x68\xa1\xad\xc2\x73\x8c\xc6\x9d\x63\xe3\x39\x4e\x56\x66\x29\x77\xd8\x3f\xc0\x84\xfa\x28\xb7\xbb\x58\x89\xf9\xc7\xc1\xb7\xf3\x8f\x3f\x3c\x9d\x7f\xfc\xe1\xbb\x0a\xcf\xd3\x61\x75\x93\xe8\x65\x1a\xe1\x84\xbf\xf9\xb7\x6f\x9f\x7c\xf7\xdd\x03\x08\x6b\x42\x45\x80\xb0\x40\xbc\x5c\xf6\x46\xaf\x6f\x7a\xaf\x07\xf7\x49\x97\x58\x26\xeb\x02\x07\x88\x4e\x58\x8c\x58\x95\xb0\x2a\x3e\x4a\x9c\x2c\xf6\x1e\xc9\xda\x94\xe2\xa4\x22\x27\x0b\xa3\x1c\x03\xeb\x84\x78\x84\xb8\x38\x4f\xfd\x38\xc4\x7f\x30\x9a\x72\x09\x6e\xb5\xbb\x88\x09\xb1\xcb\xd2\x9d\xca\xfc\xc2\x80\x8d\x6b\xa7\xe8\x11\x2a\x0c\x54\xa7\xe7\xa2\xb7\xca\xf4\x07\x29\x13\xd3\x11\xbd\x4c\x2e\xf4\x12\x3f\xb7\x2a\xd1\x32\xe9\x88\x97\x99\xfc\x87\x8e\xe9\xd7\xbe\xcc\x65\x4c\xbf\x6c\x74\xa2\x8c\xea\xb4\xda\xee\xd7\xb3\x59\x26\x23\x8d\xc5\xc9\xb8\x23\xfa\x59\x2a\x73\xfb\xca\x3f\xd4\x72\xd3\x11\x17\x32\xd1\x06\x3f\x8b\x1c\x7f\x0e\x92\x75\xac\x61\xf4\xdd\x24\x1a\xa8\x98\xe6\x32\x57\xe6\x14\xe3\x0d\xcc\x4e\x65\x32\xc9\xd3\x8e\x78\x25\x33\xa3\xc5\xc9\x35\x14\x0a\x99\x9c\x76\xc4\x2b\x9d\xd8\x61\x5e\x41\x97\xde\x74\xc4\x6b\x85\x5d\xd3\x11\xaf\x33\xa5\x3e\x74\xc4\x9b\x22\x59\xcb\x0c\xf3\x82\xdd\x93\x28\x4d\x14\xde\xec\x88\x61\x2e\x2d\x04\x7f\x95\x3b\x89\xe5\x96\xbf\x9d\xc1\x7a\x75\x7f\x88\x3b\x1c\xbd\x6e\x75\x5b\x65\x0c\x74\x38\x5a\xd7\xdf\xd2\x4c\x61\x04\xfb\x93\xde\xb2\xbf\x1e\x7b\x47\x5c\xca\xfc\x96\x26\xbd\xd4\xf9\xa6\x90\x09\xaf\xeb\x0a\x56\x5a\x4a\x7f\x89\x51\x9a\xdd\xa9\x35\xfd\xca\x50\x8a\x13\x82\xfa\xb4\x23\xae\x53\xa0\x08\x3f\xb3\xbc\x58\x17\xb4\xe8\x49\xba\xb5\xe3\x88\x49\x61\x0c\x0f\x38\x55\xd9\x02\xbf\xba\x5f\xce\xfa\xfb\x4c\xc7\x31\xa8\x38\x8d\xd3\x5b\xf9\xc1\xfe\x54\x89\xea\x88\xe9\x8e\x09\x31\xbd\x53\x11\xfd\x32\x2b\xb2\x0f\xf8\x05\x7b\xe6\x43\x26\x35\x86\xa7\xf3\xfd\xad\x56\x39\xed\x64\x75\x7c\x17\x31\xd4\xe5\x79\xe3\xf7\x89\x97\x3d\xfc\x48\x6d\x3f\xcd\x06\xbf\xb8\xc3\x69\x38\x1e\xdd\xb7\xab\xac\xf8\x04\xd3\xff\xeb\xb7\x55\x39\x68\xe3\xbe\xf2\x4b\x3b\xba\xb1\x46\x29\x90\x7e\x39\x9b\x74\xc4\x64\x76\x49\x68\xed\xa7\x09\x6c\xc4\x7b\x24\x53\x9a\xa8\x12\xa1\x35\x44\x41\x36\xa5\x89\x0a\xc4\x39\x29\x90\xf3\xab\x01\x34\xe9\xf9\xc5\x60\xda\x7f\x50\x98\xb3\x6a\x28\x16\x99\x56\x2b\xb1\x55\xb0\x00\x5a\xed\x50\x4b\x35\xec\x2e\x01\xac\xf6\x7b\xf6\x3d\xc0\xd0\x82\xc9\xe8\xcd\x1f\x3a\xef\x08\xae\x65\x2c\x8d\x39\x20\xcb\x89\xd1\x5b\x1d\xcb\x0c\x54\xfd\xab\xbc\x95\x51\xba\x3c\x85\xa9\xca\x67\x00\xfe\x8f\xb4\x91\x10\x48\x10\x73\xc7\xb1\xf2\x6e\x30\xed\xb6\x5a\x87\xc0\x32\x97\xbd\x1b\x4c\x03\x9c\x4c\x06\xd7\x83\x5e\x68\x38\xdc\x87\x8e\x5d\xa6\xe0\x8a\xa1\x17\x09\x25\x55\x5c\xe0\x1c\x93\x8c\x05\x28\xda\xab\x22\x21\x9a\x87\x46\x7e\xa4\x72\xa9\x63\x15\x85\xef\x91\xfb\x69\x94\xe6\xea\x39\x4e\xbd\x45\x9a\x6f\xc4\x9b\xe1\xc5\x60\x7e\x33\xba\x18\xf7\x19\x84\x29\x61\xee\x10\x28\xa0\x99\xd7\x3b\x1a\x77\xd8\xcc\xa4\xb5\x85\x33\x94\x27\xe5\x32\xc5\xd9\x98\xab\x78\x2f\x4c\xb1\xdb\x65\xca\x18\x15\x3d\x84\xcb\x0a\x92\x78\xbb\x86\xb8\xf4\x9e\x16\x8d\xc1\x41\x52\x18\xee\xbf\x16\xd2\xe8\x33\x9d\xe4\x2a\x8e\xf5\x1a\x2a\xe8\xc1\xba\x84\x5c\x2c\x60\x60\xca\x9c\x34\x18\xb2\x47\x5a\x6d\xbf\xb9\xb1\xdd\x00\x92\x75\x95\xe8\x44\xdc\xca\x4c\xa7\x05\xfc\x1f\x06\x0a\x95\xe9\x8a\x01\x6c\x31\x68\x7d\xc9\xda\xf2\x99\xb6\xdf\x76\x60\x64\xae\xe0\x13\x68\xb5\x85\x04\x4f\x2a\x11\x2b\x49\xda\x1b\x8d\xc6\x16\xcc\xc1\x92\x3a\x1e\x55\x18\x75\xb7\x83\xa2\x96\xa5\xe5\x32\x5a\x6d\x6f\xd2\x64\xca\x14\x71\xde\xe1\x1d\xb0\xcb\xd2\xa5\x32\xde\x24\xbd\xdb\xa4\xb1\x72\x4b\x61\x79\xc5\xeb\x90\x49\x92\xe2\x14\x8b\xd8\x09\xd4\x15\x63\xa7\xe8\x75\x9a\x17\x15\x8c\x70\xa6\xad\x72\x54\x6a\xf9\x8e\xea\xd6\x2d\x85\xf9\x4b\xb9\x62\xe7\x3d\xf9\x93\x73\x47\xc8\x22\x4f\xb7\x32\xd7\x4b\x19\xc7\x7b\x91\x29\x76\x04\xde\x39\x33\x92\x9e\x4b\x57\x6c\x6f\xc1\x11\x92\xef\x4f\x9f\x63\x9f\xd9\x21\x68\xeb\x76\x44\xf9\xc1\x9d\x8e\xd6\x2a\x0f\x3f\xc1\x36\x07\xc8\x1d\x67\x7b\x1b\x9c\x3c\xde\x07\xd7\x71\x5e\x49\xd3\xc1\xfc\x99\x32\x60\x97\x8e\x90\x1d\xc1\xa7\x49\xbe\x81\x50\xeb\xbd\x7c\x39\x19\xbc\x1d\xc2\xd6\x08\xf9\xee\x45\xb0\x77\x7b\x97\x3f\xf7\xde\x4d\xe7\x17\x83\x59\x6f\x78\x39\xb8\x98\x4f\x07\x7d\xa2\x4d\x7d\x4f\x93\x84\xb5\xfb\xaa\xdc\xda\x00\x37\x09\xdc\xd2\x77\x3a\x90\xe2\x42\x96\x1b\xd5\xf0\x99\xa2\x6e\xa1\x7d\xd1\xcc\x19\x04\x8d\x48\x93\x18\x66\x75\x83\x20\x78\x50\x97\x3c\x5c\xf7\xa1\xf2\x3d\x1c\x5d\x0e\x47\x83\xf9\x70\xf4\x66\x30\x19\xce\x06\x17\x24\x08\x1e\x14\x51\x66\x93\xde\xf1\xa9\xa7\x93\x8d\xca\x48\x67\x72\x82\x99\xe4\x13\x91\xd0\xc9\xe4\xaa\x08\xa6\x3d\x01\xbf\x31\x3d\x22\x8d\x05\x37\x35\xe0\x2e\x37\xc6\x1d\x8c\xcf\x34\x8b\x74\x22\xb3\x3d\x3f\xc9\xdf\x75\x45\x1f\xca\x65\x56\xc0\x86\x31\x1d\x08\x20\xf7\x07\xd1\x45\x1a\xa3\xd7\x09\xa6\x6b\xb5\x05\x8e\x45\x6c\x7b\xe3\x7c\x09\x0b\x69\x98\xbf\x14\x8b\x2b\x78\x07\xb0\x0f\x37\xe9\xdd\x43\x28\x6d\x46\x56\x1d\xa5\xaf\x6e\x2e\x2f\xe7\xd7\xbd\xd9\x9b\x47\xda\x32\xa1\xbc\x5f\x15\xf8\xc0\x9a\xff\x2c\xcf\xc1\xe9\xce\xd3\x17\x1c\x71\xd8\xf3\xce\x97\x87\x0f\x37\x4a\xc2\xa4\x6d\xb0\x6b\x78\xe3\x92\xa3\x47\x99\x9c\x86\xb7\x14\xd8\xca\x0f\xec\xae\xc6\x6b\x76\x8e\x22\xd1\xbf\x16\xca\x8b\x27\xec\xec\xa3\x48\xb1\x67\x60\x1d\xde\x43\xb9\xad\xc4\x74\x36\x19\x5e\xcf\x5f\x4d\xc6\x57\xf4\x64\x93\x57\x90\x04\xa1\x90\x90\x25\xd9\x59\xa4\x56\x3a\x21\x97\x65\xe6\x85\x28\xd6\x0d\x02\x4d\xf1\xe0\x0e\x12\xc8\x6d\x0f\xe8\xd5\xe0\x22\xfc\xe0\x87\x9d\x24\x88\x58\x6c\x1b\xb1\x95\xf9\x72\xc3\xe0\x42\xac\x9d\xc1\xa5\x08\x5b\xb7\x3e\x05\x01\xdb\xb4\x40\x30\x7d\xc5\xad\x62\x0e\x28\x82\xf5\x55\xc4\x66\xa0\x32\xc2\xe2\x26\x21\x6f\x6d\x31\xc7\x03\xda\x88\xac\x48\xea\xd2\x1b\x2b\x11\x79\x0a\xba\x01\xdc\xae\x3f\xbd\x2d\xe5\xe0\x64\xae\xf8\xdd\xd8\x77\xc4\xcb\x82\x47\xa0\x23\x16\x45\x6e\xc3\x3b\xd5\x45\xc3\x63\xa0\x97\x9b\x56\xfb\xd0\x09\xe4\xcf\xa0\xc0\x47\x50\xf1\x03\xe9\xd2\x0d\x14\x9e\xc8\x99\xfa\xb5\xd0\x99\x0b\x08\xe5\x8c\xbf\x3a\x63\x54\x36\x41\xb7\xd5\xaa\x33\x85\x63\x9c\x06\xa6\x19\x8e\xfa\x9f\xcd\x38\x44\xe3\x1c\x96\x50\x02\x11\xab\xa2\xa3\x62\x89\xc5\x16\x23\x48\x40\xa5\x30\x7c\x06\xd3\xe6\x72\x78\x0b\xf6\x1a\xa0\x71\xfa\xab\x86\xdb\x19\xcf\xe5\x29\x16\xe3\xa4\x60\xed\x20\xb5\x2c\x7b\x78\x0c\x86\x83\xf2\xe1\xe5\x4e\x79\x5a\x15\xf6\x9f\x4e\x74\x78\x50\x07\x47\x3a\x79\x62\xad\xbf\xb3\xd5\xae\x7a\x01\x38\xa2\xe2\x56\x69\x39\xc4\x6b\xcf\x09\x6c\x78\x1c\xd3\x3b\x69\x18\xa1\x34\x65\xba\xdd\xe9\x98\xf4\xf0\xc2\x6b\x1b\x67\x43\xb1\x8a\xe5\xba\x4a\x3a\x4f\x9a\xda\xb9\x39\x7d\x33\x9e\xcc\x1e\x29\x02\xfd\x89\xb8\x2d\xa0\x68\x91\xa4\xca\xc4\xc9\xa2\xc8\xc9\xd3\x68\x0c\x51\x00\x5a\xf9\x69\x29\xaa\x0c\x07\x41\x4a\x76\x80\x07\x4d\x53\x04\x86\xf1\x68\xbd\x54\x46\x44\xa9\x32\xc9\xd7\x18\x0c\xea\x68\x9a\xe5\x22\x4e\x93\x35\xfb\x85\x62\xfd\x41\x89\x34\x11\x17\xe3\x69\x47\x5c\xc9\x65\x07\x5a\x75\xff\xe2\x6c\x32\xbe\x7a\xe0\x3c\x08\x41\x0c\xfe\xd5\xce\x83\xbf\xf6\xde\xf6\xa0\x62\xf7\x6e\x66\xe3\x23\x16\x40\x83\x3b\x13\x1a\x6d\xb6\xcb\xf0\x08\x89\x6f\x1b\xb7\xa3\x80\xc2\x49\x91\xe4\x3a\x66\xc1\x83\x4f\xa3\x34\x3f\x05\xb3\x4b\x67\xd2\x9c\x99\x7c\x1f\xab\x32\x76\x6c\x8e\x19\x13\x75\x0f\x18\x1e\xab\x8e\xc1\x32\x62\x23\x6f\x95\xf8\xef\x82\x96\xf0\x01\x1b\x62\x5d\xc0\x8a\xfa\x29\x87\x9f\xb5\x32\x9b\x11\x27\xf9\xa6\x30\x2c\x13\xc0\x3a\x32\x11\xea\xe3\x2e\xd6\x4b\x9d\x8b\xbf\xd0\x22\x68\x65\x38\xbc\xe0\x23\x6b\x56\x6e\x4e\xef\x47\xfd\x21\x52\xab\x87\x4e\x0d\xf7\x2f\x7b\xa3\xd1\x60\xf2\x89\x88\x97\x84\x6e\xc0\x07\xae\x94\x30\x26\xcf\xbf\xa9\xfe\x83\x9e\x6e\xc4\x42\xb9\x1d\xb2\x50\x6b\xb8\x84\x92\xf5\x7d\xe4\xf8\x6a\x21\x93\x44\x65\x5f\x35\x20\xbf\xd5\xfe\x24\xf4\x7b\x94\x03\x97\xce\xab\x6f\x15\x9a\x56\xbb\x84\xa4\xe2\x84\xe8\x3e\x0e\xb1\x8c\xb1\x23\x3c\xfd\xd3\xec\xf7\xb0\xb3\x65\xdb\x56\xfb\x41\x76\xfe\x29\xaf\xa1\x4e\x9a\x66\xd3\x85\x83\x12\x55\x64\x8a\x9f\xf2\x47\x61\xb1\x3e\x0b\x73\x70\xab\x7d\x84\x87\xdf\x1f\xe5\xe1\x4f\xe1\xe0\x0a\x0a\xc5\x01\xa2\x41\xa2\xab\x9b\xcb\xd9\x90\x54\xce\xfe\xf5\xf5\x7c\x38\x2d\x8d\x0d\x77\x06\x86\x38\x4f\x49\x9f\xf3\x68\xcf\x11\x0f\xa1\x20\xe1\xb6\x88\x73\x7d\x06\x5e\x16\xfd\x7f\xfb\x37\x7b\x38\xc8\xd8\xe3\x74\x11\xa7\xcb\x0f\xe2\x44\x77\x55\x17\x86\x06\xfd\x95\xae\xc4\xf9\xf9\xff\x01\x41\x78\x7e\x7e\xee\xf1\x72\x6a\x37\x41\x13\xb0\x56\x14\xbb\x23\x79\xe1\xdc\x10\x96\xc5\x08\xf3\x3a\xa5\xb0\xae\x12\x89\xba\xf3\xdf\x90\x21\x8e\xd0\x93\x5d\x6c\x7d\xa9\xd5\x25\x4a\x13\x5a\x4c\x95\xd9\xc9\x81\x63\xfd\x67\x10\xae\x8c\x12\xbd\xc2\x59\x20\x76\x99\x5a\xb9\x68\x0f\x45\x93\xed\x6a\x84\x4e\x4c\xae\x64\x54\xd7\xad\x38\xe9\xc8\x67\x45\xb8\xd1\x48\x95\xda\x2a\x99\xf0\xe9\x69\x13\x4b\x64\x2c\x32\x04\x5b\x1d\x8e\x70\xa8\xb6\xda\xb4\x09\x33\xb5\x4c\xd7\x89\xfe\x07\x2c\xea\x64\x2f\xb6\x69\x76\x8f\xe7\x92\x36\xdf\x11\x82\xd7\x36\x1f\xdb\x1f\xf3\x8b\x71\xbf\xe1\x68\xc5\x23\x30\x71\x11\xe3\x62\x05\xc7\x1b\x67\xce\x5a\x33\x2c\x6b\xaa\x1a\x10\x69\x7f\x32\xd9\x8b\xc3\xf7\x08\x60\x0d\x90\xce\x4a\xef\xcb\x71\x60\xac\x7e\x57\x59\xa7\x10\x07\x86\x01\x83\x33\x1d\x5c\xf7\x26\xb0\xc0\xd9\xe3\x74\xdd\x7b\x3d\x98\x3e\x4a\xa8\xec\xb2\x34\x2a\x96\x4a\x48\xf0\x14\xa9\x79\x6b\xca\x86\xb2\x01\x3c\x6b\x2f\x36\x9d\x70\x55\xb0\x43\x7f\x1a\x0b\x0a\x32\x0a\x60\x3e\xae\x58\x2c\xc5\xea\x9c\x94\xb0\x73\x52\x19\x90\xe8\x54\xc9\x7d\x32\x42\xdf\xe7\x40\x05\x69\x9b\xa1\xac\x6c\xf7\x59\xef\xe5\x7c\x3a\xfc\xaf\x41\xa3\x92\xcb\x92\xd3\xe6\x47\x60\x61\x9c\x6e\x45\xa9\x1c\xb9\x5c\x78\x87\x32\x69\x6c\xa4\x8a\x6b\xc3\x8b\xc8\x53\xe7\x84\x11\xb9\x5c\x18\x1c\x06\xe5\xdb\x88\x4f\x89\x55\x26\xd7\x9e\xa6\x57\x3a\xa1\x28\x3c\xbd\xfc\x5c\x3c\xed\xf8\xb0\xba\xfb\xe4\xcf\x9d\x2a\x98\xcf\xc5\x77\xdd\x56\xcb\xaf\xdf\x51\xda\xd1\xfb\xbb\x8a\xfb\xae\x0e\x99\x33\x61\x38\xf7\x03\xb0\x21\x38\x62\x9c\x39\x21\x97\xa4\xc1\xb0\xb8\x85\xcd\xd5\x6a\x1f\x92\xb1\x2b\x7a\x48\x66\xd3\xd2\x20\x97\x8e\xbe\x87\x63\x0f\x89\x6c\xa0\xd9\x0b\x5a\x27\x27\xc1\xb9\xd8\x9d\x8c\xe0\xa9\x6b\xb5\xc5\x57\xc8\xb7\x53\xab\x95\x5a\xe6\x2f\xfe\xb2\x93\x99\x98\x22\x9b\x63\x40\x1f\x98\xe7\xef\x93\xaf\x9c\xb1\x64\xe3\x8e\x10\x2a\x79\x8a\x80\xab\xd3\x95\xe9\x20\x78\x5f\x8e\x42\x39\x44\x7f\x29\xff\x3e\x65\x9b\xa3\xbe\xe7\x9c\xa9\x41\x7c\x67\x3d\x7d\x78\xf2\xd0\x98\x91\xeb\x4c\xee\xf0\x5c\xbe\x21\x4b\x81\xd7\x5d\x59\xe7\x57\x5d\xf1\x8e\xed\x42\x2c\xed\x7d\xf2\xb5\x37\x50\x09\x7a\x6f\x14\x49\x87\x29\x32\x5e\x8c\xca\xa0\x19\x27\xea\x0e\x72\xd7\x46\xca\x4b\xc7\x23\x24\xa1\x0d\x36\x9c\x56\x87\xff\xfb\xdf\x8f\x0d\xde\x6a\xd7\x87\x07\x71\xed\xf0\xa0\xa4\xc6\x86\xdb\x6d\xf6\x06\x0e\x42\xff\xc5\x9d\xf4\xab\x4d\x33\xbd\xd6\x89\x8c\x49\x89\xc7\xbe\xfa\x19\x5b\x1f\x58\x4f\x90\xf8\x01\xbd\x2c\x57\x99\x8c\xc5\x3f\x71\x42\xfd\x86\xff\x3a\x0f\x40\x8a\x97\xe9\xf8\x27\x9b\xda\x46\x72\x81\x99\x2d\x36\x83\x15\xea\x40\x8c\x58\xc8\xe5\x07\x13\x53\x32\xe6\xfb\x53\x48\x0b\x36\x30\xe0\xd5\x05\x3c\xcb\x34\x59\xc5\x7a\x99\xc3\x79\x04\x0b\x9d\x74\x73\xcf\x9a\xef\xff\x49\x2e\x99\xf7\xbf\xb9\x60\xb9\x51\x9c\x06\x2a\xa3\x5b\xcd\x0c\x0f\xd3\x90\x56\x8a\x00\x5b\x9a\x88\xbf\xd8\x97\xfe\x42\x70\x14\xe4\x06\xf3\x99\xa8\xbc\xd0\x93\xf7\x6e\xe4\xf7\xbf\x9d\xb6\x5a\xbd\xcb\x61\x6f\x5a\xb5\x39\xbc\xe1\x3c\x65\x41\x31\xbe\x9e\x0d\xaf\x86\xff\x35\x98\x73\xdc\xe6\xd5\x78\x32\xef\x1f\x1e\x8e\x99\x4f\x45\x42\xc8\x56\x9b\x9c\xf0\xd0\xe7\x14\x03\x9c\xa7\x30\x57\x6b\xd1\x2a\x3a\x61\xbc\xcd\xc6\xa1\x28\x97\xe8\x83\x63\x4e\xe0\x8c\x4e\x33\x0e\x82\xf7\xbb\xd8\x75\xa4\x90\x9a\x5c\x26\x4b\xd5\xb1\x89\x59\x2c\x5d\xb1\x3d\xdd\x56\x77\xbe\x64\x27\x87\x23\xbd\x5a\xa9\x4c\x25\xb9\x55\x1d\x9c\x2d\x6b\x5d\x8f\xde\x59\xc8\x0f\xa7\x5b\x24\xc0\x44\x1d\xa1\xf2\xe5\x03\xd2\xb8\x19\x3b\xe2\x85\xf8\xe7\x3f\x81\x98\x6e\x5f\xfc\xf6\xdb\xbb\xc1\xf4\x9f\xff\x14\x2a\x36\x4a\xfc\xf6\xdb\x68\x8c\xdf\x93\x48\xfc\xf6\xdb\x7d\x58\x86\x59\xf2\x58\x24\x43\xd1\x17\x84\x97\xeb\x7d\xbe\x41\x12\xb3\xc5\xf9\xef\xc3\x38\xa7\x98\x11\x3a\x7d\x9c\x1d\xf8\x0f\xb0\xef\x0f\xb1\x12\x77\xec\x22\xc7\xee\x32\x62\x27\x97\x1f\x10\x33\x86\x4b\xfd\xd7\x42\xc6\xec\x48\x5b\xa6\x3b\xe7\x34\x8d\xd3\xf4\x43\x49\x9c\xcf\xc1\x38\x61\xaa\x3c\xff\x0e\x10\x0a\x8a\xbc\x1a\x4f\x66\x93\xde\xe8\xb1\x08\x7d\x95\x66\x48\x40\x85\x1d\x60\x31\xf9\x29\x48\xac\x70\x2c\x8f\xf4\x58\x90\xc2\xb5\xde\x03\x12\x43\xfe\xf6\xcd\xc5\xe5\x63\x41\xc2\xb3\x5f\x00\x1e\x0c\xf3\x89\xf4\xc1\x2b\x8f\x00\x66\x7a\x39\xec\x0f\x1e\x0b\xcd\x34\xd6\x4b\xd5\x6a\x7f\x19\x46\xf7\x6c\xde\x6a\x7f\x36\xa3\x6f\xd3\xa8\xa0\x1c\xaa\x7c\xef\x59\x9b\x4c\x67\xa3\x76\x12\x2b\x80\xaf\x30\x4f\xed\x0e\x5b\x67\x69\xb1\x33\x9f\xc3\xeb\x16\x49\x1e\x97\x0e\x60\xa3\x62\x9c\xdb\xec\xef\xcd\x4c\xe9\x39\x8c\x28\x22\x40\x47\x6f\x42\x5f\xab\x8f\xb9\x4a\x0c\x6b\xab\x4e\x25\x85\xd6\x49\x5a\x6f\x66\xc0\x1b\x9c\xa5\xc9\x5a\x96\xf3\x10\xdb\x70\x08\xab\x19\xd5\x59\x80\x40\x49\x29\xa5\x08\x51\xf9\x29\x4a\x8a\x40\x91\x92\x94\xa0\x97\x9f\xe9\x44\x6c\xe5\x6e\xa7\x93\xb5\x75\x2c\xbb\xf1\x91\xee\x96\x41\x0b\x21\xbd\x2a\x47\x20\x43\xe7\x81\xd7\xd0\x65\x96\x57\x0a\x15\xf0\xe4\x0b\x47\x34\xce\x60\xc3\x67\xf8\x4a\xd2\xa9\x5f\x2e\x07\x11\xbb\x28\xa0\x31\x9e\x09\x1c\xfd\x16\x24\xe3\x1c\x7b\x15\x57\xc7\x73\x31\xbc\xb8\x44\x66\xcd\xad\xb4\xff\x4f\xc9\x38\x87\x58\xeb\x9b\x8d\xcc\x76\xe2\xa4\xdf\x3e\xed\x88\x7e\x07\xd6\x66\x47\x5c\x74\xc4\xf5\x9b\xeb\x8e\xd8\x46\xe2\xe4\x4a\x66\x1f\xa2\xf4\x0e\x59\x3e\xe3\x05\x38\x59\xdf\xaa\xb3\x7e\x87\x65\x35\x32\x58\xf4\x52\x75\x04\xb6\x09\xc6\x63\x89\x21\x4e\x56\xfa\xa3\xdd\x74\x28\xc9\xe0\x4f\x9f\xbb\x5f\x5e\xe1\xcb\x8e\x58\x65\xca\x21\x03\x2c\xe6\x9e\x2a\x87\x79\x95\x29\x85\x84\xf2\x0f\x49\x7a\x97\x34\x3c\xe9\x7e\xe9\x8a\x61\xc2\xa9\x5e\x48\xa9\x5e\x4a\x56\x2d\x2c\x52\xa0\x26\x23\xf7\x10\xf4\x46\xbe\x8e\x01\xa2\x11\x58\x64\xad\x35\xc2\xf6\x17\x76\xc1\x69\x56\x5f\x15\xec\x02\x56\x80\xb4\xb7\x17\x99\xdf\x03\x19\x49\x5b\x87\x48\x66\x4e\xab\xdb\xef\x88\x47\xa2\xab\x93\xa5\x7d\x1e\x8a\x8e\xf1\xe3\xd0\x47\xe2\xc4\x4d\xa1\x0d\x68\x71\x4a\xd4\x17\xdd\x15\x33\xbc\x34\xa2\x5f\x79\x86\xdf\xa6\x8c\xa8\xc2\xa8\xe7\xc8\x33\x7e\xe1\x87\x7c\xd1\x2f\x8d\x7b\x22\x02\x0f\x03\xdd\x0d\xd9\xd2\x9e\xc9\x3c\x3b\x63\x5f\x24\xe9\xbc\xfc\x82\xb6\x00\xd9\x4e\x9b\x34\x8e\x54\x56\x0e\xe8\xca\x34\x32\xb1\x2c\x4c\x9e\x6e\xcb\xd1\xb8\x08\x00\xfe\x82\x44\x95\xf6\x1b\xd5\x26\x5c\xf7\x66\xb3\xc1\x64\x34\x2d\xd3\x2e\xd9\x9a\x61\x00\xc9\x25\x0f\x43\x5b\x56\x9d\x76\xad\xc1\x2f\xb3\xc1\x68\x3a\x1c\x8f\xe6\x57\xbd\xeb\xeb\xb2\xba\x21\xb0\xa4\xaf\x7a\x93\xbf\x5d\x8c\x7f\x1e\xcd\xa7\x37\xd7\xd7\xe3\xc9\xcc\x19\xd1\x0a\xa9\xa7\x2a\xaa\x5a\xd0\xbb\x4c\x9d\x71\x36\x01\x10\x1b\x7b\x87\x10\x94\x3e\xb9\x5c\x52\xf4\x75\xed\xe2\x01\x6e\x3b\x30\x83\x38\xbb\x85\xec\x21\x9b\x1a\x4a\x32\xd2\xb9\xe9\xeb\x76\x0e\x52\x6c\x94\xaf\x05\x89\x24\x7c\x6a\x2b\x9d\xa9\x85\x8c\xe3\x6e\xa2\xf2\x73\x3e\x2e\xcc\xf9\x96\x27\xb2\x25\x21\xd6\xdb\xe3\x3d\x0d\x7c\x1e\xa0\xb4\xc1\xad\x27\x48\x88\x00\x33\x17\x19\xd0\xea\x3e\xad\x48\x03\x68\x9d\x8e\xce\xf0\x8a\xe9\x8f\xe5\x37\x6f\x66\x57\x97\x96\xd5\x7e\xb9\xba\x74\x06\x1d\xeb\xf8\x35\xd0\x91\x12\xd2\x15\x17\x9c\x24\x84\x23\xcc\x5a\xa4\xb4\xf9\xd2\x15\x59\x10\x77\x32\xc3\x06\xda\xee\x64\xae\x17\x3a\xd6\x39\x76\xa1\x36\xa6\x50\x0f\x3a\x4d\x0e\x68\x58\x73\x9a\x90\x29\x84\xfd\x3b\x1b\xf7\xe7\xc3\x51\xff\xf2\xe6\x62\x30\x7f\x33\xe8\x5d\x0c\x47\xaf\xeb\x5e\x13\x49\x19\xad\xff\x50\x59\x2a\xb8\x7c\x4b\xc6\xb1\x33\x1f\x8d\x28\x76\xec\x7c\x03\x1f\x53\x66\x34\x29\xe0\xd5\xec\x0d\x0e\x1b\xf9\xa0\x59\x6e\xc1\x86\xfb\x33\xc9\xe1\x31\xe8\xb8\x9c\x05\x0c\xb6\x51\x40\x37\x71\x30\x59\x5b\xa8\x02\x88\x84\xcc\x39\x61\xba\xeb\xf7\x22\x79\x03\x56\x4a\xe6\x45\xe6\x93\xc7\x91\xe8\xb0\xdb\xc5\x9a\x35\x03\x2c\xcd\xe3\xde\x2d\xba\xc1\x3f\xf1\xe4\xc0\x3f\xf1\xe3\x8f\x07\xfe\x89\xef\x1f\x11\xaf\x3c\x40\x7d\xc5\xff\x04\x07\x47\x13\xca\x41\x9b\x27\x9e\x32\x6e\xab\x31\x67\x95\x02\x38\xd6\xc9\x07\xae\x23\x62\xcf\x51\x96\x29\xb3\x4b\x91\xe5\x93\xfa\xcd\x42\xfa\x3b\xa7\x27\x50\x1c\x2a\xd0\x60\xec\x4e\xd4\x59\xf0\x2a\x34\x84\xfa\x3e\xa3\x58\x05\x3c\xeb\x1f\x98\xd1\x51\x89\x97\x81\x46\x9c\x25\xa7\x93\x48\xdf\xea\xa8\x80\x0b\x98\xbc\x2c\x8b\x7d\x99\x3b\x2e\xfe\x4f\x41\xfa\x82\x4e\xaa\x05\x8d\x58\xb9\x35\x56\xd6\x71\x8a\x5d\xbb\xc7\xce\x72\xae\x52\xc4\x02\x2e\x87\xa3\xbf\x79\xcc\x91\x13\xfe\x21\x56\x3f\x78\xab\xc6\xea\x43\x52\x25\x61\x10\x8a\xe9\xec\xd2\x67\x6d\x58\x4f\xb5\xc9\xa3\xe7\xcf\x4d\x0e\x41\xd2\xb1\x7f\xdc\x52\x8c\xdd\xea\x67\xa7\xa4\xa3\x30\x27\xde\x49\xca\x05\x09\x22\xb5\x27\x92\x08\x0e\x91\x2b\x56\x69\x86\xd2\x2c\x84\x9b\x2f\xbd\x66\x4a\xbe\x88\x5d\x91\xe3\x00\x64\xd7\x03\x17\x93\x98\x66\x4f\x73\x10\xfa\x8d\x55\xee\xc9\x4f\x49\x07\x3e\x45\xcf\x88\x48\x2d\x63\x28\x96\xf4\x87\xd5\x6b\xca\xb0\x2e\xce\x67\xb8\x92\x65\xb6\x26\x66\x30\xce\xbd\x58\x05\x5f\x75\xd7\x5d\x1a\xf2\x24\xc0\xc1\xe9\xbf\xb7\xda\x54\xe9\x54\x98\xc3\xef\xc4\x3f\x7f\x3b\x65\x0f\xbd\x75\x62\x23\x4e\x00\x90\xd9\x1d\x4c\xa7\x35\x84\xdf\x32\x8d\x63\xb9\x48\x7d\x35\x62\xa4\xe1\x77\xda\x32\xcb\xea\xe4\x36\x8d\x6f\xab\xc4\x20\xa9\xef\xd2\xfd\x48\x80\xca\xe5\x12\xe5\x8c\x0f\xb9\xba\x5f\xde\x0c\x2f\x67\xc3\xd1\x7c\x3a\xbb\x0c\xe9\xdf\x44\xfc\x2b\xbd\xcc\x52\xd4\x0c\x7e\x6d\xa0\xa7\x9d\xf7\x2f\x87\x5e\x1b\xec\x34\xd1\x86\x8b\xb0\x1c\x71\xac\xcb\x87\x36\x26\x29\xcb\xe0\x58\x56\x17\x1f\x58\x24\xbc\xf0\xfd\xcb\x61\x8d\x41\x0f\x8c\xa2\xe9\xf0\xda\x3f\xf2\x48\x53\xc8\xe8\x9d\x38\x31\x4a\x3d\xaf\x95\x48\x66\xfa\x56\x65\x0b\x99\x7c\x00\x4e\x0b\xec\xc9\xee\x32\xdd\x96\x15\x93\x46\xef\xce\x35\xaa\xd8\x4e\x9b\xad\x28\xe7\xa8\x04\x9c\xec\xe0\xa2\x58\x97\x0d\xf3\x03\x7d\xb4\x33\xc8\xd2\x91\xc6\x14\x5b\x65\x8f\x7e\x26\x27\xd0\xbd\x2b\x16\xb1\x5e\xba\xe8\x88\xf5\x82\xef\x32\x7d\x2b\xf3\x2a\xc3\x50\xad\x4a\x92\x96\x71\xb1\x5d\x96\xe6\x9c\xc6\xf6\x41\xed\x49\x62\xd8\x02\x68\x24\xdf\x3d\x80\xe9\x10\x85\xc1\x3f\x87\x69\xa8\x6e\x21\x1b\x0c\x61\x42\x53\x66\x0a\x4e\x2c\xa4\x3f\xaf\x11\xb0\x4d\x22\x20\x7b\x07\x9b\xd1\x9f\x39\xec\x86\x8c\xf4\x52\xe6\x30\xed\xd6\x2a\x87\xae\x0c\x56\x85\xf8\x52\x99\xd8\xaa\x7c\x93\x46\x86\xed\x21\x0c\xa0\xb2\x7c\x0f\x95\x25\x08\x04\x55\x59\x8a\xf0\x07\xe5\x36\xc8\xed\x0b\xbd\xec\x54\x17\x94\xbb\x49\xfc\x0c\x8b\x7d\x30\x81\x3b\x51\xab\x02\xdc\x1d\x53\x34\x03\x1d\x83\x77\x69\xf6\x81\x53\x02\xfd\x40\x80\x5a\x27\x11\x94\xcb\x35\xaf\x32\xcd\xbc\x38\x96\xc2\x50\x94\x06\x32\x6a\xbf\x53\x14\x02\x71\x8a\x3c\x8e\x66\x8c\x04\x75\x85\x4e\x18\xec\x1f\x48\x48\x9f\xe2\x54\x99\x27\xd9\xdf\xc9\x3d\x6d\xb2\x56\xfb\x9e\x6d\xf6\xb0\xb0\x1f\x5e\x5c\xce\xaf\x27\xe3\xeb\xc1\x64\xf6\x2e\xa0\x74\xb8\xdf\xad\x3f\xcf\xda\xd9\xac\xcf\x05\x85\xad\x55\x3c\xf9\xf4\xd8\x8b\x21\xd2\x84\x5e\xde\xcc\x06\xf3\xd7\x93\xf1\xcd\x35\xa2\x62\xad\x76\x4d\x11\x6a\x0e\x1f\x65\xca\xb9\x62\xab\x43\xa7\xab\x4a\x90\x9a\x97\xc5\xab\xa0\xd5\x89\x13\xf8\xb0\x93\x7d\x59\xf9\x4c\xda\xbc\xf7\x48\xf2\x08\xf4\x6c\x57\xbc\xdc\x3b\xac\x40\xb3\x8e\xe3\xf0\x31\x69\xc1\x15\x5b\x44\xa5\x17\xe5\x4a\x54\xe4\xf7\x55\xbc\x7f\x60\xef\x34\xa1\x20\xd8\x3b\x43\x9b\x18\x27\xa3\x08\xa6\x8c\x4d\x96\x04\xe1\x49\x84\x83\xea\x6e\x0d\x16\xa5\x25\x5d\x4b\xcb\x81\x8f\x41\x1c\x1e\x00\x21\xd9\x8b\x44\x51\xd2\xbd\x1d\x03\xac\x67\x87\xc5\x09\x13\xa1\x40\x9a\xcd\x06\x2a\x38\x3b\x40\x43\x38\x0b\xdc\xc2\xc6\xe5\xdc\x23\x50\x1a\xd1\x6a\xe1\x7a\xc0\xd2\xa2\xc8\x4f\x95\x6e\x77\xc8\xb4\x36\x01\x62\xc4\xad\x96\xe2\xbd\x4e\xec\x0c\xf7\x63\xc9\x72\xc7\x68\x30\x45\x96\x6b\x7f\x7c\x75\x3d\xbe\x19\x5d\x4c\x0f\x65\xf9\xcd\x4b\x7a\x12\x06\x56\x20\xcb\xb1\x16\xd8\x3a\x8c\xb5\x90\x55\x3d\xb9\x09\x58\x98\xc3\xad\xb6\x38\x59\x85\x96\xb0\xc3\x30\x24\xa9\x95\xae\x5e\x25\x38\xe5\x78\x39\xc9\x2c\x22\x50\xb1\xf0\x0f\xb3\x7b\x0b\x63\xf2\xb9\x5f\x16\x2e\x5e\xdb\x81\x5e\xb9\x81\x5c\x0a\x31\x97\x33\x68\x8e\x78\xe2\x7f\x56\xff\x5a\x6d\x3f\x38\xce\x15\xd1\x8b\x73\x95\x25\x54\x25\x1a\xef\x83\x58\x07\xb1\x61\xa2\x50\x05\xc8\xe0\xfa\x14\xb0\x56\x5b\xbc\x4f\xd2\x60\x14\x67\x2b\x1d\x47\x3e\xe7\xfa\x05\x68\x75\xc2\xbd\xd1\xac\xe1\x1c\x5b\x7a\x18\x84\xba\xec\x4d\xa7\xcd\x19\x64\x7c\x66\x75\x98\xf7\x20\xac\x22\x51\x24\xc0\x05\x98\x14\x25\x0b\xc8\xe9\xc5\x41\x06\xff\x54\xb9\x79\x7d\xdd\x0e\x99\x2b\x56\x94\xb2\x91\xc3\x48\x26\x70\x1d\x5f\x9d\x72\xe4\x02\x11\xa0\x14\x7b\x49\x48\xef\x1f\xb4\x21\x67\x22\x36\x4c\x48\x5a\xc2\x15\xe2\x6f\x70\x9f\x9f\x62\x97\xb9\xc4\x6e\x7a\xe6\x52\xce\xd4\x2f\x9c\xdb\x3f\x99\xbd\x3a\xad\x3b\x13\xf2\xd0\x2a\x42\xd2\x9a\xd5\x5f\xe9\x00\x40\xa4\x76\xbb\xd0\x09\x97\xe7\x6a\xca\x11\x6e\x8c\x2a\x3f\xb0\x11\x8e\x60\xd8\x6d\x84\x3a\x25\xa6\xc3\xab\xeb\xcb\xc1\x7c\x3a\x9b\xdc\xf4\x67\x8d\x84\x60\xfc\x07\x14\xa9\x50\x82\x8c\x68\x3a\xc9\x98\xf7\x23\x99\xc3\xc9\xa7\xe2\xc8\x8a\x0e\x3a\xab\xe8\xa4\x8a\xd4\xca\x7d\xe1\xfc\xb5\x8e\x86\x14\x23\x6c\x0e\xfd\xfa\xfd\x87\x00\x45\x03\x75\x5d\x18\xd5\x1a\x0e\x50\xfa\x3b\x1c\x15\x26\xcb\x8a\x4e\x41\xcb\x19\x95\x61\x4f\x7d\x6d\x01\x8b\x45\x1e\xdf\xf8\xc7\x6c\x31\x34\x70\x81\x65\xc1\xe4\xb9\x1f\x19\x01\x53\x3e\xc4\x45\xad\xf6\x83\x7c\x14\x72\xd1\x23\xe8\x5d\xa3\xa3\x17\x7c\x44\xef\xd9\xbb\xeb\xc1\xc5\xe0\xd5\xfc\xcd\xf0\x62\x30\xe5\x67\x1c\xa9\xfd\x19\x20\x3d\x8d\x28\x54\x6a\x21\x85\x9b\x52\xc3\x21\x4a\xc6\xa1\x4a\x8a\x6d\x15\x43\x70\xf1\x1d\x3c\x69\x9f\xab\xd7\x83\xd0\xef\x3c\x45\x57\x4c\x53\x96\x7c\x60\x0a\x3e\x5e\x66\xfb\x9d\x9a\x8a\x7f\xfe\x46\xbf\xcc\xb8\x88\xa6\x5a\xeb\x5b\xd3\x12\xca\x23\xcf\x71\x22\xe8\x6e\xdf\xef\x5a\xd8\xfd\xd9\x13\x4c\x5f\x19\x99\x5c\x80\x2c\xf1\x09\xf2\x66\x16\xe2\x64\xdf\x1e\x2b\x26\xbc\x64\xc7\xc7\x78\x36\xa2\x79\xa7\x6c\x8a\xc1\x7b\x9e\xef\x77\xec\x67\xa1\xb0\x03\xe7\xb0\x82\xc4\x7d\x76\xd0\x26\xa5\x57\x97\x1b\xbc\xa0\x61\x0a\x6a\x66\xd2\x44\x44\x7a\x89\x12\x1f\xb6\xcf\xd8\x87\xb7\xe3\x7a\x24\x80\x62\x1d\x8a\x0c\xd4\xd7\xfe\x7c\x8d\xf7\x15\x60\x91\x82\x8e\x14\x29\xb4\x3b\x89\x3a\x22\x51\x5c\xc2\x4e\x1c\x80\x85\x3f\xc0\x61\x8d\xdc\x53\x2a\x20\x78\xd3\xe8\x7f\x78\x12\x9b\xfd\x76\x91\xda\x18\x62\xb1\x13\x4b\xb9\xdc\xa8\x30\x9f\xcd\x1e\x36\x97\xe3\xf1\xdf\x6e\xae\xe7\xfd\x5e\xff\x0d\x64\xd0\x7f\x0d\xca\x9e\x20\xf4\x42\x90\x6d\x96\x29\x43\x76\xa9\x1d\xd7\xd8\x88\x06\xfb\x4a\xb0\x7a\x02\x9a\x36\x6f\x57\x4c\x35\x4c\x97\xe0\xa8\x23\x69\x0c\x95\x02\xae\x5b\xa4\xc8\x5b\xcf\x21\xbd\x93\xae\x72\x96\x85\x74\xb4\xf3\xba\x5d\xbb\x0e\xca\x55\x23\xc9\xa5\xb7\xbe\xc4\x1e\xeb\x23\xc7\xb9\xd3\x34\x3f\x28\xb5\x03\xff\x58\x30\xa1\x00\x64\xea\x8c\x57\x1c\xb9\x25\xb3\x86\xae\x4a\xd8\xf2\x34\x15\x66\xeb\x0a\x4b\x03\xa5\x75\xa1\x96\x88\x9d\x9b\x38\xbd\x53\x59\xf3\x7b\xb1\xcc\x10\x55\xd9\xaa\x2d\x12\xf8\x51\x73\x2a\x0d\xf7\x8c\x51\x1e\x7f\x44\x10\xed\x90\x45\x4d\xb5\x70\xf6\xa4\xd9\xb6\x88\xe5\x73\xf1\xec\xef\x27\x4f\xff\xfc\x6f\x07\x44\x20\x37\x02\x25\x59\xe8\x48\x64\x32\x59\x63\x40\x6d\xc4\x93\x6e\xf7\x47\xce\xad\x62\x16\xc1\x87\x9d\x9a\xab\x0a\x4a\x54\x38\x7d\xba\x12\xcf\xfe\xfe\xf4\xcf\x2f\xfe\xfc\xfd\xf7\xdf\xfe\xb9\xd5\x2e\xb1\xd1\xcb\x09\x1b\x08\x26\xd1\x86\xcb\x8a\x03\xd5\x9d\xb2\xb8\x4b\xd0\x0b\xe3\x3b\xa5\x14\xeb\xb5\x22\xc5\x1d\xdf\xa6\xbb\x5c\x6f\x65\x1c\xce\x6a\xd3\xdc\x90\x77\x84\xd4\x9a\x54\x5b\x27\x17\x9a\xaa\x3c\xce\xb9\x78\xe0\x5b\x7c\xd2\x6d\xb5\x0e\x30\xe5\xf4\x9b\x27\x5f\xbc\xcf\xd5\x4b\x34\xcc\xf8\x17\x77\xb9\x62\x36\x1b\xfc\x32\x9b\xf4\xfa\xb3\x79\xef\xf2\xb2\x49\x11\xa8\x90\x28\xf0\x2e\x40\x5a\x59\x17\x78\x52\x0f\x0c\x90\x7c\x72\x9f\xa8\xc8\x3b\x93\x45\x52\x3a\x45\x59\xe3\x81\x18\xbe\x95\x3a\xc6\x69\xd4\x15\xd7\xd6\x23\xe1\xdc\xa5\xde\xa6\xc2\xbe\x35\xd0\x0b\x6c\x90\xc9\x7f\xee\xe4\xf0\x46\x47\x11\x1a\x5c\xd9\x66\x4d\x56\xa5\x75\x60\x5d\x4f\x86\x6f\x7b\xb3\x81\x00\xcb\xe2\xb8\xbd\x45\x01\xab\xfb\x72\x3a\xeb\xcd\x86\xfd\xb2\xd2\xaf\x04\xbc\xe6\xd5\xe6\x44\x31\x13\x56\x12\x2b\x71\x27\x33\xe4\x85\x1b\xee\xd3\xd3\x90\x9c\xe9\xa4\x38\xe7\x8f\xba\xb2\x0c\x9b\xe3\x18\x59\x77\xcb\xcf\xbd\xc9\x88\x1c\xfd\x15\xcc\x3f\x20\x9e\x1d\x04\xa0\x5a\xf0\xcf\x89\xe7\x1a\x6d\x1d\x12\x1a\xe8\x8b\x03\xc6\x79\x82\x1a\xaa\xfd\x00\xb8\xf5\x37\x7b\x7d\xba\xe9\x50\x7e\xe4\x6a\xdd\x3a\x1e\x5e\xed\xfc\xed\x70\x32\xbb\xe9\x1d\x61\x49\x8f\x65\xb7\xf2\x5b\x9d\xe5\x85\xb4\x09\x44\xd6\xcb\x51\x83\xe1\x4b\x42\xe0\xd7\x76\x1c\x82\x5e\xff\x6f\xbd\xd7\x47\xf1\xed\xf0\x4c\xca\x12\x27\xe6\x40\xdb\xa0\xfa\x90\x84\xc0\xa0\xa3\xed\xcb\xae\x9c\xd7\x74\x3f\xee\xcb\x1d\xd1\xb4\x70\xde\x84\x15\x3e\xa1\x1d\xc9\x0b\x6d\xb5\xbf\xc8\x52\x79\x11\x0f\x2c\xf5\x72\xdc\xef\x5d\x3e\xc2\x98\x14\x27\x56\x7e\x40\x75\x33\xa7\xce\x72\x40\xf6\x41\xea\xc2\x61\xec\x77\xf5\x91\xe4\xc7\x60\xbd\x9a\x83\xcc\x49\x75\x7e\x4e\x67\x9f\xe8\x24\x2c\x02\x73\x4e\x3e\x3b\x2e\x92\x32\x94\x09\x03\x6b\x7b\x61\x53\x4b\x39\xe1\x0b\xe9\x0d\xce\x25\x7c\x1c\x75\x24\x2c\x5a\xcd\x58\x29\x8d\x71\x12\x63\xa8\xf4\xf2\x15\x96\x81\x62\x1a\x64\x43\x90\xaa\x13\x02\x47\x5c\x8b\x16\x52\xb1\xdb\x5b\x50\x93\x39\x54\x1c\x58\x62\x8c\x24\x9f\x4a\xce\x4d\x3c\xd9\xc4\x81\x83\x1a\x76\xaf\x7b\x0a\x9c\xbe\x42\xf2\xb2\x4d\xad\xff\x14\x54\x5b\x44\xbb\x7d\xfe\xc0\x80\x8f\xe4\x38\xcb\x4b\x57\x83\xd9\x9b\xf1\x45\x60\x47\x39\x1f\xab\xc3\x5b\x88\x92\xb0\x6f\x05\x36\x42\x92\x26\xfb\x2d\x5a\x0c\x78\x0b\xc2\x73\x12\x8c\xa8\x8f\x39\xb5\x1e\xb2\xda\xfa\x83\xe6\x8d\x1f\x43\x80\x45\x89\x5b\xbf\xf6\x33\xcc\xfd\xb7\xff\x04\x53\xfd\xf6\xb5\xcb\xb6\x09\xb7\x62\x43\x89\x3e\xd5\x49\xb3\x5d\xc6\xaa\x54\xd9\x9a\xd2\x67\xbd\x63\x45\x0d\xc0\x54\x7c\x7d\x0d\xdf\xb3\x7b\xc6\x1e\xc5\x8f\xc4\x7a\x6f\x34\x1e\xcd\x47\xd3\xeb\x5e\x7f\x30\x3d\xdc\xe7\x0d\x9d\x2c\x1e\xd2\x4f\x36\x70\x0a\x59\x05\xbb\xf1\x30\x66\xb7\x51\xf0\x8d\xdb\xaf\x2e\x8d\xa4\xca\x6b\xc0\x51\xb5\x86\xfd\x88\x40\x76\xed\x25\x90\x39\x05\xad\xd3\xd8\x84\xaa\xba\xc2\xd3\x6a\xfb\x0d\xd1\xd0\xf9\x91\x5d\xb4\x70\x93\x22\x04\x43\x92\x00\x6a\x93\x47\xd7\xe5\x65\x60\xbb\x3f\x80\xe3\x06\xec\x89\x7b\x71\x7c\x8f\x20\x7d\x14\x8e\x1d\x26\x0f\xab\x50\xd1\x06\x12\x2a\x13\xe3\x8a\x1e\x14\x1b\xad\x32\xb4\x7d\xdc\x1f\xba\x5b\x08\xe9\x5e\x92\x3e\x1a\xe5\x15\x2c\xb6\xda\x5f\x1e\x8f\x5e\xa8\x86\x4e\xce\x10\x91\xaf\x26\xc3\xc1\x28\x74\x44\x3f\x16\x97\x62\x95\x69\xc5\x91\xe3\x32\x98\x5c\xe5\x45\x87\x97\x4a\xb8\xf9\x08\x72\x0e\x52\x75\x1e\x01\xe3\xc1\xe2\x1b\x79\x65\x38\x9a\xbf\x1c\x5f\xbc\x3b\x52\xa7\xd4\x08\x5e\xb2\x3f\x30\x10\xa8\xf6\x0b\x96\x69\x91\x44\xa1\x2f\x77\x91\x46\x7b\xd6\x2a\xd8\x05\xde\x88\x83\x56\xdb\x8d\xe0\xe0\xf7\x9d\x8e\x39\x46\xe1\x5c\xf1\x5f\x9b\xa0\xb8\xec\x70\x09\x8f\xa1\x7d\x05\xe2\x8a\x3e\x82\x37\x87\x23\x64\x7e\xf5\x2e\x4b\x84\x44\x2a\x57\xd9\x96\xaa\x2e\xf4\xaa\x36\xa9\x4f\xe4\x85\x93\xc6\xb5\x8b\x86\xff\xdd\x69\x7e\xce\xf5\x8e\x87\x1c\x4d\xbd\x43\xa0\x8a\x6e\x2b\x9d\x1a\x4e\x10\x17\x2e\x5e\x28\x74\xd5\xe4\x21\xca\xf0\x01\x47\x3e\x78\xf4\xf2\xf0\x4c\x64\x5c\x1d\xe7\x01\xe4\x54\x21\xf7\xff\x6a\x4c\xd3\xef\x4d\x07\xf3\xe9\x60\x34\x6d\xee\x02\xe8\x80\xa8\xb0\x0d\x9d\xef\x3e\x5f\x18\x47\x94\xf3\xcb\x81\xbd\xc9\x49\x72\x46\x0e\xb4\x98\x62\xbd\x95\x7d\x42\x4c\x58\xec\x76\xb5\x47\x48\x20\xb9\x50\x17\x62\x3e\x5e\xe8\x5a\x9f\x13\x55\x99\x53\x68\xdf\xaa\x62\xf5\x63\x81\xb3\x38\xb8\xe9\x20\xd6\x67\x13\xe5\x49\xd3\xb0\xde\x3c\x9c\xeb\x7a\x75\x50\xa8\xee\xb2\x12\x50\x72\x62\xe0\x0c\x4b\x8c\x86\x15\x5a\xa9\x79\xff\x59\x27\x51\x7a\x87\x24\xbd\x13\x4b\x17\x78\xcb\xfa\xfb\xf5\x9d\x4e\x4e\xa1\x30\x18\xd4\xb0\x63\x9d\x0e\x92\xb2\xf2\xe4\x13\x03\xb7\xbc\x28\x9b\x8c\x4c\x21\xfc\xd6\x01\x89\x1a\x09\x49\xbb\x7f\xda\x1f\x5f\x7f\x02\x21\xe1\xe7\x0f\x8e\x4e\x8a\x6e\x58\x17\x1e\xf5\x44\xe1\x66\x31\x49\x54\x6a\x11\xae\x20\x41\x37\xf0\xf5\x01\x99\xb9\x07\x4a\xc5\x52\x7a\x94\xfe\x71\x00\xcb\x71\x88\x9d\x38\x9c\x4f\x06\xaf\x06\x93\xc1\xa8\x3f\x38\x04\xdc\xe5\xae\x9e\x1e\xa2\x00\xec\x46\xa2\x09\x51\x4f\xee\x5c\x68\x7b\x52\x91\xdf\x0c\x31\x82\xaf\x8d\x6d\xee\xdc\x71\x25\xe9\xa2\x4f\x78\x99\x38\xef\x6d\x00\x77\xab\xed\xaa\x26\xbd\x53\xb8\xf4\xf2\x7e\x06\x0e\x0e\xa1\xab\xe1\x60\xfa\x66\xfc\xb3\x4f\x8f\x43\xae\x6b\x9d\xee\xbc\x9c\x1a\xe1\x29\xf0\xe9\x2a\x7b\x02\x55\x33\xd0\x0e\x9c\x74\x43\xca\x19\x1b\x91\x4d\x44\x77\x11\x53\x5f\x36\x76\x6f\x40\xf2\x70\xb5\xf5\xfc\x23\x0f\x94\x0b\x95\x5d\x0d\xae\x5e\x02\xc2\x63\x70\xb9\xbc\x79\x82\x4b\x46\x91\xaf\x45\x45\xda\x09\x02\x4c\x5e\xbd\x84\xcf\xd9\x49\x55\x18\xca\x64\x05\xb9\xa0\x79\x05\xa8\x0e\xb5\x21\x71\xfd\x05\x90\x5f\x4b\x89\xdd\xd6\xa6\x7a\xa8\x03\x49\x69\x77\x3c\x40\xe1\x66\x30\xeb\x5c\xfe\x6a\x3c\xe9\x0f\xd8\x6a\x64\xd4\x3d\x8e\xc6\x44\x5d\x5e\x24\x37\xb9\x67\x8f\x06\xd7\xb9\xf1\xdd\x00\x8d\x54\xcd\x24\xe7\xac\xbb\xd6\x96\x36\x75\x7f\x91\xc9\xe5\x07\x75\x5f\x15\x32\x41\xd6\xb8\xe6\x03\xc8\x38\x46\x36\x1c\xbd\x1a\x1f\x03\xc8\xa6\x0c\xfe\x2f\x1b\x89\xfc\xdf\x78\xc2\xd6\x36\x1e\x55\xa4\x88\xfa\x1c\xb8\x64\xc1\xf6\x60\x92\x4c\xb0\x0c\x77\x48\x36\x31\x25\xda\x8e\x70\xc4\xb7\x59\xb9\x6a\xa0\x81\x61\x87\x39\x0e\x0e\xa7\xe7\x9c\xd6\x56\x9c\xae\x6a\xfd\x13\x99\x7b\x90\x1f\xbe\xdb\xc8\x85\x72\x59\xc1\x8b\x7d\xd9\xa7\x11\x02\xb9\x41\xf5\xf2\xaf\xd6\x82\x74\x81\x4e\x6a\x99\xf5\x21\xa4\x1c\x40\x7b\x0f\x52\xa8\x5e\xfe\xd3\x71\xd2\xd4\x81\x84\x2c\x75\x60\x23\x28\x23\xfa\x17\x23\xa6\xcc\x0c\x20\xb9\x58\xf1\x2d\xeb\x64\x15\xdb\xfb\x09\x30\x24\x3d\xef\xe2\x6b\x4e\x21\xe1\xdd\x44\x7f\xfa\xee\x54\xcd\x98\xa6\xad\x52\xc7\xa0\xe7\xbe\xd1\xb8\x99\xf9\xa6\x73\x74\x38\x9f\xce\x9f\x4e\x67\x9f\x84\x6e\xb0\x20\x61\x9c\xf0\x79\x0f\x33\x56\x51\x6d\x38\x55\x7d\x19\xf4\x7f\xe3\x58\x79\xa5\x09\x5c\xd9\x13\x94\xfa\x8c\x54\x49\xc0\x07\x62\x30\x42\x8d\x0c\x76\x8b\x94\x21\x00\x4b\x8b\xd2\x4b\xb7\xd8\x1f\xb0\x1a\xa0\xa8\x73\x69\x19\x14\x18\xae\x0e\x5e\x28\x11\x35\x1a\x57\x34\x32\x48\x15\x7b\xb9\x0b\x49\x0f\x20\x0c\xa2\xdf\xb1\x27\x1f\x1e\x15\x3c\x1d\x4e\x14\xee\x95\x4f\x9c\xa9\xd5\x2e\x6d\xa0\x63\x93\x3d\xc4\x41\x87\xbc\xd1\xc4\x41\x9c\x8e\xd5\xa0\x1e\x3e\xb0\x55\xbd\x33\x00\x6e\xd4\x15\xa7\xf4\x38\x8d\x3f\x4f\x2b\x1b\xd2\xed\xa3\x03\x06\x08\xdf\x0a\x74\x2f\xcf\x01\x3a\xf3\xf4\x7e\x40\x46\x95\x70\x87\x10\x1d\xdf\x39\x2f\xdf\x05\xda\xe4\x21\xe4\x9d\xda\x96\xf5\x8a\x9a\x71\xc5\x6d\x38\x3f\x8b\x38\xde\x9f\x95\x95\xb9\x04\x47\x87\xb5\x00\xed\x1a\x5b\x41\x47\x6e\xf2\x0b\xd4\x47\x6f\xb5\x83\xf1\xc9\x9e\x5a\xb8\x96\x8b\x18\xa7\xc3\xbe\x58\x37\xb6\x2f\xd9\xc6\xf8\xc8\xa7\xce\x4b\x0e\x0c\x5d\x53\x9c\x6b\x7a\xab\x32\xef\x3e\xd6\x2b\x71\xa0\x4e\x57\xa0\x6f\x1e\xa9\x5e\x14\x52\x85\xc1\xae\x8f\x3f\x0e\x89\xdf\x6a\x3f\x5e\xe8\x55\x88\xd2\x44\xba\xd9\x64\x88\xb8\xc8\x64\x3c\x1b\xcf\xaf\x7a\xb3\xfe\x1b\xe4\x07\x96\x90\xb2\x7f\x08\xd2\xc8\xb3\xed\x4a\xea\x18\x01\x79\x14\xc4\xd8\x9c\x5f\x4e\xca\x40\x7c\x11\xdd\xfa\x58\xc8\x21\x58\x82\x6a\xd3\xad\xca\xcb\x40\x09\xfb\x24\x7c\x53\xa7\x4c\xc1\xeb\x8e\x3c\x14\xaa\x28\xe0\x3b\x95\x58\x5d\x46\xe2\x75\x8a\x24\x0f\x9f\x15\x5b\xf3\xac\x87\x2d\x4e\xfc\xd0\xf5\x56\xa3\x2e\x2c\xc1\x77\x7a\x44\x3a\x42\xb4\x0c\x3a\x0c\xe5\x75\xa6\x8b\x5b\xf2\xa1\x59\xcd\xb3\x7c\x00\x4d\x11\x36\x29\xea\x16\x16\xa8\xfd\x01\x8b\xa0\xe9\x10\x67\x75\x71\xd3\x5c\xaa\x83\xb0\x6e\x61\xca\xb6\xc1\x53\xcd\x38\xad\x6e\xfa\x9c\xf7\xe6\x72\xa9\x76\x07\xd0\xd7\xe0\xae\xc1\x8c\x48\x0d\x8c\x24\x98\xd2\x0f\x29\x89\xcd\x4b\xf1\x4c\x00\x89\xf7\x7a\x30\x1a\x50\xa6\xdd\x6c\x7c\x31\xbe\x1c\xf2\x89\x57\xeb\x65\xc2\x45\x06\x27\xef\x06\x53\x4a\xda\x72\x01\xe0\x93\xd1\xf8\x94\xef\x76\x89\x52\xc7\x97\xd6\x05\x89\x5f\x81\x5e\x7b\x7d\x45\x14\x16\xe3\xbc\xc7\xd3\xce\xcb\xd3\xac\x1c\x1f\x87\x8b\x76\x53\xeb\x70\xd5\x55\xdd\xa9\x0a\xd9\x60\x3a\xfb\x7c\xc8\x94\xc9\x3f\x01\x32\x34\x22\xfd\x42\x90\xb9\x55\xdf\x03\xd9\xcb\x9b\xd7\x9f\x0d\xd8\xa2\x58\x3f\x1e\xae\x45\xb1\xfe\x42\x60\xb9\x25\xdf\x07\xd6\xc5\xe0\x7a\x32\xe8\xf7\x66\x83\x8b\xcf\x81\x8e\x85\x47\x84\xf6\xc8\xa8\x8d\x88\x1e\x0b\x64\xf0\x46\x00\x6b\xab\xfd\x3b\xa0\xad\x42\x52\x81\x76\x30\xea\xbd\xe4\xfe\xc5\xe8\x38\x3f\xbd\x07\xcc\x65\x9a\x78\xc7\xc9\x91\x58\x8a\x41\x3e\x4f\xf6\xc1\x92\xed\xbd\x5e\x89\xff\xe0\x2f\xe6\xb1\x5c\xa8\xf8\x3f\x45\xb7\xdb\x15\xef\x51\x7c\x8f\x30\x5d\x24\xde\x63\xcc\xfa\x43\xad\xb6\x7f\x8c\xbe\xb6\x0e\x66\x04\xab\xea\x6b\x65\xea\x39\x58\xae\x7a\xbf\xcc\x87\xa3\xe1\x6c\xd8\xbb\x1c\xfe\xd7\x60\x32\x87\xb5\x77\xe0\x09\x0e\xef\x31\x2a\x5b\x22\xc5\xfc\x9d\x74\x1a\x34\xf5\x02\x95\x31\xe3\x94\x24\x3c\xc2\x1c\x44\xef\x34\x13\x5b\xb9\xcc\x52\x71\xce\x6a\x0c\xf1\x05\x79\x2b\xa1\xec\x59\xe7\x6e\x5d\xe5\xad\x52\xce\x9d\x7c\x3c\x8d\xfe\x87\xca\x2a\x05\x4c\x54\xf8\xe5\x17\xe5\x3a\xd1\x42\x1b\xe1\xeb\x68\x74\x5e\x77\x32\x89\x1b\x14\xb7\x95\x0b\x7e\x82\x55\x50\x58\x23\x98\xc4\x04\xcd\xe3\x5d\xc6\x9a\x5d\xa9\xe4\xcb\xd8\xca\x2e\x3f\xe9\x2a\x2c\x66\x74\xd0\x5b\x37\x21\xc1\x6f\x3c\x02\x82\x74\x3f\xbe\x05\x2c\x76\x77\x1d\x8a\xf7\xf0\x3d\x86\x70\xa6\x99\x78\x8f\x75\x85\x9f\x31\xa7\x1f\x41\x17\x7a\x09\xca\x2c\xa2\x9c\x22\x77\xa1\x1e\x57\xe3\x3c\x2a\xa9\xec\xe9\x93\x27\x4f\x9e\x1c\x24\x96\x7d\x8b\xcc\xb2\x66\x9e\x11\x2f\xc4\xb7\x4f\x2a\x75\x0b\xf0\xe0\xdc\x4c\x07\x17\xec\xcc\xe2\xda\x85\xfa\x95\x06\x65\x0f\x1d\x98\xb6\x41\x5c\x51\xb0\x91\x89\xc0\x4a\x8e\x6a\x76\xc6\x74\xb3\x4d\xc6\x48\xe6\x44\x89\x23\xde\xd6\x52\x7f\xdd\x72\xbe\x6a\xcd\xb7\x47\x5d\xbe\xdd\x1e\xf6\x4e\xfc\x4f\x96\x22\x75\xd0\x85\xa8\xa8\x70\x15\x0c\x3d\x80\x1c\x5e\x04\x83\x8a\xb7\x5e\x11\x9a\xe0\x7c\xf5\x09\xa8\xac\x90\x6d\xd3\x5b\x15\x3c\xa2\x92\xdc\x35\x78\xc6\x7b\x3f\xd1\xcd\x6c\xc3\x24\x52\x1f\x09\x55\xfe\x8b\x57\xd4\x46\x40\xcc\xd0\x6d\xe1\xad\xa6\xbe\x75\xa8\x26\xf2\xfb\xe7\xf4\x51\xe0\x56\x20\xad\x1e\x11\x15\x78\xa1\xd0\x72\xd0\xfc\x13\x80\x1e\x79\xfb\x81\xbb\xea\xf1\xa9\x50\x87\xbc\x7c\xee\xb1\xe0\xb7\xda\x07\x08\xf8\x1c\xf0\x03\xb0\xea\xe0\x43\xce\x02\x3b\xf3\xb7\x83\x09\xb5\x4b\x78\x35\xbc\x9c\x0d\x26\xf7\xb7\x9e\xdb\x65\x29\x0a\x5d\x71\x44\xda\xae\x92\xce\xeb\xe2\xd4\x51\x2e\x7d\x43\x09\x2c\x6a\x67\xc1\xae\x79\xe5\xe2\x33\xbe\x65\xd0\xbb\x7c\xad\x23\xed\xa4\x4c\xf0\x06\x06\x78\x87\xb9\x67\xab\xf7\x11\x9e\x96\x8d\x57\xb8\x65\xaa\x9d\xcb\x2a\xf8\xb4\xbc\xc5\x5e\xa8\x8f\x6a\x49\x75\xa1\xe2\xe4\x56\x43\xd1\xde\xa5\x3b\x95\x9c\x9c\x9e\x3a\x4f\x3f\x74\x8f\x40\x5a\xed\x8a\xfc\x0c\x4b\x71\x79\x1f\xfe\x1b\x53\x15\xa4\x4c\x9b\x66\xc4\x75\x44\x75\x30\xf7\xb6\x4b\xdc\x27\x6f\x36\x1c\xf9\xf4\xa5\xab\x9a\x68\xb5\x83\xee\x0b\x48\xb6\x97\xb9\xcf\x2c\x77\x10\xdd\xe1\xee\x00\xb2\x92\x4c\x2e\x93\x08\x7d\x13\xb8\xc9\x83\x4b\xf0\x76\xcd\xfc\x6c\x68\x8f\x51\x67\x3b\x8d\x50\x63\x61\xdb\xca\xcf\xa8\x46\xc1\xd1\x6a\xe2\x84\xf0\x44\xbe\xec\xbd\x1b\xa3\x3b\xda\xf0\x72\x70\x3f\x87\xc4\x72\x9f\x3a\x00\x83\x9e\x7d\x0b\x6e\xb6\x52\x07\x16\x63\x87\xaf\x30\xa9\x2d\x2c\xb6\x84\x9e\xd3\xcc\xd0\x00\x21\x5d\x85\x1b\x91\x30\xc7\x58\xe0\x3e\x3f\x09\x02\x06\xee\x23\xee\xa2\x93\xf8\xc0\x9c\xb8\x93\xfb\xae\x98\xa5\xac\xad\x89\xbc\x3a\x39\x71\x1d\x1a\x96\x2a\xae\xc5\x35\x6e\x9d\x14\xf2\xa6\x23\xc7\x74\x6a\x49\xd6\x9c\x07\x74\x16\xb3\x95\xeb\x9b\xff\xf9\xab\xeb\x28\x1f\xae\x44\x90\x8f\x51\x72\xac\xda\x65\x5f\xa3\x36\x04\xbd\xf9\x6d\x73\x36\xc7\xe2\x97\xb4\xbc\xee\xc7\x6d\x1c\x84\xa0\x43\x7a\x3b\xd6\xaa\xc1\x52\xaf\x98\xb2\x51\xd4\xca\xda\x39\xbf\xbb\x6c\x61\xcf\xe9\x4a\xd8\x33\xbc\x4c\x9f\x28\x75\xb0\x9a\x5a\x56\x82\x2d\xa3\xd6\x79\xed\x4a\x93\xc0\x74\x0e\x39\xa8\xac\x3b\xc5\x05\x03\x42\x6d\x77\x39\x6e\x8c\x0c\x79\x2c\xf8\xe7\x79\xb0\x3f\x84\xbe\x3f\x7c\xc9\x42\xfd\x1e\x36\x84\x69\xee\x5a\xad\x2c\xf4\x82\xcf\xd2\x12\x3e\x96\x2f\x65\x98\x8f\xb4\x3f\xcd\x19\x22\x24\xc2\x5d\xa1\xa9\xf4\x8a\x40\xd7\x8f\x84\x47\x14\xfd\x1d\xf6\x83\x3a\xbc\xd1\xc5\x67\x50\x94\x74\xe5\xd1\x83\xc6\x1a\xb4\xc2\x5c\x7d\x14\x79\x9a\x82\xc8\xb6\xcc\x11\x85\x74\x84\x7c\x54\x29\x22\x37\xc8\xa4\xbe\xfe\x5d\x25\xdd\x3b\xfd\x41\xef\x54\xa4\x25\xdd\x13\x8c\xbf\xce\x5f\xea\xc5\x4c\xfd\x52\xb6\x98\xc1\xfd\x58\x5d\x2e\x09\xb7\x75\x50\xa0\x82\x6d\x13\xcd\xfc\xb2\xd0\x8b\x58\x43\xbe\xec\x36\x7b\x87\xca\xba\xd6\xd7\x6a\x8b\xcb\xde\x6c\xf0\x0b\x21\x7e\x3a\x7b\x77\x89\x02\x13\x17\x35\x0b\xaa\xf0\x7c\xd7\x49\x86\x06\x82\x70\xa7\xb2\xb8\xcc\x45\x2f\x55\x42\xa3\xe0\x98\xe4\x5b\x24\x3c\x78\xef\x97\x3a\x67\xbd\x3b\x59\xa5\x02\x71\xee\xdc\x6f\x55\x4f\x2a\x83\x76\x03\x55\x46\x70\xff\x5e\x7c\xf1\x12\x82\x7e\x53\xd1\x80\x2f\x29\xc0\xad\xa3\x36\x5b\xdd\x55\xd4\xaf\x71\x83\x93\xbf\x4a\xee\x8b\x57\x17\x80\xeb\x7e\xba\x19\x0e\x1a\x4d\xd8\xbc\xc8\x12\x91\x26\xe7\xe9\xca\x95\xc0\xdb\x55\x94\xe1\x61\x2f\x36\x6d\xd4\xbb\x7e\x90\x84\x62\x79\xb8\xe2\x89\x2a\x6e\x47\xee\x72\xb5\xb5\x6e\xc6\xc0\xc6\xf2\x73\x61\x9a\x74\xb5\x3a\xae\x9b\x90\x1b\xd8\x0e\x7d\xf0\x2f\x74\x23\xf9\x04\xfe\x47\x40\xea\x88\x70\x00\x71\xab\x5d\x81\xb9\x84\x58\x65\x59\x9a\x09\xf4\xfe\x50\x59\x76\x5a\x87\xdc\xcf\x5d\x01\xde\x85\x96\xea\xe0\x07\x15\x0b\x00\x1e\x5a\x38\xa0\xd7\xbb\xe7\x62\x86\x85\xfa\xaf\x51\x8d\xba\x81\xf0\xc7\x59\xee\x3c\xc4\x9f\xa4\xc0\xfb\x85\xd5\xf1\xc6\x2a\x1d\x9b\xa2\x78\x6c\x3e\x7c\x65\x33\x15\x6f\xae\x06\xa3\xd9\xe0\xe2\xd0\x83\xde\x10\x3b\x70\xc8\x6a\xb5\xcb\x55\x43\x9e\x34\xe5\x7d\x12\x8f\xd4\xb2\xff\xea\x83\xfb\x3c\x5f\x77\x76\x55\xc5\xe3\x42\xf9\x9a\xc2\xc7\x40\x7e\x00\xd2\x71\xc8\x91\x59\x38\x98\x4c\xc6\x93\x07\xd3\xe9\x1c\xc8\x15\x80\x49\x5b\x44\xd3\x26\x2d\x63\xcb\x2b\xcd\x4e\xaa\x32\xbd\x04\xae\x75\xf7\x15\x48\x4b\x7d\x5b\x4b\x67\x35\xcc\x7c\x44\xc6\xdd\x23\x2a\xf2\xee\x65\x14\x84\x56\x5e\x0d\x5c\xdc\xc4\x62\x51\x9a\x7c\x8d\x0e\x6c\x30\x12\xd3\xcc\x0b\x65\x78\x67\x8a\x5d\xe9\x58\xba\xcb\xd2\x64\x1d\xef\x1f\x8b\xc8\x12\x43\x55\x16\xa2\xd3\x89\xd0\x38\x1a\xcf\xaf\x7b\x93\xde\xd5\xc5\xb8\xcf\x92\xcf\xed\x43\xe7\xc4\x67\xed\xbe\xc2\x2a\x0e\x2c\xe3\x0c\x83\x7a\xdd\x12\x52\x78\xc9\xc1\x72\x50\xb8\x04\x4e\xb3\x21\xa5\x00\x05\x74\xe7\x39\x44\x1b\xda\xf6\x00\x96\x7a\xa0\xa6\x42\x4d\x8a\x7c\x60\x3d\x5c\x3d\x44\x48\x01\x82\x75\xe2\xbc\x25\xad\x76\x39\x7c\x75\x01\x2e\xbd\x38\xe7\x97\xc1\x50\x72\x61\xdc\xdd\xf6\x95\x67\xf9\x1e\x85\xc7\xf2\xff\x67\x73\x3f\x89\xcb\x03\x72\xdc\xb3\xed\x7b\xd3\x63\x8c\xdf\xb0\xdf\xf5\x76\x0b\x1d\x02\x2e\x24\x61\x70\x9d\x3a\x6a\xa7\x40\x33\xb7\x1b\x30\x82\x4a\x96\x69\x81\x64\xc6\xc7\xad\xd3\x2f\x40\xd4\xfd\x0b\x4e\xac\xcf\x5f\x8d\x27\x57\xbd\x59\x93\x3b\x8f\x55\x76\x56\x4f\x9a\xe5\x3a\x43\xd0\x6a\xbb\x1b\xe5\xa3\x02\xf9\x5c\xb3\x8d\x0f\xa2\xb0\x0d\xca\xea\x1e\x0d\xf5\x27\xe8\x6d\x1d\xf1\x27\x38\xe2\xac\x91\xf6\x27\x9b\x31\x26\xd7\x8d\xf7\x3c\x51\x23\x43\xf2\x7d\x3a\x9b\x8a\x5e\xc2\xeb\xce\xd7\x18\x5c\x51\x15\x2e\x96\x7b\x72\xe7\xa4\x36\xbb\x30\x93\xfb\x92\xef\x17\x2c\xcd\x82\x00\xe8\xad\xf4\x1a\xb8\xf8\x13\x9b\x6f\xae\x7d\x60\x19\x7e\xac\x2f\x8d\x1f\x74\x1a\x1d\xad\x14\x7e\x02\x8d\xb0\x01\x9a\xd0\x2c\x94\x48\x17\xb8\xe1\x4f\x45\xe8\x02\xd2\x6c\xbb\x1e\xbf\x82\x83\x10\xf7\x9c\xf0\xf6\xdc\xe2\xcc\x11\x9a\xa9\xe8\xa8\x4c\x84\xfe\xea\xf0\xf1\xaf\x2a\xa4\xbf\x1c\xbf\x7e\xd8\x84\x74\xd9\x5a\x0c\x3b\xe3\x0e\xb8\x24\x59\x1c\xea\x1b\x4c\xea\xda\xad\xee\xc1\x25\x52\xc1\xd5\xe5\xe5\xa5\xb2\xa1\x2e\xd0\x6a\xd7\xb4\x01\x07\x9f\x5b\xaa\xf8\x9f\xd4\x2f\xb1\xfc\xd2\x63\xf0\xc7\x68\x94\xc3\xd1\xf5\x8d\xcf\x4b\xa9\x53\xc3\x31\x15\xf9\x31\xcf\x53\x77\x77\x6e\x9a\x79\x15\x88\x79\x36\xf0\xf4\xaa\x88\x0b\xac\x9c\xb5\x04\x8b\x18\xec\x8d\xef\x38\x91\x37\xb8\x52\x6a\xbb\xc7\x27\xdd\xe5\x6e\x67\x1b\xe7\x85\x33\xd0\x03\xe7\x85\xc9\xce\x4d\xb6\x3c\xdf\xee\xb9\x45\x18\x8c\xa2\x9d\xf4\x5e\x50\xbe\x50\xbe\xba\x38\xce\x8d\x75\xe1\x7d\x6f\x66\x54\xbb\x8b\x82\xab\x0e\x3a\x86\xfa\xe8\xba\xab\x55\x62\xe4\x90\xa1\x7a\xe4\x1a\x7f\x12\xff\x30\x6b\x20\x26\x5b\x16\xa7\x07\xff\xa8\x6f\x7a\x77\x9a\x2d\xb9\x3d\xfa\x43\x77\x3d\xd0\x54\xee\x82\x75\x92\xc4\x11\xdf\x99\x84\x6f\x42\x24\xb7\xda\x15\xe9\xe8\x9b\x1d\x0f\x39\x0d\x3d\xf6\x2a\xae\xbb\xf5\x42\x89\x9b\xd9\xab\xb3\x1f\xfc\xa8\xa5\x57\x8d\x2f\xb7\x8f\xf5\x42\xa3\x87\x02\xdd\xd1\x80\xe7\xed\x5f\xd4\xe6\x18\xe9\xed\xe8\xd5\xb8\x58\x96\xad\x9d\xd0\x9d\xd6\xb8\xb1\xa6\xec\x67\x72\x83\x1c\x04\x02\xa8\xa9\x5b\xa5\xa5\xdb\x3a\x29\xc8\x9a\xf5\x3d\xdc\xdc\xbb\xe7\xe5\x1c\x6c\x8b\xe3\x10\x77\xd7\x36\xbb\xf5\xdf\x13\xbb\x26\x40\x1d\x55\xe6\x83\x51\x7f\x7c\x11\xf6\xd7\x79\x61\x31\x11\x1c\xa5\xa1\xb7\x2f\xd8\x1f\xcc\xea\x26\xe4\xb3\x4e\xa5\x25\x6e\xe0\x1d\xf4\x2c\xc6\xfe\xe4\x26\xdf\xc4\x9d\x8e\xa3\x25\x0c\x92\x1d\x7a\x09\x67\x89\x11\x27\xc4\xf1\xdf\xd0\x6e\xb0\x29\x5b\xdf\x74\x37\xd4\xfb\x68\xa5\xd1\x86\x48\x38\xcd\xc4\x12\xff\xcc\xfb\xbd\xc2\xdb\x08\xb5\x32\x75\xff\x4f\x73\xfb\xdd\x34\xa3\xa4\x10\xfb\x1e\x5f\x42\x6c\xf3\x67\x1a\x7a\xf4\xb6\xda\x95\x2e\xbd\x07\xbb\xc6\x13\xc9\xbf\x5b\xf6\xee\x0d\xe5\x08\x95\x31\xc1\x67\x7c\xd0\xbb\xb7\xdd\x78\x2b\x64\x79\x93\xae\xc7\x12\x94\xca\x9c\xda\x5d\x3d\xff\xa6\xbb\xec\x88\x6f\xba\x4b\xfb\xff\xc7\x8f\xf4\x63\xb7\x43\x3d\xe5\x37\xdd\x25\xba\x55\x7f\xd3\xfd\x6f\x6a\x6c\xfd\x4d\x57\x6b\xfa\xdf\x3e\xa4\x77\x3b\xfa\x61\x1f\xd1\x49\x4c\x3f\x22\xfa\x11\xd9\x1f\xa9\xfd\x41\x77\xac\x7f\xd3\xdd\x6c\xe8\x0f\xfb\xf6\xc6\xbe\xbd\xb1\x6f\x2f\x0d\xfe\x8f\xf0\xdf\x6e\xb3\xe3\x1f\xdf\xf1\xcf\xef\xed\xcf\x7c\x4b\x83\xe9\x64\x69\x87\xdb\xe2\x2f\xd7\x9e\x97\x7e\xa7\xf7\xb7\xf4\x79\x94\x7e\x14\x27\xdc\xf0\xca\xf5\xd6\x91\xde\x1d\x29\xfa\xae\x07\x31\xf5\x73\xfe\xa6\x1b\xa5\xcb\x47\x3e\x2e\xbe\xe9\xe6\x1f\xf3\x7b\x1e\x6e\xb5\x6b\x8f\xef\xf6\x58\xd0\x6e\x7f\x87\x1f\xab\x1f\x9f\xd8\x1f\x04\xd4\xea\xc9\xb7\xf6\xc7\x0f\xf4\xe3\xa9\xfd\x41\xff\xa5\x19\x7e\xdc\x6e\x22\xbb\xc0\xdb\x8d\x45\x65\xb1\x5c\xe1\xc7\xaf\x06\xbe\xf0\x08\xe8\x58\x2a\xe7\x7d\xf6\x1b\xc6\xed\xcb\xea\x35\x13\xdf\x74\x97\x40\x7f\x79\xd5\xc4\x37\xdd\x0d\x3e\xa0\xff\x76\x3b\xfc\xff\xf1\x23\x46\xa4\xdf\xb1\x7f\xc0\x15\xf8\xf5\xe3\xc7\xca\x9d\x14\x38\xf3\x26\x83\xfe\xcd\x64\x3a\x7c\x7b\xaf\x26\xe2\xba\x80\xf3\x2e\x31\xc5\x22\xd8\x60\xac\x7a\x70\x52\x18\x39\xb4\x38\x45\x30\x38\xaf\x81\xd7\x3b\x15\xc7\x0f\x28\xce\xe5\x6a\x2a\xff\x02\xa3\x0c\xc5\xe4\x94\x93\x7d\xdf\x7a\xef\x3f\xa7\xbd\xaa\xd4\x6a\xfb\x1a\xa6\x32\x0e\x65\x85\x5c\xf5\xd8\xa6\xd3\xe9\x4e\xee\xbd\x84\x53\xd2\x68\x78\x75\xed\xdb\x74\x67\x5a\x80\x94\xfd\xa1\x47\x39\x47\xf0\xce\x96\xf9\x64\x29\xb2\xe3\x0c\x2f\x56\x87\x35\xb4\x5e\xc0\xd6\x85\x56\xf5\x3e\x56\x92\x1c\xfe\x23\x56\x8e\x1e\xb8\x32\x16\x52\xa6\x48\x90\x71\xc0\xe8\x73\xb8\xe5\x7f\x2f\xa8\x6b\x7f\x74\x1e\xa5\x4b\x53\xc3\xf4\x7c\xfa\xee\x0a\x5d\x77\x9b\x1d\xcd\x74\x25\x41\x9d\x43\x9c\x1e\x52\x53\x62\xbc\x03\xce\x76\xb1\xd1\x4b\xea\x3a\x6c\xc4\x89\x14\x37\x89\xfe\x58\xa9\x6c\x62\x77\xea\x29\x3d\xef\xc8\xd4\x6a\x97\x84\x22\xee\x7a\x80\x9f\x0e\x20\x10\x4d\xb9\x7a\xbf\xf3\x90\x73\x93\x7c\xde\x39\x87\x64\x94\x8f\xae\xce\x61\xa9\x32\x4c\xc9\xf8\x63\x50\xc1\x34\xc1\x12\xea\xbc\x91\x6f\xca\x61\xad\xeb\x8d\x72\xde\x20\xcb\xd6\x38\xa3\xdd\x05\x8a\xf0\xb7\xa1\x18\xa2\x72\xf3\x2f\xb5\x38\x27\x27\x28\x2f\x02\x05\x6c\x94\x9a\x15\x4e\x29\x56\xc1\x1d\x58\x0c\xb8\x83\x40\x7c\x73\x8e\xc7\xcf\xbf\x69\xb5\x0e\x10\xe1\xb0\x0d\x5d\x6f\xc0\xe3\x97\xa2\xc7\x3d\x3e\x7d\x77\xf5\x72\x7c\x39\xbd\x6f\x43\x87\x28\xe4\xa6\x4a\xa4\x37\x23\x14\x5e\xa6\xae\x06\x2d\xd4\xbc\xf7\xc5\x75\x77\xae\xee\x7c\x87\xf2\x4a\x98\xd9\x5a\x47\x6c\x40\x97\x93\xb8\x35\xa1\x6c\x14\x96\x6a\x35\x8b\xb6\x03\x1f\x41\x9a\x45\xe4\xbd\xb2\x3d\x57\xad\xfd\x6c\xd5\x99\x6f\x9c\x29\x81\xe7\x4c\xb1\xb0\x86\x79\x57\x0c\xec\x85\x62\xe6\xb9\xe8\xf9\x90\x78\x47\xf4\xfa\x48\x95\xc0\x41\x61\x7f\x7b\xfe\xbc\xf2\xad\xff\xfd\xf9\xf3\x6f\x66\x94\x3d\xf7\x2f\x67\x84\x87\x89\xef\xa8\xe9\xe4\x4a\x49\xed\x1e\xb5\x93\x3b\x7a\x29\x73\x03\xa9\x9b\xcc\x19\xa7\xdf\xf3\xee\xf4\x6c\x59\xbd\x95\xce\x7b\xbe\x39\x0f\x19\x9d\x1a\x5d\xc4\xf7\x7d\x59\x57\xc4\x4e\xc3\x53\x12\x15\xc1\xfa\x84\xa8\xac\xbf\x49\x4e\x1c\xc2\xf3\x68\x71\xe1\x5f\xfc\x64\x71\xf1\xe5\xb4\xe2\x9a\x7a\x69\x4b\xee\xf9\xdc\x0c\xb0\x56\x45\x4c\x65\x57\x07\x88\x71\x8f\x94\xc7\xf7\x11\xa7\x5b\x4d\x73\x60\x37\x53\xab\x7d\x5c\x6f\xb0\xea\x59\x61\xc2\x63\xd2\x11\x10\x68\x7a\x1f\xa5\x70\x34\x13\x41\xbd\x0f\x18\x5e\xe6\x2c\x2c\xc8\x68\xa0\x5f\x65\xad\x0f\x1e\x23\x75\xf8\x2a\xc7\x08\xde\x1c\x5e\xf5\x5e\x7f\x79\xe6\xd6\xdb\x4a\x18\xc7\x87\x44\x99\xa5\x1d\x5d\x0f\xec\x49\x66\xb5\xf7\xf4\x7e\xc8\xe5\xc1\x32\x45\x9d\xcb\xbd\x0b\xc4\xa5\x3d\x3c\x2a\x01\xa6\x62\x6c\x7b\x55\xb0\x4c\x7b\x61\xbe\xf4\x59\x2e\x25\x71\x8f\x67\xaf\xf0\x3b\x3c\x45\xab\x7d\x98\xc6\xd2\x98\xc4\xf2\x9c\x4e\xc6\xff\xb0\x6f\xff\xa7\xf8\x8f\x32\xfd\xe4\x3f\xe9\x1b\x9b\xcf\xe2\xbf\xd7\xe6\x90\x2b\xea\xf0\x5b\x17\x69\x38\x12\x67\xb2\xb4\xda\xcd\xb9\x2c\x35\xa0\x88\xeb\x9d\xd0\x64\xf7\x9b\x17\xd6\x76\x21\xad\xb6\xc7\xe5\xd1\xdc\x16\x72\xe6\xd9\x55\x95\xfb\x30\xd4\x21\xb9\x19\x6f\x5e\x46\x98\xc0\x27\xb6\x30\xa8\x49\x61\x60\x0c\x53\x6c\x1f\x0a\x1b\x6a\x4f\x29\xc2\x40\x39\x6a\x70\x72\x9a\x7f\x77\x17\x11\x52\x31\x05\x4e\xcd\x15\xd8\xb6\x6c\x59\x08\x4c\x98\x25\x6e\xa6\xe6\x68\x06\x06\xba\x73\x5d\x03\x18\x58\xf7\xa0\x0b\x2f\xd9\x06\xa8\x34\x01\x17\x9b\x5b\xb5\xce\x4f\x4e\xa0\x28\x21\x93\xe5\xc6\xd7\x5a\x61\x60\xe4\xcd\x58\x5f\x34\xb5\x08\x84\x95\xfe\xff\x06\xd3\xde\xd6\x71\xc4\xfb\xc6\x0b\x66\xbc\x27\x86\x19\xae\x61\x43\xd6\x89\x7e\xcf\x9e\xb4\x34\x45\xd5\x0b\xb6\xa7\xf3\x2f\xf2\xc9\x81\x4d\x24\x8d\x36\x35\xfe\x44\x30\x48\x66\xe5\xd2\x2d\x53\x93\x7a\x40\xbb\xd5\x9d\x3b\xdc\x57\x28\xde\xfb\xcb\xe5\x31\x57\x58\x0c\xe2\x6a\x2d\xf8\xe2\x2e\x5e\x8b\xcc\xc2\xc4\x11\xe7\xe4\x7f\xee\x56\xf5\xc2\x6f\x81\xe0\x60\x7b\xb1\xdd\xcf\x97\xbb\xdd\xdc\x7e\x77\x6a\x5d\x68\x15\x3c\x81\x08\xee\xe2\x1e\x64\x77\x20\x6c\x40\xa4\x48\x90\x2c\xe1\x97\x67\xfc\xf5\x90\x5d\x77\x52\x35\xa1\xd3\xfb\x33\xad\x06\x97\x94\x77\x85\xb5\xda\xa5\x9e\x4e\x4a\x75\x15\x4f\x9d\xea\xaa\xca\xbd\xf2\xff\x05\xc6\xac\x63\xaa\xe1\xd8\xe7\x47\xa6\xe3\x1b\x54\x4c\x97\xb9\x48\x95\x83\xbf\x53\x1a\x6b\x4c\x16\x9b\xbe\xe9\xfa\xb6\xb6\xda\x15\x2c\x9e\x72\x84\x10\xb7\x8e\x04\x3c\xce\x6f\x56\x86\x0a\x0f\x46\x10\xd9\x05\xac\xa9\xff\xa1\x4b\x2b\x08\x0d\x79\xac\x68\x91\xa5\x77\x46\x71\x67\x67\x12\x56\xbc\xfc\x97\x93\xf1\xcf\xd3\xc1\xa4\xba\xf8\x7b\x72\x4b\x47\xe3\x12\x49\x15\x0c\xd4\x14\x83\xea\x23\x8f\xd9\xc7\xe5\x92\x89\x87\xdd\x4e\x2e\x99\xb1\x2b\x7a\xee\x57\x8e\xf6\xba\x2b\xf5\x08\x62\xbe\xd3\x01\x0c\x51\x25\x62\x79\x09\x81\x75\xa8\xb2\x7c\x07\xaa\xbd\x13\x39\xc8\xf4\xad\xac\xc3\x8d\x28\x79\x99\x7a\xe9\x97\xe0\xc8\xf8\x4d\x17\x97\xf4\x89\x13\x93\xfa\xeb\xd2\x12\xb9\xf5\x49\x74\xb4\x97\x1f\xbe\x53\xa9\x8a\x2f\xcb\x54\x15\x9a\x1c\xa0\xdd\xe3\x34\xe4\xcd\x9b\xe9\x60\x7e\x75\x81\xd7\xe7\xbd\xe9\xfc\xaa\x37\x1c\x5d\xbb\x9e\x89\x94\x41\xe5\x6e\x47\x2a\x4f\xf1\xf2\x86\x30\xdf\xb7\x0c\x8a\x8c\xf1\x57\x03\x7b\xe6\xeb\x08\x9d\x1b\x7f\x9d\x95\x3f\x6e\xf9\x78\xe2\xac\xf5\x2d\xf4\x37\x64\x81\x43\xb8\xe1\xe6\x8d\x8f\xdd\x4d\xbe\x8d\xdd\xb5\x3a\x25\xed\xeb\xdd\x61\xa4\xbf\xfe\x85\x53\x01\xfc\xa5\x00\xaf\x75\xfe\xa6\x58\x70\x34\xd5\xdd\xc0\x51\xde\x49\xa1\x91\xaf\x16\x15\x94\x4c\x41\x39\xd8\x24\x38\xbc\x60\xe0\xad\xed\x34\x8a\x56\xeb\x08\x8a\xfe\x07\x82\x7b\xcc\x69\xb4\x37\x89\x97\xdc\xcb\x5f\xe0\x5f\xc0\x13\xb5\x9d\x7e\xb4\x6d\x83\x3b\xae\xca\x0d\x50\xb1\x55\x02\x55\xe6\x82\x35\x6f\x15\x95\x2d\x6b\x1d\x3b\xa0\x9a\xc4\x9c\xf9\x74\xbd\xd2\x7e\x31\x6e\x6b\x05\xb1\x89\xe7\x48\x25\x44\x86\x78\xa6\x23\x57\xe2\xc9\xd3\xbb\xae\xde\x95\x7c\x5f\xa6\x22\xca\x42\x3e\x28\x61\x8a\xcc\x33\x2c\xd1\xfc\xed\x60\xf2\xb2\x37\x1b\x5e\xd1\xa5\x63\x83\x49\xb8\x83\x46\xe3\x07\xeb\x5b\x2b\x58\xf2\x82\x9f\x65\x5a\x79\xc7\x4d\xd9\xf0\x9f\xf0\xea\x0d\x59\x7f\xdd\x8d\x33\xcb\xc2\xe6\x5b\xa5\x63\xa6\xbc\xb7\x8c\xf8\x19\x0d\xde\x9d\xed\x4c\x37\xe4\xe5\xe9\xa1\x71\xf3\xc0\xd2\x6b\xeb\xb9\x6f\xe9\x28\xe0\xbc\x9e\xf7\xc7\xb6\x0b\x0f\xf2\xa8\x9a\xd6\xcf\x2d\xd9\xdd\xd6\x71\xd5\x47\xb6\xeb\x18\x09\x42\xee\xaa\x85\x9c\x6a\xee\x1a\x46\x1e\xa5\x92\x50\x21\x15\xcb\x4b\xf3\xc5\x88\xaf\x57\xa2\x8b\x44\x59\x1e\xbb\x5b\x28\x79\x40\x66\x25\x19\xdf\xc9\x3d\xb6\x0b\x49\x14\x6e\x77\xf7\x60\xcd\x43\x03\x78\x0d\x99\x32\xbe\x33\xcf\xc5\xfc\xe5\xbb\xf9\x64\x70\xd9\x9b\x0d\xc7\xa3\xc3\x43\x1c\x0f\x07\x95\x0a\x8e\x26\xa4\xb9\x23\x5b\x2b\xdf\x13\xc7\x96\x9f\x97\x74\xf6\x09\xab\xc0\x7c\x50\xe2\x85\x4d\xf6\x60\x22\xcd\x91\xf5\x39\x82\xd6\xa1\x98\x7e\x0e\x08\x7e\xa9\xad\x76\x1d\x08\xbf\xa7\x91\xa8\xa4\xa2\xf3\x82\xb5\x24\x6c\x35\xff\xda\x67\x42\x14\xac\x55\x88\x7b\x20\x82\xe7\x9d\x39\xba\x01\x28\xec\x9c\xa3\x62\xad\xd5\xae\xc0\x8e\x81\x37\x7b\xa4\x22\x93\xd3\x9d\xf8\xd4\x01\x41\x69\x7d\x4d\x6b\xb3\x9c\x79\x84\x0e\xa0\x21\x73\x29\x1c\xf9\xee\x48\x0d\x58\xbe\x2b\xc6\xa1\x16\xba\x77\x09\x3c\xe1\xe3\x8f\xdc\xe1\x96\xb1\x8f\x60\x26\x64\x6c\xc6\xc7\x6c\x3c\xbe\x9c\x0d\xaf\xa7\x61\xb5\xfd\x49\xd0\x70\x9e\x1b\x6a\x6d\xd0\xe2\x11\xbc\x29\x4b\xe4\xb0\xb8\xf5\x17\x4f\x13\x28\x5c\x60\x8e\x6c\x6c\x49\x29\xea\xb9\xde\xb1\x23\xb5\xec\xbe\x15\x5a\x23\x2e\x25\xd2\x17\x9d\xc3\xb1\x4b\x8d\x39\xc2\xae\x31\x2e\x9d\xea\x83\xd7\x48\xca\x14\x7c\xee\x0e\x10\xa2\x27\xb8\x8f\xc0\x59\xfa\xfe\x72\x3e\xba\x0a\x84\x94\x17\x6a\xe8\x8f\x72\x97\x48\xc4\xa9\x74\xa9\x12\xf4\x29\x1f\x67\x52\x2c\x74\xce\x97\x02\xb8\xdb\xba\xa0\x93\xa4\xbb\x3c\xd4\x01\xc3\xcc\xf6\x07\x48\xf3\xa0\x66\x57\xe3\xd3\x0a\x23\x97\xe7\x8e\x27\x9b\x17\xde\x55\x91\x05\xad\xe5\xcd\xac\xf7\x7a\x7a\x6c\x8b\x57\xea\x19\x4c\xa0\x5e\x78\x22\x42\x93\xa6\x2e\xfe\x8c\x70\xc2\x5b\x29\xb1\x39\xa1\x6c\x83\xc6\xed\x27\x4f\xe1\x5c\x4d\xe3\xf0\x3e\x1f\x77\x1a\xb8\x6b\xae\x4b\x36\x21\x05\x06\xed\x43\x66\xee\x7d\x7e\xb9\x54\x22\x5f\x8f\x6e\xbe\x36\xbe\x9e\xc7\xbe\x96\xcb\xf5\x1a\x24\xb2\x91\x2f\xe8\x8b\x26\xb8\xfb\xb6\x31\x23\xc4\x0e\xc0\x3f\x9c\x62\x89\xec\x22\x62\x52\xb2\x14\x39\x21\xae\xd5\x16\xdf\x75\x7f\xe8\xfe\x19\xce\xd2\x8d\x5e\x6f\xdc\x85\xc4\x5c\xba\xa0\x91\x29\x53\xcd\x2e\xc0\x0d\x83\x67\x62\x08\xad\xd3\x7a\xb0\x04\x14\x35\x53\x56\xac\xa5\x2b\x06\x80\x1e\x1c\xd0\xde\xaa\x4b\x21\x99\x44\x01\xa9\x58\x7d\xa9\x5e\x3d\xc0\x46\xcd\x99\xb8\x0a\x74\x18\xb6\xf4\x2d\x81\xfc\x96\xa0\x98\x29\xab\xe0\x8c\x6b\x44\x54\xe9\xed\x49\x50\xc9\x43\x4d\x59\x71\xb0\x92\x62\xd5\xe4\x5e\xb4\x54\xa1\xa6\xdd\xc4\x9c\xfc\x9d\x4d\xa5\xe7\x67\xd6\x78\xe6\xd4\x46\x44\xb8\x23\x28\xc8\x18\x94\xc2\xf8\x4a\x0e\x17\x3e\x72\x2e\x48\xf2\x6c\xb1\x75\xc9\x40\x07\x35\x1e\x7c\x7d\x13\xb8\x23\x53\xa6\x88\xf3\xe7\x21\x5f\x05\xc0\x31\x23\x05\xfa\x5e\x69\x9b\x77\x38\xff\x07\x32\x9c\x22\x77\x75\xf6\x16\x49\x7a\x57\x65\x70\xf6\xc5\xa5\x2b\x0b\xfe\xf1\x8d\x3c\x1a\xff\xfe\x7d\x5c\x52\xbd\xf2\xaf\x76\xc2\x1d\x68\xa8\xe1\x6e\xde\xa8\x72\x3b\x37\x27\xa9\x23\xe2\x4e\xf7\x5e\xe6\x7a\x2b\x96\xe9\x6e\xef\x10\x18\xf4\x43\x2f\x0f\x7b\x0a\x0b\xd2\x9f\xdc\x59\xbc\xec\xa5\x47\xf5\xb9\xde\x8d\x0a\x5f\x53\xde\x54\xf3\xaa\xa1\xab\xfb\xd4\xb9\xe7\x62\xca\x8d\x96\xdf\xd3\xd0\x0f\x48\xc6\xd6\x01\xb0\x55\xd1\xe6\x4c\x95\x7f\x91\xb5\x55\x6f\x7b\xc3\xe8\x21\x1b\xf5\x8f\x32\xbd\x7a\x97\xd7\x6f\x7a\x2f\x07\xb3\xa1\xed\xaa\x77\x31\xf8\xe5\x50\x7c\xc3\xd7\x5e\x5d\x18\x2d\x89\xcd\xa1\x56\xdb\x77\xa5\x64\x95\x63\x11\xd8\x43\x5d\x27\x86\xe8\xc0\xe2\x42\x3b\x67\x47\xfb\xc8\x9c\x14\x71\xca\x59\x73\x3e\x52\xcc\x15\xe9\x7c\xd7\x14\x79\xe5\x7c\xbb\x77\xf3\xe0\x85\xc4\x87\x60\x55\xce\x2c\x30\x45\x7f\x7c\x39\x9d\x0f\x47\x73\x42\x41\x00\xfb\x11\xef\x4f\xbe\xf1\x89\xd5\xa8\x9d\x4f\xe3\x62\x9b\x70\xb7\x8c\x32\xc7\xba\x01\x4b\x65\xed\x3c\x7a\x3f\xed\x62\x9d\x37\xf4\x11\x78\x7a\xd0\x47\xe0\xd9\x61\x13\x81\xc7\xdc\x7c\xdd\x00\x78\x85\x96\xa8\x4d\x6b\x80\x9b\x70\xf3\x3d\x89\x01\xbe\xa6\x2a\xbc\x4b\x56\x27\x81\xf3\xc3\xe4\x32\xcb\x59\xbf\x02\xe5\xb7\x29\x72\xdc\xd5\x4a\x7f\xec\x54\x5e\xe2\x03\x9d\x2f\x44\x2c\x6f\x3b\xa4\x0b\x98\x58\x1a\xe8\xe4\x08\xd6\xec\x49\x3d\x7c\x3d\x1a\x4f\x06\xf3\xeb\xc9\xe0\xd5\x90\xf8\xb2\xd5\x3e\x46\x1d\xc9\x6b\xa0\x54\xd0\xd2\x53\x60\x3f\x54\xe6\x20\xcf\x80\x43\x30\x96\x78\xb1\x67\x58\x67\x85\xd2\x22\xf8\x0e\x07\xf3\x65\xd0\x5e\x05\xe6\x7f\x3a\x65\x9b\x54\x2b\x7b\xfc\xfc\x51\xa2\xc5\xb7\x84\xa1\xb9\x1a\xa4\x4a\xf3\x09\x52\x59\xd9\xe3\x7a\xce\xd0\x2b\x07\x5e\x85\x19\x83\x39\x1f\xdf\xcc\xee\xc9\x1f\xb7\xc1\x47\x8f\x13\xe4\x59\xf9\xed\xea\x22\x7c\x38\xd5\x2a\xa9\x5e\xa0\x2e\x4c\xd1\x4c\x45\xd5\x58\xa5\x9d\x6b\x7e\x31\x9c\x0c\xd0\x35\xef\x5d\x38\x54\x78\x15\x3b\x3c\x98\x79\x5d\x8a\xb9\x80\x33\xae\xfa\x7a\x2e\xa0\x3c\x3e\x82\xf5\xaa\x58\xa8\x73\x5d\x88\x80\xe0\xdf\x0b\x1a\xbd\x82\x25\xf2\x37\xfa\xf0\xc5\x43\x92\x10\x3a\x62\x10\xd2\x70\x27\x7a\xe8\x85\xa3\x61\x83\x0b\x11\x39\x21\xe4\xb9\x80\x5a\xdc\x11\x48\x40\xed\x88\xae\x34\xbb\x7b\x9c\xf9\xdd\x2f\x87\x84\x1a\x7c\x84\x84\xee\x01\x16\xac\x5f\xee\x3e\xe8\x25\x38\x28\x3b\x73\x4d\x05\x31\x76\x5d\xb9\x81\xf3\x05\xea\x4d\x03\x2a\x7c\xc0\x8b\xb9\x31\x48\xf5\x38\xae\x50\xf9\xa0\xb3\x9d\xc7\xdb\x09\xf0\x4b\xda\x0b\xdc\x68\xfc\xba\x9a\x45\x6c\xc2\xfa\x14\xb2\x2e\xf7\xdc\x11\xc3\x75\x7c\x41\x9d\xb5\xd9\x28\x95\xfb\xf4\x06\xb7\x04\xd8\x29\xae\xea\x08\xac\x5e\xf6\x27\x48\x9b\x0c\x06\x27\x63\x08\x55\x74\xf1\x2a\x97\xaf\x73\xe3\x9c\x92\x3c\xb3\xc9\x60\xf0\x76\x38\xf8\xf9\xb4\x2b\x86\x14\xe4\x80\xe5\x13\xef\x45\x86\xbb\xf0\xb6\xbe\x99\x7f\x78\xc6\xb4\xda\x9e\x2f\x18\x38\x17\xd7\x70\xab\x3d\xbb\x23\x6e\x16\x89\xba\x9b\x33\x86\xfc\xdf\xab\x34\xcd\xc3\xbf\x09\x6a\x02\xba\xbb\x34\x80\xfb\x5d\x5a\x64\x7d\x82\x06\xfd\x61\xca\x8a\xa8\x44\x6c\xd3\xa8\xc2\xed\xb5\xe1\x83\x82\x0d\xee\x29\x25\xbe\x72\x86\x0d\xdd\x61\xf7\x15\xdf\xe7\x73\x18\x08\x3d\x6c\x92\x53\x05\xb0\x4a\x0b\xbe\x50\xc3\x5e\x2a\x69\xc2\xc6\x8a\xe5\x39\x6a\x70\x1f\x30\xf5\x15\x44\x41\xfa\x06\xd7\xf9\xc1\x4a\x42\x5a\x6c\xd9\xb7\x84\x8a\x1a\xe9\x96\xc5\x70\xf2\x43\xfc\x52\xf4\xad\xd8\xad\x33\x59\xde\xf5\x97\xa8\x3b\x95\x85\x86\x26\x2f\x8f\xdb\x77\x84\x8e\x13\x24\x0d\xb0\x9e\xe7\x82\x57\x88\xe0\xb8\x2b\xdd\xc8\x1b\xcb\x0d\x3b\x5d\xe6\x57\x83\xa7\xe9\xf7\x6e\x77\xde\xc8\xa2\x7a\xd2\x06\xe2\x6e\x3c\x9e\x7d\xfa\x46\xb7\xec\xf4\x80\xb8\xfb\xf4\x3d\xee\x77\x38\xb1\x0c\xa6\xb0\xdc\x15\x42\x52\x69\x9b\x70\x0f\x3f\x49\x47\x4e\x3f\x16\xb1\xf4\x1d\x78\x2a\xf4\x8c\x53\xb3\xb7\x10\xf4\xe0\x46\x8e\x70\x0d\x1c\xe4\x3e\xc2\xe3\x9f\xca\xe1\x76\xe4\x56\xbb\x99\xc7\x3d\x87\x7f\x01\x71\x6f\xe9\x7b\x94\xfe\xd4\x28\x62\xfa\x66\x30\x98\x7d\x02\x0f\x2c\xa5\x59\xda\x4d\x41\x72\x04\x68\x81\x28\x61\x49\xcb\x02\x10\xad\x7d\x20\xfd\x43\x7e\xc8\xeb\x13\x80\xa7\xce\xf2\x22\xe1\x1a\xa4\x34\xfd\x60\xcd\x9f\x9a\x6e\x56\x4f\x07\x3c\xc6\x3f\x0e\xbd\x81\x50\x0f\xed\xe2\xdf\x4b\xbd\x06\x68\x8f\x92\x0e\xd9\x15\xcf\x59\xba\xd7\xc4\x3a\x02\xa2\x00\x0e\xc7\xf0\xa4\x17\x92\xa0\xe2\x6d\xb1\xa4\xef\x08\x69\x7c\x2c\x9c\x18\x3f\x4b\x17\x48\x8b\xf2\x97\xdb\x63\xef\x9e\xd4\x88\xc9\x69\x0a\x6c\x58\xac\x0a\xf8\x47\xf9\xa6\x55\xc8\x25\x64\xd6\xaa\x5c\x7d\x19\x16\x0b\xd6\xdf\xc8\x62\x07\x50\xde\xc7\x68\xa5\xab\x3a\xe4\xb8\x56\xbb\xce\x73\x7c\x62\x97\x19\x16\x7c\xc0\xbb\x8b\x65\xb0\x7e\xaf\x2f\x84\x6f\xb4\xda\x61\x0b\x48\x26\x5f\x57\xdc\xf0\x85\xf0\x65\x93\x5e\xee\x1a\x2b\xe0\x7f\xcf\x8a\x58\xf9\xe4\x77\x3b\x9a\xc4\xde\x70\xcd\xfa\x61\xd3\xc3\x91\x8e\x50\x12\x74\x61\xbc\xc3\xbd\xf8\xea\x28\x32\xe4\x1f\x27\xb7\x26\x5f\x7f\xc7\x95\xc2\xce\xa3\xdf\xb0\x68\x51\xf6\xb7\xca\x6c\x96\x5b\x85\x11\x38\x7b\x9a\xa9\x5c\xec\xd0\xe6\x96\x16\x56\x4b\xa5\xda\xed\x4b\x2e\xe6\x81\x7d\xde\x49\xe0\x86\xf3\xea\x77\xf5\x8c\xad\x74\x64\xa7\x1b\xdd\x1a\x46\x02\xfa\x56\x42\x6f\x71\xe9\x0b\xe5\x04\x90\x1a\xc4\x4e\x5a\x64\x84\x57\xde\x61\xf6\xb4\x56\x2a\xa3\xd9\x54\x34\x26\x77\x82\x66\xca\xf6\xea\x4d\x13\x9f\x2e\xcc\xfd\xf8\x4e\x1f\xdb\x39\xeb\x4b\xb0\xfa\x01\x2b\x37\xb2\xfa\x27\x75\x3d\xb2\x98\xe4\x44\x5a\xd2\x99\x29\x2f\xaa\x16\xdc\x27\x0d\xb4\x34\xdb\x97\xe9\x4e\x37\x1a\xb1\x1c\xa0\x06\xf1\x48\x3d\x72\x27\x0c\xdd\x1c\xe5\x06\x63\x53\xac\x3a\x08\xdd\x8f\x77\x64\xa4\x1b\x9f\x1c\xfe\xa7\x4c\xc5\x48\xc7\xff\x3b\xeb\x32\x4c\x8b\xca\x09\xcd\x75\x4d\xe1\xf1\xe3\xd9\x0c\xd1\x1e\xef\xb4\xe6\xea\xa5\x61\x30\x44\x80\x5a\x7c\xdb\xf1\xd5\x1c\x65\xf2\x1f\x5a\x47\x74\x45\x0f\x69\x04\x89\xcf\x60\xf3\x59\x7f\x87\xf0\x49\x73\xa6\xcd\xbf\x73\x1e\x20\x24\x45\x12\xb4\x20\x4e\xb3\x52\x29\x73\x4e\xf3\x2f\xc9\x2a\x3e\xe5\xea\x40\x2a\xf6\xc7\x97\xe3\x09\x81\x3b\x7f\x73\xc3\xd5\x6d\x61\x4b\xb5\x65\x1a\xa7\x7e\xbb\x05\x74\xf1\x09\x92\x2e\xbc\x26\xa3\xff\x2e\xb8\x76\x82\xde\xf1\xe9\xf4\xe1\x4e\x83\x00\x59\xc8\xe5\x07\xb4\x89\x87\x2c\xb1\xec\x26\x97\xcb\x34\x63\xe5\xd6\x75\xcd\xa1\x31\xba\xe2\x0d\x59\x9e\xcc\xb1\x16\x8d\xe4\xfe\x4c\xd6\xe8\xc6\x0a\x47\x18\x3d\x78\xb7\x51\x2a\xee\x60\xcb\xb5\xda\x3e\x22\xd4\xdc\xf6\x0a\x23\x36\x29\x6f\x76\xff\xfa\x24\x22\xef\x46\x68\xb5\xc5\x93\xb0\xc3\x5b\x86\x2c\xe2\x3f\x3f\xc1\xaa\xf6\x0a\x85\xa6\x1d\xf1\xf4\x19\xfd\xb9\xce\x14\xc2\x0e\x4f\x7f\xa0\xbf\x96\x7b\x99\x74\xc4\xb3\xef\xe8\x8f\x45\x5c\xa8\x8e\xf8\xf6\xc9\x13\xc4\xd4\x8a\x6c\x17\x73\x53\x89\x6f\xed\x40\x99\x2b\x3e\x79\x54\x4f\xd3\x6f\xbf\x3f\xbc\x2a\xfb\xd9\xb3\x27\x5f\x86\x5f\x6a\xfc\x00\x7e\x11\xcf\x9e\x3d\x39\xc6\x33\x53\xee\xcb\xc1\x5d\xc0\x30\x1f\x3c\x8c\x99\xce\xf7\xe4\xfb\x33\xa8\x4e\x23\xe5\xf8\xd4\x31\x11\x11\x8c\x3b\xdc\x1c\x30\x15\x68\x50\xed\x64\x5b\x1e\x08\x74\x7c\x60\x23\xae\x33\xb9\x37\x4b\x89\xbd\xcc\xbb\xd0\x35\x7b\xc1\x1c\xcf\xbe\xff\x9e\x7b\xcc\x51\x9a\x17\x5f\xd6\x93\x22\x30\x87\x8e\xb6\x3c\xff\xa3\x30\xfd\xec\xfb\xef\x0f\x30\xfd\xf4\xc9\x97\xc7\x34\xb0\x48\x98\x7e\xfa\xe4\x28\xa6\x5f\xf7\xae\xae\x7a\x87\xb8\x5e\xcb\xed\x56\xba\x5c\xef\xb2\xa9\xbf\x93\xa7\x38\x9d\x8a\xad\x4e\x88\xa7\x91\xc5\x9c\x26\xe4\x37\x58\x35\x6c\xd4\x0a\x21\xde\x02\x9d\x46\x2c\x54\x9c\xde\xd1\xb2\x70\xd3\x92\x8c\x0a\x52\x2e\x7d\x28\x9d\x29\x13\xeb\xf5\x26\x57\x19\xb7\xc2\x94\xc6\x12\x83\xae\xe4\xbe\x55\x78\xbb\x0c\xbe\x7b\x57\x62\x44\xf2\xce\x5f\x7b\x5f\xa0\xee\xe6\x56\xf3\x65\x54\x78\x85\xeb\x0e\xe4\x12\x97\x4a\x33\x9c\x0c\x1c\xc5\x1c\x7f\x08\xb7\x25\x3c\x05\xfc\x0c\xd8\xa6\xfb\x43\x27\x18\xf8\xd9\xb3\xca\x0e\x0e\x1e\x7c\xd6\x7d\x66\x37\x22\x26\x74\x9a\x50\xab\xed\x6c\x76\x8f\xe0\x06\x6e\xf9\xae\x81\x5d\xbe\x3b\x8c\x12\xfc\xf0\xe5\xb9\xc5\x72\x82\x78\x21\x7e\x78\x12\xf8\x77\xe9\xa9\xd9\xf0\x6a\x30\x9d\xf5\xae\xae\x9d\xb1\xdb\x14\xf5\x67\x33\x34\x5d\x35\xb9\xc5\x20\xa0\xe0\x23\x64\x5d\xad\xec\x88\x03\x6d\x8e\x50\x95\xeb\xad\x2a\xeb\x17\xc8\xa1\x78\x27\x2b\x95\x0b\x65\x02\x19\x85\x0d\x79\x7e\x28\x21\x1b\x15\xef\x00\x18\x1a\x43\xdb\x31\x9c\xe1\x82\x21\x62\x69\x72\x94\xdf\xb2\x4d\x51\xb8\x08\xd5\x41\x2f\x08\xf8\x8d\x69\x1c\x2c\xea\x77\x86\x69\x1f\x81\xfb\x12\xab\x75\xa7\x76\x88\xfb\x8b\x77\xa3\xde\xd5\xb0\x3f\xbf\x1a\x8c\x6e\xa6\xf7\xe1\xff\x00\xe1\x55\xe0\x2a\x98\x47\xb5\x80\x4e\x38\x00\x42\x1e\xb8\x5b\x95\xd9\xc8\x4c\x22\x6f\xf5\xda\xbe\xb2\x55\x49\x51\x6d\xa0\xb5\x47\x4a\xb1\xf5\x33\x39\x33\xe3\x56\x4b\xba\xcc\x7a\x4a\x9e\x21\x32\x65\x39\x7d\x85\x6b\x49\x82\x01\xdd\x7c\x1c\x4f\x0c\xba\x93\x17\x71\xae\x51\xde\x1a\xab\x5b\x15\x93\x96\x9d\xcb\x05\xa3\x14\x5a\x0d\x2e\x5d\xe3\x89\xd1\xf7\x2d\xe2\xfa\x2b\xf4\xa7\xdd\x87\x0c\xd6\x15\x17\x41\xd4\xd8\x19\x3b\x60\x0d\xdb\x52\xc2\x25\x89\xf0\xd0\x51\x5a\x5e\xd1\x5d\x02\x81\x4c\x21\x2a\x88\x00\x83\xfe\x94\x5b\xf6\xe2\x17\x7f\x6f\x1a\xce\x23\xf8\xa2\x4a\xf1\x4a\x44\xb3\x89\x31\x2a\x8d\xf6\xbf\x00\x6f\xb0\x17\x81\xd7\xcd\x3a\xbe\xbd\x7b\x91\x76\x10\x36\x59\x52\xda\xa1\x6e\x67\xe3\x6a\x63\xe8\xbf\x2a\xfa\xe3\x77\xce\x01\xe8\x7e\xe7\xfc\x0c\x5e\xc6\x8b\x36\x3e\x37\x1f\xdd\x5c\xcd\x07\xa3\xd9\x64\x38\x98\x7a\x93\x97\x0f\x3a\x67\x7a\xb1\x51\x5b\x46\x7d\xd1\x1a\xdb\x56\x03\x5a\x50\x6b\x97\x12\x23\xf5\xa5\xec\x05\x0c\x36\x8c\xf4\x92\xec\x36\xea\x3e\x1f\xef\x49\x1b\x87\x6a\x9f\xd1\x74\xea\xe3\xce\xa6\xf1\x01\x79\xcb\x34\x8e\xe5\xce\x28\x37\x49\x65\x4b\x21\x47\x20\x43\xeb\x46\xa7\x04\x5b\x85\xc3\x0e\x40\x83\xd2\xdc\x36\xed\x84\xd2\xdb\xec\x7e\xe1\x6d\x92\x8b\x6d\xca\xaa\x72\xa9\xd8\x1e\x80\x45\x1b\xd9\x5d\xdb\x7c\x52\x24\xe8\x81\xdf\x6a\xfb\xfa\x6a\xb7\xc0\xc8\xce\x25\x63\x74\x47\x41\xfd\xdf\x12\xd1\x02\xbb\xab\xe4\x16\x9d\xd8\x4e\xbb\x62\x9a\x7a\x53\xb6\x12\x38\x6f\xb5\xfd\x6c\x4f\xab\x4a\x93\xe4\xeb\x41\xab\xb3\x2c\xfc\xf5\xe3\x5d\x41\x27\x34\xd7\x39\xb8\xeb\x12\x5a\xed\xf2\xa0\xc5\x64\xd4\xbf\x9a\xd2\xf4\x54\x13\x80\x70\x84\x62\x52\x9b\xe5\x03\x3d\x84\xa7\xb5\x98\x24\x87\x4b\x7d\xde\xc7\xe8\x6d\x3f\xfe\xf8\xe3\x8f\x7f\x9c\xe2\x76\xc8\xb0\x5e\x71\xe3\x6d\xef\x07\xc1\xa5\x64\xec\x69\xaa\x0c\xd5\xa9\x26\x43\x42\xd8\x56\x8c\xc6\x8a\x27\x3b\xdc\xde\xae\x93\x33\x57\xf7\xa4\x99\xe8\xed\x76\xb1\xfa\xda\x88\x5f\x28\x6f\xef\x5b\x64\x84\xab\xb5\x3d\x5e\x22\xf0\x5c\xba\x83\xf8\x20\x42\xdf\xea\x2c\x4d\xf0\x57\xad\x99\x12\x3f\xa7\xb2\x2e\xf4\x2b\xd5\x5d\xa6\xdb\xf3\x8f\x18\xee\xfc\xb4\xe3\xcb\x25\x5c\x65\xf1\x78\xfa\x4b\xab\x2d\x9e\x3e\xe9\x7e\x2f\x4e\x2e\x55\xba\x93\x59\x74\x1a\xf6\xc8\x2e\x9b\x56\x72\x7a\xa7\xca\x6b\x4d\x17\x1d\x64\x14\x4c\x42\x52\x5b\x78\xf1\xe8\x11\xd3\x7f\x52\x24\xd4\xd4\x8d\xf4\xc8\x03\xed\x1e\x51\x62\xf2\xe3\x78\x4f\xb6\x7b\x93\x04\x61\x16\xbe\xcc\x8d\x92\x9d\x63\xb2\xcc\xde\x0b\x07\xf9\xbf\xcf\x2f\xf5\x22\x93\xd9\xfe\xfc\xc2\xe1\xe6\x7c\xba\x91\x99\x8a\xce\x5d\x2d\x03\x41\x87\xbf\xa6\x2a\x2f\xaf\xd0\xfb\xa5\x4c\x2f\x5b\x69\x58\xb8\xd8\xee\x90\x02\x08\xa1\x15\x3b\xeb\xc3\xbf\x0f\xef\x31\xcf\x8b\x64\x38\x7d\xab\xce\x39\xa9\x34\x92\x38\xf5\x63\x65\xce\x21\x71\x2c\x7b\xd0\x5c\xe7\xf3\xb2\x68\xa6\xd9\xae\xfd\x23\x85\x7c\x9d\xd1\x6b\xea\x91\x1f\xbb\xd6\xb6\xd1\x15\x13\x05\x68\x5f\x29\x15\xa1\x60\xab\xca\x3c\xf8\xd4\x35\x1d\x82\x9c\x47\x87\x80\xed\x22\x53\x71\x2c\x39\x87\xc5\x26\xfb\x78\xb5\xe4\x80\xf7\x38\x81\x5b\x0a\x78\x41\xcb\x1e\xfa\x70\x70\x9d\xb8\xcc\x63\xd8\x4f\xdb\x1d\x22\xb2\x69\xc6\x7c\x95\x0b\x53\xe8\x5c\x9d\xba\x7d\x07\x87\xc5\xee\xbe\x13\xd3\x1d\x04\xe5\xb6\x05\x60\x9f\x82\x60\x46\x61\x1d\xc5\xf6\xe3\xf9\xab\xc1\xe0\xc2\x5f\xa2\x46\x28\xfe\xaa\x79\xca\xaf\x5a\xe1\x9c\xee\x7c\x01\x90\xdc\xed\x32\x4c\xbd\x29\x12\xfd\x6b\x81\x6e\x9e\x3a\x52\x49\xee\xe2\xab\x15\x24\x82\x79\x55\x2e\x16\x45\x12\xc5\x7c\x55\x47\x90\xb8\x23\x45\x06\xd5\x0e\xad\x57\x52\x68\xaa\x67\x44\x5a\x76\xf9\xd0\x7c\x1d\xc1\x11\xe8\x65\xba\xed\x6e\xf7\x8c\xe9\xee\xd5\xde\x6e\x9d\xda\x11\xca\x17\x35\x77\x99\x2b\xd8\x29\x88\x41\x8f\xa3\x3e\xcd\xd6\x5d\x16\x30\xdd\x6b\xee\xf7\xf7\xc5\xf0\xfe\xf2\x66\x74\x71\x39\x98\x0f\x2f\x3c\xde\x1b\xa6\x73\x36\x3b\xbf\x73\x7d\xf3\xf2\x72\x38\x7d\x33\x98\xe0\xb5\xcf\xa1\x42\xab\x7d\x48\x07\xb1\x2b\x16\xb1\x36\x48\x4c\xfe\x04\x22\xb4\xda\x15\x32\x1c\x23\x42\x65\xa6\x47\x62\xda\x2f\xe7\x8b\xe1\xba\x82\xb7\x43\x5c\xbb\x09\x8f\x62\xbb\xbc\xf9\xd1\x72\xb3\x56\xe6\x5e\x3c\x1e\x85\xd3\x4f\xf5\x47\xc0\x46\xab\x14\x2f\x44\x05\x9e\xba\xe2\xf0\x66\x76\x75\xf9\x66\x70\x79\xd4\x8e\x8f\x6a\x7b\x1f\x8b\xb2\x99\xde\x81\x62\x81\x41\x42\xed\xe2\x39\x67\xf3\x6d\x90\xea\xe3\x7e\x5d\xba\x3b\x48\xec\x9f\x1f\xdc\xdd\x50\xee\x83\x1d\x20\x2a\xf3\x0d\xcb\x54\x16\x96\x8c\xae\x29\xdf\x95\x46\x25\x5f\xba\xca\xbf\x36\x64\xbb\x88\x37\x30\xc6\x7e\x4e\xb3\x0f\x66\x93\xee\x5a\xed\x9a\xce\x81\x74\xfd\xad\x7b\x85\x34\x0e\x95\x9c\x15\xe6\x1c\xcd\xed\x60\x9d\x9c\xdb\xdb\x6b\x0d\x32\x92\x3e\xfe\x5f\x3a\x7a\xf1\xec\xe9\xd3\x6f\x7f\x38\x15\x94\xd0\xc0\x57\xf5\x97\x69\xe2\x87\x13\x3a\x2b\xc9\x49\x77\x1d\xbb\x4c\x0e\xb6\x2c\x60\x47\x53\x86\x64\xa0\x71\xb4\xda\x8d\xa9\xe4\x7c\xff\xa9\x3b\x42\x78\x38\x97\x83\x00\x84\x9c\x74\x97\x9b\xed\x69\x57\xf4\xc3\xaf\x7c\x24\x00\x8a\x3c\x92\xcd\x9d\xf2\x06\x9e\x64\x10\xc4\x8f\x3f\x58\xab\xd5\x9e\xd7\x9d\x50\x27\xf6\xe1\x38\x91\xc6\x51\x09\x75\xf8\xbc\x38\xe9\x6e\xe2\x1d\xb0\x42\xa0\xb8\x27\x76\xb1\xcc\xf1\x80\x77\xed\xd9\x98\x9c\x5d\x1f\xae\x1c\x20\xcd\xda\x2f\x9f\x8b\x9b\x9d\x5d\x29\xd9\xfe\x47\x2f\xa6\x9c\x4c\x74\xca\xb0\xb5\x65\xbc\x76\x85\xae\x5d\x0f\x27\xed\xdb\x8a\xf2\xbb\x34\x3b\x76\x39\x5f\x49\xa4\x3b\x47\x9f\x70\x4a\x10\xe9\x56\x53\x4e\x8b\x1d\x6a\xe9\xd7\x59\x22\xd9\x1c\xdf\xaf\x5f\x52\x93\xf1\x3b\xaf\xa6\xc9\x28\xd1\x7f\x73\xf5\xe0\x7d\x36\xb5\x18\xd1\x8a\xcb\x6c\x60\xde\x40\x7d\x05\x9b\x30\x5b\xf8\x7b\x5f\x60\x0a\x20\xa1\x97\xf2\x18\xcb\x94\xc4\x32\xdc\xc4\x69\xda\x6c\x24\xb1\xd8\x87\x37\x84\xca\x6c\x83\x16\xc5\x78\x83\xf4\xc0\x03\xfd\xf9\x13\xb1\x43\xf0\xd7\x31\xe4\xc1\xe7\x93\xf0\x20\x9c\xf4\xa6\x4f\x57\xd8\xcf\x1e\x91\xaf\x18\xa7\x4b\xee\xc2\x53\xe9\xae\x45\x41\x01\x77\x1f\xae\x47\xa3\x8f\x1c\x70\x72\x5f\xbc\x2b\x77\xf4\xc9\x66\xb3\xec\xaa\x8f\xea\x94\xfc\x59\x49\x9a\x9c\x51\xcf\xdd\x4e\x90\x8f\x46\x8a\x3e\xae\x10\x43\xae\x55\x91\x1c\x1b\x89\x53\xe9\xca\xfd\xef\x05\xa0\xe3\x3b\x5a\x10\xbc\x27\xe8\x8f\x10\x9a\xee\x64\x17\x91\xdd\x8a\x98\xe4\x17\x41\x76\x05\x99\x0d\xc8\xf6\x23\xf4\xdf\x0c\xe9\xc6\x84\x32\x2a\x80\xe6\x05\xc2\xb8\x76\xc7\xdd\xe5\x46\x07\x67\x40\xa5\xc7\x4c\xab\x5d\x5e\x60\x49\xeb\xd3\x9e\xbd\x1a\xda\x27\x6d\xa5\x81\xd7\x03\x2c\xcc\x42\x6f\x34\x3e\xfd\x92\x7b\xb2\x11\x11\x15\x40\x85\x38\xb4\x30\xec\xbe\xb4\x36\xb9\xef\xd4\x1b\x24\x16\x53\xbb\x5f\x25\xde\xe4\xdb\x98\x4e\x24\x8b\x8b\x93\xcd\xe6\xc3\x69\xc7\x49\x35\x62\xa3\x53\xf6\xfc\x54\x4e\x3a\x7e\xe0\x8b\x80\xd2\xb0\xce\x0a\x4d\x5f\x0e\x47\xbd\xc9\xbb\xf9\x6c\xdc\xaf\x51\xd4\x75\x8d\x44\x31\x62\x22\xb3\xfd\xa1\x54\x3e\x4a\x56\xc9\x19\x41\x0d\xaf\xe0\xaa\x52\x47\x5b\x4f\xd4\xae\x78\x65\x1b\xc6\xd8\xf0\x28\x32\x25\xec\xed\x0d\x80\x56\x89\x6b\x97\x00\x01\x44\x8d\xd0\x10\x7f\x51\xe4\x79\x9a\x98\x3f\x9c\x0f\x02\xe4\x88\x23\x7c\x30\xc3\x15\x15\xbf\x5c\xf7\x46\x17\x8c\x3f\x2b\x7f\xca\x61\x30\x1a\x84\x2d\xe7\x3a\xe4\x6a\x4b\xb7\x77\x58\xe3\xce\xdd\x53\xe2\x62\xd0\xaa\x01\x65\x07\x72\xa8\xaa\x59\x02\x29\x2c\x86\xc9\x6b\x85\x23\xed\x0f\x47\x4c\x00\x75\x13\x62\xea\x8a\xe5\x4f\x6f\x9a\x74\x4a\xac\x7c\x91\xe6\x1b\xf1\xd3\x1b\xbe\x69\x1d\x57\x1b\x72\x55\x33\x3e\x7b\x3b\x9c\xcc\x6e\x7a\xc8\xe3\xbb\xa4\xdc\x0a\x78\xfa\xe1\xd8\x91\x49\xa8\x70\x06\x72\x86\x1d\x59\x25\x57\xba\xf0\xc4\x51\x47\xd6\x4f\xd0\x1c\x7f\x05\x5a\xf9\x9d\x34\xab\x64\xbe\x49\xf1\x53\x1e\x28\x30\xa4\xee\x41\x82\x75\x7f\x5d\x6e\xfc\x11\xe1\x9e\x66\xbd\xa1\xaa\x85\x7c\x41\x52\x1c\x90\xa1\x82\xe0\xa3\x64\xf8\xe9\xcd\xeb\xea\x19\x59\x6f\x4e\xa6\xc4\x4f\xfd\x37\x0f\x69\x19\xad\xf6\x83\x7a\xc6\xaf\x7c\x51\x22\x69\xf3\x74\xb6\x96\xf3\x60\xed\x41\x5b\xdc\x56\xfb\xc0\xf1\xb6\x4a\x63\x7f\x37\xff\xe3\x70\x02\xb6\xaa\xa3\xc4\x43\x22\xaa\x9c\xe9\xf6\x6b\x95\xd7\xaa\x76\xb2\xb3\xfa\xa9\xa7\xa7\x4b\x56\xa4\x80\x21\x53\x18\x50\xfe\x94\x3b\x26\x60\x3b\x9c\xd7\x6f\x53\x39\xea\x8e\x30\xb1\x8b\x15\x92\x8b\x90\xe5\xcc\x6f\xfa\xf7\xce\xcb\x1b\x37\x0f\x2c\x95\x28\x5d\x76\x7f\xcd\xbb\x3a\x75\x3e\x39\x73\xfe\x6b\x7e\xf6\x5d\xf7\x87\xf3\x5f\x73\x70\x2b\x9f\x17\x94\x85\xde\xf6\x6b\x3e\x7d\xa4\x15\xfd\xe9\xfe\x8a\x46\x54\x57\x50\x29\xc4\x63\x9c\x15\x0d\xdb\xfa\xb3\x68\xd0\x6a\x57\x71\xf9\xf9\x34\x78\xab\x33\xc4\xfa\xfd\x55\xa6\xe6\xf7\x50\xe2\xd6\x0e\x86\x52\x4f\xcb\xce\xe6\x1e\x92\x80\xc6\x5f\x80\x04\x35\x74\x82\x06\x2f\x60\x09\x55\x24\xc0\xf5\xbc\x7f\x33\x75\x7d\xbc\x02\x9f\x05\x8d\x87\x9e\x49\x0d\x24\xc0\x0e\x97\xdc\x1b\xad\x6c\x2b\x67\x8f\xb3\xcf\xc1\x74\xdf\x8d\xf4\x8a\xbb\x67\xfd\x0e\x44\xdb\x55\x9d\x95\xed\xe4\x4e\xbf\x04\x2a\x0f\x70\x54\x13\x1c\xe1\xf7\xbd\xd9\x6c\x32\x6d\x60\x5e\x57\x76\x88\x49\x65\x9e\x67\x7a\x51\xc0\x53\xe2\x9b\xd6\x71\xaf\xb9\xff\x9f\x61\xd3\x62\xab\x8a\x4d\xc4\x67\x3f\x13\x9b\x9c\xe4\xc1\x40\x7c\x6d\x78\xdd\x3e\xc7\x9d\x1a\x02\xa2\x3b\xfc\x21\xde\x2c\xba\x44\xaf\x1c\x8c\x10\xd7\x6a\x7f\x1e\xea\xec\xc4\x67\xe5\xda\xbe\x08\xea\x0e\x51\x13\xa2\xae\x76\x9c\x3f\xc6\xe4\x4d\x57\xa4\xea\xb4\xda\x35\x65\xa7\x6a\xc1\x1e\x35\x5f\x6b\x1a\x52\xea\x92\x90\xf9\x23\x15\x89\xee\xaf\x9b\x9d\xbb\xd3\xf5\x77\x82\x1f\x80\xe7\xce\x14\x06\x9f\xc5\x99\x7f\x7f\xd0\xbf\x1c\x5e\x4f\x07\xcd\x3e\xcb\x4f\x09\x77\x76\x44\x9e\xae\xad\xbd\xe3\x3b\x19\x97\x3e\x20\xd2\x91\xf6\xd0\x17\xb7\xc8\x03\x1d\x2c\x63\x8d\x20\x3d\x70\x22\x76\x71\xb1\xd6\x09\x62\x91\xad\x76\x10\xd9\xd3\x86\xbf\xe1\xeb\xeb\x3f\xd8\x7b\x67\x7d\x6f\x8a\xb2\x16\x9a\x1d\x02\xac\xec\x23\xa9\xc6\x46\x03\x79\x96\x0e\xe7\xcc\x55\x8d\x01\xef\x64\x71\xce\x2c\x17\x64\xa7\x45\x63\xca\x5f\xdc\xe2\xe9\x3a\x14\xce\x88\x2a\x13\x83\x7d\x0b\x2f\xbb\xca\xb2\x31\x36\x35\x6b\x50\x76\x6e\xab\xc7\x85\xca\x9e\x7f\x0a\x3e\x20\x9d\x6f\x74\x72\x64\x90\xd2\xa2\xf7\xf5\xde\xec\x83\x64\x9a\xc1\x2b\x3f\x1f\x5e\xf0\x75\x79\xe8\xaa\x4e\x29\x23\x68\x17\x01\x58\x1c\x8e\x69\xf1\xbc\xf4\x4c\x51\x4d\x60\xa5\xdd\xad\xf5\xa7\x20\x80\x23\xb3\x7f\x91\xaf\x2e\x64\xba\x52\xd7\xee\x71\x40\xa5\x0c\x03\x64\xbe\xbb\x5f\x23\xc3\xfc\x0c\x9d\x86\x19\xc6\x51\xcf\x12\xa3\xd5\xae\xe2\x9a\x3b\xdd\x3f\x48\x68\xc6\x39\xf9\x38\x29\x67\x89\xa5\x24\x5e\xef\x8a\x41\xd8\x59\xcb\x47\x2a\x9d\xeb\x85\x9e\x47\xe7\x44\x4a\x69\xf1\x20\xfc\x61\xfa\x64\x88\xc4\x3a\xa6\x6b\x1c\xf2\xa0\x5e\x39\xb4\xb7\x46\x53\xd3\x45\xce\x1f\x21\x1f\x06\x95\x55\x70\xf9\x05\x5d\x39\xcd\x4c\x5c\x0a\x2d\x5f\x9e\x65\xb0\x3b\xb7\x48\x28\xb5\xed\x0a\x12\x85\xb6\xab\xe4\xf5\x08\x3b\x8d\xb8\xa6\x00\xd8\x60\xce\x55\xae\xb9\x48\x75\x9f\x16\x99\x48\xef\x12\x17\xca\xb8\x18\x4e\x7b\x2f\x2f\x07\xc7\x9b\x4a\xd4\x2f\x88\x5d\xa6\x28\xaf\x35\xce\x09\x28\x4e\x90\xe3\x76\x2a\xa0\x8d\x53\x28\xc3\xa5\x4e\xfa\x45\x23\x72\xed\x13\x90\x47\xe3\x8a\xcf\xa4\x5c\x67\x59\x9a\x0e\x9b\x9b\x61\x81\x3c\xd0\x79\xd9\xb0\x0a\xd4\x59\x78\x37\xba\x7d\x99\x25\x4b\xb9\x81\x43\xd5\x44\x9a\x5a\xf6\x1e\x98\x36\x53\xaa\x6c\xaa\x0f\xe6\xaa\x65\xd9\x61\x01\xdc\x4d\x93\x2b\xd2\xf2\xc3\xb2\x60\xcf\x07\x7f\xe0\x5e\xae\xd2\xe6\xc0\x6e\xae\xf8\x3a\xcb\x75\x1d\xed\x1d\x40\xe7\x86\x24\xf8\xcf\x28\x33\x90\xdb\xb5\x04\x77\xc2\xf3\x36\xab\xfa\x26\x88\xb5\x76\xb1\xdc\x8b\x8d\x56\x19\xe9\x69\xcb\x6a\x9f\xb2\xb0\x8c\xd4\xa7\x9b\x57\xa0\x41\xbc\x84\x6a\x36\x77\x32\x51\xb1\x3b\xdb\x82\x59\x82\x03\x22\x58\xa2\x8b\xb5\x05\x4b\x3c\xa1\x52\x0a\x9f\xd9\x88\x7c\x38\x57\xd3\x58\x8e\xb6\xe2\xe2\x16\xd2\xaa\xb8\xfc\x88\xc5\x0c\x2e\x9a\x4e\xb3\x0f\x42\xba\x2c\x4a\x0e\x37\xdb\xd4\x4a\x13\xa4\x81\x76\xc4\x05\xc6\xe8\x88\xfe\xd4\x7a\x81\x56\xb8\x49\x95\xca\xc8\x98\x9a\xe4\x4e\xa4\x46\x4d\x48\x90\xd8\xa6\x11\xfa\x5c\xf3\xb0\xa7\x5d\x1f\x94\x42\x16\x9d\xed\x9b\xbc\xcb\xd2\x85\x5c\xd0\x85\xa5\x68\x8a\x2d\xb0\xa9\x0a\x2e\x6b\x53\x2e\xec\x44\xf2\xd7\x35\x4c\x13\x6f\xb5\x33\x6e\xc2\xba\x2c\xab\x0e\x8a\xc6\x8a\xa7\x53\x97\x24\xd8\x6a\xfb\x16\xd7\x8d\x45\x9c\xe5\x2e\xea\x8a\x9e\x09\xea\xb3\x3a\x95\x42\xd8\x6a\x49\x65\x63\x10\x70\x23\xc3\xd7\x7d\x00\x1f\x01\x39\x5b\xf3\x8b\x94\x25\xc4\xce\x50\xd4\x62\xb3\x7e\xea\x3d\xb9\x40\xf3\x7a\x2b\xab\xeb\xc9\xf8\xaf\x50\x31\x61\xe4\x54\xa4\x00\x9e\x5d\x48\xc3\x29\x87\x1b\x2a\xbf\xbc\x4f\x00\xe4\x72\xe1\xe2\x76\xb4\xf1\xdd\x9d\xa6\x60\xc9\xcc\xa5\xff\x1d\x4a\x43\x6e\xe4\x89\x23\x90\xa4\x56\xad\xfe\xf0\x0f\xdd\xfc\x87\x3b\xfb\x60\xf3\x0f\x90\x67\xf7\xb6\x77\x79\x33\x98\xce\xaf\x07\x13\x74\x33\x6c\x76\x8d\xa9\xbc\x9a\xda\x48\x9d\x52\x5d\x21\x02\xfb\x1e\x1d\x29\x69\x6b\x5a\xaf\x2f\x57\x58\x52\x3f\x32\x96\xb0\x0f\xf8\x10\xb9\x1a\x91\x73\x39\x19\x21\x28\x3a\x70\xd9\xb9\x60\x0f\x7b\x45\x6e\xb1\x23\x67\x25\x8d\x1a\x2e\xc7\xa6\x24\x91\xa6\x64\x7b\x27\xf0\xcc\x38\x25\xe1\x34\x76\xc6\xd3\xa3\x92\x1d\x1b\x5a\x13\x7d\xf7\xbb\x29\xd3\x88\x76\x1c\xfc\xdf\x05\xca\x3f\xd8\x99\x96\x1b\xb6\x8e\x84\xc3\xe1\x80\xae\xa7\x2e\xc3\x59\x9b\x3a\xf1\x5a\xed\x90\x7c\x9c\x9b\x2b\xee\x74\x94\x6f\xc4\x89\x4e\xc4\x4e\x7f\x54\xb1\xf1\x0e\x5e\x92\x4f\x38\x1d\xcb\x2e\x4f\x58\x06\x01\x80\x24\xe0\x47\xa1\xec\xe9\xf7\x4f\x0e\x91\xf6\xec\xfb\xdf\x9f\x1f\xea\xe0\x9d\xff\x3c\xbc\x08\xee\x0e\x79\x21\x9e\x7d\x1f\xe6\x87\x0e\x7e\x99\x81\x91\xff\x46\x8d\x9f\x7e\x1e\x8e\x2e\xc6\x3f\xbb\xb3\xb9\x32\x62\x2d\x71\x12\x77\x79\x84\x1d\xed\xd0\xf0\x25\x83\xdf\xdd\xde\xf5\x64\xb8\xe0\x95\x73\xfd\xb1\x5a\x7f\xaf\x4d\x10\x11\xbc\x23\x89\xfd\x87\xee\xeb\x26\xf8\x82\x7d\xcd\x58\xe0\xca\xcc\xc9\xd5\xcd\x65\xcf\xdd\x0e\x7c\x80\x05\x73\xbb\xae\x61\xc1\x95\x62\xee\xa2\xd5\x33\x73\xbb\xe6\xae\x83\xd5\x66\x90\x6b\x9d\x6f\x8a\x05\xa5\x95\x44\xf2\x6e\x21\xb3\x3c\x4d\xce\xf9\x05\x8a\x8b\x01\x89\x4b\xb9\x53\xe2\xa4\x5a\x33\xe8\x3e\xef\xa6\xd9\xfa\xb4\x12\x7e\x80\xc2\x55\xc4\x38\x03\x8c\x98\xbe\x7d\xed\x6a\x17\x03\x69\x7e\x3d\x7a\xed\x6e\x83\xf7\x66\x81\x73\x86\xce\x50\x6b\xea\xde\x09\xb2\x60\x51\xa0\x41\x27\x55\xa2\x97\xd0\x5c\x72\x41\x85\x6d\xd0\x69\x29\x2e\xae\x39\xb6\x76\xed\x7a\x77\xb0\x04\x91\x99\x7a\x2e\x76\xc9\x5a\x9c\xe4\x1b\x25\x22\xb5\xfa\x7f\xb8\xfb\xf6\xee\x36\x8e\x63\xcf\xff\xf1\x29\xfa\x0a\xd9\x35\x99\x40\xd0\xc3\xf6\x26\xf1\x5a\x3e\x0b\x91\x90\x84\x04\x24\xb0\x00\xe4\xd8\xbb\xda\xc3\xd3\x00\x1a\xc0\x48\x83\x19\xdc\x99\x81\x48\xfa\x9e\xfd\xee\xf7\xfc\xaa\xab\xba\x7b\x06\x03\x12\x14\x49\x39\xb9\xce\x39\xb1\x49\xce\xf4\x74\x57\x55\xd7\xfb\x01\x4a\xa6\x86\xf5\x2a\xff\xbc\x54\x47\x58\x33\xe7\x45\x31\xa5\x3c\xc0\x22\x8e\xcb\xa0\x28\x41\x02\x50\x3c\xde\x4f\x16\x9b\x64\x79\x6f\xba\xa8\xc3\x38\xd1\xc5\x26\x59\xe2\x7a\xd8\x02\x5f\xfe\x80\x6f\x9d\x82\xc5\x17\xc8\x8a\xc8\xa3\xdf\x48\xc3\xee\xeb\x89\xf9\xc5\x63\xc4\xd7\xdc\xe7\x1e\x2d\x21\x16\xca\x2c\xdc\xda\x7b\x24\x23\xeb\xd6\xb7\x05\x13\x18\x43\x36\x83\xe5\xb1\xd8\xc6\x81\xe4\xc8\xb6\x09\x29\xcd\x32\x64\x62\xad\x13\x5b\x12\xc7\x23\x61\xa0\x23\x61\x5f\x17\x7f\x6c\x03\x3b\xbc\x1b\xd7\xfe\x12\x00\x70\x23\xd4\x02\xb3\x12\xa3\x1b\x52\xd4\x59\x17\x2b\xb3\x76\x36\xb6\x13\x44\x35\x4c\xed\x2f\x3b\x4c\xed\xfb\x5d\x96\xf6\xe2\xfe\x1c\xcd\x63\xeb\x7c\x32\xee\xfd\x1f\x09\x2e\x20\xe3\xdd\x63\xcc\x28\x79\x6c\x32\xea\x9c\x8f\x87\x9d\x51\xf7\x7c\x22\x48\x74\xb9\xbf\xd5\xe9\x87\x78\xcf\x02\xa8\xe4\xbe\x82\x5e\xeb\x2f\x5b\xc6\x73\x82\x37\x9a\xe6\x27\xe3\x8e\xb5\xd5\xa4\xf2\x9b\x60\x5e\x87\x9f\x0e\xe2\x26\x77\x60\xc1\x5e\x57\xfd\x8f\xf6\xf3\x16\xdd\x05\x3c\xec\x1f\xe3\xd4\xa9\xb2\x62\x9b\x57\xe5\xfc\x25\x48\x86\xaa\x02\x2b\xfa\x51\x89\x1a\xe6\x06\x92\xbf\x4a\x04\xc2\x84\x77\xf9\x42\x40\x01\x81\x1f\x85\x3e\x63\x72\x3b\xe6\xc0\x2c\x16\xce\x9e\x7f\xac\x62\xa6\x3a\xe4\x55\xdb\x33\xca\x33\x67\x9d\x93\xd1\x80\xa2\x7a\x9c\x47\x07\x4f\x08\x5f\xc8\x0f\x89\xb9\xe4\x92\x76\xe2\x44\x1f\x32\x13\xfc\x86\xff\xcd\xf6\x09\x57\x0b\x24\xe6\x92\x5f\x96\x3f\x33\xf5\x73\x0f\x9c\x80\x12\x72\x3b\x7a\x13\xf0\xa7\xa6\x45\x39\x25\xd3\x33\x50\xc5\xdb\xfc\xa4\xe7\xf3\x87\xf8\xcd\x27\xf8\x2f\x25\xe9\x84\x8d\xc6\xee\x49\xbc\x57\xd3\x75\xc7\xe4\xc9\x18\x9d\xc9\xbb\xbf\x75\x7e\x11\x54\xc3\x03\x8b\xee\x29\x19\x6f\xd9\xed\xcd\x9a\x37\x67\xba\x58\xfd\x4d\x5f\x55\x45\x0a\x65\x3a\xea\x62\xf5\x51\x5f\x59\xa9\x62\x35\x17\xf4\xd6\x51\xb3\x38\x02\x55\x43\x45\x0f\x8c\x32\xe7\xad\xb2\x5f\x13\x15\xd1\x09\x1a\xfb\xb9\x4d\x66\x9e\xda\x07\xe0\x86\x8b\x8a\xb5\xde\xe4\xd2\x23\x01\x8d\x40\xac\x75\x1f\x16\xce\xd1\xae\xbd\x6f\x14\x0d\x42\x32\x79\x4e\xe6\x64\xb8\x33\x41\x82\xa0\xea\xaa\x28\xa2\x72\x7f\x05\xa6\x5d\xcb\x46\x5d\x7e\x88\xcd\x09\xc4\xd0\x76\xf2\x27\xc8\x8d\xe0\x4f\x39\xd8\x88\x16\x12\x5f\x73\x95\x15\x5a\x7d\x49\x4b\x63\x4e\x01\x2b\x52\xb8\x80\x9c\xc9\xa8\x18\x0b\x68\xb6\x4e\x53\x11\xbf\x82\x61\x12\x22\x3f\xf8\x47\x14\x18\x9c\xdc\x1d\x29\x50\x7e\xcb\x0e\x17\xbf\x37\xbe\xed\x9c\xb3\x19\x10\xb8\xd7\x16\x64\x35\x01\xae\xcc\x43\x97\xdf\xe7\x51\x61\x4a\xe1\x11\x1b\x1d\xc9\x43\xd2\x7a\x66\x92\x67\x28\x4d\xcb\x8b\x67\xbc\x0c\x42\x4a\xc7\xbe\x2c\xc4\x5d\x82\x3d\x0a\x05\x60\xf1\x14\x9e\x80\x23\xd7\x6b\x4e\x5a\x9f\x83\x71\xae\xd8\xd2\x9c\xd2\x50\x4d\x4a\xc2\xd3\x45\x34\x8d\xe2\xa8\xb8\x3e\x6e\xa9\x73\xca\x28\x38\x3b\xeb\xb3\xbf\x00\x5b\x3f\xeb\x5b\xa5\x64\xfc\xf3\xdb\xfd\x08\x93\xcf\x1e\x80\xb6\x10\x31\x55\xa4\xf1\xef\x03\xc5\x82\x91\x26\xeb\xdf\x86\xba\xc4\x94\xfd\x49\xa5\xb0\x4d\x90\x31\x71\xa3\x28\xbf\x95\x6e\x19\x08\x79\x11\x25\xa4\x8f\xf8\x77\x21\xbe\x56\x62\xb6\x3b\x87\x1f\x6f\xb6\xfd\x31\xe7\x86\x81\xe5\x4e\x15\x2d\xc9\x0f\x65\x42\x28\x2d\x87\x96\x86\x88\x3b\x41\x37\xe2\x82\x43\x98\x4f\xb6\x18\x51\xe7\xd5\x3b\xed\xdf\x25\xef\x08\x62\x1f\xd5\x43\x78\xef\x59\xbb\xfd\x4c\x68\xaf\x06\xaf\xe5\xc6\xe4\x7c\x06\xdb\x0e\x15\xe1\x13\x75\x6a\xe2\x88\x6a\x82\xcf\x4d\x41\xbe\x2a\xee\x8f\x87\xeb\xf3\xef\xdb\x68\xf6\x29\xbe\x76\x1d\x74\x38\xf3\x55\xa6\x25\x31\x4f\xb1\x6c\x51\xa0\xa3\xde\xa5\x97\xa8\x44\x69\x71\x8b\xaa\xbc\xc8\xd2\x64\xb9\xdb\xcb\x50\x18\x12\x7a\x1c\xc3\xc3\xc7\x4d\xb1\xfd\x4a\x48\xc0\x5d\xef\x63\xde\x12\xee\x98\x9b\x4d\x9c\x5e\xaf\x5d\x66\x60\x1d\x55\xcb\x12\xb3\x79\xd2\xfe\x98\xcf\x71\xde\xac\x9d\x98\xe2\x59\xb2\x59\x0b\xe4\xfe\xd7\xcb\x07\xa2\x79\x41\x8f\xa3\x79\x66\x11\xf8\x78\xb0\x7f\xf9\x2e\xb3\x09\x11\xee\xb2\x8a\xeb\xc7\x79\x70\xdb\x20\x06\x1a\x1b\xa3\x76\xa0\x1a\x82\x1c\x7c\x04\x4f\x2d\x72\xcf\xe6\xdb\x2c\x94\x95\x4e\xc2\x59\xa2\x66\xe7\x5a\xa3\x59\xb7\xa5\x57\x6a\x62\x7e\x79\xd6\x39\x1b\xe3\x10\xf2\xdf\x6c\xfa\x3e\x0c\x14\x83\x8f\x39\x95\x20\x84\x0f\xe6\xe0\xdc\x96\x01\xae\x83\xd1\xc1\x1f\xf5\x67\x6d\xef\xac\xda\x44\x88\x63\x40\xf7\x47\x0a\xe6\x5c\xd4\x4a\xf6\x11\xd3\x22\x69\x22\x65\x82\xe2\xec\x10\x28\xe1\x85\x7a\x91\x50\xca\x56\xba\x8f\x48\xe0\xee\x55\x8d\xe6\xbd\xfb\x57\x1d\x02\x68\x07\x48\x26\x57\xc7\x99\x71\xbe\x71\xb7\x33\x3a\x79\xd7\x3d\x7f\x2b\xae\xbe\x80\x4d\x97\x3c\x01\x62\x33\xc0\xef\x6e\x0b\x11\xa6\xe9\xd5\x0d\x16\xb8\x2d\x19\x8c\xaf\x41\x80\xfc\x82\x49\x96\xb0\x4c\x48\x19\x0b\xb0\x05\x91\x75\x2a\x71\x3d\xcf\x96\x89\x51\xa5\x49\x8d\x27\xbc\x5d\xb5\x15\x7c\xd7\x35\x8a\x8b\x02\x4f\x25\x95\x03\x81\xf2\xe3\x96\x6b\x5a\xe0\xff\xf8\xbf\xdf\x0d\x8f\x69\x4a\xb6\x2d\x81\xcb\x83\xf7\x6c\x85\x11\xf2\x94\xfd\x34\x49\x2e\xfb\x76\x10\x70\xe3\x76\xa8\x22\x15\x66\x4a\xe2\x42\x1e\xbe\xf7\xe8\xd4\x48\xf8\x89\x4a\x1a\x81\x7b\x3b\xf5\x84\x13\x2a\x80\x52\x13\x92\xef\x54\x83\x42\xcb\x40\xe3\x0b\x00\x0d\xc1\x89\x0b\xe7\x42\x1e\x77\x47\x3f\x77\x47\x17\xaf\x3b\xe3\xee\xe9\x85\xc5\x28\x69\x87\x5c\x7f\x89\xd0\x84\x0d\x0f\x88\x9b\x43\x5a\xd0\x06\x23\xf6\xdc\xc0\xe3\x40\x19\xfc\x64\xae\xa7\xa9\xce\xe6\xff\x13\x44\xf5\x71\xbb\xde\x88\x88\xe1\x27\x41\x01\xf0\x10\xfd\xa8\xc9\x7a\x57\x9f\xcc\xf5\x4f\xea\x4f\x6a\x0c\x04\x5c\x0a\x99\x96\xfe\xe8\x1a\xea\xe6\xd2\x4e\x77\xc0\x09\xb1\xd6\x16\xb4\xba\x8f\x95\x2a\x0e\x82\x18\xa0\x7b\x32\x19\xf5\x7f\x6a\xa9\x1f\x3b\xfd\xc9\x4f\xcf\x7e\xb4\xd2\xfd\x27\x42\x1d\x52\x69\x8f\xdb\xaa\xe7\x5b\x78\x56\x76\x87\x5f\xfd\x38\xdb\x66\x39\xe1\xf9\x12\x82\x96\xf6\x22\x67\x8a\x92\xf2\xa9\xac\x04\x84\xe7\x08\xbe\xbb\x56\x20\x15\xdd\x88\x3c\x8e\xff\x91\x43\xd5\x83\x4b\x3e\xf2\xc9\x5c\xe7\x3f\xb5\x91\xbf\x9d\xe7\xea\xc7\x2e\x7a\x65\xff\x04\xc8\xe5\x26\x46\x2e\x0e\xc2\x16\x85\x59\x63\xef\x3f\x1a\x72\x07\xd1\x5f\x67\x50\x30\x62\xbe\x4d\xf6\x08\xe1\xdc\x52\xd6\x68\xdc\x16\xec\x62\x28\x14\x97\xab\xcc\x5f\x8f\xf2\xa0\x9d\xa9\xc7\x2a\x70\x35\x05\x49\x98\x9c\x36\xfc\xe3\x78\x15\x2d\x8a\x9f\xfe\x14\x42\xe6\x27\xee\xb1\x46\x04\x5f\x05\x1d\x9d\x4a\x1c\xc8\xf8\xb6\x1b\xa1\x48\x08\x94\x73\x56\x4e\xa5\x67\x45\xf4\x19\x2e\xbd\x94\xfa\x3e\xcc\x4c\x2c\xe9\xb4\xb6\x17\xea\x6d\xf6\xc5\x43\x98\xdc\x25\x1e\x27\x52\x3b\x30\xb9\x03\x66\xb8\x7b\x8f\x2a\x3c\x31\xa0\x13\xbe\x99\x3e\xad\x26\x02\x2b\xe7\x31\x5d\x96\x2a\xb4\xba\x34\x53\x95\x9b\xec\xb3\xc9\x42\xa3\xd2\xfe\x9e\x0d\x52\xfb\xa8\xb7\x48\x09\xeb\x99\xe1\xee\x32\xc5\x65\x8a\x0c\xfb\xcf\x18\x3c\x9c\x2e\xc2\xf5\x42\x36\x81\x15\x6c\xa7\x6a\xfc\x17\x5f\x2d\x48\xd8\xd1\x79\xa7\xcf\x47\x21\x5a\xa0\xbe\x14\xec\x95\x13\xae\xd4\xda\xcb\xe9\x87\xef\x86\xac\x07\x83\xd3\x07\xdf\x92\xde\x1d\x1c\x4d\x25\x29\xec\x27\x91\x19\xa7\x3c\xd3\x77\x2a\xfb\xa8\x82\x93\x96\xb0\x0a\xa6\x4e\xc2\x03\x85\x49\x30\xcc\xca\x28\x48\xe8\x5c\xf1\xf0\xa2\xe6\x5e\x56\xb3\x5f\xa2\xd1\x54\x4f\xba\xf2\x48\x8f\x17\x27\x1a\x1d\xcb\xd2\x15\x37\xc5\xbd\x8c\xdb\x12\x75\xed\x92\xde\x2e\x45\x55\x6d\xdb\x2a\x78\x6e\x92\xc2\x49\xaa\xe2\x34\x59\x06\x63\x78\x68\x1f\xc3\x77\xc3\x46\xb3\x16\x53\xc4\x18\x89\xec\x6a\x38\x1c\xe8\x2b\x28\x69\xd3\x89\xcb\xaa\x71\x93\x3d\x2a\x38\xf0\x53\x79\x75\xe2\xf1\x40\x18\x34\x59\xa5\xbc\x9c\x27\x17\xb1\x9e\xc3\x8f\x96\xef\x0e\x19\x2e\xb8\x55\xa9\x10\x4e\x08\xcd\x8b\xf7\xa3\x3e\xb3\x3d\x3c\x91\x4e\xc5\x4c\x6b\x34\x2b\x27\x69\x97\x66\x2b\xe5\xab\x68\x93\x73\x0b\x75\x1f\x43\xe6\x4d\xaa\x23\x10\x3b\xff\xc0\x4e\xfc\x70\x4f\x90\x5d\x78\x82\x39\xf0\x6c\x19\x89\x13\x09\xd0\xb2\x77\x8e\xef\x17\x85\x88\xb8\x67\x67\xf9\x5c\xdc\x58\xa2\xd1\x54\xbf\xe8\x4d\xa4\x93\x4a\x92\xea\x15\xfd\x12\x36\xce\x33\x1e\xbf\x54\x21\xe1\x7f\x1e\x02\xae\x12\xa7\xda\x8d\x1a\xef\xa0\x8c\x35\x20\x37\xee\x49\x57\xa0\xb3\x4a\x73\x0e\xf7\x87\xfc\xd1\x91\x1c\x57\xe4\x52\x9e\x50\x0d\xd1\x5e\xde\xcc\x52\xfe\x95\x49\xe1\x40\x32\x68\x34\x6f\x24\x84\xfb\x70\xab\x0a\x26\x19\xd9\x8e\x55\xd5\xb1\x33\x80\xad\x8a\x0e\xba\x2a\x28\x71\x12\x26\x06\x18\x6d\x13\x0b\xf7\xb9\xbf\xbe\x73\x5d\x68\xe0\x2e\x64\x42\x7e\x4e\x96\x08\x86\x2a\xbb\x01\xdb\x47\x32\x8c\x4d\x58\x05\x34\xe8\xab\xa7\x9d\x49\xc7\x57\xf3\x84\xd9\xfc\xe4\xa3\xa5\x65\x45\x79\xe2\x64\xeb\x9d\x1e\x23\x5c\xb6\xf9\x03\x6f\x10\xfb\x6b\x5f\xad\xe3\x87\x01\xac\xdf\xa0\xbb\x45\xe5\xcf\x3c\x08\x9c\x1b\xcd\xea\x63\xd2\x19\x83\x0f\x2f\xd5\x60\x3a\xa9\x4b\xda\x64\xa3\x84\xfb\x5e\x50\x86\x93\x0c\x84\x46\x08\x62\x3d\x15\x4f\x1a\x5d\x2a\x4e\x15\xa2\xb3\xcb\xd8\xf7\x31\xce\xcd\x08\x2e\x56\x59\xba\x5d\xfa\x9e\x31\x3e\x79\x3d\xe7\x6c\x42\xeb\x05\x2b\x29\xd9\x68\x5a\x2b\x96\x46\x86\xe4\x44\xb7\xa9\x7b\xa2\xa1\x06\x2e\x8e\xbe\xc1\x40\xf7\x9c\x66\xd7\xff\x60\xc1\xcd\x87\xc4\xad\x94\x73\xb2\xb0\x0e\x8f\x69\x5b\x3b\x17\x2b\xcd\x9c\x22\xe1\x69\x9a\xa2\x2c\x45\x79\x65\xa4\x08\x68\xd0\xda\x41\xd2\xe8\x0f\xaa\x20\xfa\xb5\xcc\xd9\xaf\xe6\x7c\x8b\x55\xb1\xca\xb9\x57\x94\xf3\xca\x1b\xf0\x5a\x14\x85\xb8\xd0\x06\xca\x65\xec\x12\x91\xa2\x55\xe1\x2e\x60\x80\x7f\xc7\xca\xd6\x7a\xb3\x61\x23\x27\x51\x08\x7d\xd0\x49\x68\x2a\xb4\x35\x08\xb4\xaf\x71\x73\x3e\x5c\x3f\x5c\xa8\xe4\xd4\x10\x50\x2e\xd0\x94\x98\x0d\x1c\xeb\xaa\x8f\x72\xb8\xdb\xeb\x71\xf0\x0a\x77\x0c\xd7\xf9\xc5\xab\x38\x9d\xbd\x90\x9f\x5e\xe2\xa7\x97\xaa\xdd\xbe\xef\x05\xad\xff\xea\xef\x35\xa1\x8a\x62\x47\xec\x4c\x79\xd8\x0d\xf8\x84\x10\x67\x32\xf5\x3b\x13\x4e\xd4\x2d\x01\x65\x9f\x51\x10\xee\x6d\xbf\xe2\x51\x49\x39\xb3\xdf\xa8\x53\x1e\xe8\x2f\x07\x8f\xa9\xb2\x1f\xff\xe7\x99\x53\x05\xcf\xee\xd5\x01\xb4\x57\x01\x44\x95\xfa\x4a\x40\x10\x28\x01\x4e\xb4\x7e\x19\x54\x27\x67\xa7\xbe\x8e\x6d\x8f\x5b\xd4\x83\x8a\x03\xbd\x36\x95\x9e\xb4\x78\x04\x2a\x48\x31\x9f\xd7\x86\xdd\x91\x2b\xe0\xfc\x4a\xf0\x2e\x0e\x4f\xdf\x30\x85\x04\x50\x40\xa8\x03\x5b\x2b\xa7\x32\x36\x9a\x37\xbe\xb1\x99\x2f\xe8\x3c\xc4\xf8\xe9\x45\x0e\xba\xe0\xb7\xf0\x0e\xe4\x86\x57\x25\xa9\x33\x5b\xa5\xb9\xa4\xb1\xc1\xb5\xf6\xd9\x64\xa2\x26\x4c\xaf\xdd\x62\xd6\x95\xcd\xf2\x7c\xc6\x74\xa9\x62\x9d\x2c\xb7\x48\x76\x60\x81\x28\xbb\x00\xbc\x88\x0d\x4e\x8d\xb1\x91\xc3\x79\xb4\xa0\xb1\xbc\x45\x7c\x0d\x4f\xcf\xae\x7f\xca\x19\xf5\x52\x50\xc4\x1e\x9a\xca\x97\x1e\x8a\x08\x1c\x7a\xf7\x10\xc1\x59\xe7\xef\x5d\x4a\xdf\x3e\x98\x10\xaa\x24\x20\x37\xd9\xa5\x42\x43\xf2\x13\xb5\x84\xb3\x1f\xa2\xbc\x74\x1b\x5d\x78\x8c\x1b\x08\x3e\xa3\xda\x9e\xf6\x54\x17\xe5\x19\xa4\xf6\x10\xa5\x4d\x7a\xcd\x02\x83\xad\x78\x21\xd9\xc4\x5c\x30\xc6\x46\xe7\x51\xbb\x30\x57\xc7\x7b\xb5\x32\x7c\xd4\xca\xb8\x7b\x83\xbb\x06\x90\x16\xdc\xee\x1b\xe5\x7b\x57\x3e\xd3\xdd\x60\x1e\xa4\xdc\xec\xc0\xdc\x4d\x9d\x74\x6e\xe6\x24\x25\x0d\x28\x8f\x75\xbe\x52\x47\x1f\x8e\xa1\xab\x2d\xa2\x2c\x2f\xd4\x6c\xa5\x33\x3d\xb3\x8e\xb3\x60\x6c\xbd\xde\x16\xe9\xda\x75\x02\xd6\xd2\x06\x38\xe4\x02\x73\x73\x3b\x72\x6b\x71\x12\x60\xc4\x63\xb9\x06\x76\x75\x48\xde\x43\x2d\xf5\x12\xe3\x01\x51\x5b\x87\xb0\x5d\xd4\xb2\x1c\x3c\x19\x9c\x0d\x3b\x27\x13\x5e\x8a\xc1\x52\x27\x06\x05\x3c\x3c\x91\x87\x02\xf2\xb3\xc2\xe5\x79\x88\x92\x93\xb3\xe6\x0c\xff\xbb\xa5\x8e\xc5\x36\x26\x7c\xe7\x98\x65\xe6\xf5\x42\xdc\x49\x3c\xe4\xda\x74\x83\x2f\xe5\x76\x58\x0f\xb2\x6c\xe1\xc6\xe5\x8f\xc6\xfb\xc1\x76\x3e\xb8\x3f\xbc\xca\x20\xa8\x15\xd3\xc3\x0e\x12\x93\x27\xbf\x0e\xeb\xd9\x0d\xa7\x60\x6c\xf4\x06\xe5\x0f\xd7\x1b\x96\x28\x42\x60\xd6\xb7\x43\x4a\x31\xfc\x3d\xd9\xde\x9c\x08\xfd\x9d\x3a\x7a\xf9\xe2\xb9\xba\x52\x2f\xff\xfa\x67\xb5\x5e\x1f\xb7\x54\x6c\x23\x17\x47\x7f\x69\x7f\xaf\xae\xd4\x8b\x17\x68\x95\xb3\x32\x39\xfd\x65\xa9\x63\xfe\x03\xba\xa4\x7e\x27\x7f\x22\x01\x63\xae\xcc\x6c\x4b\x1a\xe9\xd1\x9f\xdb\x2f\xe9\x5d\xb4\x51\xe5\x47\xf6\x03\x54\x7f\x77\x7f\x80\x06\xd0\x12\x60\xe2\x7f\xaf\x94\xfe\x4e\x00\x6a\x15\xce\x61\xe7\xe4\xef\x9d\xb7\x87\x0f\x8e\x21\x62\x53\x1b\x3d\xfb\x84\xb2\x07\x70\x74\x37\xbf\x70\x7f\x2f\xa1\x92\xc6\x46\x1f\x97\x05\xaa\xd6\xb0\x42\x29\x0c\x2a\xc1\xae\x55\x54\xe4\x6c\x3e\x07\xa5\xa1\x3c\xf9\x40\xe5\xd7\x49\xa1\xaf\x94\xeb\xce\x44\x5b\x76\x4f\xc9\x8d\xf8\xb0\xcd\x8d\xfb\x92\x65\x88\x6d\x19\xdd\x88\x7d\xa1\xb7\x3d\x72\x78\x51\xbe\x16\xe4\x58\xf8\x4a\x2a\x06\x80\xb7\x0a\x04\x5c\xaf\xec\xab\x69\x56\xfd\xfd\x7f\xd0\x1f\xfe\xbf\x1f\x25\x8f\xef\xb0\x07\x31\x4a\x8a\x38\x5a\xe3\x5c\x6e\xa7\x7a\x9d\x23\x7c\xeb\x00\x5a\xf9\x72\xcd\x87\xff\xaf\x5b\xe5\xff\xfd\x07\xbf\x8d\xaf\x95\x27\x95\x25\x29\x77\xbd\xe1\x75\xbd\xb2\x2a\xa8\xb9\x3f\x89\x95\xf7\x25\x14\xe6\x4d\x59\x7a\x4b\xe6\xe7\xdd\x40\x5d\x5a\x6d\x4c\x96\x53\xad\x30\xe1\x4d\x06\x21\x32\x3f\x2f\x25\x8b\x3a\xed\x9b\x42\xd4\xed\x70\x0a\x63\x25\x95\x06\xc9\x21\xd7\x28\x93\x5d\xaa\x6d\x52\x44\x12\xf3\xc9\x88\xba\x66\x2b\xbd\x01\x13\x80\x25\x62\x23\x7d\x07\x4c\x79\x73\x23\xb2\x78\x0c\x25\xa7\x23\x3a\x47\xd9\xc1\xc3\xdd\x62\x53\xf8\x8f\x64\xd1\xbe\x41\x90\x45\x1a\xa6\xf1\x73\x81\xb7\x13\xa1\x83\xc4\x4e\x7d\xab\x0e\xc9\xe3\x97\x39\xcd\xef\x13\xfa\x10\x52\x1c\x14\x84\x05\x9f\xd8\x3c\x8d\x92\xe5\xbf\x71\xa5\xe4\x22\xc5\x44\x1b\xc0\x88\xd5\x05\xce\x42\xf5\x6d\xbe\xd7\x46\x53\x01\x5b\x30\x9e\xd0\x7e\xe1\x07\xf5\x87\x22\x2a\x62\x83\xd6\x6b\x7f\xc0\x5c\x06\xd0\x7d\xcb\xfe\x27\xfe\x65\x81\xc1\x23\x2b\x5b\xea\x0f\x2c\x74\x70\xa1\x83\x9f\xa8\x7a\x87\x96\xe0\xdf\x4c\xb3\xc8\x2c\xfc\x03\x71\xba\x4c\x2b\x3e\x7d\xa9\xfe\xb4\x9f\xf7\x37\x89\x1a\xc1\x05\xfd\x4f\x45\x1d\xe0\xe7\x41\x2f\xc2\xe8\x39\xdd\xc2\xba\x45\xdc\xc9\x5d\x73\x78\x2a\x86\xb3\xdd\xe7\xc1\xda\xd2\x70\xcc\xd4\xfd\xaf\x4d\xe9\x5a\xa8\x7d\xd7\xe6\x90\x01\x99\x95\x6b\xc3\x73\x3e\x0e\xbd\x36\xfc\xf8\xfe\x6b\xe3\x27\xda\xc5\xfa\x01\x6e\x4d\x30\xd5\xb2\xd1\x2c\x33\x07\x97\x9d\x52\x7f\x5f\x82\xd5\xe4\x8a\x54\x46\x6b\x36\x9a\x8e\x60\x1d\x3a\x43\xb0\xed\x0e\xd7\x3c\xe0\x26\xf1\x37\x6e\xb9\x49\x0f\x44\x0f\x8c\xef\xbd\xf4\x50\x2d\x88\xbc\x91\x32\x7c\x87\xae\xf0\x40\x80\x3a\x11\x4a\xa9\xe6\xd2\x4d\xf2\x70\x32\xdb\xe3\xdd\xe1\xae\xf4\x06\x0f\x18\xb1\x42\xfa\xfe\x93\x0c\xab\x83\xc5\xfe\xcb\x0e\x0c\x7c\x20\x4a\xd9\xa1\x84\x5a\x4a\xf9\xda\xc3\x00\x4b\xfe\x23\xd7\x5d\xd7\xa3\x28\x70\xf2\x48\x2f\x33\xaf\x93\xf0\x52\xb7\x8c\xcc\x6b\x34\xef\x37\x34\xef\x76\xa0\x06\x63\xf3\xc2\xa6\x27\xc3\xd3\x37\x17\xef\x7e\x1d\x76\x47\x54\xe3\x26\x76\xab\x5f\xa5\x15\xe8\xb8\xbb\x75\xdb\xdc\x2e\x07\x72\x9c\xab\x5d\x6c\x37\xe2\x9c\x63\xca\xc3\xd3\x37\xea\x88\x53\xfc\xf3\x97\x9b\xf9\x02\x68\x11\xdf\xd2\xb1\xe5\xd5\x78\x86\xd3\x0c\x65\x44\x0f\x71\xeb\x38\x4a\x3e\xe5\xd5\xf2\xf1\x20\x0d\xee\x38\xcc\xf8\xc0\x18\x1e\x2b\xd8\x4c\x32\x33\x6c\x24\x12\x50\x3f\x99\x3c\xbc\x58\xf9\x36\xb2\xbd\x10\xc1\x97\xd3\x84\x6a\x65\x29\xef\x0d\x7b\x94\xac\x12\x6c\x09\xc5\xa0\x26\x7b\xb0\xcc\x99\x7a\x04\x55\xa0\xbf\x93\x3a\xc3\x48\x2a\xbb\xfd\xf6\xdb\xd0\xae\xa6\x50\x40\x5c\x71\x48\x31\xc2\x89\x4f\x30\x8b\x89\xaf\x7d\x45\x17\x8b\x5a\x30\x11\x88\xb2\xba\x46\x0b\xe8\x68\x43\xc3\xe1\x11\xe8\xc0\x84\x75\x93\xa9\x7f\xdf\x6a\x64\xcd\xd3\xca\x35\x09\x96\x8f\x06\xbc\x12\x54\xd4\x5e\xe0\x11\xe4\x2f\x5e\x77\x26\x27\xef\xce\x06\xa7\xdd\x5b\xe1\x87\xae\x9a\x78\xef\xc3\x14\xdd\x9e\x50\x4b\x45\x44\x49\x57\x55\x38\x82\xbf\x01\x25\x98\x81\xe8\xfc\x30\x8e\x6c\x2b\xfe\x0b\xbc\xf6\xc9\x98\x8d\x4c\xef\x80\x6f\x70\xa1\x4c\x96\x51\xd6\xd1\x6c\xb6\xcd\x5a\x21\x31\xeb\xfc\x93\xe4\xbc\x41\xc6\xd1\xbd\x82\x1b\x83\x3f\xc0\x08\x89\xb8\xd5\x35\x17\x1e\x57\x9b\xd9\xb9\xe2\x97\x28\x21\x1d\xef\x71\x7d\x1c\x55\x20\x3b\x5c\x94\xaa\x64\x59\x31\xea\x9d\x52\x8f\x8c\xde\x49\x77\xbc\x8b\x8d\x72\x4f\x78\x02\x26\x9c\xe6\x2c\xc1\x59\xe8\x70\x2b\x11\xab\xb3\xe5\x7e\xfa\x05\x06\xed\xdb\x58\x7e\x8b\xda\x6b\x22\x08\x26\x3f\x9b\x62\xd6\x76\x4d\x62\x6f\x0b\xb0\x3c\x1c\x4c\x4a\xa7\xdd\x0b\x93\xf1\xe0\xfd\xe8\xa4\x7b\x71\x52\x4b\xa0\x35\x20\x11\x70\xd8\xf4\x18\x22\xd0\x39\xdb\x0b\xec\x3d\xc0\xe5\xa4\x59\x86\x20\x86\x3a\x57\xc5\x4e\x78\x82\x44\x20\xad\x47\x25\x34\x32\x73\x0b\x34\x16\x38\xed\x59\x7a\x5a\xdd\x20\xe7\x91\x50\x10\x03\x7c\x84\xd7\xa3\xc1\x3f\xc6\xdd\xd1\xd7\x00\x6d\x08\xb4\x12\x68\xbd\xc2\xf0\xba\xf7\xda\x4e\x8a\xbe\x49\x5d\xf0\x0a\x59\x41\xd7\x29\xb0\xce\xa7\xd1\x34\x8e\xd2\x65\xa6\x37\xab\x6b\x1e\x3b\xb1\x89\x75\x94\x24\xe8\x6f\x0f\x23\xd8\x18\x53\x38\xc5\xff\xe6\x69\xad\xaf\xa3\x29\x78\x05\xd8\xc8\x87\x19\x2c\xe3\x92\x69\xb0\x1f\x60\xf4\xc1\x87\x82\x99\x07\x88\xbb\xa2\xb4\xfe\x0e\x45\xfa\x41\x81\x5f\x30\x7e\x51\xe6\xb3\xb1\xd2\xfb\x40\x93\x17\xcf\x07\x7e\xf0\x22\xbd\x4a\x4e\x62\x1e\x41\xe2\x24\x7c\x38\xd9\x2f\xdb\x26\xf9\xd7\xa0\x44\x0f\x2c\xb5\x8f\x12\xbb\x67\x83\xbf\xf5\x82\xa8\x28\x43\xb5\x8e\x16\x8f\x5c\xa0\x15\x75\x0c\xdc\x54\x1e\xa9\xf2\x14\x77\xa5\x9a\x1e\xdf\x31\xc2\xac\xd3\x8f\x91\xe8\xb7\xa2\x74\x22\x91\x9e\x4c\x59\xbd\x37\x68\xdb\x2a\x07\x39\xdc\x63\x75\x3a\x6f\xa0\xea\x96\x5d\x71\x96\x1b\xd7\x3f\xeb\x96\xc6\x11\x1f\x0a\xd0\x55\x28\xfe\x5e\x29\x04\xa3\xc9\x9b\xaf\x95\x40\x80\x4f\xdd\xa6\xb3\xc8\xb5\x09\xf6\x25\x0d\xc3\xfc\x6f\x14\xeb\x0e\xeb\xe8\x37\x56\xd6\xff\x91\x66\x73\xf5\xd7\x3f\xbb\x58\x09\x04\x2d\xd5\xca\x16\x69\x0a\xb5\xbe\x28\xae\xad\x4c\xb1\x4c\x7f\x34\x79\x43\x59\x00\x70\x91\xe5\xcf\xcc\x3c\x2a\xd2\xec\x96\xeb\xe5\x73\x14\xb0\x0f\xa5\xea\xee\xc7\x68\xf2\x46\x88\xe7\xd6\x0c\x05\xac\xf2\xcf\x93\x9f\x90\x15\x8b\xbb\x10\x36\x36\x5f\x25\xeb\xe0\xf0\x02\x1b\xfc\xef\x95\xca\x8a\x45\x4d\x18\xed\x16\x62\xd8\x13\x44\xb3\x88\x13\xdd\xfc\x9f\x3a\x84\x56\x07\xa3\xf0\xf0\x35\x34\xc4\x20\x1a\x4d\x4a\xb6\x54\x0d\x94\x84\x80\x76\xed\xd8\x8a\xe9\xb9\xba\xde\x98\x0c\xf6\xa7\x5a\x44\x26\x9e\x13\xc0\xec\xab\xce\x50\xbd\xdd\x4c\x6d\x34\x0f\x36\x54\x0f\x32\x53\x1b\xcd\xaa\xa1\x4a\xd7\x17\x91\xcf\x74\x2d\x0e\x5f\xfa\x95\x14\x33\xc7\x46\x2e\x2b\xc7\xb0\x78\x1c\x6d\x81\xd4\x0f\x78\xc8\xed\xe1\x02\x77\x21\x5e\x1f\xea\xb9\x3a\x42\x2a\x88\xb1\x39\xbb\x74\xf7\x73\x29\xbe\xe7\x66\x13\xf6\xd8\x8f\x89\xf4\x0a\x3a\xab\x48\xef\xa7\x9a\x5d\x77\xe4\xb9\x03\xdd\x51\xbf\xa5\x34\xe1\x16\x25\xc0\x54\x5b\x8d\xad\x32\x8c\xc5\xa3\x75\x14\x6b\x6a\x34\xcd\xb7\x85\xfa\xd6\xd6\xe5\x06\x52\xc5\x37\xb2\xaa\x69\x98\x28\x19\x64\x79\xb4\x4c\xf8\xee\x60\xd4\x0d\x46\xb5\xdb\x68\x42\x91\x4a\xed\x44\xa3\x19\xba\xe3\xf3\x96\x5a\x47\xb6\x14\x28\xdc\x1a\xe9\xd5\x6e\x18\x5c\x94\x95\x41\xe7\x93\xd5\xb9\x91\xe1\x5d\xa3\x2f\x42\xd1\x2c\x98\x65\xf1\xd0\xdf\xc7\x23\x88\x09\x02\x3c\x50\xc3\x06\x5b\xf2\x87\xc0\x98\x77\xdd\xb9\x4c\x5c\x48\x68\x28\x73\x2c\x4a\x69\xbc\x6a\x16\x81\xb8\x99\xd1\xb3\x6a\xc8\x7b\xe7\xb6\xc2\x3a\x11\x5e\xcf\xd1\x30\x87\xc9\x46\xb3\x16\x97\x35\x59\x9e\xe8\x64\x59\x98\x35\x26\x48\x19\x5f\xd3\x9b\xf3\x34\x14\xb2\x05\x4a\x91\x04\x7b\xab\x04\x36\x4f\x0d\x78\xb0\x7f\xef\xcd\x1d\xbb\x10\xef\x03\x91\x2f\xcc\x2d\x83\x28\x60\x63\xa1\x61\x73\x77\x6b\x90\x6c\x41\xee\xa4\x7b\x93\x35\x18\xe8\x0a\xff\x1a\xb6\xe0\x5e\xa2\xab\xda\x81\x8e\x4d\x88\x5e\xf5\x15\x35\xc3\xb5\x4e\xac\x39\xf3\x95\xd4\xc3\xb3\xce\xf9\x2e\x8d\xec\x53\x0f\x65\x73\xd2\xdb\x6b\x16\xeb\x3c\xe7\xe9\xc5\x07\xcc\x25\xf3\xda\x1c\xbe\xaa\x54\x05\xda\x9c\x41\x77\x7e\xb0\x36\xe7\xb7\xf3\xb5\xd4\x39\xd5\x09\x54\xb8\xb5\x4e\xbe\x75\x8f\xcb\x68\xf9\x20\x9c\xe6\x9f\xf4\x29\x1c\x53\x94\x9a\xf8\x33\x56\xe1\xe5\x5f\x01\x79\xaf\x75\x72\x17\xfa\x06\x50\xab\xf4\x1d\x80\x53\xa0\x2d\xc9\x56\x49\x08\x72\xc7\x55\xea\x66\xd4\x3a\x1e\xe6\x54\x9f\x52\xae\xbb\xe3\x80\x8d\xa6\x47\x49\x29\x73\x8e\x7b\x83\x39\x89\x34\x4f\xd1\x01\x3c\x85\x64\x41\xd6\x21\xd9\x08\x9a\xfb\x55\xf2\x00\x7e\xfa\xef\x46\x53\x7d\x0b\x39\xb1\xc9\xc8\x81\x64\x38\x23\x7d\x9e\x16\xea\xa8\x7d\x2c\x7d\x3c\xa6\x66\x19\x25\x49\x10\x6a\xda\x3d\x10\x39\xf3\x45\x80\xec\x27\xd2\xf6\xb7\x0f\x01\x6f\xff\x69\xf7\xcf\x2b\xd5\xfe\x36\x04\xf7\xf8\xfd\xeb\xd3\xde\xe8\xd6\x79\xc0\x8e\x1a\x84\xba\x00\xa9\x28\x29\x91\x50\xb9\x01\xa4\xbf\x12\x50\x15\x28\xba\x3f\x27\x03\x87\x0f\x9b\x73\xa7\x36\x4e\x6f\x08\x29\xd2\x6f\xdb\xe5\x0d\x48\x0b\xca\x36\xf7\x74\x9b\x3f\x04\x78\xf8\xec\x02\x9a\xaa\x00\xc3\x56\xf6\xa8\xe0\xa4\x4f\x0a\x67\x12\xaa\x83\x21\x92\x30\xb7\x24\xda\x41\xfd\xb6\x04\x4c\xe5\x21\x0a\xe9\x05\x8d\xf7\xf1\x8a\x2b\x64\x22\xdf\x93\x49\x0a\x04\x21\x44\x61\xf0\x7a\x45\x66\x74\x1c\x10\xf6\x51\x6e\xe3\x4e\x79\x69\x3d\xac\x05\x91\x86\x4e\x2d\x24\xf6\xdc\xab\xee\x45\x5b\x33\xc2\x8d\x5b\x60\x01\x51\x7b\x3b\x46\x99\xc4\x07\x2e\x25\x82\xb8\xe5\xee\x5b\xa9\x9d\x9d\x1d\xa6\x77\x6d\x5c\x3e\xf3\xfd\x45\xe2\x3e\x1c\x95\x95\xe6\xdf\x59\x24\xfe\x72\xd6\xff\x5a\xd2\x10\x9f\x3a\x58\x1a\x06\x05\xb9\x7e\xa2\xd7\x06\x5d\xab\x39\x00\xee\x9a\x58\xf3\x7d\x86\x62\xc5\xca\x16\xb8\x15\x8c\xe5\x03\x03\x5e\x65\xe9\x89\x5d\xd6\x04\xac\x40\x10\xbf\x9c\xf5\x85\x2f\xdc\x2a\x3e\x7f\x71\x6d\xe6\xbf\x96\xf8\xbc\x51\xda\x1d\x56\xc8\x57\x02\x42\x95\x74\x83\xd3\x0b\x74\xf0\xbf\x57\x8a\xab\xf7\x18\xdd\x78\x6c\x38\x1a\xbc\x1d\x75\xce\xfa\xbd\xf1\xa4\x77\xfe\xf6\x56\xa4\xcf\xd1\x8a\x82\x0b\xf0\x96\x99\x5e\x73\xe6\x02\xe9\xad\x47\x1e\xa5\x75\xea\x32\xee\x35\x86\xfb\xe6\x4f\xc5\x6e\xc7\x6f\x03\x03\xec\xd8\x4f\x1e\xf3\xa4\x1e\x66\x05\xb8\x8a\x8f\xc2\x05\x08\x61\x50\xa2\xa4\x42\x27\x05\x46\x96\x27\x10\x10\x2c\x6b\xd1\x19\x14\xd2\x6e\x51\x5d\x71\x2f\x75\xdd\x31\x9c\xba\x0f\xf2\x15\x90\x86\x9a\x5d\x00\xf8\xf3\xf1\xc5\x59\xf7\xec\x35\x19\x2f\x17\xe3\x93\xc1\xb0\x7b\x2b\xf0\xd9\x3e\xe1\xa1\x11\x34\x9a\xcc\x8d\x28\x8c\x98\x91\xe7\xb3\x74\x63\x90\xf3\x7a\x69\xe2\x18\x0d\x41\x0b\x29\xfd\x2b\x37\xbf\x79\x20\xc6\xb9\x0f\x06\x35\xa7\xfb\xfd\x18\xe7\xe9\xe0\xe4\xf5\x60\xf0\xf7\xaf\xc5\x3c\xe5\x73\x07\x33\xd0\xd3\x74\x36\x85\xbb\x18\x08\x74\x99\xd2\x95\x20\x9b\x7b\x78\x78\xfa\xe6\x50\xfe\x28\x1b\xd9\xb5\x2e\xf8\x2f\x07\xb3\x48\xd9\xe1\x2e\x9b\x6c\x34\x6f\x8e\x8f\xdc\x89\x4d\x36\x9a\x8e\x51\xde\xca\x26\xe7\x76\x4b\x77\x21\x56\x81\x47\x95\x60\x2b\xd0\x60\x78\xd9\x39\x6d\xf8\x46\x80\x64\x79\xf4\x8e\x6c\x53\x3c\x0b\x52\x5f\x80\x50\xe8\xfa\x7e\x9c\xb3\xd1\xac\xf2\xce\x1a\x5a\xbf\x0b\xf7\x6c\x34\x77\xf9\xa7\x4a\x4b\xc7\x96\x55\x6f\xa4\xbf\x87\xc3\x47\x05\xc8\x5f\x91\x81\x70\xe4\x5a\x75\xb6\x45\xfa\xd6\x24\xea\x34\xf0\x3d\x7e\x25\x2e\xd2\x79\x3f\x19\xbc\xed\x9e\x5f\x9c\x76\xef\x10\xb7\xa2\x2e\x26\x75\x9b\x76\xad\xd0\x7f\x78\xf6\x0c\x85\x58\xc8\xc7\xb4\x6e\xae\x45\x9a\x2d\x0d\x35\x21\x3c\x2e\x4d\xf2\xb7\x0a\x1c\xcb\x63\x3f\x87\xe4\x50\x15\x2e\x20\x3c\x92\xd6\x3c\xd8\x03\x44\x86\x4e\x8c\xd8\xb5\xb9\xda\x98\x2c\xa2\x57\x62\xa2\xf1\x28\x91\x49\x09\x62\xd2\xae\xd3\x9b\x1b\x2a\x96\xf9\x5d\x08\x32\xc7\xef\x04\xc6\x8f\x49\x2e\x15\x79\x33\x34\x59\x8c\x66\x70\xdb\xf8\xab\xb9\xaf\x86\xdd\x51\xff\x6c\x70\x7a\x07\x4a\x09\x77\xd9\x68\xd6\xa0\xfe\x8b\x75\xf7\x46\x73\x0f\xf2\x1f\x11\xf5\x72\xfe\xb2\xa8\x63\x28\xf1\x1f\x39\x22\x7e\x30\x88\x70\x5c\x37\x6a\xaa\xd1\xf4\x85\x82\x94\xdb\xdb\xb2\x00\xb4\x2d\xa4\xac\xeb\xcf\x97\x31\x72\xc5\x91\xd8\xae\x6e\x49\xe4\xf6\xe1\xc9\xd3\x9f\x7b\xbe\x51\xac\x4b\x1c\xdc\xa5\x9b\x87\xe2\xb4\x02\x9e\xd2\xb9\x51\x02\x56\x82\x8c\x52\x37\xc3\x6f\x38\xea\x4e\x26\xbf\xd6\x01\xb0\x7e\xf7\x4e\xba\x27\xd1\xcc\xc4\xf0\xaf\x58\x5d\x1f\x57\x25\xa7\x8e\xce\xac\xe4\x6c\x74\x26\xfd\x9a\xd4\x6a\x0b\x4f\x41\xc6\xf5\x33\xdc\xde\x43\xa2\xab\xe5\xce\xd4\xd4\x24\x02\x41\xf4\x42\x92\xfc\xf1\xe8\x12\x39\xf7\x0a\xdd\x03\x07\x9c\xa4\x46\xee\xf5\x95\x4e\xe6\xdc\x18\x37\xca\x2b\x67\x38\x1f\xb4\x58\x34\x87\x42\xef\x86\x03\xad\x91\xae\x45\x61\x5e\xae\x30\xa0\x67\xe9\xb8\x74\x16\xeb\x7a\xa1\x60\xa6\x74\xc1\x78\x30\xb3\x83\xb1\xb1\x17\x97\x8c\xa5\x5a\xb3\x18\xa5\x35\xae\xb6\x05\xd1\xd2\x20\x88\xc4\x7e\x1e\xa1\xd5\x39\xdd\x0a\x22\xf5\x36\x3d\x49\xa4\x6f\xdb\x7c\x6c\x32\xb3\x88\xae\xc2\x6a\xba\xbc\xa0\xfc\x21\x8e\xe6\x5a\xaf\x91\x6c\x08\x25\xae\x3f\x77\x46\x17\xc3\x51\xf7\x4d\xef\x97\x2a\x4e\x11\x87\x4a\x7d\x81\x7b\xdd\x57\x83\x71\x0e\x53\x4e\x33\x83\x77\xd0\xdd\xc7\x79\x9a\x7c\x03\x78\x4b\xf1\xbd\xb1\x69\x54\x14\x71\xfd\x26\xf7\x47\x7c\x48\x28\x97\x0f\xf5\x7b\xe5\xcf\xc0\x21\x9c\xa5\x60\x51\x48\xc9\x97\xb7\x1f\xe0\x9f\xe0\xf6\x77\xcf\x69\x36\xd4\x70\xd4\x1d\x8e\x06\x27\xdd\xf1\xf8\x10\x85\xd7\x80\xbc\x11\x2a\xd1\x94\x11\x70\xf2\x34\xdc\x29\x6b\xf2\x18\x7b\x6b\x1b\xac\x88\x8f\xd1\x85\xca\xac\x44\x20\x95\xf9\x96\x78\x0a\xd7\x19\xd6\xed\x31\xa0\x7d\x3e\x0a\xf5\xf7\xb7\xa3\xf1\x03\x97\xf8\x0d\xa7\xb8\xda\x80\xb3\x40\xbb\x59\xeb\x59\x96\xba\x5a\xd6\xd2\x7e\xb9\x17\x6f\x6f\x21\x0b\x81\x9d\x90\x0f\x14\x43\x03\xc5\x35\x3a\x4b\xd7\x9b\x28\xe6\xa6\x33\xae\x19\xe3\xc6\x64\xe0\x89\x66\xde\x56\x67\xf4\x05\xfa\x64\x1e\x74\xa0\x99\xc3\x65\x4b\xe3\x7a\x78\x70\x22\x1a\x28\x5d\xa2\x6c\xfb\x5a\x42\x86\x54\x00\x8a\x71\xff\x17\x83\xf3\xfe\xaf\xb8\x67\xd0\x80\x84\x64\xef\x25\x41\x6a\x91\x5f\x02\x19\x79\xb3\xcb\x60\xad\x97\x22\x35\x5b\x04\x70\x6b\x70\x92\xfb\x8e\x51\xfe\x3b\xd2\x4e\x16\x4b\xad\x2b\xa0\x42\x99\x19\xca\x5d\xfd\xc5\xa0\x27\x82\x41\xfa\x9e\x53\xd9\x4f\xf7\xce\xbb\xa7\xdc\xa4\x8c\xf7\xd5\x19\x5f\xc8\xef\xb1\x85\xaf\x01\xb7\x1a\x88\xd4\xc0\x8d\xdb\xfd\xf4\xce\x4f\xfa\xef\x4f\xeb\x52\xbd\x5b\x1c\xa3\x08\xee\x0b\x5f\x28\x00\xdf\xbe\x76\x41\x63\x12\x44\x7c\xd9\x86\x49\xe0\xd2\x30\xdd\x9b\xf2\x6a\xc4\xd7\xf1\xbe\xa2\xea\xa0\xc3\x57\x8f\x55\x27\xae\x4a\x9b\x3f\xb0\x50\x49\xbc\x04\x11\xef\x2a\xc8\x47\x2a\x83\xc8\x55\xb5\x21\x1f\x27\x4a\xa4\x95\x04\x66\x8a\x84\x75\xea\xcc\xb5\x9c\xec\x69\x34\x4b\x5c\xf7\x00\x70\x54\x4f\x5a\x85\x44\xe9\x94\x95\x60\xd0\xaf\x5c\xeb\x2d\x55\xe2\xf2\x2c\xf9\xd5\x86\x9d\x09\x1a\x62\x8d\x65\xac\x4e\x1d\x38\x2e\xa3\x78\x3e\xd3\x19\xba\xd9\x6d\xa0\x79\x65\x49\xae\x8e\xa8\x0e\xe8\x8f\xed\x15\x6e\x80\xfa\x63\x7b\xb5\xd9\x90\xff\x40\x5a\xf8\x6e\x8b\xa0\x88\xf7\x69\x85\xa0\x02\xf8\x56\xd2\x58\x5b\x9c\x83\xcc\x5f\xa9\xdc\xbd\xf2\x8e\x3d\x07\x3c\x30\xad\xf5\x20\x9a\xaa\x87\x8e\xaf\x93\x0c\xee\xfe\x81\xd4\x14\xb0\xfd\xb0\xcf\x99\xd4\x7f\x06\x23\x7a\x4a\x02\x0e\x28\xe6\x69\xc8\x47\x41\x96\x0d\xe0\xf3\xf4\x94\x85\x39\xd4\x30\x64\xc2\x23\x77\x66\x36\xe3\x2a\x2e\x9d\x2d\xc9\x96\x12\x1d\x8d\x2f\xbb\x26\x5f\x11\x7e\xc9\x8c\x8d\xff\x0c\xd9\xf1\x83\x74\x40\x60\xbf\xf0\xab\xb9\xb3\xfb\xd5\x51\x82\x94\x68\x3d\x43\x2f\x09\xe1\x27\xc1\x9f\x65\x02\xed\x93\x57\x4f\xe8\x26\xa4\x6b\x74\x2e\x9a\xb7\xd4\x93\x57\x2f\x9e\x40\xcc\xe5\xc8\xd9\xda\x92\x78\x9a\x20\x43\xcb\x7c\xc6\xde\x34\x33\xe0\x60\x25\xb2\x61\xa6\x06\x3a\x20\x3a\xaa\x5b\xe8\xa0\x67\x5b\x93\x7e\xb4\xa5\x79\x99\x41\x17\xe7\xe8\x33\x06\x21\x12\xf3\x46\xf0\x5c\x28\xfb\x87\x57\x0a\xc3\x99\x68\x82\x79\x90\xe8\x87\x3f\xf9\xbf\x3c\x14\xa1\x04\x84\xa0\xaa\x57\x8e\xc1\x54\x95\x4b\x80\x55\x0d\xc3\x76\xe2\x6a\x37\xaf\x9f\x82\xed\x37\xd0\x59\x05\xa9\x21\x95\x79\xf6\x23\x70\x92\x3c\xe4\x1d\xc8\x4b\x2a\x42\xad\x22\x25\x0c\x9f\x2e\x99\x9b\xcc\xe5\x4f\xcf\x1b\x2c\x9b\x55\x5c\xe8\xec\x55\xf2\xfa\x6f\x96\xaa\x5c\x1b\xcd\x12\x35\xec\xee\xc5\x75\xf7\x79\x40\xc1\x19\x88\xec\x1d\xf4\x8d\xff\xde\x1b\x5e\xbc\x79\x7f\x7e\x82\x49\xf5\x17\x84\xcc\xf1\x2d\x49\x60\xdf\xe4\x25\xce\x2e\xd9\xac\x32\xc6\x2d\x8e\x83\x84\x53\xbc\x2f\x7d\xf5\x9f\x12\x3b\xe5\x8b\xe9\xa4\x8a\x8e\x89\x0d\x43\x71\x43\x75\x64\x8b\xf2\x1c\xd1\x22\xd1\x0e\x17\xdb\x6e\x36\x26\xa3\x8e\x4a\x40\x7b\x8b\x43\xfa\xdc\xd8\x8c\x59\x26\x72\x27\xd7\xd1\x2c\x8d\x61\xcc\x8e\xb7\x61\x2b\x7f\xfb\x35\x69\xbe\xed\x9a\xf8\xcb\x04\x21\x35\x4d\xa3\xd8\x64\x4f\x6d\xce\x1e\x40\x6f\xbf\x20\x29\xb7\x0b\xb9\x75\x64\xad\xd2\xc4\x27\x3b\x2b\xad\x94\xdf\xf0\xd8\x1a\x40\x1d\x8a\xbc\x06\x20\xa6\xc8\x57\x32\xa7\x5c\x93\x4a\x8f\xe4\x07\xb7\xa8\x20\x83\x26\x9d\xb7\x77\x2a\xbc\x76\x33\x3b\x79\x1c\x0a\x2c\xdb\x42\x2f\xbd\xcb\x2e\x68\x66\xc9\xfc\xd2\x1d\xa5\xe4\x99\x0b\x98\x0a\xa5\x2d\x95\xba\x5a\x52\x64\xda\x0f\x08\x95\xfc\x0c\xeb\x2c\x71\xeb\x43\x12\xe5\x9c\x32\x43\x6d\x30\xdd\x61\x5e\xd1\x6e\x5e\xd0\xff\x4b\xa7\xcb\xce\x9c\x5c\x84\xee\x6d\x71\xaa\xbb\x13\x81\x22\xc8\xbc\xb9\x79\x51\x74\xcf\x7c\xa1\x9e\xe0\x95\x97\xe8\x36\x98\xce\x5e\x3e\xe1\x4f\xd8\xee\x9d\xf4\x77\x90\x37\xb5\xd9\x64\x98\xd6\x15\xff\x50\x70\x0a\xe5\xeb\xea\xfd\xa8\xef\x5b\xb2\x87\x1d\x5a\xfa\x51\x62\xab\x46\xd3\x3d\x60\x7c\x52\xdf\x8a\x42\x4f\x45\x6d\xda\xe6\x12\x76\x96\x73\xb6\xd9\x23\xfa\x83\x1d\xf3\xef\x80\xbc\x86\x7f\x88\x9b\xa9\x70\xb7\x53\x30\x03\x75\xe4\x03\x6f\xf4\x33\xa5\x89\x9d\x0f\x26\xa2\xe3\xb3\x15\x84\xc3\x1c\x73\x79\x92\x5b\x93\x3a\xb3\x15\x6e\xc4\x13\xf3\x60\x51\xda\xae\x7d\x96\x94\x18\xba\x24\xa5\xb2\x6d\x62\x47\x44\xd3\x96\x6c\xd6\x34\xd3\xa2\x7c\x49\xf4\x97\x42\x2f\xe9\x43\x80\x7c\xbb\xd1\x70\xc8\x52\xbb\x92\x94\x9a\x04\x73\xa7\x64\x3a\x48\x14\x6a\x85\xb6\x87\x84\x73\xb8\xf0\x42\x15\x0b\x1c\xf1\x7c\x78\x11\x58\x50\x79\x2f\x75\x94\x97\x5b\x5b\x87\x0a\x7c\x84\xe1\xa6\x7a\xce\x08\xae\x41\x6d\xa3\xf9\xe5\xc8\xa5\x9c\xd4\x12\x72\x1b\xd5\x33\xa8\x5d\x71\xd4\xe9\xf7\xa5\x7b\x6d\xad\x05\x07\x91\xe0\xb6\x44\xe9\xa4\xa2\x22\x93\x92\x60\xe6\x7e\x58\xa4\xfd\x2b\xf7\xd0\xad\x71\x3a\xe0\x99\x28\x59\x19\x94\x1e\xcc\x2b\x8b\x7a\x95\x80\x13\x37\x6e\x62\xf5\x30\x74\x1b\xa5\x8d\x2b\xb5\xd7\xb6\xe7\xce\xbc\x6f\x47\x83\xf7\xc3\xdb\x4f\x48\x83\xb6\xab\x47\xf4\x7e\x15\xeb\x74\xbd\xed\x90\xb3\x6d\x86\x96\x98\x52\x68\xf3\x4d\x5e\x59\xf6\x80\x23\x8a\x3a\x51\xde\x7d\xd5\x14\xad\x1e\x72\xe8\x9a\xa1\xdd\x74\xc6\x72\xac\xbc\x8a\x45\x91\x3c\xf6\xa9\xbb\x1e\xd4\xaf\xdd\x68\xfa\xd5\x0f\x3e\xe7\xb0\xdc\x8d\xeb\xf7\x11\xb8\x05\x67\xb0\xa2\x4f\xfa\x83\x4b\x5a\x46\xd9\x49\xbf\x33\x1e\x5f\x9c\xf6\x3a\xc8\x71\xaa\x45\x59\x89\xdb\xb8\x38\x8d\xe6\x5b\x36\x8f\x34\x67\x39\x1d\x71\xfd\xbf\x0f\xf4\xd8\x21\x8a\xfe\x5a\x15\x2b\xe2\x48\x90\x2e\xf9\x76\x63\xdc\x9f\xc2\x12\x5c\xc3\xa6\x3a\x04\xc2\x40\x61\x76\x40\xce\xfc\x99\xbe\x03\xd7\xfc\x62\x27\x74\xca\xe6\x22\x71\xe5\xcb\x34\xfb\xc4\x1f\x7b\xd7\xf9\x19\x21\xf4\x49\xa3\x19\x8c\x48\xf1\x73\x89\xf6\x0d\xb9\x4b\xac\xc9\x35\x4f\x8b\x96\xca\x23\xf4\xb5\x89\x0a\x75\x4d\x25\x4c\xc4\xf8\xe0\x28\x48\x2f\x4d\x86\x70\x0b\x15\x6c\xdf\xea\x7c\xad\x00\xb9\x4a\x56\xce\x87\x21\xee\x17\x77\xd8\xb5\x96\x62\xfb\x79\xa4\x71\xf7\x05\x19\x25\x9e\x5c\xee\xed\x05\x41\xb0\xe2\xa9\xcb\x78\xcb\x16\x0e\xcd\xb7\x33\x13\x02\x92\x3d\xc8\xb9\x41\x9b\x4f\xd7\x9c\xa7\xb2\xac\x35\xa8\x4e\x7b\x1d\xef\x5f\xd2\xa4\xd9\x90\x24\x0c\xd4\xb1\xb2\x04\xf5\xc2\x19\xdf\x47\xa3\xf9\xec\x1a\x4d\xe1\xa3\xb9\x95\xf4\xe2\x13\xa1\x66\x63\xd8\x50\x60\x46\x73\x5c\xb0\x64\xab\x09\x58\xb9\x8b\x39\x64\x6d\xbb\xd1\x70\xdb\x12\x70\x32\x50\x99\xb8\x3d\x09\xb3\xf4\x23\x7e\x8f\xd9\x4c\x3c\x44\x35\x8e\xf5\x34\xe5\xcb\x67\xd1\x48\xe0\x53\xab\x68\x5e\x7a\x9c\xc7\xd9\x58\xb9\x46\x4c\x89\xee\x29\x0f\x90\x2c\x30\x01\x8c\x84\xed\x36\x11\xe8\xf1\x8c\x58\xab\x67\xf0\x45\xb9\x8d\x44\xa8\x7b\xc3\xfb\xf3\xd3\xc1\x09\x46\xb6\x76\x26\x3c\x4f\xcf\x91\x48\xcf\x1a\xa0\xd2\xa1\x53\x48\x9b\x6f\xcb\x8e\xa5\xc6\x1d\x46\xc8\x35\x51\xe2\x23\xb6\xae\xc9\xb5\xfe\xf1\xa1\x55\x82\x2a\x5b\x2d\xf6\x39\xdb\xe8\x37\x5d\xa8\xb7\x80\xce\xe7\xe8\xb7\xea\x28\xd5\xcb\xcb\xcb\xf6\x92\xff\x46\xdd\x07\x8e\x5b\x4a\x2b\xfa\x8d\xfa\x1c\xe5\xe8\xd6\xf2\x9b\xe6\x9e\x2e\x69\xfc\x29\xe2\x40\x6e\x67\xf2\xdf\x27\x04\xd2\xfe\x76\x66\x12\xd8\x54\xaf\x4d\x1c\xab\xbe\x9e\x72\xfd\x23\x85\xa5\x1c\x43\x8c\xb8\x77\xb4\x68\x29\xa4\x0e\xa2\x35\x23\x0d\x79\x76\xf1\x4a\xe6\x01\x74\x3c\xc6\xfd\xf9\x60\x2f\xd0\x49\x72\x3b\x20\x56\x28\x88\x25\x37\xd0\x75\x3a\x98\x5c\x9c\xbf\x3f\xbb\x98\xbc\x1b\x75\x3b\xa7\x63\xa7\x9c\x71\x0a\x3e\xd5\x1e\x40\x93\x05\x9b\xc6\x9c\x9c\x19\x53\x87\xa0\x01\x84\x8d\x1b\x23\x4d\xf0\xb2\x2d\x6e\x39\x20\x8b\xc8\x68\xcc\x03\x9c\x78\xbf\xcf\xcb\xd8\x23\x5e\xc9\xe3\xe8\xca\x9f\x03\x07\x12\x23\x3c\xe8\xe3\x24\xf7\x25\xbf\xce\x0b\xb3\x6e\x3b\xb6\x82\xe5\xa3\x42\x99\xab\x4d\x1c\xcd\x22\xa4\x81\x52\x57\x44\x22\x41\xa8\x00\x20\x61\x9e\x6c\xf0\x5c\xda\xee\x70\x28\x85\x7c\x18\xf4\xf1\xa9\x8e\xe9\xf2\x4c\x4d\x71\x89\xa6\xdb\x27\xc3\xf7\x2a\x46\x6d\x24\x30\xc9\xbb\xb1\x91\x96\x7c\x63\x6a\xa7\xaf\x3f\xdf\x99\xbe\xfe\xed\xcb\x9d\xe9\xeb\x87\x0c\x5f\x77\x78\x2b\x49\x2a\xb0\x84\x0a\xb6\x1c\x42\x9f\x3b\x65\xdb\x79\x71\x02\xf7\x4d\x7c\x4d\xc5\xe8\x10\x40\xd4\xc5\x54\xd8\x4e\x2a\xea\x72\x58\xca\x18\x14\xf2\xe5\x3b\x2d\x4e\xdd\x50\x7c\x52\xe6\x6d\x99\x1f\x48\xe8\xcd\xe0\x7c\x82\xf6\xca\x16\x27\x32\x32\x77\xad\x3f\x81\xbf\xe6\x48\xfc\x20\x02\xca\xd5\x4e\x2e\x3f\x56\x6b\xb1\x41\xc2\x76\x1b\x19\x84\x68\x9d\xbe\xb5\x42\x13\xd2\x2c\x21\x03\xc0\xb5\x9a\x73\x16\x65\x9a\x05\x51\x30\x4e\x54\x9b\x60\x37\xc4\x39\x4d\xf2\x39\xca\xd2\x84\xbb\x3a\xda\x5a\x49\xb0\x2e\xaa\x3d\x92\x97\x64\xff\xf4\x46\x91\x56\x78\x3d\x47\x0e\x64\x75\x6c\x77\x3f\xab\x7b\x67\xe2\xcf\xa6\x88\x66\xfa\xbe\x48\x16\x78\x2a\x15\x4e\x2c\x96\xd5\xc3\xcb\xeb\x46\xf0\xd7\x39\x13\x98\x99\x52\x4a\x03\xd4\x17\x9a\x74\x94\x1f\x8b\x57\x75\xe1\x32\xd2\x81\x1d\x2f\xea\x2b\x74\xfd\xdd\x0e\x5d\xbf\xfc\x6e\x87\xae\x5f\xdc\x9b\xb0\xdd\x49\xe4\xc4\xe0\x54\x2f\x88\xb2\x5f\x5f\xbb\xcf\x95\x38\x48\x01\xbe\x3a\x4f\x9d\xcb\x32\x14\xa6\x74\x38\x5d\x0d\x42\x34\x9a\x0e\x6a\x80\x2f\xd9\xce\x20\x72\x21\xf0\xd0\xe9\x49\x2b\xec\xd2\xb8\xbf\x14\xa6\x60\x55\x1e\xb2\x85\xb5\x02\xec\x06\x68\x20\x02\x0f\x26\x96\x5b\x5a\x78\x08\x18\xd5\x05\x89\x4a\xca\xee\xdb\x51\x67\xe8\xa6\xb3\xed\xf1\x74\xee\x68\xbb\x84\x7d\x2e\x66\xa4\xdc\x89\x40\xd8\x93\x80\x87\xf3\xe8\x52\xae\x01\x0f\xc3\x01\x6b\x8c\x12\xfe\x21\xd4\x40\x9c\x1a\x81\x03\x87\xfd\x67\x42\x79\x4e\x5b\x58\xa4\x52\x23\x54\xa3\xa9\x93\x8c\xbb\x45\xb3\xf8\x72\x80\x86\xd0\x52\x6a\x57\x6b\x15\xa0\x0e\xfa\xfd\xce\xeb\xc1\x88\x74\x96\x2f\x02\x6e\xa3\xe9\xc1\xfb\xa5\xc0\x2d\x4d\x77\x80\xc2\x6f\xeb\x00\x93\x19\xa2\x9c\x47\x01\xec\x5b\xc2\xb2\xf0\xb4\xf5\xf9\x5a\xfc\x05\xae\x6b\x97\x0f\x23\xac\x40\x2a\x58\xc3\x26\x25\xd5\x3d\x9a\xfc\xf1\x30\x51\x03\xe2\x1a\x4c\x90\x6b\xc1\xfe\x79\x7c\x47\x14\x78\x04\x10\x32\xe0\x7b\x68\xd5\x01\x9d\xfd\x07\x21\x74\x1f\xed\xd4\xa5\xe3\xec\xa7\xbf\xf7\x67\xfd\x8b\xfe\x9d\xaa\x09\xc2\x9b\x68\x33\x1c\xca\xd6\x80\x33\xbf\x28\xa9\x84\x7b\x1a\x94\x63\x94\x83\xb3\xb7\xdf\xe4\xea\x3d\x72\xc3\xcd\x5c\x9d\xa5\x98\x54\x4e\xca\x4f\xbf\x34\x47\xe4\x8b\xb3\x23\xf6\x02\xc5\x9d\x56\xa9\xf2\xa5\x3c\x1f\xdc\x00\x13\x1e\x2b\xd6\xe2\xfe\xdd\x64\xca\x82\xf2\xd7\xa6\x58\xa5\xf3\xb0\xf2\xde\x57\x25\x3b\x9a\x4f\x24\x79\xc7\xf7\x14\x5d\xeb\xe4\x5a\xd6\x49\x33\xbf\x0c\x96\xc4\x9f\xf0\x0a\x4e\x64\x98\xae\xd6\x1a\x1a\xc5\x14\x06\x37\x4a\x15\x53\x35\x8d\x96\x6c\xe9\xd9\x1c\x37\xab\xf8\xd3\xe1\x7a\x67\x3d\xab\xc5\xbd\xe9\x75\xfb\xa7\x20\xdb\xcc\xe4\xab\x34\x9e\x2b\x6e\xfb\x6e\x83\x69\x4e\x19\xa6\x21\xbc\xb9\xe7\x1d\x76\x6c\x81\xd5\xae\xbc\x78\xe7\x58\x75\xa2\x97\x06\xa0\x08\xda\x4e\x42\xf1\x46\xac\x20\xcd\xd0\x2a\x95\xbe\x51\x76\x30\x98\x60\x0b\xdc\xca\xc6\x5c\xcd\x8c\xe1\x6c\xbb\xef\x9f\xff\xb7\x30\xca\x4d\x0b\xc0\x51\x87\xae\x40\xc4\xbd\xe7\x6d\x35\x4e\x21\xfe\x92\x92\x09\xe7\x17\x2d\x52\xf5\xe2\x79\x4b\x6d\xa9\xf7\xcd\x8b\xef\x05\xb0\xf8\x96\xde\x6c\x8c\xce\xd0\x42\x8b\xfc\x16\x8b\xd0\x0e\xb0\x9b\xc8\xd5\x8b\xef\x2d\x5e\x8b\x94\x72\x85\xd7\xe9\x36\x21\xb3\x8d\x97\x61\xbc\x86\x09\x40\x98\xb2\xf0\xfc\x20\x0d\xfd\xc5\xf3\xe7\x0f\xac\xca\xd4\xe2\xd8\xab\x32\x4c\xc0\x93\xee\xd9\x10\xc9\xc8\x81\x31\x7c\x43\x77\xb8\xdb\x2e\x74\x68\xde\x03\x1c\xde\xbf\x48\x16\xa6\x98\x34\xd2\xc2\xc3\xa6\xd7\x51\xdb\x94\x46\xd3\xcd\x33\xc8\x1f\xeb\x4a\xd7\x9c\xb5\xe6\x4a\x4b\xe6\x06\xf1\xc3\x56\x7d\xfc\x10\xdb\xae\xe6\xd2\x54\xc2\xef\x8d\xa6\x07\xdd\xad\xb2\x60\x47\x18\xc3\x1c\x0a\xc5\x42\xa3\x59\x2f\x8d\xc5\x8f\x15\x0a\x61\x51\xaa\xdd\xa0\xff\xaa\x18\xe5\x80\xdd\xe3\x09\x94\x12\x08\xf7\x0b\x14\x7e\xec\xf4\xe2\xf5\xaf\x5f\x0c\xed\x46\x73\x87\x50\xef\x07\x6d\xd6\xa4\xff\xf5\xa0\xed\xc1\x58\x0f\xed\x93\x4e\xbf\xcf\x7f\xdf\x73\xc3\xf7\x01\x0e\x63\x92\x42\x4d\xef\x3a\x04\x25\x3a\xf0\xab\x65\x9c\x4e\x75\xec\x73\x02\xc4\x1b\xcd\x02\xab\x5a\x89\x51\xae\xff\x62\x87\xd2\x6d\x45\xb4\xd4\x5f\x12\x7d\x84\xd0\x73\x17\xc0\x1c\xa7\x30\xc7\xd7\x29\xe6\x3c\x69\x38\xbe\x83\xe6\x87\x3c\xbe\xdf\x4f\x9e\xc4\x19\x84\x3d\x81\x04\x64\x1a\x7c\xa3\xe9\x76\xcd\xdd\x02\xfc\x78\xfa\x0f\x78\x89\xde\x91\x6e\xc1\x6d\x75\x1a\xe5\xbc\x77\x1d\xac\xc9\x06\x2f\x3c\x98\x33\x64\xd7\xc6\x51\xbe\xb2\x42\x0b\x13\x29\x1c\x91\x48\x4f\x81\x0f\x70\x7d\xba\xc5\x1f\x8b\xdd\x05\x08\xbf\x41\x83\xc1\x53\xdd\xd1\x97\x13\x86\xc9\xfe\x2b\x90\x86\xc9\xbe\x88\x38\x4c\x76\x0b\x79\x98\xec\x7e\x04\x62\xb2\x47\x27\x11\x87\xfc\xbd\x24\x42\xb4\xd1\x3b\xe9\xf4\x2f\xde\xf5\xba\x23\x48\xbd\x5f\x0f\xa3\x14\x9c\x1c\xe9\x40\xf0\x64\x47\x26\x43\x50\xe1\x9a\xf0\x14\xfb\x20\x70\x90\x60\xa7\x55\x61\xae\x0a\x74\xa5\x49\x13\xf3\x68\xec\xb2\xee\x34\x35\xec\xd2\x15\x06\xdf\xe1\x6a\x88\xca\x13\xde\x08\x48\x64\xcd\xc2\x1b\x31\x9b\x95\x0e\x1a\x6d\xc9\xaf\xf1\x10\xd9\x40\x0e\x64\xea\x52\x5f\x4b\x5c\x28\xb8\x5d\x5e\x97\x82\x75\xe0\x1a\xd5\xb8\x6a\x14\x97\x40\x5d\xa3\x74\xd1\xbe\x38\x2d\x81\x1d\xaf\xfe\xf3\x8f\x06\xee\x2a\x1c\x55\x45\x3a\x89\xff\xb0\x77\xd6\x79\xdb\xbd\x78\x33\x18\x9d\x75\x26\x37\xf9\x10\xa9\x2f\xae\xcf\x15\xf2\xbf\xcb\x4b\x9d\xdf\xa6\x68\x1c\x53\xd8\x44\x25\x1a\x85\xbd\x89\x35\xcf\x61\x4e\x17\x3b\x0b\x61\xcf\x6c\xc3\xd8\xe0\x47\x50\x12\xc7\x0f\xd4\x05\xee\xe4\xfb\x2e\xe6\x73\x74\x78\x04\xe7\xd8\xa7\xe2\xb0\xeb\x6f\xb6\x4a\xd3\xdc\xa8\xfc\xf3\x52\x5d\x07\xce\x6b\x40\x13\xe1\x5e\xdb\x9e\xc1\xb7\x04\x2a\x52\x75\xb5\x2a\xd6\xe8\x15\x67\xa7\x88\x34\x9a\x25\x6b\x6c\xfc\xf3\x5b\xf6\xaa\x7f\x8e\xec\xe0\xb8\x28\x51\xbd\xae\xfa\xeb\x9f\xd4\x91\xd5\x51\x68\x4a\x42\xd0\x09\x92\x02\x3e\x9c\x2c\xca\x58\x86\x0a\x73\xbc\x77\xfa\xdc\x26\x59\xb6\xd4\xc7\xcd\xb2\xa5\x96\xd1\xa2\x85\x9d\xb7\xd4\x26\x59\xfe\xb0\x9c\xcb\xbf\xdd\x7f\xce\x74\x94\xa5\x30\xaf\xdc\x0f\xe5\x3f\xd9\xff\x0f\x7f\xb1\x9c\x47\x9b\x78\x9b\xcb\x4a\xf4\x03\x1b\x1d\xc1\x6f\xe4\xdf\xfb\xe9\x77\x93\xdc\xdb\xdb\x59\xa2\x4d\xa1\xdf\x4d\xb2\x64\x76\xb1\xf3\x88\x5f\x83\x40\xe2\x92\x70\x45\x9e\x31\x69\xfb\xef\x70\x22\x90\x95\x47\x4c\xc4\x4c\x5f\x34\xff\x4f\xcf\x28\x7f\x0c\x28\xe5\xbe\xd0\xc4\xeb\x28\x46\xac\x7e\x4b\xd3\xb5\xd4\xe6\x6f\x34\xb5\xdd\xaa\x4a\x55\x20\xd5\x1f\x5c\x2b\xcc\x40\xc8\x12\x21\x00\x66\x46\x14\x97\xea\xe1\x73\x89\x29\x54\xf7\x6a\x13\xa7\x19\x15\x3e\x1a\x4e\x89\xc1\x07\x10\xf5\xa7\x8f\x65\x46\xbd\x89\x32\xb3\x48\xaf\x5a\xea\x64\x95\xa5\x6b\xd3\x52\x63\xbd\xd0\x59\x64\x7d\x7d\x03\x64\x50\x7b\x1a\xc7\x3d\xb4\xc4\xf7\x45\xb4\x2d\x94\xdd\x68\xd6\xd3\x76\x5b\x0d\x62\x3c\xc6\x93\x4a\x48\xa0\xf6\xba\x25\xc2\x06\xf0\xb8\xd7\xe9\x63\x89\xd3\xde\xf9\xa4\x3b\xea\x9c\x4c\x7a\x3f\x77\x2f\xf0\xb9\x3d\x71\xce\xdb\x6a\x48\x2a\x9e\xfc\x12\x87\x71\x7a\x04\x8f\x03\xaf\x94\x3d\x44\x45\x29\xc4\xbf\xfb\x66\x39\x21\x1a\x5f\xb9\xef\xe5\xd8\x97\x1a\xc0\xa7\x25\xae\x75\xda\x1b\x1d\x9c\x65\x7a\x43\xd5\x4c\x25\x50\x58\x9a\x03\x55\xcb\x9f\x8f\x84\xad\x7f\x98\xa7\x05\x4f\xcc\x65\x1d\xeb\xf8\x9e\xe7\xf6\xe7\xaa\x39\xf7\xd9\xf8\xe4\x21\xcf\xbd\xce\x67\x5f\x7a\xee\x75\x3e\xdb\x39\x77\xa3\xb4\xbd\x9a\xed\x9f\xf6\x3a\x0f\xb9\x7d\x24\x9e\x7c\x29\xda\x22\xbd\xbb\xfd\xd2\xf6\x2a\xdb\xa7\xd8\x33\xcf\x27\x8a\x75\x52\x6c\xd7\x31\xd7\x84\xf7\x3b\xe7\x13\xf8\xc4\xfe\xd6\x19\xf9\x1b\xe8\x13\x91\xab\x67\xe3\xfa\x2a\x7f\x0d\x3f\xea\xcf\xda\x47\xd4\xb0\xa4\x7c\xa0\xfd\x51\x67\x84\x9f\x9b\xaf\x23\xa4\x28\xde\x78\x6f\x1b\x15\x81\x31\xd1\x47\x61\x11\xc1\x54\x98\xab\xf9\x96\x4a\x97\x75\x90\xfc\x8f\x9f\xf3\xc2\x6c\x76\x52\x8f\x44\xd5\x51\x5a\x5d\xea\x0c\x4c\x1f\xec\x02\x9d\xf6\x94\x49\x66\x70\x4d\x42\xb2\x6b\xf5\x81\x1a\x3a\x6e\xd7\x6e\xd6\x9c\xcb\xf3\x80\x65\xc4\x02\xd5\x0d\x65\x71\xab\x7a\xed\x87\x35\x45\xf2\xd4\xa3\xb6\x7b\x07\x8e\x77\x01\xfe\xc9\x9b\xb7\xc4\xeb\x6f\xa2\x2a\x5d\xdb\xc4\x99\xb6\xe2\x20\xde\x68\xec\x2e\x79\xc0\x3e\x7c\x0c\x16\x9c\x4f\xdc\x75\x5c\x81\x88\x0f\xe0\x4b\x61\xa8\x96\x02\xf4\x00\xc0\xbf\x31\xc5\xa2\x98\xaa\xa0\xf1\xe6\x80\xa3\x76\xeb\xab\x69\x9c\xce\x3e\x85\xf0\x61\xb7\x0c\xc3\xc8\xdf\xad\xc1\xc4\x5a\x14\x17\x67\x9d\x5f\x2e\xce\x07\xa7\xdd\xfa\x2b\xc6\xea\xae\xb8\x89\xbd\x17\x9e\x5c\xfe\xd2\x9a\x49\x8c\x5a\x89\x29\xf8\x50\x80\x04\x12\xaa\x6f\x06\x76\x06\xc7\x09\xf2\x4a\x6a\x0a\x11\x07\x89\xc4\x4a\x5c\xa7\xc8\xb6\xc9\x4c\xda\x51\xd0\x0a\x92\x30\x11\xe5\x2e\x0f\x49\x46\xed\x81\x82\x4d\x6e\x12\xca\x9c\xd0\xf4\x6d\x64\xec\xa3\x3d\xd3\x5c\x4d\xd3\xab\xd0\xf5\x2f\x5f\x89\xaa\x3b\xb6\xb2\xa0\xd1\x54\xb3\x55\x14\xcf\x33\xe3\x34\xee\x2c\x4d\x0b\xbb\x66\x70\x1c\x5c\xb5\x18\xe9\xd6\xd7\xe1\x79\x1a\xcd\x7a\x98\x8b\x4f\x9b\x0e\xe2\xc7\x12\x39\x60\x82\x53\xc5\x71\x5b\x75\x90\xec\x98\xc8\x5e\x1b\xcd\x52\x47\x22\xf9\x34\x63\x6f\xb1\xcd\x48\x95\xca\x0c\xba\x10\xcc\xd8\x10\x01\xa2\xfd\x16\x4e\xbb\xc3\xc9\xbb\x43\x63\x02\x35\x51\x81\xef\xef\x9d\xe0\x50\x05\x05\xae\x8d\xfa\xfe\xb9\x50\x68\xcd\x76\x0f\xa1\xd0\xb9\xd9\xc0\xd7\xbc\xf0\x40\xad\xb7\xc8\x3a\xfc\xa4\xeb\xc4\xf5\x2d\xfb\x40\x00\x5e\xeb\x65\x01\x62\x11\x27\xd6\xb3\x55\x29\x77\x0e\x76\x09\x10\x3f\xbd\xe6\x22\x0d\xe6\x96\x60\xd2\xa8\xf0\xd3\x85\xf5\xf9\x7c\xab\xcc\x5c\xb2\x92\x1d\x42\x41\x70\x73\x81\x53\x4c\x71\x32\x41\x97\xcb\xcd\xf3\x64\x25\xaf\x72\x1d\x62\x48\xad\x3e\xc9\xc7\x6b\xf4\x88\x27\xc1\x66\xcc\xd4\x4b\x0a\x27\x2d\x51\x2c\x10\xe3\x16\xb8\x7c\x50\xb8\x7d\xb6\x2c\xe0\xc8\x61\x05\x25\x98\xd9\x0e\x91\x2b\xd5\xa5\x51\xe2\x6e\x6e\xa9\xae\xd1\xf4\x74\x77\x67\xaa\xab\x27\x7b\x99\x7c\xa9\x3d\xba\x9e\x33\xf8\x93\x94\x7f\x27\xcb\x20\x33\xf5\x50\x32\xdd\xa1\xd2\x7b\x11\x69\x1d\x05\xfa\xf4\xb2\x31\x13\x1e\x9e\x98\x8c\x3a\xe7\xe3\x61\x67\xd4\x3d\x2f\x67\x68\x06\x8d\x6e\xdc\x20\x1d\x2a\x63\x2b\x32\x9d\xe4\x18\x6e\x48\xf9\x90\x53\x3d\xfb\x84\x48\x7b\x32\xf7\xbd\x40\x24\x83\x19\x37\x97\x0f\xd5\x02\xa7\xd4\x9c\xab\x0c\xef\xcd\x3f\xa2\x64\x8e\xec\x5c\xdf\x35\xd9\x18\x34\xa0\x2c\x52\x37\x4e\xc1\x52\xc7\xd6\xf9\x28\xc0\xf2\xc8\x2a\xfb\x87\x95\xd6\x3f\xa8\x53\x72\xeb\x00\x1b\x9c\x76\x88\x12\x39\x38\x36\x48\x08\xb4\xea\x5d\xa1\x20\xae\x18\xfe\x32\xb2\x18\xa7\x7a\x1e\x5f\x2b\x9d\x14\xd1\x53\x1d\x47\x1a\xb2\x23\xd6\x53\x13\xe7\xb2\xa4\xbd\x07\x01\xc9\x1c\xd1\x28\x84\x62\x65\xae\x25\x4a\xbc\xd2\x19\xaf\x06\xd6\x79\xfc\x58\xb6\x51\x15\x5b\x15\xdb\x28\x44\xea\xd9\xfb\xfe\xa4\x77\x31\xe9\x8c\xde\x76\x27\xe3\x0a\x5a\xad\xc9\xcb\xa9\x56\x0e\xc7\x6e\x3c\x94\xf4\xb8\x62\x41\x0e\xd7\x48\x42\x43\xa3\xf8\xe0\xee\xc1\xa7\x29\x54\x1f\xf5\x74\xc2\x80\x75\x10\x13\x2d\x09\x95\x92\xc7\x95\xa9\x93\xf8\x2c\xd6\x5a\xe8\xbc\x30\x99\x6d\x1f\x6c\x13\xd7\x2d\xdb\x32\x97\x15\x13\x14\x2f\x1c\xfd\xf4\xa2\xfd\x97\xf6\x8b\xe7\xc7\x42\x1a\xa0\x93\x55\x94\xb7\x76\x1a\x52\xd5\x50\xde\x63\x62\xa3\x0c\xe6\x12\x36\x58\x75\x70\xd5\x44\xfd\xee\xdb\xee\x79\x4d\x4b\xaf\xb2\x7e\xe0\xd0\xa1\x55\x6c\x96\x26\x81\x96\xb5\x84\x16\x8d\x84\xd8\x20\x77\x51\xa6\x74\xf3\xcd\x40\x12\x50\xba\xcd\xa1\x14\x70\x44\x58\x67\x70\x4c\x78\xdb\xc0\xeb\xa5\x73\x49\x61\x7a\x3c\x87\x65\xf5\xd0\x55\x87\x25\xc3\x06\x10\x3c\xe9\x77\x3b\xe7\xef\x87\xb7\x26\xc4\x70\xdd\x2e\x0e\x43\xee\x9c\x35\xc6\xc8\x15\x74\xb2\x46\xb3\x6a\x1a\x89\x7c\x75\xd0\x0c\x61\xf4\xc8\x67\x0f\x0f\x15\xfc\xf3\x4a\xfd\xda\x1d\x37\xfe\x73\x00\x11\x94\x9d\xda\xc4\xab\x01\x00")
+
+func dataCppDoxyfileBytes() ([]byte, error) {
+	return bindataRead(
+		_dataCppDoxyfile,
+		"data/cpp.doxyfile",
+	)
+}
+
+func dataCppDoxyfile() (*asset, error) {
+	bytes, err := dataCppDoxyfileBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "data/cpp.doxyfile", size: 109508, mode: os.FileMode(420), modTime: time.Unix(1792283723, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _dataDefaultHtml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8c\x54\x4f\x6f\xd4\x3e\x10\xbd\xf7\x53\xcc\xcf\xbf\x1e\xe0\xb0\xb1\xda\x5e\x10\x38\x91\x50\x8b\x04\x12\x14\x44\x7b\xe1\xe8\xc6\x93\x3a\xc2\xf9\x23\xdb\x81\x5d\x45\xf9\xee\x68\x1c\xe7\xdf\x76\xc5\x72\x5a\x7b\xc6\x33\xf3\xde\x9b\xb7\x11\xff\xdd\x7d\xbd\x7d\xfc\xf1\xed\x03\x68\x5f\x99\xec\x42\xd0\x0f\x18\x59\x3f\xa7\x0c\x6b\x96\x5d\x00\x08\x8d\x52\xd1\x01\x40\x54\xe8\x25\xe4\x5a\x5a\x87\x3e\x65\x9d\x2f\x76\x6f\x18\xf0\x98\xf4\xa5\x37\x98\xf5\x3d\x24\x8f\x74\x82\x61\x10\x7c\x8c\x8d\x79\xe7\x0f\x31\xff\x40\xa7\x90\x1f\x63\x34\x85\x4f\x63\xc4\x53\xa3\x0e\xb1\xa4\xac\xdb\xce\x43\xa9\x52\x26\xd5\x7e\xe7\x50\xda\x5c\x33\xf0\x87\x16\x53\x36\xdd\x5a\x23\x73\xd4\x8d\x51\x68\x53\xf6\x10\x83\x13\xa6\xce\x1c\x55\xef\x2c\xba\xce\x78\xc7\x32\xc1\x3b\x33\xbe\xea\x7b\xf0\x58\xb5\x46\x7a\x04\x46\xdc\x3b\xf9\x8c\xbb\xa2\x34\x1e\x2d\x83\xe4\x73\x8c\x38\x18\x86\xf0\x5e\xe8\xab\xec\xf1\xd0\xa2\x13\x5c\x5f\xcd\x2d\xac\xac\x9f\x11\x2e\x6b\x07\x6f\x53\x48\xee\x65\xb5\x2e\xb8\x26\xde\x94\x1b\x06\xa8\x29\xd5\xca\x1c\x05\xd7\xd7\x73\xf9\xef\xd2\x6b\x78\x55\xd6\x0a\xf7\x70\x99\x7c\xc1\xea\x09\xad\xa3\x76\xaf\x93\xf7\x75\xae\x1b\x4b\x7a\xb5\x99\x90\xa0\x2d\x16\x29\xfb\x9f\x84\x86\x61\x60\xd9\xfd\xd4\x0f\xaa\xb1\x4a\x70\x99\x09\xde\xd2\x48\xac\xd5\x04\x62\x86\xf8\xb3\xac\x95\x5b\x46\xcd\xe5\xe3\xb4\x05\xf3\xcd\xd1\x2e\xf5\x4d\x14\x55\x2d\xba\x8d\xa4\x93\x5b\x23\x9d\x5b\xf1\x55\x1e\x94\xf4\x72\x37\x89\x99\xb2\xbe\x5f\x84\x0c\xa8\xb7\x44\xbe\x63\x11\xa2\x74\x26\x40\x61\x20\xb1\x50\x7e\x1a\xaa\xce\xb6\xa4\xc0\x1d\xba\xdc\x96\xad\x2f\x9b\x3a\xf4\x50\xd1\xba\x5b\x2d\x04\x57\xe6\x54\xfc\xc5\x2d\x6c\x25\xf9\x58\xa2\x25\x5f\x1d\xa6\x0c\x39\x20\x70\x86\x39\xb5\xf1\xc2\x62\x27\x3d\xe5\x19\x24\xab\xbe\x71\xca\x3f\x78\x87\x7c\x13\xf7\x4a\xc6\x3a\x61\x90\xd5\xcb\xb2\x98\x1f\x2f\xae\x89\xff\x3e\xcc\x83\x28\x2f\x45\x9c\x2b\x36\x62\x46\x9e\xf6\x04\xa7\xd9\xc0\x6c\xae\x9d\xc7\xf0\x38\x27\x3b\x62\x1a\x6f\x23\xd1\xd3\xd6\x9b\x7b\xc4\x16\x67\xf7\xfd\x17\x88\x39\x2d\x67\x25\xf9\x39\x5c\xab\x9b\x18\x0d\x94\xfd\x92\x16\xa4\xda\x8f\x5f\x94\x4f\x01\x71\x4a\xbb\x4a\xd6\x91\x61\x78\x27\x78\x2c\xd8\x54\x2f\x0f\x1f\x42\x36\x98\x71\xfd\x70\x03\xb7\x68\x9a\xf0\xad\x09\x10\x04\x1f\xbf\x80\x82\x6b\x5f\x99\xec\xe2\xcf\x00\xfb\x08\xa7\x80\xa4\x05\x00\x00")
 
 func dataDefaultHtmlBytes() ([]byte, error) {
 	return bindataRead(
@@ -93,12 +121,12 @@ func dataDefaultHtml() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "data/default.html", size: 2686, mode: os.FileMode(420), modTime: time.Unix(1698879908, 0)}
+	info := bindataFileInfo{name: "data/default.html", size: 1444, mode: os.FileMode(436), modTime: time.Unix(1792281960, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
 
-var _dataJavaDoxyfile = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xe4\xfd\x7b\x77\x1b\xc7\xb1\x2f\x0c\xff\x8f\x4f\xd1\xc7\xcc\xbb\x4c\x3a\x20\x28\xc9\x76\x8e\xa3\x1d\xe5\x0d\x44\x42\x12\x62\x12\xa0\x01\xd0\x96\xf6\xd1\xd9\x58\x8d\x99\x06\xd0\xd1\x60\x7a\x3c\x3d\x43\x12\xce\xca\xf3\xd9\x9f\xd5\x55\xd5\x97\xb9\x80\x00\x2d\xda\xd9\xe7\x3c\x59\x2b\xa6\x48\x0c\x7a\xba\xab\xab\xab\xeb\xfa\xab\x23\x76\xa1\xee\xb7\x4b\x99\x08\xf6\xbc\xf7\x5d\xef\xf9\x9f\x3b\x9d\x23\x36\x5b\x4b\xcd\xe0\x6f\xb1\xd0\x51\x2e\x17\x42\xb3\x62\x2d\x98\x16\x45\x21\xd3\x95\x66\x85\x62\x0b\xc1\x4a\x2d\x62\xb6\xd8\xc2\x47\xb1\x8a\xca\x8d\x48\x0b\x5e\x48\x95\x32\xbd\xd5\x85\xd8\x74\x8e\x58\xac\xee\xb7\x2b\x91\xb2\xe3\xbb\xbb\xbb\x1e\xfd\xd2\x53\xf9\xea\x84\x2d\x55\xce\x38\xcb\x72\xf5\x0f\x11\x15\xbd\xce\x51\xe7\x88\xf5\x93\x84\x15\xe2\xbe\x60\x7c\x59\x08\xf3\x69\xac\xca\x45\x22\xd8\x9a\xeb\x35\x3b\x3e\x3a\x3a\x61\x52\xb3\x48\xa5\x5a\xc6\x22\x17\x31\xe3\x2c\x52\x1b\xf3\x52\xc6\xd3\xd8\x7c\x96\x25\x3c\x12\x31\x93\x69\xe7\x88\x2d\x73\x95\x16\x4c\x2d\x61\x76\xb3\xfe\x5b\x26\x0b\x78\x24\x17\x91\x88\x65\xba\xda\xf1\x4a\x2d\xd3\x95\x7f\xe5\xc3\x6f\xbc\x93\x49\x62\xe8\x20\x57\xa9\xca\x45\xdc\x03\xca\x09\xb3\xb2\x0d\x37\x2f\x7b\x69\xfe\xd0\x7f\xcb\x5e\xb1\x5b\x9e\x94\x82\xfd\x2f\xf8\xd1\x65\xbd\x5e\xef\x7f\x77\x8e\xd8\x1b\x95\xb3\x44\xea\x42\x77\x99\x2c\xc4\x46\xb3\x88\xa7\x8c\x27\x1a\x68\xcb\xb3\x4c\xa4\xb1\x88\x59\x69\x66\x64\x47\xfa\xe3\x8e\xa1\x7e\x34\xbf\x99\x3d\xe2\x85\x99\x6e\xc1\x65\xca\x74\xc6\x23\xa1\x99\x5e\xab\x32\x89\xcd\x90\x44\x9d\x85\x28\xee\x84\x48\xd9\xcf\xa5\x2a\x84\x66\xc7\x1f\xbf\x60\x1f\xbf\x38\xe9\x75\x3a\x47\xa7\x4f\xf7\xbf\xce\x11\xbb\xc6\xad\x65\xb9\x48\x78\x21\x62\x33\xaf\xa5\x5c\x95\x39\x72\x88\xca\xcc\x0f\xfd\xb4\x2f\xb5\xac\x5b\xf0\x15\xd3\x99\x88\xe4\x52\x12\xe7\x8a\x34\x52\x66\xd7\x91\x67\x81\xf7\x92\x84\x45\x6b\x9e\xf3\xa8\x10\xb9\x66\x32\x85\xe7\x2a\x93\x34\x5c\x64\x0e\x01\x90\x75\xa9\x92\x44\xdd\xf5\x60\x87\x63\xb1\xe4\x65\x02\xfc\x74\x33\x7b\x73\xfa\x1d\xbb\x5b\xcb\x68\x6d\x7e\x85\xed\xdb\xf9\xc2\xce\x11\x32\xdb\x42\x2c\x55\x2e\xe0\xb9\xa5\xcc\x75\xc1\x54\x14\x95\x79\x2e\xd2\x48\x20\xc7\xe2\x1a\x7a\x70\x36\xcd\xf1\x29\xb5\xd0\x2c\x91\x0b\x19\xa9\xf4\x96\x1d\xab\xdc\x7c\xb7\x73\xc4\xf0\xf7\x45\x29\xcd\x64\xd2\x42\x99\x67\x22\x3c\x5b\x66\xf0\x22\xe7\xa9\xc6\x79\xf4\xd8\x54\x98\x6f\xac\x8b\x22\xd3\x2f\xcf\xce\xcc\x79\x5c\xa5\xa5\x39\x8b\x67\x5a\x2d\x8b\x3b\x9e\x8b\x33\xfb\x86\x33\x37\x82\xe1\x4f\x33\xa5\x4c\x69\x2d\xcd\x59\xb4\xeb\xd2\x96\xdb\x2d\x2d\x90\x2f\xa5\x7e\x89\x24\xe9\x75\x3a\x17\xe3\xf7\x1f\xde\x0c\x2f\x07\xf3\xc1\xe8\x7c\x7c\x31\x1c\xbd\x65\xf0\xbf\x57\xf8\x40\x87\xbe\x7f\x3d\x19\xff\x7d\x70\x3e\x9b\x8f\xfa\x57\x03\xd8\x38\x43\x45\x7b\x0c\xef\x54\x1e\xc3\x72\x39\xd3\xe2\xe7\xd2\x12\xc8\xfc\x59\x33\x5d\xe6\xb9\x2a\xe1\x90\x2c\xb6\x20\x6b\x8c\xb4\x38\x45\xbe\xee\xb2\x32\x4d\x84\xd6\x6c\xab\x4a\xc6\x73\x81\xe7\x08\x08\x7a\x27\x7f\xe1\x79\x7c\x82\xdb\x4a\xc7\x43\xc6\x22\x2d\xe4\x72\x4b\x74\x25\xb1\x04\x64\xc0\xbd\x6d\xca\x38\xa9\xd9\x4a\xa4\x22\x37\xcc\xdd\x43\xbe\x4b\xf9\xc6\x90\x00\xf7\x1c\x39\xca\x6c\xb9\x2c\x12\x98\xf6\x46\xe9\xc2\x7f\x87\x65\x7c\x25\x34\x8a\xae\x94\x71\xb6\x14\x77\x4c\x15\x6b\x91\xe3\x41\x7d\x80\xc0\x57\x5b\x7b\xb8\x7a\x9d\x4e\x85\x7e\xfe\x7f\xaf\x1a\xf4\xbd\xb9\x7a\x3d\x98\x00\x85\x8d\x9c\xb1\xe2\xbb\x50\x4c\xa4\x28\xfa\xec\xa2\x55\xce\x72\x71\x2b\xb5\x59\x64\x5a\x6e\x16\x22\xc7\xe5\x75\x8e\x58\x64\x65\xc9\x9a\xa7\xf1\x16\xd9\x3a\x8f\xd6\xf2\xd6\xd0\xd6\x90\xc8\xaf\xae\x4a\x2c\x95\x33\xb9\x64\x5a\x6d\x04\xbb\x15\xb9\xc6\xc3\x65\x24\x55\xae\x12\xba\x2d\x2c\xe1\xc2\x35\xe1\x9c\x2b\x6b\xba\xd1\xf6\x5d\xf6\xa9\xd7\x93\xe1\xe0\x0d\x2c\x4c\xa5\x02\x16\x97\xe5\xea\x56\xc6\x82\x71\x2b\x67\x78\x02\x9f\x25\x32\xb5\x57\x5a\x66\x0f\x78\x78\x0d\x21\x4b\x18\xd1\xcb\x73\xcd\x78\x81\x87\x48\x65\x66\xf3\x04\x8f\xd6\xb0\x65\xb0\x63\xc4\x36\x2b\x79\x2b\xd8\xad\x14\x77\x86\x80\x9d\x23\xf6\x73\x29\xa3\x4f\x86\x99\x38\xe3\x0b\x55\xe2\x00\x59\x99\x67\x4a\x0b\x7b\x17\xd9\x2b\x8f\x7d\x2f\x44\x86\x7c\xe5\x67\x64\x06\xce\xc3\x6d\xc5\xc5\x55\x49\xf0\x93\x2c\xd6\x15\x0a\x5c\x8e\xdf\x8e\x2b\x04\x40\xe1\xb7\x65\x9c\x25\x6a\xa5\x0c\xf5\x79\x0a\xc2\x02\x57\x28\x8d\xbc\x8b\x92\x32\x16\xb1\x11\x22\x69\x93\xbb\x51\xce\x6d\xf8\xbd\xdc\x94\x1b\xb6\x16\x72\xb5\x76\x97\x29\x0c\x49\x04\x48\x55\xc1\xc4\x7d\x24\x44\xcc\xbe\xfd\xd6\x1c\x1c\x79\x2f\x12\x64\xea\x22\x18\xe0\x4e\xc6\xc5\xba\xe5\x3b\x2f\x9e\x3d\xa3\xaf\x78\x59\x07\xb7\x6a\xa4\x32\x73\xa4\xdd\xeb\x0a\x94\xaa\xaa\x2c\xb2\xb2\x60\xb1\xcc\x45\x54\xa8\x7c\x1b\x10\x0a\x68\xd0\xc2\xff\xe3\x9b\xd9\xf5\xcd\x6c\x7e\x31\x9c\x0c\xce\x67\xe3\xc9\x07\x2b\x63\x2c\xf7\x5b\x52\x99\xe1\x8f\xe1\xae\x32\x7b\x6a\x28\xb6\xd0\x2a\x29\x0b\x71\xc2\x32\x5e\xac\x81\x4e\x85\x0a\xa4\xc1\x2e\x56\xb7\x5a\xc1\x5d\x2e\x8b\x42\xa4\x3d\x36\x5c\x32\xce\xdc\xc8\x66\x30\x06\x87\x09\xce\x9d\x88\xcd\xed\xef\xbe\xe4\x1e\xa3\x05\x27\x2a\xa2\x51\xd7\x22\x17\x4e\x9d\xba\xe3\x9a\xe9\x82\xe7\x20\x7a\x86\xcb\xce\x11\x4b\xc4\xb2\x60\x8b\x84\xa7\x9f\xf0\x1e\x83\xeb\x24\xa0\x94\x7b\x03\x1d\xb1\x06\x59\xac\x70\x36\x97\x49\x7c\x16\xab\x48\x1b\x0a\x0e\x71\xcb\xcf\x27\x83\xfe\x6c\x30\x9f\xde\xbc\xbe\x18\x4e\xa6\x96\x84\x5a\x14\x66\x9e\x1f\x06\x53\xf3\x50\xea\x67\x07\x3b\x98\x0b\x5e\x08\xf6\xcd\xb3\x3f\xff\x89\xe9\x72\x61\x34\x02\x3b\x19\x73\x29\x1f\xcb\x94\xbd\x60\x89\xb8\x15\x89\x3e\x61\x46\x8a\xe7\xad\x3b\xec\x8e\x1e\x7d\x40\xca\x15\x4f\x0d\xe7\xc2\x8b\x62\xa9\x8b\x5c\x2e\xca\x42\xd4\xb6\xc5\xdc\xdd\x9a\xa9\x5b\x1c\x59\x8b\xf0\xfd\x3d\x36\x48\xf9\x22\x41\x51\x02\xbb\x81\x82\x22\x90\x8d\xcb\x32\x31\x54\x4f\xd9\x52\x80\xc2\xe8\x96\xc7\xd9\xba\x34\x62\x60\xa3\x4a\xd4\x30\xb5\x2a\xf3\x48\xe0\xfb\xba\xb8\x53\xe6\x28\x94\xa0\x2c\x83\xa2\x51\x9f\x14\x1d\x39\x6d\xee\x8b\x60\x8b\xe0\x78\xc0\x1d\x70\x27\xb5\x39\xc9\xe6\xde\x37\x43\x89\x1c\xd6\x6d\xae\xbf\x2c\x57\x8b\xc4\x68\x8b\xf6\x8a\x06\x15\x05\x45\xe8\xee\x2b\x63\x34\xee\x75\x3a\xb5\x5d\x74\x47\x85\x8d\xc6\xc1\x5e\xf7\x2f\x2f\xc7\x3f\xcd\x6f\x46\xc3\xf3\xf1\xc5\x00\xae\x95\x96\x0d\xef\x56\x37\x9b\x1b\xdd\x88\xa5\x2a\x3d\xed\x4f\xcf\x87\x43\x23\xdb\xbd\x6a\x55\x28\x12\xa9\x76\xd9\xe6\x9a\xd4\x86\x70\x35\xb2\xc0\x51\xa1\x77\x8c\xc6\xdd\x5d\xe3\x59\x4e\x16\x3a\xe2\x99\x39\x3f\x86\x12\xe2\x9e\x6f\xb2\x44\xb0\xf9\xfd\xe0\xeb\xf9\xfd\x77\xcf\xe7\xf7\xdf\x7d\x53\xe1\x79\x78\xea\x26\x95\x91\x8a\xcd\xee\xdc\xfc\xf1\xeb\x67\xdf\x7c\xb3\x87\x60\x6d\xa4\x08\x08\x16\x88\x97\xcb\xfe\xe8\xed\x4d\xff\xed\xe0\x21\xe9\x92\xf0\x74\x55\x9a\x0b\x44\xa6\x24\x46\x50\x25\xac\x8a\x0f\x4f\x93\xc5\xd6\x11\x59\x6a\x2f\x4e\x2a\x72\xb2\xd4\xc2\x32\xb0\x4c\xf1\x6c\x98\x41\x0a\xe5\xc6\x41\x45\x57\xa5\xba\xe0\x86\x5b\xf1\x14\xd1\x46\x64\xb9\xca\x44\xee\x26\x66\xa8\x71\x6d\x15\xbd\x5b\x34\x29\x78\x2e\x5e\xb2\xfe\x32\x97\x9f\x38\x4f\x75\x97\xf5\x73\xbe\x90\x91\xf9\xb9\x11\xa9\xe4\x69\x97\xbd\xce\xf9\x2f\x32\x81\x7f\x9e\xf3\x82\x27\xf0\x8f\xb5\x4c\x85\x16\xdd\xce\x91\xfd\xe7\xe9\x2c\xe7\xb1\xc4\xbb\xb8\xcb\xce\x73\xc5\x0b\xfc\xca\x2f\x22\x5a\x77\xd9\x05\x4f\xa5\x36\x3f\xcb\xc2\xfc\x3a\x48\x57\x89\x34\x16\xd8\x4d\x2a\x0d\x29\xa6\x05\x2f\x84\x3e\x31\xe3\x0d\x74\x26\x72\x9e\x16\xaa\xcb\xde\xf0\x5c\x4b\x76\x7c\x6d\x14\x0a\x9e\x9e\x74\xd9\x1b\x99\xe2\x30\x6f\x8c\x2e\xbd\xee\xb2\xb7\xc2\x9c\x9a\x2e\x7b\x9b\x0b\xf1\xa9\xcb\xde\x95\xe9\x8a\xe7\xe6\xbd\x86\xdd\xd3\x58\xa5\x42\xc3\x2c\x86\x05\xc7\x15\xfc\x9d\x67\x1c\x66\xee\xfe\x75\x6a\xac\x57\xfb\x0b\xbb\x33\x57\xaf\x9d\xdd\x46\x68\x6d\x74\x38\x98\xd7\xf7\x2a\x17\x66\x04\xfc\x09\xdf\xc2\x7f\xee\xfa\x0e\xbb\xe4\xc5\x2d\xbc\xf4\x52\x16\xeb\x92\xa7\x34\xaf\x2b\x63\xa5\x29\xa4\xed\x48\xe5\x77\x62\x05\xff\xa4\x55\xb2\x63\x58\xf5\x49\x97\x5d\xab\x04\xd6\x7a\xad\xf2\xa2\x5c\x95\x30\xe9\x89\xda\xe0\x38\x6c\x52\x6a\x4d\x03\x4e\x45\xbe\x80\xbf\xd1\x3f\x4e\xcf\xb7\xb9\x4c\x12\xb3\x8b\xd3\x44\xdd\xf2\x4f\xf8\x53\xa4\xa2\xcb\xa6\x19\x6d\xc4\xf4\x4e\xc4\xf0\x8f\x59\x99\x7f\x32\xff\x30\x67\xe6\x53\xce\xa5\x19\x1e\xee\xf7\x1f\xa5\x28\xe0\x24\x8b\xdd\xa7\x88\x56\xed\xef\x1b\x77\x4e\x9c\xec\xa1\x47\x6a\xe7\x69\x36\x78\x6f\x2f\xa7\xe1\x78\xf4\xd0\xa9\x42\xf1\x09\x0a\xf9\xef\x7e\xac\xfc\xa0\xad\xe7\xca\x4d\x6d\xe7\xc1\x1a\x29\x43\xf4\xcb\xd9\xa4\xcb\x26\xb3\x4b\x20\xeb\xb9\x4a\x8d\x8d\xf8\x80\x64\x52\xa9\xf0\x04\xad\x11\xca\xc8\x26\x95\x8a\x40\x9c\x83\x02\x39\xbf\x1a\x18\x4d\x7a\x7e\x31\x98\x9e\xef\x15\xe6\xa4\x1a\xb2\x45\x2e\xc5\x92\x6d\x84\xb1\x00\x0c\x3d\xbd\x96\xaa\xc9\x5d\x02\x2a\x1e\x7c\x4e\xbe\x07\x63\x68\x19\x93\xd1\x99\x3f\x78\x3b\x99\x75\x45\x09\xd7\xba\xb1\x2d\xc7\x5a\x6e\x64\xc2\x73\x33\x93\xbf\xf3\x5b\x1e\xab\xe8\xc4\x98\xaa\x74\x07\x98\xff\xc6\x52\xf3\x45\x82\xfb\xb1\x9b\x2a\x1f\x06\xd3\x5e\xa7\xd3\x5c\x2c\x71\xd9\x87\xc1\x34\xa0\xc9\x64\x70\x3d\xe8\x87\x86\xc3\x43\xe4\xc8\x72\x91\x09\xd2\x67\x81\x24\x55\x5a\x98\x7b\x8c\x13\x15\x8c\xda\xb8\x2c\x53\x64\xc7\xc0\xc8\x8f\x45\xc1\x65\x62\x34\xc5\xd0\xf6\xe8\x1c\xb1\x91\x2a\xc4\x4b\x33\xab\x85\x2a\xd6\xec\xdd\xf0\x62\x30\xbf\x19\x5d\x8c\xcf\x69\x09\x53\xa0\x5c\x73\x51\x86\xcc\xc1\x3d\x89\x66\x26\x6e\x57\x65\x97\xec\xfd\x17\x29\x73\x37\x16\x22\xd9\x32\x5d\x66\x59\x2e\xb4\xf6\xee\xaa\x5d\xb4\xac\x10\x29\x50\xac\x2d\x2d\x9d\xa7\x45\x9a\xc1\xcd\x96\x1a\xc3\xfd\xe7\x92\x6b\x79\x2a\xd3\x42\x24\x89\x5c\x19\x15\xb4\x31\x2f\xc6\x17\x0b\x63\x60\xf2\x02\x34\x18\xb0\x47\x3a\x47\xee\x70\x9b\xe3\x86\x86\x97\xb8\x87\x13\x75\xcb\x73\xa9\x4a\x0d\x8c\x05\x9e\x07\x36\x30\x0a\xa1\xd1\xfa\xd2\x15\xf2\x99\xc4\x4f\xbb\xc6\xc8\x5c\xaa\x12\x94\x43\x8e\x9e\x9f\x44\x70\xd0\xde\x60\x34\xb2\x60\x1a\x53\xea\x3a\x52\x99\x51\xb3\xcc\xa8\x0b\xb9\xf2\xd3\x30\xc3\x11\x0b\xe4\x42\x97\x49\xd1\xa5\x13\x90\xe5\x2a\x12\xda\x99\xa4\x77\x6b\x95\x08\x3b\x15\x92\x57\x34\x0f\x9e\xa6\xca\xdc\x62\x31\x39\x81\x7a\x6c\x6c\x15\xbd\x6e\xfb\xa4\x82\x11\x4e\x25\x2a\x47\x5e\xcb\xb7\xbb\x8e\x6e\x29\xf3\x7e\x2f\x57\xf0\x5b\xc7\x7f\xb0\xee\x08\x5e\x16\xca\x48\xb0\x88\x27\xc9\x96\xe5\x82\x1c\x81\x77\xd6\x8c\x84\xe7\xd4\x92\xec\x2d\x91\x16\xb2\xd8\x9e\xbc\x34\xbc\x81\x43\xc0\xd1\xed\x32\xff\x87\x3b\x19\xaf\x44\x11\xfe\xc5\x1c\x73\xb3\xe4\xae\xb5\xbd\xb5\xb9\x30\x9c\x0f\xae\x6b\xbd\x92\xba\x6b\xde\x9f\x0b\x6d\xd8\xa5\xcb\x78\x97\xd1\x6d\x52\xac\x8d\x50\xeb\xbf\x7e\x3d\x19\xfc\x38\x34\x5a\x6a\xc8\x77\xaf\x2a\xea\xe9\x4f\xfd\x0f\xd3\xf9\xc5\x60\xd6\x1f\x5e\x0e\x2e\xe6\xd3\xc1\x39\x0c\x50\x3f\xd3\x48\x09\x38\x57\x35\x3b\x25\x70\x4b\xc3\xae\x7b\x91\xee\x0f\xaa\xa6\x3b\x45\xdc\x9a\x6b\x02\xde\x9c\x03\x2d\x55\x9a\x18\xb3\xba\x45\x10\xec\xd5\x25\x9b\xf3\x6e\x2a\xdf\xc3\xd1\xe5\x70\x34\x98\x0f\x47\xef\x06\x93\xe1\x6c\x70\x01\xc7\x7e\xaf\x88\xd2\x6b\x75\x47\xb7\x9e\x4c\xd7\x22\x07\x9d\xc9\x0a\x66\x90\x4f\xb0\x85\xad\x96\x3e\x9e\x09\x5e\xd0\x23\x5c\xe3\x72\x95\x36\xdc\x65\xc7\xb8\x33\xab\x57\x79\x2c\x53\x9e\x6f\xe9\x49\xfa\xac\x67\x6e\x2c\x5d\xe4\xa5\xb1\x61\x74\xd7\x50\xc4\xfe\x02\xfb\xc2\xb5\x96\xab\xd4\xbc\x0e\x8c\x2c\x43\x69\x85\xb3\x02\xa6\xe7\x9a\xf8\x4b\x90\xb8\x4a\x55\x01\xe7\x70\xad\xee\xf6\x91\xb4\x9d\x58\x75\x92\xbe\xb9\xb9\xbc\x9c\x5f\xf7\x67\xef\x0e\xb4\x65\x42\x79\xbf\x2c\xcd\x1f\xd0\xfc\x27\x79\x8e\x06\x1c\x1e\xad\xe0\x8a\x03\x47\x29\xf9\xf2\xcc\x1f\xd7\x82\x1b\x93\xb6\xc5\xae\xa1\x83\x0b\x8e\x1e\xa1\x0b\x74\x08\xc0\x0e\x6c\xf8\x27\x72\x57\xc3\x90\xf0\x8e\x32\x95\x3f\x97\xa2\x62\xc9\xec\x91\xdb\xf5\xf5\x36\xe5\xb6\x60\xd3\xd9\x64\x78\x3d\x7f\x33\x19\x5f\xc1\x93\x6d\x5e\x41\x10\x84\x8c\x9b\xdf\xf3\xd3\x58\x2c\x65\x0a\x2e\xcb\xdc\x09\x51\x33\x6f\xb3\x41\x53\x90\x98\x20\x8a\xe9\x78\x18\xbd\xda\x70\x91\xf9\x41\x0f\x5b\x49\x10\x93\xd8\xd6\x6c\xc3\x8b\x68\x2d\xac\x8c\x5e\x16\xa7\x6b\xb4\xe9\x1b\xaf\x80\x09\xb7\x4d\xd0\x30\x7d\xc5\xad\xa2\x1b\x3b\xd2\x43\x36\xa8\x39\x47\xbc\xc5\x0d\x42\x1e\x95\xc6\x40\x27\xcc\xcb\xb4\x2e\xbd\x71\x93\x94\xd9\x37\xb3\xdc\x9e\xbb\xbd\x71\xe7\xb6\xaa\xac\xfa\xdd\xc8\x77\x44\xd3\x32\xc2\xa3\xcb\x16\x65\x81\xf1\x81\xea\xa4\xbb\x38\x01\xeb\xce\x08\x9d\x40\xee\x0e\x0a\x7c\x04\x15\x3f\x90\xf4\x6e\xa0\xf0\x46\xce\xc5\xcf\xa5\xcc\x6d\x40\xa8\x20\xfa\xd5\x19\xa3\x72\x08\x7a\x9d\x4e\x9d\x29\x1c\xe3\xfc\xf3\x9f\xac\x37\xcd\x23\xf6\xaf\x7f\xb5\xf0\xcf\x70\x74\xfe\xab\x79\x08\xb6\xbb\x30\x46\x51\x6a\x44\x91\xd7\x1a\x1b\x12\x8a\xdb\x4b\x88\xfc\x6f\x22\x49\x34\x5d\xc7\x70\xce\x2c\x09\x83\x63\x67\x26\x60\x55\x59\x99\x1a\xe9\x25\x40\xcd\x34\xba\x3d\x0d\x57\xbb\x53\x91\x7b\x9b\x37\x62\x38\x28\xdd\x63\xf6\xc2\x47\x51\x08\x4b\x93\xe1\x9d\x1d\xdc\xee\x70\x0c\xd0\xf5\xe9\xae\xc4\x6d\x25\xb8\x62\x67\x89\xcc\xe2\x14\xe9\xd4\xd8\x1d\xe6\xc6\xce\x8c\x78\x8c\xad\x4b\xd0\x28\x73\x32\x01\x95\xbc\x74\x8a\xc7\xe9\x90\x2d\x13\xbe\xaa\xee\xa2\xdb\x9a\xda\x15\x3a\x7d\x37\x9e\xcc\x0e\x94\x86\xee\x72\xdc\x94\xd1\x9a\x84\x56\xce\x8e\x17\x65\x01\x4e\x47\xad\x61\x07\x8c\x82\x7e\xe2\xa5\x96\xa6\x78\x48\xd5\x99\x26\x21\x18\x93\x87\x0e\x2b\xcd\x62\x25\x74\xfa\xa5\x19\xcc\x68\xa6\x2a\x2f\x58\xa2\xd2\x15\xb9\x88\x12\xf9\xc9\x90\x8f\x5d\x8c\xa7\x5d\x63\x17\x77\x8d\x82\x7d\x7e\x71\x3a\x19\x5f\xed\xb9\x1a\xc2\x25\x56\x94\xd7\xca\xd5\xf0\xf7\xfe\x8f\x7d\xa3\x6d\xf7\x6f\x66\xe3\x1d\xc6\x40\x8b\x67\xd3\x28\xb7\x79\x96\x8b\xc2\xaa\x60\x10\xc2\x83\xd8\xc2\x71\x99\x16\x32\x09\x02\x7b\xb1\x2a\x4e\x90\x7f\xc9\xba\x39\xd5\xc5\x16\xd8\x88\xc2\xc8\x7a\x97\x5d\x51\x77\x86\xc1\x7c\x2b\x63\x90\xb8\x58\xf3\x5b\xc1\xfe\x51\xc2\x14\x3e\x99\x03\xb1\x2a\x8d\x41\xf5\x43\x71\x0a\xd2\x2a\x78\x9b\x66\xc7\xc5\xba\xd4\x24\x1e\xc0\x37\x99\x32\x71\x9f\x25\x32\x92\x05\xfb\x1b\x2a\xa0\xe6\x59\x73\x8f\x61\x60\xa4\x6d\x62\x27\x0f\x93\xbe\x49\xd4\x56\x1b\xcc\x3e\xf6\xba\x3f\x1a\x51\x5c\xea\x70\xc2\x73\x20\x37\x30\x8d\xb1\xf7\x8d\xf9\x70\xf6\x55\xf5\x7f\x68\x01\x2c\x84\x3d\x21\x0b\xb1\x92\x29\x9c\xdb\x07\xb6\xe3\x8b\x05\x4f\x53\x91\x7f\xd1\x42\xfc\xce\xd1\xa3\xc8\xef\x48\x0e\x3a\x41\x11\xea\x36\x18\x4a\xc0\x95\x54\xfc\x11\x7b\x78\xba\x46\xb1\x1d\x3c\xfd\xc3\xec\x73\xd8\x19\xd9\xd6\x1c\xed\x3d\xec\xfc\x43\xf1\x00\x27\xd7\xf9\xd8\x6c\x54\x95\x93\xdd\xd7\xf7\x32\x71\x1b\x07\x77\x8e\x76\xf0\xf0\xc7\x9d\x3c\xfc\x18\x0e\xae\x90\xb0\x29\x3c\xcc\x37\xaf\x6e\x2e\x67\x43\xd0\x3e\xcf\xaf\xaf\xe7\xc3\x69\xe0\x4b\x20\xa1\x17\xd2\x5c\x81\x6a\xe7\xc8\x5e\xe4\xc2\x88\x78\xa3\x5f\x97\x49\x21\x4f\x81\xd6\xe7\x7f\xfc\x23\x5e\x0e\x3c\x71\x34\x5d\x24\x2a\xfa\xc4\x8e\x65\x4f\xf4\xcc\x3a\xe0\x37\xb5\x64\x67\x67\xff\xc3\x08\xc2\xb3\xb3\x33\x47\x97\x13\x3c\x04\x6d\x8b\x45\x51\x6c\xaf\xe4\x85\xf5\x48\xe0\xca\x81\xf2\x52\xe5\xa8\x65\xa5\xe2\x2e\xc8\x6d\x30\xb7\x9f\xb2\x93\xad\x4f\xb5\x3a\x45\xae\x43\xe3\xa9\xf2\x76\xf0\xe5\xa0\x2b\xcd\x50\x87\x48\x22\x97\xa0\x33\x65\xb9\x58\xda\xc0\x0f\x04\x96\x71\x36\x4c\xa6\xba\x10\x3c\xae\xab\x59\x94\x7f\xe4\x12\x24\xec\x68\xa0\x55\x6d\x04\x4f\xe9\xf6\xc4\xf4\x0d\x9e\xb0\x5c\xe9\x80\x77\x38\xc4\x65\xcc\x21\xcc\x45\xa4\x56\xa9\xfc\xc5\x28\x78\xe9\x96\x6d\x54\xfe\x80\x13\x13\x78\x62\xc7\x86\x37\xcc\x37\x30\x45\xe6\x17\xe3\xf3\x9d\x51\x32\x9e\xb2\x32\xb5\x0a\x8e\xb3\xd3\xac\xe1\xa6\x49\xd6\x54\x35\x20\x50\x04\xcd\x4c\x9b\xdf\x43\x07\x8a\x59\xd2\xa9\x77\xc4\xec\xf3\xed\x54\xe6\xd9\xe2\xdb\xb1\x8a\xc2\xe0\xba\x3f\x31\xc6\x38\x39\x9f\xae\xfb\x6f\xdb\x54\x86\x16\xa1\x92\xe5\x2a\x2e\x23\xa3\x68\xa5\xe2\x0e\xd4\xbc\x95\xc0\x38\x0d\x8f\xd6\x34\xf5\xb6\x1b\xae\x45\xf1\xa3\x75\x5a\xe5\xd8\xa8\x8f\xc6\x92\x5c\x3a\x4d\xff\x0c\x94\xb0\x33\x50\x19\x32\x1e\x89\x4a\x1a\x94\x66\xf2\x21\x5f\x2a\xe8\x0a\xad\xab\xac\x1c\xf7\x59\xff\xf5\x7c\x3a\xfc\xcf\x41\xab\x92\x4b\x92\x13\x53\x25\x20\x1e\x88\x99\x57\x90\xd5\x51\xf0\x85\xf3\x2d\xa3\x93\x4b\x23\xfb\xe2\x24\x0a\x65\xfd\x31\xe6\x49\x6d\x2e\x03\xff\xed\x48\xc5\xc6\x06\xe0\x2b\xb7\xa7\x57\x32\x85\x78\x3a\x7c\xf9\x25\x7b\xde\x75\x11\x76\xfb\x97\x3f\x75\xab\xcb\x7c\xc9\xbe\xe9\x75\x3a\x6e\xfe\xd5\xff\xbd\x62\xdf\x54\x3c\x79\xf5\x95\xb9\x2c\x02\xbf\x36\x9e\x48\xae\xad\x65\xc1\x23\x90\xfb\x24\x6e\x35\x26\xde\xb5\xe4\x12\xf4\x53\xfc\x1e\x5b\xd3\x2d\xb1\x54\xf9\xe6\xa5\x39\x8b\x7c\x23\x5e\xc1\x3c\x29\x1f\xce\x86\xf1\x78\x1c\xcb\x74\xd5\x39\x62\x5f\x68\x19\x0b\xb1\x5c\x8a\xa8\x78\xf5\xb7\x8c\xe7\x6c\x2a\x63\xc1\x06\xf0\x07\xfd\xf2\x63\xfa\x85\xb5\x9b\x30\x04\x69\x84\x4a\xa1\x58\x46\xa9\x17\xf6\x22\xf8\xe8\x47\x81\x74\xa2\xbf\xf9\xdf\x4f\x7c\xa2\x4e\x65\xd6\xd6\xd4\x80\xc1\xd1\xe9\x87\x3b\x5a\x37\x66\xf8\x2a\xe7\xd9\x1a\xbd\x69\x6b\x74\x36\x9a\x79\x57\xe6\xf9\x45\x8f\x7d\x20\x13\xd1\x4c\xed\x63\xfa\xa5\xb3\x55\x91\x0f\xac\x51\xc4\x2d\xa5\xc0\x78\xd1\x02\x78\x3d\x15\x77\x46\xee\x62\xd0\xdc\xfb\x20\x41\x97\x81\xb8\xc3\x49\x75\xf8\xff\xfa\xaf\x5d\x83\x9b\x2b\xa2\x3a\x3c\x9e\x50\x10\xeb\xe8\xfa\xe1\x2c\x5b\x6f\xb5\x8c\x78\xe2\x3e\xb8\xe3\x6e\xb6\x2a\x97\x2b\x69\x24\xab\x39\x78\x86\x23\x7f\x32\x47\xdf\x50\x3d\x15\x90\x1f\x99\xc8\x42\xe4\x3c\x61\xff\x34\x37\xd4\xbf\xcc\x7f\xba\x7b\x56\x6a\xbe\x0c\xd7\x3f\x98\xd7\x18\xd4\x35\xcf\x6f\xcc\x61\x40\xa1\x0e\x72\x60\xc1\xa3\x4f\x3a\x81\xbc\xcc\x8f\x27\x5d\x3c\x44\x66\xc5\x89\xe0\xc0\xad\x91\x4a\x97\x89\x8c\x0a\xed\x1c\x9b\x90\x62\x44\xac\xf9\xf1\x9f\xa0\x89\x7d\xfc\x97\x8d\x9b\x6b\x41\x19\xa1\x3c\xbe\x95\xc4\xf0\x18\xf6\x71\x29\x4a\xec\x6f\xf8\xa5\xbf\xc1\x3a\x4a\xf0\x88\xb9\xa4\x54\x9a\xe8\xf1\x47\x3b\xf2\xc7\x7f\x9d\x74\x3a\xfd\xcb\x61\x7f\x5a\xb5\x39\x9c\xf5\x35\x25\x41\x31\xbe\x9e\x0d\xaf\x86\xff\x39\x98\x53\x08\xe7\xcd\x78\x32\x3f\x6f\x5e\x8e\xb9\xcb\x4a\x82\x04\x54\x5d\x00\x1d\xce\x29\xdb\x00\x52\x16\xd2\x64\x5b\x0b\x5c\x81\x24\x76\x36\x1b\x45\xa5\x6c\xce\xcf\x06\xe2\x11\x5c\x26\x2a\xa7\x78\xf8\x79\xcf\x9c\x3a\x50\x48\x75\xc1\xd3\x48\x74\x31\x47\x8b\xa4\x2b\x5a\x61\xce\x20\x05\xb9\x60\xe5\x70\x2c\x97\x4b\x91\x8b\xb4\x40\xd5\xc1\xda\xb2\xe8\x85\x74\x7e\x43\x7a\x58\x6d\x64\x51\x88\xb8\xcb\x44\x11\xed\x91\xc6\xed\xd4\x71\xd2\x78\x17\x11\x8d\x72\x7c\x28\x0d\x8d\x1e\xcf\x60\xd9\xd7\xdb\x62\xad\x52\x4b\xd2\xcf\x23\x28\x25\x93\xc1\x67\x2e\xa2\x0e\x42\xcd\x13\xd7\xdd\x51\x9e\x34\xe4\x0c\x47\x57\x53\xc6\xa3\x4f\x7c\x85\xce\xf3\x9f\x4b\x9e\x90\xcb\x2c\x52\x99\xfd\x46\xa2\xd4\x27\x4f\xfb\x5f\x43\x50\xa0\x54\x70\xd7\x37\x28\x6a\x28\xfe\x66\x3c\x99\x4d\xfa\xa3\x43\x29\xfa\x46\xe5\x45\x0e\xe2\x85\x48\xf9\x18\x2a\x56\x38\x92\x46\x3a\x74\x4d\xe1\x5c\xf7\x33\xc9\x8f\xef\x2e\x2e\x0f\x5d\x92\x79\xf6\x09\xd6\x63\x86\x79\xe4\x06\xc1\x2c\xf7\x2f\x66\x7a\x39\x3c\x1f\x1c\xba\x9a\x69\x22\x23\xe1\x97\xf3\x04\xa2\xc3\x7c\x62\xec\xc4\x5f\xc9\xe9\x1b\x15\x97\x90\x2e\x55\x6c\x33\xff\x0c\x18\x8a\xe6\x4e\xc5\x08\xb2\x31\x9c\xcc\x8b\x57\xb9\x2a\x33\xfd\x6b\x98\x1d\x89\xe4\x68\x69\x17\xac\x45\x62\xee\x65\x72\xed\xe6\xda\x7b\x06\x63\x70\xfe\xc3\xd5\x8a\xb7\x96\xb8\x2f\x44\xaa\x5d\xa0\xc4\x06\x00\x64\x81\xbe\x6a\x6d\x78\x83\x12\x32\x49\x8b\xb2\xce\x60\x8c\x7c\x90\x1a\x51\x7d\x0b\x1a\xa6\x2b\x79\x0b\x4a\xa1\x7b\x85\xdf\x91\x35\x58\x54\x90\xd8\x7d\x2a\x53\xb6\xe1\xe0\x61\x47\x1f\xb2\x1d\x5f\xdd\x8a\x3c\x37\x5a\x06\xe8\x4d\x85\x00\x47\x43\xe0\x15\xb4\x49\xe4\x95\x9a\x04\xf3\xe4\x2b\xbb\x69\x94\xac\xc6\x20\xd0\x6a\x5e\x08\xae\x39\x37\x9d\x2e\xa5\xd9\xf9\xc4\x25\x1d\xfa\xf4\x71\x49\xda\x3a\xee\x2a\xae\x8c\x97\x6c\x78\x71\xd9\x05\x49\x8b\xff\x9d\x82\x45\x08\x09\x41\x7a\xcd\xf3\x8c\x1d\x9f\x1f\x9d\x74\xd9\x79\xd7\x58\x93\x5d\x76\xd1\x65\xd7\xef\xae\xbb\x6c\x13\xb3\xe3\x2b\x9e\x7f\x8a\xd5\x5d\x7a\xd2\x65\xe3\x85\xe1\x64\x79\x2b\x4e\xcf\xbb\x24\xac\xbb\xc8\xcb\x5d\x38\x59\x5d\x54\x1b\x8d\xc4\x60\xc7\x4b\x79\x8f\xbc\x69\x56\x4a\x7f\x7d\x69\xff\xf1\xc6\x7c\xd8\x65\xcb\x5c\x58\x62\x98\x09\xdb\xa7\xfc\x30\x6f\x72\x21\xba\xac\x4c\x3f\xa5\xea\x2e\x6d\x79\xd2\x09\x28\x36\x4c\x29\xab\xab\x10\x39\x8b\x38\xa9\x0e\x48\x14\xa3\x06\x43\x9a\x63\xa1\xd8\xaa\x14\x5a\x1b\x42\x43\x6e\x37\x6a\xa5\x31\xd0\x12\x27\xac\xf2\xfa\xac\xcc\xe7\xa4\xe0\x48\x67\x0f\x12\xbf\x07\x32\x12\x8e\x0e\xb2\xe3\x49\xf5\xf8\xed\xf0\x38\xf4\x64\x1a\xe1\xf3\xe8\x1c\xb3\xe3\x20\x47\x1f\x07\x55\x0d\xd7\xef\xae\x4f\x60\xf7\x59\x6f\x49\x1f\x73\xcd\xce\x2b\xcf\xd0\xb7\x21\xf9\xa9\xd4\xe2\x25\x93\x69\xf4\xca\x0d\xf9\xea\xbc\x17\x64\x38\x98\xc9\xe1\x30\x46\x37\x53\x65\x11\x1c\x2b\xcb\xce\xe6\x5c\xa4\x6a\xee\x3f\x80\x23\x00\xb6\xd1\x5a\x25\xb1\xc8\xeb\xde\x00\x43\x8a\xa8\xd4\x85\xda\xf8\xd1\x28\xdf\x3f\xd1\x0a\x15\x52\xb2\xcf\xa0\x0c\xe1\xba\x3f\x9b\x0d\x26\xa3\xa9\xcf\xb0\x24\x6b\x85\x16\x08\x2e\xf7\x02\xfc\xd9\x15\xa7\x5c\x67\xf0\x7e\x36\x18\x4d\x87\xe3\xd1\xfc\xaa\x7f\x7d\xed\x0b\x19\x02\x4b\xf9\xaa\x3f\xf9\xfe\x62\xfc\xd3\x68\x3e\xbd\xb9\xbe\x1e\x4f\x66\xd6\x48\x16\x29\x5f\x24\x22\xae\x5a\xc8\x59\x2e\x4e\x29\x71\xc0\xbc\x37\x71\x0e\x1f\xd8\x97\x28\x82\x40\xeb\xca\xfa\xfb\xed\x71\x20\x06\xe9\xfa\x24\x27\x75\x87\x59\xa0\x20\x23\xad\x1b\xbe\x6e\xc7\x40\xe1\x87\x2b\xfb\x88\x79\x2e\xd3\xd5\x52\xe6\x62\xc1\x93\xa4\x97\x8a\xe2\x8c\xae\x0b\x7d\xb6\xa1\x17\x61\xf5\x07\x7a\x73\x9c\x27\x81\xee\x03\xb5\x64\xf6\xb1\x30\xf7\xc1\x30\x73\x99\x63\xf1\x02\x2d\x2c\xa4\xa1\xd1\x2a\xed\x3e\x1b\xed\x50\xde\xfb\x4f\xde\xcd\xae\x2e\x91\xd5\xde\x5f\x5d\x7a\x7d\x1d\x74\xf8\xda\xd2\x0b\xa8\x63\xb9\xa0\x7c\x20\x08\xd6\x80\xc5\x09\x87\x4f\x2d\xc1\x42\xb8\xe3\x79\x0c\x21\x12\x5e\xc8\x85\x4c\x64\x61\x4e\xa1\xd4\xba\x7c\xa8\xa0\x02\x9d\x22\x8d\x3d\xac\x39\x45\xc0\xd4\x81\x6a\xb2\xf1\xf9\x7c\x38\x3a\xbf\xbc\xb9\x18\xcc\xdf\x0d\xfa\x17\xc3\xd1\xdb\xba\x57\x84\x43\xf2\xea\x2f\x22\x57\x8c\x2a\xb5\xcc\x36\x93\x79\xa8\x59\x99\x91\x73\x0d\x94\x45\x71\x2b\x12\x60\xbf\x6a\xa2\x86\x4d\xd1\xb7\xf6\x53\x81\xcb\x5e\x82\x6b\x03\xf3\x27\x28\x3d\x01\xf9\xd8\x90\x1b\x38\x18\xac\x29\x9e\x32\x19\x33\x5e\x50\x6e\x74\xcf\x9d\x45\x2c\xee\x13\xbc\x28\x73\x97\x27\x9e\x6c\x19\xcf\xb2\x44\x92\x66\x60\xa6\xe6\x68\x6f\x27\xdd\xe2\x7f\x78\xd6\xf0\x3f\xfc\xf9\xcf\x0d\xff\xc3\xb7\x07\x84\x26\x1b\xa4\xaf\xc7\x26\x5b\x49\x6e\xf6\xe6\x99\xdb\x19\x7b\xd4\xbc\xcc\x23\x01\x9c\xc8\xf4\x13\x95\x0c\x91\x67\x28\xcf\x85\xce\x54\x0a\xe2\xc1\x7b\xd4\x0c\x27\x61\x26\x02\xc4\x99\x02\x0d\x06\x4f\xa2\xcc\x83\xaf\x62\x42\x78\xf5\x9c\x41\x2c\x02\x5f\x87\x8c\x8e\x4a\xcf\x2d\x2a\x3d\x32\x65\x32\x8d\xe5\xad\x8c\x4b\x9e\x00\xcf\x82\xbb\xc7\xa5\x89\xb3\xff\x1f\x03\x7d\x41\xa6\xd5\xda\x45\xa8\x81\x02\x6b\x65\x95\xa8\x05\x70\xc6\x62\xeb\x5c\xa1\xfd\x9b\xd9\xf8\x72\x38\xfa\xde\x0b\x1e\x05\x9a\xd0\xc3\xac\xde\xf8\x56\xd3\xff\x67\x8e\xab\x11\xc9\xd3\xd9\xa5\x4b\xd0\x40\x4f\xb4\x2e\xe2\x97\x2f\x31\x8c\xdf\xc5\x5f\x6e\x21\x38\x8d\xfa\xd9\x09\xe8\x28\xc4\x89\x77\x1c\xd2\x3e\x82\x48\xec\x31\x87\x0d\x07\x45\x63\xa9\xf2\x13\xf4\x33\xce\x2e\x9d\x66\x0a\xbe\x86\xac\x2c\xba\x28\x33\xcd\x34\xa8\x6e\x44\xb7\x7b\x92\x83\xd0\x6e\x22\x0a\xb7\xfd\x90\x5f\xe0\xb2\xf1\x34\x8b\x45\x94\x70\x74\x0c\x6b\xd2\x6b\x7c\xd8\xd6\xdc\xcf\x4a\x0b\xc6\xf3\x55\x89\xbe\x62\x5b\x45\x59\x59\xbe\xe8\xad\x7a\x30\xe4\x71\x40\x83\x93\xff\xe8\x1c\x81\xc7\xa0\xd4\xcd\xcf\xd8\x3f\xff\x75\x42\x1e\x78\x74\x52\x9b\x5b\xd9\x2c\x99\xdc\xbd\x70\x5b\x43\x7a\xa4\x4a\x12\xbe\x50\xae\xf0\x30\x96\x7c\x95\xf3\x0d\xb1\xac\x4c\x6f\x55\x72\x5b\xdd\x0c\x90\xfa\x36\xb3\x0f\x53\x6d\xa2\xa8\x34\xaa\xf3\x1e\x1d\xf9\xf5\xcd\xf0\x72\x36\x1c\xcd\xa7\xb3\xcb\x70\xff\x03\x57\xb6\xdd\xfb\x2b\x19\xe5\x4a\xab\x65\xf1\xa5\x36\x6a\xda\xd9\xf9\xe5\x90\x79\xdd\xb1\x65\x6b\x6c\x15\x85\x0b\x7c\x40\x99\x0b\xc8\x2d\xa3\x18\x19\x86\x25\x6d\x71\x5f\xa9\xc2\xf5\xf5\xfc\xfc\x72\x58\xe3\xcf\x86\x4d\x34\x1d\x5e\x57\x6e\xdc\x03\x2c\x21\x2d\x33\x76\xac\x85\x78\x59\x2b\x86\xcc\xe5\xad\xc8\x17\x3c\xfd\x64\x48\x5a\xc2\x55\x13\xa9\x8d\xaf\x8d\xd4\x32\x3b\x93\x69\x91\xab\x93\x76\x23\xca\xfa\x21\x41\x01\x44\xff\x15\x84\xb2\x30\x8a\x0f\x31\x13\x73\x30\xd0\x57\xa9\x75\xb9\xa1\xcc\x7c\xda\x4d\x43\xee\xac\x5c\x24\x32\xb2\xc1\x0f\x74\x72\x67\xb9\xbc\x35\xf6\x58\xc8\x2f\x50\x95\x92\x2a\x1f\xf6\xca\x72\x55\x50\xc2\xda\x27\xb1\x05\x81\x81\xa5\xce\xc6\xde\xda\xe7\xfd\x0e\x48\xd8\x12\xec\x32\x9a\x5b\xc8\x06\x43\x63\x41\x83\xd1\x60\x2e\xac\x2c\x57\xd9\x4a\x60\xe2\x93\xf9\xb7\x51\x11\xdc\x95\x43\x5e\xc6\x58\x46\xbc\x30\x6a\xc9\x4a\x14\x50\x68\x98\x02\xbf\x98\x7f\x6e\x44\xb1\x56\xb1\xf6\x45\x78\x99\xc8\x8b\x2d\xc4\x8c\x7c\x9c\xa7\xca\x52\x40\x3f\x73\x8a\x82\x2c\xbe\xd0\x89\x0e\x15\x40\x85\x7d\x89\x7b\xc3\x62\x1b\xbc\xa0\xbd\xe2\xcd\xde\x52\xf0\x06\xb8\x05\xef\x54\xfe\x89\x92\xff\xdc\x40\x66\xd5\x32\x8d\x8d\x6e\xb9\xa2\x59\xaa\xdc\x49\x63\xce\x34\x04\x61\x8c\x88\xda\x66\xa2\x87\xea\x21\xea\xf1\x46\x1e\x82\xf6\xcf\xb5\x80\x0b\xc6\x9c\x1f\x23\x20\x5d\x32\x53\xe5\x3d\xe9\xf6\x8e\x6f\xe1\x90\x61\xb2\xd8\x8e\x63\xb6\x5f\xd6\x0f\x2f\x2e\xe7\xd7\x93\xf1\xf5\x60\x32\xfb\x10\xec\x74\x28\xeb\x29\xc4\x02\x66\x36\xa9\x73\x41\x09\x6b\x2d\x28\x63\x13\x61\x2f\x86\xd3\xd9\x64\xf8\xfa\x66\x36\x98\xbf\x9d\x8c\x6f\xae\xe7\x17\xe3\x73\x12\xcd\xfb\xa2\x43\xb9\xb0\x9e\xd6\x96\x54\x44\x1f\x83\x76\x91\x31\xdc\x56\x33\x3b\x76\x2c\x97\x86\x36\xbe\xc6\x19\x4b\x66\x83\x64\x47\xf7\x6c\x8f\xbd\xde\x5a\xaa\x80\xe3\x3b\xa9\xe6\x44\xe2\x80\x9b\x52\x43\xc2\x61\x10\x59\xb3\xe7\x2a\xd9\xee\x39\x3b\x6d\x24\xa8\x4a\x52\x63\x2e\xf3\x38\x86\x92\x66\x48\x8b\x34\x1b\x8f\x49\x43\xa0\x25\xe2\x1c\x90\xa4\x7e\x5f\xbd\xe1\x40\xb7\xa0\xb9\x3b\x20\x07\x79\xcb\x52\x01\xe9\xf5\x38\x86\x61\x3d\x1c\x16\x1c\xd9\xb1\xcf\x12\x82\xd2\xb2\x06\x19\xc2\xb7\x74\x8e\xcc\x97\x28\xbb\x3e\x86\x39\x98\xd9\xae\x31\x3a\xc0\xe3\xd8\xbd\x4a\x6d\x32\x55\x1a\xa5\xdc\x13\x86\xdd\x4a\xce\x3e\xca\x14\xdf\xf0\x30\x95\x90\x34\xa3\xc1\x74\x36\xb8\x98\x9f\x8f\xaf\xae\xc7\x37\xa3\x8b\x69\x53\x96\xdf\xbc\x86\x27\x8d\x7d\x15\xc8\x72\x33\x17\x08\xed\x84\x59\xa7\xe4\x11\x72\xd9\x85\x66\xb1\xe6\xc0\x75\x8e\xd8\xf1\x32\x34\x84\x2d\x85\x8d\x24\x45\xe9\xea\x34\x82\x13\x0a\x87\x83\xcc\x82\x0d\x2a\x17\xee\x61\xf2\x6e\x81\x85\x8d\xd7\xbe\x2f\x51\xbc\xc6\x81\xde\x38\xd5\x82\x92\x85\xa9\x70\x41\x06\xb5\x0b\xa4\xfd\x41\xa2\xca\xc2\x1e\xaf\x1e\xeb\x27\x85\xc8\x53\xc8\xf1\x4b\xb6\x41\x28\x03\xd8\x30\x15\x2c\x13\x96\x49\x5c\x86\x57\xe7\x88\x7d\x4c\x55\x30\x8a\x35\x95\xf6\x1d\xff\x90\xac\x6d\xa1\x5e\x67\xd5\x50\x36\x2d\x3c\x6c\x36\xea\xb2\x3f\x9d\xb6\x27\x88\x39\x2d\x19\x79\x0f\x73\x5c\xca\x14\xf1\x1c\xb0\x38\x61\x6d\x2c\x07\x09\xb8\x19\xc1\xe1\x75\x15\x3a\x60\xad\xa0\x28\x25\x1b\x87\x88\x0c\xcb\xb5\x7c\x75\x42\x81\x09\x63\x90\x2b\x73\x96\x00\x06\x00\xdd\x83\x18\x51\x86\xcd\x36\x16\x24\x4c\xe1\x8a\xa7\x58\x54\x7f\x82\x32\x19\x6f\x44\x78\xe6\x92\xcf\xc4\x7b\xca\xe2\x9f\xcc\xde\x9c\xd4\x7d\x09\x45\x68\x14\xc5\x4a\xa0\xb8\xc6\x0b\x20\x35\xa4\x5e\xc8\xd4\x96\xf7\x42\x36\x70\x6b\xd0\xf8\xb0\x7c\xe5\x3a\x85\xed\x41\xa8\xef\xc4\x74\x78\x75\x7d\x39\x98\x4f\x67\x93\x9b\xf3\x59\xeb\x46\x10\xfd\x83\x1d\xa9\xec\x04\xd8\xd0\x70\x93\x11\xef\xc7\xbc\xe0\x6c\x29\x45\x12\xa3\xe8\x80\xbb\x0a\x98\x3c\x16\x4b\xfb\x81\xab\x84\xa0\x3d\x84\x10\x60\x7b\x64\xd7\x9d\xbf\x48\x65\xa2\x65\x77\x6d\x94\x14\xed\x06\xa8\x11\xa0\xa0\x2f\x18\x56\x70\x0b\x22\x67\x54\x86\x3d\x71\x55\x04\x24\x16\x69\x7c\x1d\x08\x69\x9b\x3c\x80\xd6\xc5\x68\xfc\x30\x31\x02\xa6\xdc\xc7\x45\x50\x81\xf7\x30\x1f\x85\x5c\x74\xc0\x7e\xd7\xf6\xb1\xba\xdf\xb3\x0f\xd7\x83\x8b\xc1\x9b\xf9\xbb\xe1\xc5\x60\x4a\xcf\xd4\x9c\x47\x5d\xc6\xdd\x1e\xc1\xc5\x85\x2b\xed\xe2\xda\xba\x68\x1b\x8a\xb4\xdc\x54\x29\xc4\xa0\x46\xbc\xf6\x24\x3e\x57\xaf\xfc\x40\x3b\x1c\x5f\xd1\x63\x53\x45\x92\xcf\xbc\x90\xae\x97\xd9\x36\x13\x53\xf6\xcf\x7f\xc1\x3f\x66\x54\x2e\x53\xad\xea\xad\x69\x09\xfe\xca\xb3\x9c\x08\x2f\x83\xef\xf7\x70\xed\xee\xee\x09\x5e\x5f\x19\x19\x06\xb1\xa5\x55\x4b\xf2\x4f\x37\x59\x88\x72\x79\xfb\xa4\x98\xd0\x94\x2d\x1f\x9b\x67\x63\x5c\x40\x90\x96\x5a\x6c\x33\x72\xb3\x40\xd4\x81\x52\x54\x21\x26\x4a\xfe\xd9\xd4\x3b\x75\x09\xca\x25\x52\xe9\x2d\x66\x2a\xb3\x58\x46\x50\x92\x4a\xc1\x51\x74\xe1\x65\x54\x79\x84\x21\x0d\xc3\x72\xb4\xa8\x2f\xdd\xfd\x4a\xf9\xc5\x76\xb1\x52\x33\xc8\x80\x12\x69\x64\xf6\x39\x15\x54\xac\x0e\x1c\x60\x26\xbe\x87\xc3\x5a\xb9\xa7\x9a\xba\xa2\xe5\x2f\x3e\x0b\x7f\xbb\x59\x28\x8c\x21\x96\x19\x8b\x78\xb4\x16\x61\xba\x1a\x4a\xdf\xcb\xf1\xf8\xfb\x9b\xeb\xf9\x79\xff\xfc\xdd\x00\x92\x46\x3c\xfa\x07\x7c\x21\x48\x26\xcb\x85\x06\xb3\x14\xc7\xd5\x18\xd0\x20\x57\x09\xec\x36\xa8\xe1\xe6\xf0\xf6\xd8\x54\x82\x63\xda\x5f\x75\x20\x8d\x8d\x4a\x21\x52\x0d\xc9\xf0\xe8\x38\x44\x42\x2d\x0b\x11\x94\xc8\xd3\xbc\x2d\x30\x07\xa4\xa2\x81\xe4\x92\x1b\x57\x4c\x0f\xc1\xfe\x58\xf8\xf4\xe5\x4f\x42\x64\x86\x7f\x70\xd6\x60\x4a\x89\x53\x9a\x71\x6c\xa7\xdc\xb3\x0e\x5c\xb7\xb6\x42\x29\xa6\x37\xb6\x84\x34\x50\x5a\x17\x22\x52\x66\x2e\x89\xba\xa3\xdc\xa5\xc6\xf7\x12\x9e\x1b\xc3\x78\x23\x36\x2a\xdf\x42\x75\x29\xd7\x84\x0e\x23\x1c\xfd\x60\x43\xa4\x25\x16\xc0\x67\x49\x30\x81\x36\x65\xc2\x5f\xb2\x17\xff\x75\xfc\xfc\x4f\x7f\x6c\x6c\xc2\x09\xc6\x71\x6e\x79\x22\x63\x96\xf3\x74\x25\x50\x7f\x7b\xd6\xeb\xfd\xb9\x5b\x49\xe9\x33\x7f\xec\xd6\x3c\x55\xa0\x6b\x06\xaf\x57\x4b\xf6\xe2\xbf\x9e\xff\xe9\xd5\x9f\xbe\xfd\xf6\xeb\x3f\x19\xed\xc4\x52\xa3\x8f\xba\x98\x80\x3d\x60\x1c\x4a\x21\x6a\xaa\x3b\x24\x69\xfb\xa5\x97\xda\x61\xa2\x94\xab\x95\x00\xc5\x1d\xf4\xf2\xac\x90\x1b\x70\x74\xb9\xb7\x62\x16\x1b\xd3\x99\xb1\x9e\x32\x25\xd1\xc7\x75\x2b\xc5\xdd\x61\xbe\xc5\x86\x6b\xf1\x59\xaf\xd3\x69\x50\xca\xea\x37\xcf\x9e\x1c\xd1\xea\x75\x29\x93\xf8\x77\xc6\xb3\x22\x36\x1b\xbc\x9f\x4d\xfa\xe7\xb3\x79\xff\xf2\x72\x3f\x18\x83\xf7\x2e\x40\x2d\x1f\x78\xc0\xd3\x46\x4e\x21\x5c\xd0\xee\xb6\x70\xbe\x64\x96\xaa\x3a\xa0\x89\x11\xc3\xb7\x5c\x26\x46\x58\xf7\xd8\x35\x7a\x24\xac\xb7\xd4\xd9\x54\xc0\x02\xe6\x2b\x18\x63\x6a\xe4\x80\xac\x65\x1c\x8b\xd4\xc2\x32\xe1\x89\xb5\xcb\xba\x9e\x0c\x7f\xec\xcf\x06\x46\xa0\x64\x18\xf0\x4b\xb6\xee\xc3\xe9\xac\x3f\x1b\x9e\xfb\x9a\xbe\xd0\x31\x5c\x71\x6a\x53\x1e\x98\x0e\x6b\x86\x05\xbb\xe3\x79\x0a\x4e\x77\x44\xe4\x69\xc9\xbd\xf4\x29\x2e\x90\x1e\x6a\xab\x2e\x30\x85\x31\x46\x77\xcb\x4f\xfd\xc9\x08\x9c\xce\x35\xcf\xf4\x83\xe2\x39\xdc\xb5\xdd\x55\x08\x75\x22\xb4\xec\x2f\x87\x94\x4a\xf4\x04\xb5\xd4\xf5\x99\x85\xa3\xbb\xb9\x1e\x33\x68\x71\x71\xec\x9d\xad\x9d\xc7\xfe\xd9\xce\x7f\x1c\x4e\x66\x37\xfd\x1d\x2c\xe9\xa8\x6c\x67\x7e\x2b\xf3\xa2\xe4\x98\x1f\x84\x5e\x8e\xda\x1a\x9e\x72\x05\x6e\x6e\xbb\x57\xd0\x3f\xff\x3e\x80\xec\xa8\xd3\xdb\xf3\x6f\xb1\xb6\x89\x39\x80\x63\x95\x82\xf1\x66\x96\x81\x7a\xe9\x93\xce\x9c\xe6\xf4\x30\xed\xfd\x89\x68\x9b\x38\x1d\xc2\x0a\x9f\xc0\x89\xf4\x49\x16\x4f\x31\x55\x9a\xc4\x9e\xa9\x5e\x8e\xcf\xfb\x97\x07\x18\x93\xec\x18\xe5\x07\xe8\xf0\x27\xd6\x72\xe8\x1c\x01\x02\x12\x46\xc3\x2a\x38\x3b\x07\x52\xbd\x9a\x62\x4c\x39\x73\xee\x9d\xd6\x3e\x91\x69\xa5\x5e\xb3\x62\x99\xf6\xd8\x85\x35\x07\x29\xae\xb6\x65\x98\x39\x4a\x09\x5f\x90\x4d\x66\xd3\x84\xf6\x18\xe3\xed\x54\xa9\xd5\xd4\x2f\x13\xee\x6b\x29\x03\xc5\x34\x48\x86\x00\x55\x27\x5c\x1c\x90\x12\x48\x65\xcf\x96\x59\x2c\x45\x8a\x03\x4b\x8c\x88\xe4\x32\xc5\x09\xae\x93\x4c\x9c\x45\x59\xc0\x42\xed\x53\x86\xd3\x97\x3c\x12\x24\x1a\x1f\x43\x6a\x9c\xbd\x3d\xe7\x7b\x06\x3c\x90\xe3\x90\x6a\x57\x83\xd9\xbb\xf1\xc5\xb4\xc6\x71\x01\xdd\x42\x92\x14\xeb\xaa\xc0\x4c\x55\xba\xdd\xa8\x52\xb7\xe4\x27\x61\x22\x0e\x80\x0c\xa1\xb6\xbe\xd7\xbc\xf1\x19\xe7\x86\x45\x81\x5b\xbf\x74\x6f\x98\xbb\x4f\xff\x69\x98\xea\x5f\x5f\xda\x64\x9b\xf0\x28\xb6\x14\xe3\x43\x45\x74\xb5\xfe\xd0\x83\x50\xba\xa4\xf6\x02\x10\x05\x1a\x8b\xa9\xf8\xfa\x5a\x3e\x27\xf7\x0c\x5e\xc5\x87\x5e\x5e\xa3\xf1\x68\x3e\x9a\x5e\xf7\xcf\x07\xd3\xe6\x39\x6f\xc1\xac\xd8\xa7\x9f\xac\x01\x9a\x0f\x6e\xac\xd6\xcb\x98\xdc\x46\xc1\x27\xf6\xbc\xaa\x9d\x05\xd5\xd5\x6a\xf5\x1d\xa2\xc1\x02\x49\xa8\x5b\x91\x1b\xad\x53\x77\x89\xe3\xab\x9b\x0b\x49\x68\xd1\x2e\x8c\x47\x72\xd1\xae\xb9\x86\x10\x0c\xe6\x94\xcb\x65\x45\x43\xf3\xb6\xfb\x1e\x1a\xb7\x50\xaf\x45\x96\x06\x4f\x3d\x20\x48\x0f\xa2\xb1\xa5\x64\xb3\xc8\xf4\x56\x22\x3c\x8d\x05\x42\x85\x4b\x79\x2d\x45\xce\xf3\x68\xbd\x6d\xba\x5b\x30\x85\xba\x52\xba\x7f\x08\xc9\x2b\x54\xec\x1c\x3d\x3d\x1d\x9d\x50\x6d\xa9\x67\x81\xc7\xde\x4c\x86\x83\x51\xe8\x88\x3e\x94\x96\x6c\x99\x4b\x41\x81\x63\x1f\x4b\x6e\xa9\x62\x81\x34\xc2\x20\xda\xbc\x83\x38\x8d\x4c\x9d\x03\xd6\xd8\x98\x7c\x2b\xaf\x0c\x47\xf3\xd7\xe3\x8b\x0f\x3b\xca\x90\x5a\x97\x97\x6e\x1b\x06\x02\x94\x76\x69\xc4\x53\x09\x7d\xb9\x0b\x15\x6f\x49\xab\x20\x17\x78\x2b\x0d\x8c\x42\x8a\x23\xd8\xf5\x3b\x4c\x63\x8a\x51\xd8\xaf\x7f\xa9\x83\xda\xb1\xe6\x14\x0e\xa1\x4b\x65\xc5\x8d\x6a\xbd\xe1\x68\x36\x98\x8c\xfa\x97\x9e\x20\xb1\x28\x44\xbe\x81\xa2\x0a\xb9\xac\xbd\xd4\xe5\xf1\x6e\x33\x73\x13\x20\x30\x74\xe7\x88\x7d\xb4\x9a\x9f\x2b\x2b\x09\x70\x34\x9d\x43\xa0\x4a\x6e\x94\x4e\x2d\x37\x48\x50\xee\x2f\xee\xed\x10\x3e\x7c\x40\x91\x0f\x9b\x35\xe1\x2e\x4f\xf3\xf6\xc7\x30\x4d\x75\xe5\xbb\x94\xb5\xf3\xfe\x74\x30\x9f\x0e\x46\xd3\x76\xbc\x3f\xbb\x88\x0a\xdb\xc0\xfd\xee\xd2\x85\x8d\x58\xb6\x7e\x39\xc3\xde\xe0\x24\x39\x05\x07\x5a\x02\xb1\x5e\xdd\xd0\x56\xca\x2c\xab\x3d\x82\x39\x50\x14\xea\x4a\xcc\x10\x56\xe8\xa2\xcf\x09\x8a\xc8\x97\xbe\x60\xa4\x7e\x2d\x50\x12\x07\xc1\x0b\x02\x12\x05\x24\xca\x23\xc6\x29\xbc\x09\x76\x6d\xd9\xa8\x43\xb7\x59\x09\x1a\x9f\xd2\x22\xd5\x12\x11\x18\x82\x92\xf6\x9f\x64\x1a\xab\x3b\xdd\x39\x62\xc7\xb8\x2f\x32\x5d\xb1\xf3\xed\xea\x4e\xa6\x27\x0c\x92\xd6\xae\x78\x04\xe5\x41\xb4\x12\x5f\x58\xf2\xc8\xc0\x2d\x4d\x0a\x73\x91\x21\x84\xdf\x69\x6c\x51\xeb\x46\xc2\x59\x98\x9e\x8f\xaf\x1f\xb1\x91\x10\x7a\x0e\x8d\x1d\xd4\x3a\x64\x8e\xe8\x27\x04\x0b\x93\xc6\x81\xba\x43\x05\x09\xfb\xd4\x40\xab\x81\x35\x2c\xa5\x83\xf4\x8f\xc6\x5a\x76\xaf\xd8\x8a\xc3\xf9\x64\xf0\x66\x30\x19\x8c\xce\xeb\x46\xdd\x68\xec\x52\x57\x4f\x9a\x24\x30\xec\x06\xa2\x09\xca\xce\xa8\x74\x14\xf0\xa2\xc0\x6f\x96\xf1\x95\xf8\x52\x23\x8c\x73\xd7\x56\x9c\xb3\x73\xa0\xcb\xc4\x7a\x6f\x83\x75\x77\x8e\x6c\xd8\xdb\x39\x85\xbd\x97\xf7\x57\xd0\xa0\xb9\xba\x1a\x0d\xa6\xef\xc6\x3f\xb9\xec\xb8\x37\xc3\xcb\x43\x6b\x34\x4b\x2c\xa4\x87\xc2\x9d\x4a\x2a\xac\xd3\x0e\xdc\x8d\x05\xf9\x13\x70\x18\x1e\xc4\x10\xb2\x55\x61\x0f\x06\x24\x9b\xb3\x6d\xad\x3d\x35\x8f\xd9\x50\x99\x51\x8e\xcc\x77\x76\xad\xab\x52\xc8\xc0\xe3\xd8\x95\x9a\x76\x8e\x30\xc0\xe4\xeb\x65\x79\xea\xa4\xaa\x31\x94\xc1\x0a\xb2\x17\x52\xad\x00\x10\x30\xcc\x08\x3e\x00\x71\x46\x9c\x4d\xb5\x0f\x60\xc4\xdb\x1d\xfb\x81\x2a\x5a\x96\xd9\xc0\x30\x1a\x4f\xce\x07\x64\xff\x10\xe9\x0e\xdb\x63\xd8\x5d\x9a\x24\xc1\xd9\x93\x47\x83\xca\xd8\xa8\x0b\x40\xeb\xae\xe6\x9c\x52\xd6\x2d\x88\x25\x66\xee\x2f\x72\x1e\x7d\x12\x0f\x15\x19\xc3\xca\x5a\xe7\xbc\x1b\xf0\xea\xcd\x78\x67\xc1\x34\x7c\xf0\xbf\x30\x12\xf9\xbf\xf1\xd2\xd5\x22\x2f\x76\x2a\x52\x0c\x53\x00\x20\x70\x69\x51\xaa\xf6\x16\x44\xfb\x69\x3c\x54\x10\x3d\x9e\xcc\x1c\x12\xdf\x03\x35\xde\x55\x01\x4b\x0e\x73\x73\x71\x58\x3d\xe7\xa4\x79\x80\xaa\x48\x89\xde\xad\x9a\x64\x6b\xbe\x10\x36\x29\x18\x6a\x22\x09\x91\x11\x02\x44\x2d\x45\xd4\x15\x3b\xc8\x5b\xb1\x81\x4e\x8a\xcc\xba\xf7\xa4\xd6\x57\xfb\x00\x51\x10\xa4\xf0\xd1\x34\x69\x03\x18\xd1\x96\x1a\x41\x15\xd1\xef\x4c\x18\x9f\x19\x00\x72\xb1\xe2\x5b\x96\xe9\x32\xc1\x4e\x04\x58\x10\x1b\x63\x70\xd2\x19\x4d\xfe\x34\xe1\x7c\x2d\x0e\xd5\x43\x42\xa0\x46\xc1\xf6\xab\x2e\xd8\x8e\xe9\xfc\x7c\x36\x9e\x4c\xe7\xcf\xa7\xb3\x47\xb3\x20\x22\x44\x18\x7a\x3e\xc0\x8c\x55\x52\x6b\xe5\xdc\x0f\x15\x70\x37\xdc\x37\xff\x17\x8f\xfe\x09\x29\x5c\x4d\x33\xbd\x3a\x42\x33\x88\x0c\xc0\x1e\x36\x04\x80\xb4\xf5\x5e\xba\xc5\xb6\xc1\x6a\x66\x15\x75\x2e\xed\x85\xb8\x9a\xf5\x2f\xd4\x74\xa1\x4a\xca\x15\xb5\x71\x01\xe9\x61\x08\x66\x44\xbf\x65\x4f\xba\x3c\x1a\xaa\x77\xf5\x45\xe1\x59\x79\xe4\x9b\x80\x94\x64\x03\xed\x7a\xd9\x3e\x0e\x6a\xf2\x46\x1b\x07\x51\x3a\x56\x3b\xfa\xd3\x43\x47\xd5\x39\x03\x30\x33\x15\x13\x37\xac\xc6\x0f\x69\x5a\xfe\x40\xda\x73\xd4\x60\x80\xf0\x5b\x81\xee\xe5\x38\x40\xe6\x6e\xbf\xf7\xc8\x28\xbf\xee\x70\x45\xbb\x4f\xce\xeb\x0f\x81\x36\xd9\x66\x15\x57\x8f\xac\xcf\x83\xb1\xb5\x6d\xe6\xfe\x2c\x93\x64\x7b\xea\x2b\x73\x61\x1d\x5d\xe6\xcd\x01\xef\x56\x6c\x93\x3f\xb5\xd1\x31\x64\x43\xe3\x83\xbd\xb2\xb0\xe0\x8a\x66\x9c\x2e\xf9\x62\xed\xd8\xae\x22\x1b\x04\x62\xc6\x31\x89\x3a\x88\x7f\x79\x06\x33\x5f\xbc\x15\xf9\x36\xb0\x9a\x1a\xea\x74\x23\x90\xd5\x1c\xa9\x5e\x13\x52\x5d\x03\xce\x8f\xfe\x1c\x6e\x3e\xc0\x07\x1d\x28\xf4\x2a\x9b\xd2\xb6\x75\xb3\xc9\x10\x22\x3a\xe3\xd9\x78\x7e\xd5\x9f\x9d\xbf\x1b\x8e\xde\x36\xf3\x24\x51\x9e\x11\xdb\x2e\xb9\x4c\x34\xd6\x76\x50\xce\xaf\x4d\xe5\x83\xf0\x7f\xe9\xb0\x45\x30\x5d\x9b\x6f\x44\xe1\x03\x25\x16\xbe\xd7\x62\x36\xe5\x02\x52\xc8\x39\x15\x14\x50\xf7\x24\x52\x97\xb3\x5c\x15\x0a\x46\xb6\x59\xb1\x35\xcf\x7a\x88\x60\xe2\x86\xae\x83\x8a\xda\xb0\x04\x75\xef\x88\x65\x0c\x15\xb8\x39\x01\x14\xa8\xc5\x2d\xf8\xd0\x50\xf3\xf4\x0f\x14\x8a\x45\x6b\x65\x2c\x5e\xa8\xb4\x82\xfc\x63\x63\x6a\x61\x56\x17\xc1\xe3\xc2\xac\xd1\x2d\x0c\x31\x51\xf3\xb7\x76\x9a\x56\x0f\x7d\x41\x67\x33\x8a\x44\xd6\x58\x7d\x6d\xdd\xb5\x35\xcb\x14\x8d\x24\x28\x71\xd9\xc7\x04\xad\x53\xa9\x38\x6f\xde\x0e\x46\x03\xc8\xb4\x9b\x8d\x2f\xc6\x97\x43\xba\xf1\x1a\x3d\x6c\x20\xde\x7b\xfc\x61\x30\x85\xa4\x2d\x1b\x00\x3e\x1e\x8d\xb1\xc4\xa4\x50\xb1\xb2\x7c\x89\x3c\x8e\xaa\xb1\xa6\x46\x15\x71\x58\x8b\xf3\xd1\x3c\x1d\xc2\x9a\x3c\x26\x48\x86\xba\x53\x73\xd6\x55\xdd\xa9\xba\xb2\xc1\x74\xf6\xeb\x57\x26\x08\xb0\xeb\xb0\x95\x09\x5d\x3c\xd5\xca\xec\xac\x1f\x58\xd9\xeb\x9b\xb7\xbf\x7a\x61\x8b\x72\x75\xf8\xba\x16\xe5\xea\x89\x96\x65\xa7\xfc\xd0\xb2\x2e\x06\xd7\x93\xc1\x79\x7f\x36\xb8\xf8\x35\xab\xb3\xa9\x94\x22\xcb\x45\x04\x2b\x39\x70\x91\xc1\x37\xf6\x61\xee\x1c\xba\xda\xea\x4a\x2a\xab\x1d\x8c\xfa\xaf\x09\xa9\x78\x36\x1c\x8f\xa6\x0f\x2c\x33\x52\xa9\x73\x9c\xec\x88\xa5\xe8\x2e\x54\x8e\xe2\x8a\x3e\xca\x25\xfb\x0b\x7d\x30\x4f\xf8\x42\x24\x7f\x65\xbd\x5e\x8f\x7d\x14\x69\x2c\x51\x39\xfd\x68\xc6\xac\x3f\xd4\x39\x72\x8f\xc1\xc7\xe8\x60\xee\x75\x3a\x8d\xb9\x06\x25\xba\x80\xd7\xd6\x7f\x3f\x1f\x8e\x86\xb3\x61\xff\x72\xf8\x9f\x83\xc9\xdc\x58\x7b\x0d\x4f\x70\x11\x74\x2c\xf2\x88\x47\x09\x7d\xc6\xad\x06\x0d\x35\x63\x3c\x21\x9a\x82\x84\xbf\xe5\xb9\xc4\xea\xcc\x9c\x6d\x78\x94\x2b\x76\x46\x6a\x0c\x10\x0c\xbc\x95\x4b\x14\xe8\xf5\x6e\x28\xcd\x7a\x5d\xba\xf9\xe8\x35\xf2\x17\x91\x57\x0a\x98\xa0\xee\xcb\x4d\x2a\x0d\xa0\x75\xa9\xf1\x4c\xd0\x4e\x88\x9c\x4c\xec\x06\x30\x4f\xdd\x84\x9f\x99\x59\x80\xdf\x3f\x78\x89\x0e\x60\xe2\x6d\xc6\x1a\xce\x94\xbb\xbe\x74\xc2\x8f\x11\xd4\x32\xda\xd5\xa3\x9b\x10\xd6\xaf\x1d\x01\x82\x74\x3f\xea\xf7\x95\xd8\xae\x86\xec\xa3\x5e\xab\xbb\x70\x9d\x2a\x67\x1f\xcd\xbc\xaa\x6b\x27\x67\x7b\xbb\xad\x9f\x8b\x15\xcf\x63\xc8\x29\xb2\xad\xf3\xa8\x1a\xe7\xa0\xa4\xb2\xe7\xcf\x9e\x3d\x7b\xd6\x48\x2c\xfb\xfa\x19\xd4\x03\xb7\xf1\x0c\x7b\xc5\xbe\x7e\x56\xa9\x5b\x78\x37\xfe\x69\x7e\x33\x1d\x5c\x04\xae\xb7\x96\xe6\x05\x1e\x22\x07\x9d\x30\xbe\x77\x04\xb7\x29\x73\x0b\x55\x14\x6a\x63\x29\xdd\x6e\x93\x09\x9b\x68\x05\x89\x12\x3b\xbc\xad\x5e\x7f\x25\x64\xdd\xba\x6f\x0f\xf0\xbc\xed\x19\x76\x4e\xfc\x47\x4b\x91\xfa\xd2\x77\x55\xe9\x99\xc7\xf6\x10\x87\x26\x11\x24\x95\xbf\x81\xe9\x66\x80\x2d\x42\x09\xa8\xa4\x90\x6d\xd4\xad\x08\x1e\x11\x69\x61\xa1\x9c\xcd\x1f\x7f\x80\x1e\x6c\xc3\x34\x16\xf7\x40\x2a\xf7\xc1\x1b\x40\x11\x60\xb3\x5c\x08\xf6\xa3\x04\x58\xba\x63\xb9\xf4\xe7\xe7\x81\x74\xee\x60\xb9\x95\x95\x56\xaf\x88\xca\x7a\x41\xc9\xc6\xa0\xf9\x23\x16\x3d\x72\xf6\x03\x81\xe6\xf5\x82\xd4\xb6\x60\xe5\xfe\xb9\x43\x97\x0f\xb5\x7c\x55\x02\xfc\x9a\xe5\x07\xcb\x6a\xbb\x21\x01\x68\xe1\xc7\xc1\x04\xd0\x12\xde\x0c\x2f\x67\xed\x2d\x07\x3d\xb2\x5c\x96\xab\x55\xce\x37\x90\x68\x0f\x0e\x20\xeb\x75\xb1\xea\xa8\x6d\xd0\x98\xde\xaa\x4f\x02\xd9\x95\xd2\x4f\xa9\xc5\x99\x05\xeb\x72\xe8\x82\xe0\x48\x3b\xf6\x09\xde\x86\x02\x74\xc2\xec\xb3\xd5\xce\x83\x27\x35\x24\x1c\xfb\x2e\x54\xf0\x61\x7a\x8b\x2d\x13\xf7\x22\x82\xba\x50\x76\x7c\x2b\x8d\xa2\x9d\xa9\x4c\xa4\xc7\x27\x27\xd6\xd3\xbf\xc1\x3a\x5e\x2b\xad\xb2\xb2\x38\x45\x2f\x16\xe6\x7d\x04\x41\xc3\x8a\x20\xa5\xbd\x69\x27\x5c\x97\x55\x07\xb3\xdf\xb6\x89\xfb\xe0\xcd\x86\xbe\x68\xe6\x43\x5b\x35\x61\x0c\x4b\x0f\x60\xc1\x7e\x5a\xf3\xc2\x65\x96\xdb\x15\xdd\xe5\x92\x4a\x34\x75\xc1\xd3\x98\xe7\xbe\x89\x4d\x15\xfa\x9c\x42\x7b\x44\x3a\x04\x1a\x81\xc4\x6d\x44\xea\xd3\xa2\x55\x70\x74\xda\x16\x14\xde\xc8\x97\xfd\x0f\xe3\x9b\x19\x1c\xa7\x87\x39\x24\xe1\x5b\x65\x17\x18\x40\xf2\x2d\x08\x6b\xa5\xbe\x58\x00\x23\x0b\xbe\x42\x5b\x8d\x6b\xc1\x0a\x7a\x92\x9e\x65\xee\x6e\x35\x27\x8c\x8d\x29\x46\x8d\xe6\x6c\xa3\x36\x9e\xd6\x7a\xcf\xc9\xd4\x05\xe6\xd8\x1d\x37\x77\xa5\xb2\xdd\xee\x8a\xea\xcb\x81\xeb\x78\x11\xb4\xbc\xb0\xf3\x84\x90\x37\x1c\x33\xdd\xad\x25\x59\x53\x1e\xd0\x69\x42\x56\xae\xc3\xf6\x73\x4d\xea\x80\xab\x3d\x81\x7c\xb3\x00\xdf\x95\x47\x51\x5b\x13\xb9\xb4\xd8\x6b\x96\xc5\x2f\x61\x7a\xbd\xfb\x4d\x12\x84\xa0\xc3\xfd\x0e\x6b\x42\x82\xb5\xd4\x2b\xa6\x28\x10\x1b\xce\x9d\xf2\xbb\x3d\x58\x7d\x00\x96\x4e\xd3\x74\x89\x52\x8d\xd9\xd4\xbb\x30\x40\x19\xb5\x2c\x6a\x98\x18\x81\xe9\x1c\x72\x90\xaf\x3b\x05\x34\x77\xb1\xc9\x8a\x6d\xaf\xd3\x09\x79\x2c\x14\xd8\x96\x07\xcf\x87\x46\xdf\x1f\xbe\x0e\x2e\xa7\x1d\x6c\x08\xb0\x47\x84\xb4\xb2\x90\x0b\xe2\x0e\xbf\x3e\x92\x2f\x3e\xcc\x17\x60\x0b\x90\x08\xb7\x85\xa6\x2e\xe4\xc6\x7a\x6e\x24\x64\x5b\xf3\x7b\x08\x07\xd5\xec\xdd\xe2\x32\x28\xfc\xbe\xd2\xe8\x01\xae\x06\xcc\xb0\x10\xf7\xac\x50\x2a\xc1\x84\x1d\x48\x3e\xd1\x05\x10\x1f\x01\x61\xc0\x95\x6d\xeb\xdf\x45\xda\xbb\x93\x9f\x64\x26\x62\xc9\xa1\x23\xb0\xf9\xed\xec\xb5\x5c\xcc\xc4\x7b\x8f\x30\x23\xd3\xa5\xea\x51\x49\x38\xd6\x41\x41\x81\x06\xe0\x33\xdb\x76\x1e\x72\x91\x48\x05\x80\x98\x5b\x4b\xca\xba\xd6\xd7\x39\x62\x97\xfd\xd9\xe0\x3d\x10\x7e\x3a\xfb\x70\x39\x80\x13\x64\x5b\x6e\xb9\x2a\x3c\x07\x2a\x49\xab\x81\x1a\x73\x91\x27\x3e\x17\xdd\xab\x84\x5a\xf0\x1c\x40\xb6\x8a\x75\xb0\xbc\x8f\x91\x2c\x48\xef\x4e\x97\x8a\x41\x89\xb5\x3b\xaa\x6e\xab\x8c\xf9\x50\x63\x84\x90\x51\x9e\x32\x9f\xff\x88\x9d\xb7\x15\x0d\xb8\x92\x82\x42\xd9\x6c\x75\x5b\x51\xbf\xca\x05\xf8\xe1\xb1\x69\xdc\x6f\xd0\x2d\x5b\xb0\x1f\x6e\x86\x83\x56\x13\xb6\x28\xf3\x94\xa9\xf4\x4c\x2d\x6d\x09\x3c\xce\xc2\x87\x87\xbd\x0e\x4b\xad\x31\xaa\x17\x49\x28\x96\x87\x4b\x7a\x51\xdd\xdd\x2c\x35\xf8\x8f\x64\xd5\xc6\x72\xef\xe2\x20\xa4\x97\x7b\xdc\x48\x38\x74\xe3\x7f\xa1\x1b\xc9\x25\xf0\x1f\xb0\x52\xbb\x09\x8d\x15\x03\x8a\x81\x5f\xb3\x5f\xb1\xc8\x73\x95\xb3\x63\x5d\xc4\x22\xcf\x4f\xea\x2b\x6f\x2f\x1e\xb0\xa1\xa5\xfa\xf2\x83\x8a\x05\xb3\xf8\x14\x25\xef\x4c\x66\x2f\xd9\xcc\x4c\xd4\x7d\x0c\x6d\x61\xe1\x5a\xcc\x65\x61\x3d\xc4\x8f\x52\xe0\xdd\xc4\xea\x74\xab\x46\xf8\xcc\x63\xf3\xe1\x1b\xcc\xb0\xbb\xb9\x1a\x8c\x66\x83\x8b\x83\x62\x07\x96\x58\xe6\xaa\xb1\xb3\x36\xe7\xb1\x2d\xef\x13\x28\x55\xcb\xfe\xab\x0f\xee\xf2\x7c\x1d\xea\x6f\x45\x3c\x02\x2c\xa9\xde\x93\x30\xe8\x57\xde\x58\xd2\xee\x95\x5f\x8c\xcf\xe7\x83\xc9\x64\xdc\xd2\xa8\x60\x57\xc3\x8c\x70\xc1\xa0\x2d\x16\xc6\x16\xe3\x09\xf2\x4a\xbb\x93\xca\xa7\x97\xa4\xaa\x70\x1f\x01\x6c\x8a\xda\x88\xc0\x59\x8d\x29\x4d\x3c\x4c\x92\xb5\xee\x65\x28\x89\x0c\xbf\x1a\xb8\xb8\x81\xc5\x62\x95\x7e\x59\x30\x71\x0f\xb7\x50\xee\x84\xf2\x86\xe7\x50\x15\xe8\x10\xb8\x72\x95\xae\x1e\x82\x40\xa8\x12\xd2\x53\xa8\x6e\x15\x48\x8d\x64\x1c\x8d\xe7\xd7\xfd\x49\xff\xea\x62\x7c\x5e\xeb\xb6\xeb\x50\xd2\x50\xbb\xaf\xb0\x8a\x47\xf0\x21\xc3\xa0\x5e\xb7\xb4\x28\x29\x33\xbf\x51\xb8\x44\x10\x11\x32\xaf\x78\xf9\x73\x96\x0b\x73\xe0\x8d\x4e\x6b\xd6\x52\x0f\xd4\x34\xb3\xdc\xcc\x7c\xa8\x7a\x08\x88\x82\x55\x20\xd6\x5b\x82\x38\x90\x38\x7c\x7d\x37\x6d\x42\xbd\x6f\x06\xce\x17\xda\x76\xb1\x6f\xb8\x7d\x82\x82\xa8\x7d\xfc\xff\xab\xb9\x1f\xc4\x65\x63\x3b\x1e\x38\xf6\xfd\xe9\x2e\xc6\x6f\x39\xef\x72\xb3\x31\x3a\x04\x76\x1a\x2c\x54\x06\xb5\x53\x80\x9a\x6c\x45\x2a\x84\x6c\x22\x55\x62\xef\xeb\x43\xe6\xe9\x26\xb0\x53\xac\xcf\xdf\x8c\x27\x57\xfd\x59\x9b\x3b\x8f\x54\x76\xf5\x90\x5c\x8f\x1d\x80\x0f\xf5\x8e\x8f\xcb\x48\xa0\x46\x46\x41\x14\xb2\x41\x2d\x26\x94\x19\xea\x0f\x68\xda\xfd\x21\x91\xa9\x40\x23\xed\x0f\x98\x31\xc6\x57\xad\x1d\x9d\x28\xcd\x7f\xb1\x75\x36\x15\x7c\x09\x72\x54\xc8\xd7\x18\x34\xa3\x0a\x27\x4b\x90\xdb\x68\x9c\xd8\x30\x93\xfd\x90\x3a\x09\x7a\xb3\x20\x58\xf4\x86\x3b\x0d\x9c\xfd\x81\xcc\xb7\x10\xd5\x1c\xc3\x8f\xf5\xa9\x59\x13\x39\x40\x3b\x05\x3f\x81\x2c\x7c\xf7\x7f\xb5\x30\x83\x8a\x98\xa1\x21\xdc\x62\xea\xed\xee\xb0\x01\x84\x7b\x09\x74\x7b\x89\x34\xb3\x1b\x4d\xbb\x58\xb9\x87\xbe\x68\x3e\xfe\x45\x65\xeb\x2f\xc7\x6f\xf7\x9b\x90\x36\x5b\x8b\xd6\x1e\xa8\x58\x20\x8b\x43\x7d\x83\xb6\xba\xd6\xbf\xbd\xd6\x40\xcc\x1b\xca\xf4\x50\xa8\x0b\x18\x0d\xbe\xa2\x0d\xd8\xf5\xd9\xa9\xb2\x7f\xa7\x7e\x89\x9e\x65\x67\xe0\xfe\x26\x1a\xe5\x70\x74\x7d\x33\x7b\xa8\x67\x2f\x55\x59\xa5\xf1\x99\xca\x2b\x5d\xe1\xc3\x22\x97\xc0\xd3\x2b\xe2\x4a\xed\x17\x5a\xc4\x86\xbd\xa1\x85\x7e\x90\xa4\x8b\x90\x5b\x9b\x2d\x18\xac\x51\x96\x21\x36\x42\xf8\x06\x78\xe0\xac\xd4\xf9\x99\xce\xa3\xb3\xcd\x96\x20\xc2\x8c\xd5\x40\x90\x10\x7e\x82\xb5\xc9\x51\x6e\xac\x0d\xef\x3b\x33\xa3\x0a\x2e\x6a\xb8\xaa\x01\x18\x1a\x66\x8a\x38\x9c\x60\x23\x0a\x8d\xa1\x5a\xf1\x66\x79\xe3\x19\x64\xad\x31\x6b\xa0\x5f\x3f\xd2\xb4\x45\xc3\xad\x37\x67\x7b\xb8\x95\x03\xbc\xca\xb6\x52\x07\x49\x1c\x53\x4b\x24\xb0\xe5\x02\x22\x5b\xdf\x85\x03\x2d\x25\xac\xe3\x21\xa5\xa1\x27\xbe\x8d\x32\x35\xb5\x10\xec\x66\xf6\xe6\xf4\x3b\x37\xaa\xf7\xaa\x51\x1b\xfb\x44\x2e\x64\xa4\xd2\x5b\x68\xc1\x00\x8c\x08\xbf\x01\xca\x31\xa6\x90\x24\x72\x11\x79\x68\xa7\x22\xe7\xa9\xb6\x63\x4d\x85\xf5\xa2\xe3\x20\x2d\xad\x84\x85\x78\x59\x81\x74\x5b\xa5\x25\x58\xb3\x0e\xc3\xcd\x7e\xf7\xcc\xbf\xc3\xa7\xbf\x66\xb6\x41\xb3\x9d\xff\x03\xb1\x6b\x58\xa8\xdd\x95\xf9\x60\x74\x3e\xbe\x08\xf1\x75\x5e\xe1\x03\xc1\x55\x1a\x7a\xfb\x82\xf3\xe1\xea\xb9\x02\x3e\xeb\x56\x10\x71\x03\xef\xa0\x63\x31\xf2\x27\xb7\xf9\x26\xee\x64\x12\x47\xc6\x20\xc9\x78\x61\xf6\x49\xb3\x63\xe0\xf8\xaf\xe0\x34\x60\xca\xd6\x57\xbd\x35\x60\x1f\x2d\x65\x62\x98\xc0\x6a\x26\xb8\xf9\xa7\xce\xef\x55\x04\x7d\x07\x25\x04\xf2\x0f\x40\xdf\x55\x39\x6a\xae\xf0\x3d\x6a\x37\x8c\xf9\x2d\x2d\x10\xbd\xd0\xda\xc2\x27\xc8\x37\x61\x76\xed\x26\x05\x18\xdc\xae\xab\x5e\x51\x07\xee\x85\xdc\xb1\x1a\x74\xef\x51\x6b\xff\x47\xdf\x33\xd7\x51\x09\xc0\x3a\x00\xee\xea\xe5\x57\xbd\xa8\x6b\xe8\x85\xff\xbd\xbf\xef\x22\xf1\xba\x40\xb9\xe8\x8f\x7f\x34\xbf\xff\x03\x70\xad\xbf\xea\x49\x09\xff\xc5\x87\x64\x96\xc1\x0f\x7c\x44\xa6\x09\xfc\x88\xe1\x47\x8c\x3f\x14\xfe\x58\xe3\x60\xeb\x35\xfc\x82\xdf\x5e\xe3\xb7\xd7\xf8\xed\x48\xc3\xb7\xcc\x7f\xb2\x75\x46\x3f\xbe\xa1\x9f\xdf\xe2\xcf\x62\x83\x6f\x48\x23\x1c\x6e\x63\x7e\xb3\xe8\xbc\xf0\x6f\xf8\xfe\x06\xfe\x1e\xab\x7b\x76\x4c\x80\x57\x16\x5b\x87\x3b\x77\x24\x80\x9e\x00\x04\xf1\x09\x8e\x15\xab\xe8\xc0\xc7\xd9\x57\xbd\xe2\xbe\x78\xe0\x61\x73\x2b\x55\x1e\xcf\xb6\xf8\xdf\x3b\xf3\x63\xf9\xe7\x67\xf8\x03\x16\xb5\x7c\xf6\x35\xfe\xf8\x0e\x7e\x3c\xc7\x1f\xf0\x1f\x95\x9b\x1f\xb7\xeb\x18\x27\x78\xbb\x46\x52\x96\x11\x7c\xfc\xb3\xc6\xa8\xf1\x57\x3d\x19\x09\xeb\x7d\x76\x07\x26\x38\x98\xb8\x77\xf6\xa6\x9a\x0c\xce\x6f\x26\xd3\xe1\x8f\x0f\xea\x0f\x16\xba\x9b\x78\x5b\x97\x8b\xf0\x56\x70\x9d\x1d\x01\x44\x05\xe5\x35\xf9\x9c\xbc\x1b\x99\x6b\x76\x27\x92\x64\x8f\xba\xeb\x67\xd3\x6e\x8d\x43\x50\xfe\x3d\x64\x52\x3f\x34\xdf\x87\x6f\x57\xa7\xe0\x80\xa7\x91\xd2\xfb\x5d\xf4\x08\x45\x53\xf5\xb2\xc5\x00\x14\xdf\x3a\xb9\x24\xb8\x96\xc9\xd6\x7e\x1b\x93\x8e\x3c\x51\xb6\x4d\x3f\x70\x91\x0b\x41\xc5\x39\xb9\x52\xa0\x38\xf9\xa8\xb5\xf3\x78\x3b\xb1\x58\x17\x35\xb5\x26\xaf\x3c\x0f\x5a\xa4\xda\xcc\xfd\x87\x5b\xba\x1a\xd9\x50\x22\x94\x37\x92\xaf\x71\x8d\x9a\x4b\x28\x3e\x8b\x55\xa4\x6b\x94\x9e\x4f\x3f\x5c\x5d\x0e\x47\xdf\xb7\xbb\x87\xa1\x8f\x40\x9d\x43\xac\xf6\x50\x53\x3d\x9c\xdb\x0c\xd1\x56\x64\x04\x50\xc1\x9a\x1d\x73\x76\x93\xca\xfb\x4a\x3d\x12\x39\x41\x4f\xe0\x79\xbb\x4d\x9d\x23\xbf\x51\xc0\x5d\x7b\x2b\x78\x6b\x2b\xa8\x9a\x4f\x4f\x73\x35\xd9\x97\xfc\xba\xdb\xa9\x50\x76\x75\xc6\xfc\x12\x39\x18\x2b\x48\x3f\x5a\xaa\x61\x9a\x07\xae\x21\x30\x86\x68\x58\x64\x0d\x6c\x2c\x1c\x33\xbe\x32\xd3\x2f\xbc\x15\x03\x8c\x56\xe9\xcc\x0b\xb8\xe4\x08\x52\x6b\x79\x39\x49\xe0\x1e\xa8\x6c\xdc\x32\x68\x4c\x55\x3a\x9c\x7f\x58\x01\xfb\xea\xcc\x3c\x7e\xf6\x95\xa7\x76\x4d\xea\xbc\x6a\xe1\xa7\xd7\xe3\xcb\x83\xa3\x0d\x84\x7d\x04\xea\x6d\xe7\x88\x1d\xfb\x0c\xd3\x00\xe9\xcc\x39\x49\x2c\x06\x73\xf5\xa8\x37\x0f\xba\x8b\x73\x91\x9d\xeb\x5f\x62\xe7\xc4\x31\xd9\x95\x55\x93\x5d\xbb\xc6\x94\x57\x79\x0c\x4e\x26\x69\x83\x97\x6e\x5f\xbf\xb2\x1a\x7f\x17\xf1\x14\xd1\x7e\xee\xb1\x01\x52\x4f\xbf\x64\xfd\x91\x87\xee\xea\x43\x0d\x95\x91\xe7\xf8\xaf\x97\x2f\x2b\x9f\xba\x7f\xbf\x7c\xf9\xd5\x0c\x92\xdc\x7e\xf7\x9d\xdf\xbf\xdb\x76\x37\x9b\xbb\xdd\x07\xd4\xb7\x9d\xad\x91\x5b\xb6\xba\xcd\xea\xb0\x6a\xb8\x35\xe4\x2d\x1f\x56\x7b\xc3\xb5\x54\x6c\x1d\xdb\xc0\xec\x47\x5f\xfe\x43\xbe\xbd\x13\x90\x0d\xc1\xfc\xaa\x16\x69\xab\x60\x68\xae\xe7\x60\xf9\xe0\xbe\xf8\x68\xf9\xf0\x74\xca\x6b\x4d\x0b\xc4\xca\xf8\x16\xb0\x8f\x0a\x61\x1a\xc7\x78\x58\xa5\x45\x55\x7b\x68\xf1\x8d\xd5\x54\x85\xb0\xc9\xcd\x0e\x45\x01\xb5\x28\x6a\xb4\x45\xf7\xa2\xdd\x40\xc8\x8e\x8a\x55\x5a\xd8\xdf\xad\xab\xb6\x73\xc4\x64\x1e\xd6\x4d\xb4\xec\x5f\x65\xae\x7b\xef\x8d\xfa\xfa\x1a\x6e\xb7\xe1\x55\xff\xed\xd3\x33\xb7\xdc\x54\xe3\x4b\x36\x72\xf9\x00\xec\x88\xe3\x73\x28\xd0\x36\xdf\x0f\xb9\x3c\x98\x26\xab\x73\xb9\xf3\x54\x3c\x2a\x4f\xa5\x62\x13\x3b\xdd\xcf\x67\xa7\x10\x5f\xba\x64\x14\xbf\xb9\xbb\x93\x4c\xe8\x3b\xf4\x0a\xcc\x67\xa8\x66\x9b\xb4\xe6\x9a\xbc\x04\x81\xf8\x17\xfc\xf6\x5f\xd9\x5f\x7c\x96\xc8\x5f\xe1\x13\x4c\x3b\x71\x9f\xb7\xe4\x9d\x34\xd6\x8f\x9e\xcc\x70\x24\xdf\x81\xa6\x35\xe5\xa4\xad\x87\x94\x15\x9a\x61\x0b\x29\xbf\x4c\x63\x69\xef\x4b\x41\x81\xe3\x8a\xb3\xf2\xe7\x30\x54\x1a\xbb\xce\x8b\x12\x38\x59\xa9\xaa\xa6\x4d\x43\x20\x0a\x43\x08\x1e\xfc\xf1\x71\x8c\x81\x00\x48\xa8\x82\x64\xca\xff\xb0\xed\x00\xa1\xe6\xc1\xdc\x9a\x4b\xe8\x79\xe5\x90\x05\x61\x02\x11\x4f\x53\x1b\x74\x00\x84\x58\x5b\xdc\x4f\x8b\xb5\x0f\x06\xa8\x21\x43\x9b\x42\x8a\x35\xe1\xa8\xc7\xb9\x97\x23\xc2\x33\xe3\x69\xb4\x76\x25\x51\xd8\x26\x9a\x91\x5f\x16\x90\xfc\x22\x04\xa3\xfe\x6f\x6f\x81\x63\xb9\x05\xa2\xa7\x35\xda\xc0\x38\x87\x89\x4f\x07\xaa\x1f\xc8\xfa\xa6\x3f\x6c\xe6\x60\x40\x27\x35\xc7\xd3\xba\x01\xe9\xe6\x30\x87\x88\x6b\x59\xef\xdb\x06\x8d\x61\x72\x3f\x75\x64\x6a\x90\xb4\x70\x5a\xed\xbd\x43\xf0\x3f\xe8\x4f\xc7\x9b\xc2\xf0\x4f\x50\xb3\x61\x4b\x22\xa8\xbd\x16\xcd\x05\xb6\xd8\xe5\x77\x58\x5f\xfc\x4b\x3b\xee\x2b\x77\x04\x82\x8b\xed\xd5\x66\x3b\x8f\xb2\x6c\x8e\x9f\x9d\xa0\xa7\xab\x42\x27\x0c\x83\x61\x7b\x1d\x99\xa2\x77\x1f\xb6\x22\x65\x6b\x75\xe7\xa6\xa7\x5d\x93\x46\x97\x43\xdc\x46\x4e\xe7\x76\x44\x0d\x2e\xf5\x1d\xbd\x20\x92\x45\x8a\x39\xd6\x7b\x54\xe8\xd4\xad\xce\xca\x9f\x95\xff\x1b\x18\xb3\x4e\xa9\x96\x6b\x9f\x1e\x99\x8e\x6f\x26\xe7\x3b\xeb\xec\xbb\x55\x0f\xbb\xe1\x1a\xc8\xb2\xb4\xf0\xaa\x66\xb8\x80\x8a\x27\x41\x61\x69\xc0\xe3\xf4\xcd\x9a\xb3\xbe\xd6\x89\x93\xe2\xca\x10\xaf\xb2\xd1\xff\x0a\x44\x9a\xb9\x3e\x73\x75\xa7\x05\x01\x30\x83\xb0\xa2\xe9\xbf\x9e\x8c\x7f\x9a\xe2\x2e\xfa\xc9\xef\x03\x34\x6e\xa3\x40\x4d\x31\xa8\x3e\x72\xc8\x39\xf6\x53\x06\x1e\xb6\x27\xd9\x33\x63\x8f\xf5\xdd\xc1\xc4\xa0\xac\x6d\x7c\x07\x2b\xa6\xd6\x0b\xd0\x18\xad\xb2\x89\xbe\x57\x00\xaa\x8e\x24\xdf\x0d\xa9\x9d\xaf\x37\x48\xc8\xad\xcc\xc3\x8e\xc8\xed\x9d\x13\xb9\x29\xd8\x6d\xfc\xaa\x27\xee\x8b\x57\xec\x58\x2b\xd7\xd4\x2c\xe5\x1b\x97\xeb\x06\x67\x79\x7f\xe7\xa3\x36\x92\xd6\x9b\x1f\xed\xa0\x69\xc8\x9b\x37\xd3\xc1\xfc\xea\x02\xbc\x5e\xfd\xe9\xfc\xaa\x3f\x1c\x5d\x5b\x68\x43\x48\x74\xb2\x3d\x8c\xfc\x2d\xee\xfb\x78\x39\x78\x31\x44\x94\xb5\x0d\x7a\x1d\xf3\x75\x99\xa4\x86\x38\x60\x6a\xb8\xec\x4b\xbc\x9e\x28\xb9\x7c\x63\xf4\xb7\x8c\x03\x2c\xed\xb1\x4c\x63\x71\xdf\x5b\x17\x9b\xe4\x24\x80\x5c\xc6\xbd\xaf\x83\xb8\x70\xd7\xa5\xc5\x15\xee\x13\x76\xff\x5b\x59\xbc\x2b\x17\x14\xf4\xb4\x8d\x32\x7c\xeb\x08\x68\xc6\x12\x97\x58\x52\x07\x38\xde\xb0\xb5\x56\x30\x58\x1d\x8d\x34\x8a\x4e\x67\x07\x89\xfe\x0d\x31\x38\xe2\x34\x38\x9b\xc0\x4b\x4f\xf9\xfe\xb0\xea\xb5\x72\xd2\x77\xa2\x2b\xd8\xeb\xaa\x0d\x5e\x31\x4c\x78\x32\x77\xa9\xef\x91\x61\x91\x65\x2d\x3b\x44\xb9\xd2\xfa\xd4\xa3\x59\x3b\xfb\x45\x8b\x00\x1d\xd1\x97\x99\x62\xaa\x47\x2e\x63\x5b\x89\x49\xaf\xb7\xe0\xdb\x95\xb4\x5c\xda\xc5\x2e\xf6\x4f\xd2\x65\xee\x18\x16\xf6\xfc\xc7\xc1\xe4\x75\x7f\x36\xbc\x82\xd6\x60\x83\x49\xb5\xf8\x7a\x6f\x19\x6a\x85\x4a\xf5\x1c\x03\xdf\x8a\xc6\xe3\xf2\xc3\x37\xa6\x61\x93\x0a\xd2\xe8\x3d\x58\x93\xc5\xc8\xf2\x8e\x19\xdf\x5d\x0c\x03\xcf\x69\xb9\xd1\xfe\x32\x84\xe8\xd7\x23\xd2\xb7\x2a\xf8\xf2\x34\x9f\x87\xa6\x3e\x9d\x4d\x86\xd7\xf3\xf3\x31\xc2\xc9\x5c\x0d\x46\xb3\xb6\xf9\x13\x72\x7a\xd0\x57\x27\x00\x07\x03\x41\xc8\x5d\xaf\x42\x07\x0f\x96\xab\x4d\xb0\x51\xe1\x2e\xfa\xd6\xf5\x6c\x44\x5d\x90\xa0\xdd\x27\xc9\x63\xdb\x2b\xd2\x36\x3f\xb4\x57\xe1\x1d\xdf\x9a\xe3\x02\x12\x85\x50\xe9\xf6\x96\x26\xb4\x2c\xaf\x25\xa1\xc5\x01\xe8\x5c\xcc\x5f\x7f\x98\x4f\x06\x97\xfd\xd9\x70\x3c\xda\x75\x32\x9c\x0d\x57\x69\x11\x07\x8c\xbf\x05\x8e\x6d\x49\xbe\xf2\x78\xee\x90\xec\xe2\x2b\xb1\x10\x17\x61\x6f\x00\xa0\x75\x7e\x35\x87\xad\x7b\x6a\xfa\x6b\x96\xe0\xa6\x4a\x2d\x71\xe2\x96\x33\x8d\x59\xda\x67\xa5\xb6\x69\x21\x46\xa9\xb3\xd5\xcb\xbf\x6e\x45\xc1\x5c\x9b\x2e\xe8\xe0\x29\xec\x4a\x07\x1c\xdd\xb2\x28\xc4\x7b\xd8\x21\xd6\x30\xd5\x39\xc8\x95\x12\x6c\xbd\x35\x4a\x1f\x78\xd9\x81\x4f\xfd\x36\xc9\xb4\x95\x8e\xc8\x99\x3b\xf6\x21\x81\x12\x3c\xc2\xd5\x49\x3f\xd9\x2b\x35\x60\xf9\x1e\x1b\x87\x5a\xe8\xd6\xe6\xd9\x84\x8f\x3f\x2a\x41\x73\x07\x65\x42\xc6\x26\x7a\xcc\xc6\xe3\xcb\xd9\xf0\x7a\x1a\x16\xc5\x1f\x17\x7e\x68\xc2\xbd\x5a\x1b\xe5\x09\x95\x14\x47\x9c\x20\x8f\x3a\x38\xbd\x1e\x1c\x8c\x43\x26\x79\x21\x33\x72\xa4\x7a\x90\xac\xd0\x1a\xb1\x99\x8b\xae\x36\xdc\x88\x3c\x04\x1b\x09\xc0\x5d\x6c\xd6\xd3\x27\xa7\x91\xf8\x4c\x79\x2a\xe2\xaf\x36\x55\x74\x6d\x03\xac\xa5\xef\x5a\xe8\x41\xc7\x0e\x50\x5e\x00\x77\x1f\x3b\x8b\x25\x8a\xdb\x8c\x06\xf8\xab\x35\x04\xd8\x42\x16\x84\xdd\x6f\x9b\x6a\x41\x0f\xe5\xac\xa8\x16\x65\xf9\x04\xf4\x3d\x5b\xb3\x57\xb3\x7b\x48\xd1\xf6\xf7\x8e\xdb\xb6\x7a\xb0\x2f\x50\xec\xde\xcd\xfa\x8d\x06\xa3\x15\x36\xf7\xb9\xec\x81\x7a\xe1\x36\x11\x72\x1b\xa4\x47\xb9\x02\xba\x79\x89\x4d\x79\x5f\xeb\x82\xaf\xf4\xf1\xf3\x13\xd8\xeb\xb0\xed\x8e\xbd\x0d\x6c\x33\x6a\xcf\x26\x68\x5c\xe4\x68\x02\xc3\xf7\xe9\xcb\x5e\x89\x7c\x3b\xba\xf9\x52\xbb\xb2\x1b\xfc\x5a\xc1\x57\x2b\xc8\xaa\x83\x50\x97\xd1\x17\x75\xd0\xa1\xb6\x35\x71\x03\x07\xa0\x1f\x56\xb1\xfc\xa0\x4a\xf2\x97\x18\x4b\x91\xf2\xd6\x3a\x47\xec\x9b\xde\x77\xbd\x3f\x19\x73\x73\x2d\x57\x6b\xdb\x36\x98\x2a\x0c\x24\xb4\xa6\xac\x24\x01\xbc\xec\x1c\xb1\x53\x36\xc4\xf2\x08\xcc\x09\xe1\x10\x08\x08\x32\xe1\xf0\xc5\xf0\xe0\x00\x2b\x9e\x6b\xbb\x6b\x58\xcf\x6f\x95\xc5\xff\xa9\x28\x82\x64\xd4\x9c\xb2\xab\x40\x87\xb1\x11\x37\xd8\x20\x77\x24\x20\x48\x5a\xcd\xc9\x29\x72\x81\xdf\x9e\x04\x05\x37\x90\x27\x6c\x2e\x56\x58\x62\x9b\x7b\x11\x77\xe5\x18\xd3\x06\x79\x61\x3f\xc3\x8c\x77\x7a\x66\x65\x9e\x39\xc1\x88\x08\x01\x77\x9a\x6d\x0c\x2a\x56\x5c\xc1\x85\x0b\x3f\xda\x62\x36\xc8\x5f\x44\xeb\x92\x16\x1d\x94\x62\x50\x97\xa5\x19\x70\xa8\x2e\x93\xe2\x65\xc8\x57\xc1\xe2\x88\x91\xaa\x4c\xe9\x3a\x04\x63\x9a\x0e\x4a\x8c\x36\x19\x95\xaa\xbb\x2a\x83\xfb\x5e\xc5\xb0\xfc\x87\xaf\xa8\xcf\x3d\xc7\x7e\xd7\x6b\x11\xe5\xca\x0d\xd7\xd0\x50\xc3\xd3\xbc\x16\x7b\xd2\xe7\x21\xc4\x0e\xed\x29\x0b\xb9\x61\x91\xca\xb6\x96\x80\x01\x6c\xb9\xbf\xec\x11\xa2\xc5\xfc\x4a\x00\xe0\x1e\xf2\x0e\xc4\xa8\x73\xa3\x22\xe2\x68\x4b\x69\xaa\x04\xa2\xd9\x0c\xb7\x97\x6c\x4a\x78\xc8\x1f\xb1\xd5\xce\x9e\x4b\xab\xb1\xd8\xaa\x68\x7b\x4a\x6b\xe7\xc0\x8c\xc7\x0a\x34\x11\x92\x07\x6c\xd4\xdf\xca\xf4\xea\x5f\x5e\xbf\xeb\xbf\x1e\xcc\x86\x08\x7e\x77\x31\x78\xdf\x0a\xd6\x9f\x56\x27\x06\x53\x22\x73\x08\xa3\x75\xd4\x77\xcf\xaa\x5c\x81\x29\x46\x62\x08\x0b\x48\xa8\x1f\xbf\xef\x76\x8a\x91\x39\xce\x12\x45\xc9\x6d\xf5\x96\x6d\x5d\xdb\x18\xcb\x36\x34\x58\xf2\x03\xe0\xeb\x5b\x96\xd5\x48\x50\x39\x1f\x5f\x4e\xe7\xc3\xd1\x1c\x9e\x0d\xd6\xfe\x40\x42\xa2\xc7\x5a\x88\x54\x52\x6e\x52\x02\xb5\xf0\xa9\xd0\x2d\x54\xaa\x42\x34\x65\x89\x2c\x5a\xca\xfd\x9f\x37\xca\xfd\x5f\x34\x6b\xfd\x0f\xe9\x4f\xdd\xb2\xf0\xba\x1c\x68\x5b\x37\xd0\xe6\x5b\xe0\x0b\x8b\x3f\x1b\xb4\x7c\x85\x02\x0e\xbb\x69\xba\x30\x37\x26\xea\x57\x20\x5d\x15\xf4\x89\x5f\xca\xfb\x6e\xe5\x4b\x3e\x71\x3b\xc3\x66\x26\xe4\x26\x84\x3e\x49\x24\x0d\x48\x12\x37\xa9\x86\x37\xf5\xf0\xed\x68\x3c\x19\xcc\xaf\x27\x83\x37\xc3\xf7\x14\x43\x79\x20\xee\x65\xe6\x00\x19\x9b\xde\x53\x80\x7f\x14\xba\x91\x67\x40\x21\x18\xdc\xbc\xc4\x17\xb8\x93\x15\x8a\x5b\x87\xb3\xd4\x4f\x43\xf6\xea\x62\x2a\x01\x85\xa7\x3c\xda\x07\xca\x19\x50\xad\xf0\xfa\xf9\xad\x44\x8b\x43\x6e\x81\x77\x1d\x5c\x8d\x54\x99\xd9\x61\xd0\x30\xf0\x95\xc6\x85\x36\xa3\x65\xce\xc7\x37\xb3\x07\xd2\xbc\x31\xf8\xe8\x68\x12\xab\x28\xf0\x1c\x52\x84\x8f\x43\xca\x66\x90\xdb\x85\xb6\x0b\x54\x86\x54\x63\x95\xf8\xae\xf9\xc5\x70\x32\x38\x9f\x8d\x27\x1f\xc2\xa1\xc2\x86\xe9\xe0\xe5\xad\x4b\xb1\x30\xa5\xfa\x25\x33\xca\xe3\x01\xac\x57\xa5\x42\x9d\xeb\x42\x02\x54\x2e\x7d\x33\x7a\x85\x4a\xe0\x6f\x74\xe1\x8b\x7d\x92\x10\xee\x71\x1f\xd2\x08\x51\x6c\x9d\x6a\x04\x13\xf2\x7d\x0b\x29\x21\xe4\x25\x33\x6a\x71\x97\x61\xba\x68\x8f\xeb\xec\x01\x67\x7e\xef\xe9\x88\x50\x5b\x1f\x10\xa1\xd7\xa0\x02\x2a\x02\x0f\x47\xd8\x4b\x2d\xf2\x53\x8b\xfd\x07\x2f\xac\x29\x37\x9d\x23\x54\x6f\x5a\x48\x51\x47\x5f\x0f\x52\x3d\x76\x2b\x54\x2e\xe8\x8c\xef\x71\x76\xc2\x4a\x14\xd4\x67\x0d\x8f\x58\x4d\xcd\xa2\x86\xec\xa0\x4f\x41\x9f\x66\x02\xae\xb0\xc0\x2c\xdb\x44\x30\xbd\x16\xa2\x68\xa4\xd0\x1b\x3b\xc5\x16\x07\x41\xaf\x46\x07\x23\xa0\xda\x0c\x06\x2b\x63\x80\x54\xd0\x1f\x95\xaa\xcc\x6d\xc8\xc3\x23\x71\x4d\x06\x83\x1f\x87\x83\x9f\x4e\x7a\x6c\x08\x41\x0e\x63\xf9\x24\x5b\x96\x0b\xf4\xad\xc5\xae\x5a\xd5\xdd\x31\xd8\xfe\x1e\xf8\x82\x16\x67\xe3\x1a\x76\xb6\xa7\x77\xc0\xcd\x2c\x15\x77\x73\xa2\x90\xfb\x7d\xa9\x54\x11\xfe\x0e\xab\x86\x45\xf7\x22\x6d\xd6\xfd\x41\x95\x39\x4a\xcc\x37\x68\xf6\x50\xe1\x52\xca\x36\x2a\xae\x70\x7b\x6d\xf8\xa0\xae\xc2\x36\xe1\xf8\xc2\x97\x11\xf0\x95\xf8\x82\xda\xee\x34\x03\xa1\x4d\x2c\x9b\xea\x02\xab\x7b\x41\x7d\x2f\xb0\xf7\xa3\x0e\xf1\x0f\xfd\x3d\xaa\x99\x2e\xa1\xe9\x0e\x62\xed\xf1\x74\x65\x4c\x16\x88\x5d\x78\x78\x11\x88\x62\x40\x64\x22\x7c\x79\x93\xbe\x10\x7d\x2b\xb3\x55\xce\x7d\x4b\xbe\x54\xdc\x89\x3c\x34\x34\x5d\xd5\x30\xa0\x6c\x84\x8e\x13\x04\xdd\x04\x3d\xcf\x06\xaf\x00\x4f\x8b\x3a\xaf\x81\x37\x96\x8a\x60\x76\x40\x72\x3c\xc5\x71\xa7\x83\xdc\x16\xba\x47\x71\x30\x1e\xef\x4d\xa5\x69\x1e\x74\x64\xa7\x3d\xe2\xee\xf1\x67\x3c\x2c\xc9\x22\x8e\x05\xee\x0a\x57\x52\x41\x37\x78\x80\x9f\x78\xd0\x49\x9c\xa6\x0b\x61\x29\xd0\x7f\x02\xcf\x38\x94\xce\x86\x4b\x0f\x1a\x67\x84\x73\xa0\x20\xf7\x0e\x1e\x7f\x2c\x87\xe3\xc8\x0d\x79\x63\x7b\xbb\x94\x0e\x88\xf1\xb3\xc5\x3d\xee\xef\xce\xfd\x07\x3c\x87\xe9\xbb\x41\x3b\xa2\xc0\x0e\x1e\x88\xb8\x8e\xf0\x50\x80\x1c\x81\x7e\xf7\x42\x14\xae\x11\x87\xf5\x43\x83\xf4\x0f\xf9\xa1\xa8\xbf\xc0\x8c\x77\x5a\x94\x29\x95\x0a\x29\xf5\xc9\x43\xdb\x07\x1a\x50\x3d\x1d\x70\x17\xff\x58\xf2\x06\x42\x3d\xb4\x8b\x3f\x77\xf7\x5a\x56\xbb\x73\xeb\xa8\x7c\xac\xc0\x96\xb1\x15\xb1\x5e\x6a\x22\x3e\x14\x0f\x87\x5b\x50\xf1\xb6\xe0\xd6\x77\x19\xd7\x2e\x16\x0e\x8c\x9f\xab\x45\xa9\x0b\xdf\x83\xde\xec\xdb\x71\x6d\x33\x4f\xbc\xe3\x08\xfa\xb6\x00\x40\x07\x36\x44\x35\x72\x69\xa1\x55\x22\x0a\xf1\x34\x2c\x16\xcc\xbf\x95\xc5\x1a\xab\x7c\x88\xd1\xbc\xab\x3a\xe4\x38\x30\x78\x2a\x3c\x47\x37\x76\x4b\x1e\xad\xc7\xd4\x71\xfa\x42\xed\x8e\x0f\x90\x1a\x9d\xf8\xbe\xa1\xbe\xed\x1e\x4b\x97\xc0\x5d\x31\x79\xa1\x4c\x84\xcb\x76\xc7\xd1\x38\x64\x6f\xfa\x53\x2a\x35\x58\x59\x22\x37\xba\xb0\xf9\x0e\x41\xe6\xd5\x49\xa4\xc1\x3f\x0e\x6e\x4d\xea\x52\x47\x05\xbd\xd6\xa3\xdf\x32\x69\xe6\x61\xa8\x72\xcc\x72\xab\x30\x02\x65\x4f\xd3\x2e\x97\x59\xcc\x0b\xe4\xc1\x5a\x2a\x55\xb6\xf5\x5c\x4c\x03\xbb\xbc\x93\xc0\x0d\xe7\xd4\xef\xea\x1d\x5b\x01\x4e\x87\xc6\x6b\x2d\x23\x49\x00\xf5\x93\x9b\x4c\xe5\x98\x13\x80\x6d\xe2\xd1\x49\x0b\xb0\xa7\xe1\x77\x88\x3d\xd1\x4a\x25\x32\xeb\x8a\xc6\x64\x6f\xd0\x5c\x20\xa4\xae\x4a\xc3\xfe\xc1\xe6\x8b\x27\x87\x02\x5c\x3d\x05\xab\x37\x58\xb9\x95\xd5\x1f\x05\x4e\x84\x94\xa4\x44\x5a\xd0\x99\x21\x2f\xaa\x16\xdc\x07\x0d\xd4\x9b\xed\x91\xca\x64\xab\x11\x1b\x6c\x1e\x6c\x9d\xbd\x61\x30\xac\x5f\x6d\xc4\x58\x1d\x04\xda\xd8\xed\x18\xe9\xc6\x25\x87\xff\x21\x17\x89\x31\xfc\xfe\x8b\x74\x19\xbb\x85\xe1\x0d\x4d\x85\x4c\xe1\xf5\xe3\xd8\x2c\x51\x3c\x76\x4e\x6b\xdb\x1a\x2e\x18\x22\x20\x2d\xd6\xeb\x97\xba\x9e\xfc\xa7\xd2\x64\xdb\x63\x7d\x23\xce\xd3\x30\x67\x34\xe8\x96\x51\x59\x1f\xd7\xa7\x52\xff\x07\xe5\x01\x62\x7e\x99\xbf\xf8\x01\xfe\x93\x94\x32\xd7\x31\xf7\x09\x59\x25\x84\x27\xaa\xb2\xca\xf9\xf8\x72\x3c\x81\xe5\xce\xdf\xdd\x0c\x5c\x86\xbe\x43\x3e\x8b\x54\xa2\xdc\x71\xab\x5c\x85\x17\xae\x4c\x8f\xba\xa7\xfc\xa3\xa4\xda\x09\xf8\x8e\x4b\xa7\xaf\x0b\x90\x05\x8f\x3e\xad\x72\x6c\x0e\x86\xec\xc6\xa3\x48\xe5\xa4\xdc\x5a\x70\x1b\x18\xa3\xc7\xde\x95\xa2\x5a\xf5\xc5\x35\xb8\x3f\xd3\x55\x22\x30\x83\x13\x1e\xbc\x5b\x0b\x91\x74\xcd\x91\xeb\x1c\xed\x41\xa7\x32\x23\xb6\x29\x6f\x78\x7e\x5d\x12\x91\x73\x23\x74\x8e\xd8\xb3\x10\x88\x2d\x17\x71\x97\xfd\xe9\x99\x99\xd5\x56\x24\x89\xba\xeb\xb2\xe7\x2f\xe0\xd7\x55\x2e\x44\xda\x65\xcf\xbf\x83\xdf\xa2\x2d\x4f\xbb\xec\xc5\x37\xf0\xcb\x22\x29\x45\x97\x7d\xfd\xec\x59\xe7\x88\x65\x65\x9e\x25\x84\xfd\xf0\x35\x0e\x94\xdb\xe2\x93\x83\xa0\x47\xbf\xfe\xb6\xd9\xd1\xfa\xc5\x8b\x67\x4f\xc3\x2f\x35\x7e\x00\xbb\xfc\xc5\x8b\x67\xbb\x78\x66\xda\x9f\x35\x79\x26\x2b\x73\x59\x6c\xc1\xf7\xa7\x79\x41\x66\xe9\x89\x6b\x76\x01\xdc\x81\x40\x34\x0d\xa6\x42\xf3\x25\x04\x9c\xf5\x17\x02\xb0\x99\x39\x88\xab\x9c\x6f\x75\xc4\x13\x42\x98\xef\xb1\xbe\xc5\x64\x81\x06\xe5\xdf\x7e\x4b\x50\x70\x88\xc8\x81\x69\x66\x4a\x17\xec\x56\xde\xca\x98\xde\x7f\x10\xa5\x5f\x7c\xfb\x6d\x83\xd2\xcf\x9f\x3d\x3d\xa5\xa7\x08\x5f\xf1\xca\x0c\xbe\x8b\xd2\x6f\xfb\x57\x57\xfd\x26\xad\x57\x7c\xb3\xe1\x36\xd7\xdb\x63\xef\x5b\x79\x6a\x6e\xa7\x72\x23\x53\xe0\x69\x08\x0c\xa4\x22\x2d\xaa\x5b\xd1\xba\x11\x3f\x9a\xd5\x6a\xb6\x10\x89\xba\x83\x69\x1d\x19\xb2\xc7\x25\x28\x97\x2e\x94\x4e\x3b\x93\xc8\xd5\xba\x10\x39\x21\x56\x72\x8d\xb4\x82\xce\xd9\xb7\xc2\x7c\xbb\xfe\x0d\x63\xe5\x82\xbc\x73\xdd\xe9\x4b\xc1\x00\x17\x18\xf5\x21\xf3\x15\xaa\x3b\xe0\x51\x51\xf2\x84\xd6\x49\x8b\x83\x98\xe3\x77\xe1\xb1\x04\xac\x18\x7c\xc6\xb0\x4d\xef\xbb\x6e\x30\xf0\x8b\x17\x95\x13\x1c\x3c\xf8\xa2\xf7\x02\x0f\xa2\x79\xa1\xd5\x84\x8c\x6a\x86\x36\xbb\x23\x70\x0b\xb7\x7c\xd3\xc2\x2e\xdf\x34\xa3\x04\xdf\x3d\x3d\xb7\x20\x27\xb0\x57\xec\xbb\x67\x61\x43\x33\xf3\xd4\x6c\x78\x35\x98\xce\xfa\x57\xd7\x0f\x45\xfd\xc9\x0c\x55\xcb\x36\xb7\x18\xc1\xc7\x5a\x5d\xcd\x03\xd7\x40\xeb\x00\x50\xf6\xe5\x46\xf8\xfa\x05\x7c\x98\x57\x2a\x17\x7c\x02\x19\x84\x0d\xe9\xfd\x00\x68\x2d\x92\x0c\x34\x10\x63\xd6\xdc\x55\xe2\x96\x5c\x83\x6a\x06\xc0\x90\x68\x53\x94\xda\x57\x0a\x56\x6b\x77\x8c\x6d\x07\xe3\x98\x49\x7d\x66\x98\xf6\x00\xda\x7b\xaa\xee\x88\xd2\xc2\x53\x17\x1f\x46\xfd\xab\xe1\xf9\xfc\x6a\x30\xba\x79\x30\xeb\xa2\x41\xf0\x5a\xdb\xfc\x90\xf2\x1c\x13\x64\x31\x00\x02\x1e\xb8\x5b\x91\x63\x64\x26\xe5\xb7\x72\x85\x5f\xd9\x88\xb4\xac\xe2\x5c\x6d\x53\xbe\x21\x3f\x93\x35\x33\x6e\x25\x87\x9e\xd3\x53\xf0\x0c\x81\x29\x6b\x61\x9f\x30\x56\x1e\x0c\x68\xdf\x47\xf1\xc4\x00\x44\xbc\x4c\x0a\x69\xb4\xdb\x44\xdc\x8a\x04\xa1\xb3\xf9\x22\xac\x51\x2e\x3c\xe6\xe6\x66\x21\x62\xaa\xbf\x12\xd0\x55\x24\x60\xb0\x1e\xbb\x08\x73\x67\x7c\x7f\x43\x4a\xef\xb7\xb1\x7d\x87\xb5\xe4\x3b\x69\xfb\x45\x74\x41\xf9\x26\xc9\xf2\x43\x81\xec\x65\xb3\x4b\x3e\x33\x0d\xe7\x00\xbe\xa8\xee\x78\x5b\x16\x4e\xe5\xb1\x0a\x1e\xfe\x13\xf0\x86\x05\xc8\xa7\x5a\x38\xd4\xf1\x11\xb9\x1d\x5d\xca\x6b\x75\x97\x7a\x3b\xd4\x9e\xec\xb5\x39\x69\x8a\xef\x6d\x9f\xfd\x94\x14\xf2\xf0\xfa\xf6\xe4\xfc\x64\x78\x19\x9e\x81\xf8\xdc\x7c\x74\x73\x35\x1f\x8c\x66\x93\xe1\x60\xea\x4c\x5e\x8b\xb6\x4c\xa6\x17\x19\xb5\x3e\xea\x2b\xd2\x82\xaa\x01\x71\xa9\xb5\xde\xc1\x80\x1e\xe0\x20\x7b\x63\x40\x9e\x8f\xc0\x6e\x03\x90\xf8\x64\x0b\xda\x38\x18\xf8\x08\x4f\x70\x9f\x61\x1a\x1f\xe2\x31\x27\x09\xcf\xb4\xb0\x2f\xa9\x1c\xa9\x84\x83\x00\x4d\x6b\x55\x42\x38\x00\xd2\xc7\xbc\x1b\xd3\x4e\x20\xbd\x0d\xcf\x0b\x1d\x93\x02\xd5\x11\x50\x8e\x9d\x62\xdb\x58\x16\x1c\x27\xdb\x5d\xf9\xb8\x4c\x13\xa1\xf1\x9a\xc3\xfa\x6a\x3b\xc1\x18\xdf\xc5\x93\x5c\xf0\x18\xc0\x15\x84\x88\x35\x9e\x2a\xbe\x51\x65\x6a\x4c\xd3\xa9\x72\xa6\x6c\x25\x70\x8e\x79\xa9\xf0\xb6\xe7\x55\xa5\x89\x53\x17\xcf\xea\x5b\x16\xae\x4b\x78\x8f\x3d\xc3\x6a\x26\xeb\xc4\xb4\xca\xb2\xbb\x68\x11\x2a\xcb\xa8\xd8\x86\xe0\xa2\x6d\x81\x69\x6c\x01\xca\x75\x09\x50\x3e\xf6\xb5\x48\x49\x70\xb8\xd4\xdf\x7b\x88\xde\xf6\xe7\x3f\xff\xb9\xa9\x22\x3f\x99\xe2\xd6\x64\x58\xa7\xb8\xd5\x63\xad\x17\xe3\xf3\xe9\xa0\xa5\xd5\x5a\xb7\x9a\x0c\x69\x84\xed\xce\xf4\xf9\xca\xf1\xb6\x80\xcb\x54\xdd\xa3\x72\xd6\xcf\xb2\x44\x7c\xa9\xd9\x7b\x48\x6c\xfa\x1a\xb2\x32\x56\xf8\xc5\xd8\xf0\x9c\xca\x8c\xf8\x80\x8d\xbe\x95\xb9\x4a\x21\x07\xbb\x8a\x79\x44\xcf\x89\xbc\x67\xf4\x2b\xd1\x8b\xd4\xe6\xec\xde\x0c\x77\x76\xd2\x75\xe5\x12\x36\x33\x7f\x3c\x7d\xdf\x39\x62\xcf\x9f\xf5\xbe\x65\xc7\x97\x42\x65\x3c\x8f\x4f\x42\x28\x6b\x5e\x13\x59\x5a\x14\xbb\x62\xcb\xdc\xec\x25\xff\x24\xc2\xfe\xa0\x3b\x4c\xff\x49\x99\x22\x02\x9f\xd1\x23\x1b\xda\x7d\xac\x22\x0d\x7e\x1c\xe7\xc9\x76\xa1\x5b\xc3\x63\x79\xf8\x65\xc2\x33\xf6\xa9\xeb\x36\x7b\x2f\x1c\xe4\xff\x39\xbb\x94\x8b\x9c\xe7\xdb\xb3\x0b\x4b\x9b\xb3\xe9\x9a\xe7\x22\x3e\xbb\x08\x57\x67\x7e\x9b\x8a\xc2\x77\xba\x7b\xef\xd3\xcb\x96\xd2\x58\xb8\x05\xb6\x69\x80\x10\x5a\x99\xa1\x0f\xff\x21\xba\x27\xf4\x5e\x9e\x47\x6b\x79\x2b\xce\x28\xa9\x34\xe6\xe6\xd6\x4f\x84\x3e\x33\x12\x07\xd9\x03\xde\x75\x36\xf7\x45\x33\xed\x76\xed\x6f\x29\xe4\xeb\x8c\xde\x88\xf9\xd3\xd8\x35\x74\xc5\x10\x2a\x9c\xc8\xbe\x14\x46\x73\xec\xd7\x91\x38\x85\x88\x2d\x36\x10\x08\xbf\x94\x95\x9b\x45\x2e\x92\x84\x53\x0e\x0b\xba\xa4\x9c\x5a\xd2\xe0\x3d\x6d\x51\x65\xb4\x04\x9f\x01\x0d\x06\xb5\x94\x36\xf3\x98\x63\x7d\x67\x0a\xa5\x8d\xc8\x57\x05\xd3\xa5\x2c\xc4\x89\x3d\x77\xd4\x0c\x76\x37\x31\xed\x45\xe0\x8f\xad\x59\xd8\x63\x08\x4c\x24\xac\x93\x18\xff\x3c\x7f\x33\x18\x5c\xb8\x5e\x67\x08\x71\xd8\xfe\xca\x2f\x2a\x84\xb7\xf7\x0b\x48\x6a\x04\xa5\x0c\x53\x6f\xca\x54\xfe\x5c\x8a\x64\xcb\x64\x6c\x24\x36\xc5\x57\x9b\x9d\x78\x0a\xb6\x28\xd3\x38\xb1\x5d\x25\xbc\x07\x90\xb3\xdc\xa8\x76\xda\x7c\xc9\x68\xaa\xa7\xb0\xb5\xe4\xf2\x81\xf7\x75\x19\x45\xa0\x23\xb5\xe9\x6d\xb6\x44\xe9\xde\xd5\x16\x8f\x4e\xed\x0a\xa5\x7e\xca\x3d\xe2\x8a\xa0\xf8\x6c\x37\xe9\x55\xbe\xea\x59\x07\xfa\x35\xc1\xf2\x3d\x19\xdd\x5f\xdf\x8c\x2e\x2e\x07\xf3\xe1\x85\xa3\x7b\xcb\xeb\xac\xcd\x4e\xdf\xb9\xbe\x79\x7d\x39\x9c\xbe\x1b\x4c\xcc\xd7\x7e\xcd\x2e\xb4\x75\x66\x62\x59\xb9\x48\xa4\x5e\xa3\xcd\x7c\xe8\x26\x80\xe8\xf1\xdb\xb0\x6b\x13\x0e\xac\x2d\xa8\x2c\xdd\x4d\xe7\xc9\x68\x5d\xa1\x5b\x93\xd6\xf6\x85\x3b\xa9\xed\x1b\x34\x22\x1d\x25\x89\x9b\x5d\x74\xdc\xb9\xce\xdf\x74\x6d\x78\x8a\x5f\xb1\xca\x7a\xda\x92\xb4\xde\x0d\x2e\x77\xda\xf1\x71\xed\xec\x9b\x49\x61\xa6\x77\xa0\x58\xa0\xc8\xf6\xda\xc5\x4b\xca\xe6\x5b\xaf\xb3\xae\xfb\x67\x64\x5b\x85\xe0\xaf\x9f\x6c\x0b\x27\xf7\x28\xaa\x79\x36\xdf\xd0\xa7\xb2\x90\x64\xb4\xd8\x79\x57\x32\xca\x95\x56\xcb\xe2\x4b\x8d\x2f\x7e\x67\x8c\xb1\x9f\x54\xfe\x49\xaf\x55\x46\xd9\xfa\x55\x9c\xc5\x8d\xfd\x0a\xdc\x7c\x22\x3d\x2d\xf5\x59\xac\xee\x52\x63\x9d\x9c\x61\x57\x53\xdd\xe3\x3a\xbb\xff\xff\xcb\xf8\xd5\x8b\xe7\xcf\xbf\xfe\xee\x84\x81\x40\xa2\x8e\xfa\x3e\x4d\xbc\xf9\xc2\x30\x9b\xd5\xb0\xbb\x4c\x6c\x26\x07\x59\x16\xc6\x8e\x86\x0c\xc9\x6a\x86\x5b\x5b\x2a\x39\xb5\x29\xb5\x57\x08\x0d\x17\x07\x85\x24\xc7\xbd\x68\xbd\x39\xe9\xb1\xf3\xf0\xa3\x0a\x52\x4c\xaa\xee\x2a\xdd\x32\x68\x09\xec\xcf\xdf\xa1\xd5\x8a\xf7\x75\x37\xd4\x89\x5d\x38\x8e\x29\x00\x0b\xb1\x5f\x09\x9e\x67\xc7\xbd\x75\x92\x9d\x80\x27\x3c\x49\xdc\x13\x59\xc2\x0b\xf3\x80\xae\x46\x5e\x71\x7e\x39\x54\xb1\x77\x8e\xfc\xf4\xa9\xb8\xd9\xf9\x1c\xc8\xfe\xef\x42\x07\xed\x05\x76\x69\xb0\x65\xbc\x38\x43\x0b\xd7\x43\x49\xfb\x98\x64\x75\xa7\xf2\x5d\x3d\xf4\xfc\x26\xdd\xd9\xfd\x09\x5f\x69\x36\xe9\x56\x42\x4e\x0b\x0e\x15\xb9\x79\x06\xb3\xfc\x7d\x34\x19\x77\xf2\x1a\xd9\x8b\xe7\xef\xae\xf6\xb6\x9d\xa9\xc5\x88\xf0\x54\xa3\x79\x63\xa4\xbe\x61\x13\x62\x0b\xd7\x9e\x05\x40\x45\x38\xe5\x31\xfa\x94\x44\x3f\x94\x0c\x47\xb1\x62\x1f\xb1\x3e\x0c\xd5\x3d\x92\x30\x64\x9c\x19\x3d\xb0\x2d\x82\xfa\x18\xea\xc0\xfa\x1b\x89\xca\x76\xf9\xb5\x82\x05\xe7\xb0\x7e\x77\x0e\x5d\xdb\x67\x07\xe4\x2b\x26\x2a\x22\x14\x9e\x0a\xba\x16\x88\x1d\xdb\xb6\xd6\x91\xf1\xa4\x12\x7e\x02\xee\x77\x27\xfa\x78\xbd\x8e\x7a\xe2\x5e\x9c\x80\x3f\x2b\x55\xe9\x29\x60\x54\x74\x83\x7c\x34\x04\x98\xc9\xa1\x11\x6d\x5e\xa6\xbb\x46\x52\x75\x07\x8c\x13\x80\x96\xef\x60\x42\x6b\x6e\xc1\x96\x6a\x48\x84\x60\xb7\x42\x23\x91\xa7\x20\x76\x85\x98\x2d\xc4\x76\x23\x9c\xbf\x1b\x22\xb0\xbb\x8b\x0a\xc8\xa5\x11\x55\x16\x95\xb8\x17\xad\x65\x70\x07\x54\x30\x66\x8c\x50\xb6\x7d\x26\x31\x67\xa6\x92\x93\x5d\x83\x4f\xda\x70\x5d\x88\x1c\x58\x98\x84\xde\x68\xbc\x07\xf8\xe1\x09\x08\x51\x59\x68\x7b\x56\xb1\x61\x4c\xb4\xc9\x1d\xa0\x6e\x90\x58\x0c\xa8\xbc\x82\xbd\x2b\x36\x09\x5c\x10\x48\x8b\xe3\xf5\xfa\xd3\x49\xd7\x4a\x35\x60\xa3\x13\xf2\xfc\x54\x6e\x3a\x7a\xe0\xc9\x0e\x50\x6d\x9e\x95\x3d\x7d\x3d\x1c\xf5\x27\x1f\xe6\xb3\xf1\x79\x6d\x47\x2d\x4c\x24\x67\x0b\x99\x72\xc3\xc9\x75\xa9\xbc\x73\x5b\x39\x65\x04\xb5\x7c\x05\xfa\xa5\xd2\xde\xba\x4d\xed\xb1\x37\x08\x18\x83\x66\x24\xfa\x0d\xb0\x47\xa3\x79\xee\xda\x26\x40\x18\x42\x8d\xc4\xbd\xb1\x0b\x8a\x42\xa5\x4f\x2a\x9b\x5b\x89\x17\x10\xa7\xad\x5c\xca\xbc\x7b\x36\x3e\x9f\x0f\xde\x5f\xf7\x47\x17\x44\x3f\x94\x3f\xa1\xea\xa4\x40\xd8\x52\xae\x43\x21\x36\x58\xed\x84\xbd\xc5\x6d\xd3\x7a\x8a\x41\x8b\x16\x92\x35\xe4\x50\x55\xb3\x04\xe7\x9f\xf2\xfe\x3f\x73\xa5\xfd\xe6\x84\x09\x56\xdd\x46\x98\xba\x62\xf9\xc3\xbb\x36\x9d\x12\xe2\xf2\xaa\x58\xb3\x1f\xde\x5d\xfb\x56\x7d\x54\xd5\x6c\xfe\xf6\xe3\x70\x32\xbb\xe9\x5f\xce\xdf\x8c\x2f\x21\xb7\x22\x17\xe8\xd8\xc1\xeb\xab\xe9\xc9\x6a\x96\x1e\xd9\xf0\xc4\x4e\x47\xd6\x0f\x46\x73\xfc\xd9\x90\x95\xbe\x63\xa4\x52\x25\x93\xf2\x87\x22\x50\x60\x40\xdd\x33\xac\xde\xfb\x39\x5a\x9f\x34\x1a\xb2\xb9\xf2\x81\x43\x51\x11\x9e\x4a\x7f\x30\x04\xde\xb9\x0d\x3f\xbc\x7b\x5b\xbd\x23\xeb\xe0\x64\x82\xfd\x70\xfe\x6e\x9f\x96\x41\xfc\xf9\x90\x9e\xf1\x33\xf5\x33\x44\xcd\x0b\x74\x0b\x7f\x5f\x49\x1d\xe2\xe0\x36\xd3\x1c\xd9\x12\x1a\x3e\x3e\x86\x26\x66\xd5\x75\x92\xb8\x95\xec\x50\x18\xaa\xbc\x56\xb5\x93\x8b\x4a\xcf\x7a\x4a\x56\x84\x80\x61\x50\x1a\xf4\x43\x61\x99\xe0\xda\x42\xc1\x04\x69\x04\x8d\xec\xdc\x2c\x11\x5c\x63\x5e\x16\x7d\xd3\x7d\xef\xcc\x37\xc6\x6c\x58\x2a\xb1\x8a\x7a\x3f\x17\x3d\xa9\xac\x4f\x4e\x9f\xfd\x5c\x9c\x7e\xd3\xfb\xee\xec\xe7\xc2\x70\xab\xed\x04\x60\x34\xaf\x23\x37\xe7\x07\x6e\xc6\xcf\xf4\x57\xb4\x92\xba\x42\xca\x80\xfb\x1e\x70\x56\xb4\x1c\xeb\x5f\xb5\x07\xc6\x92\x08\x69\xf9\xeb\xf7\xe0\x47\x99\x17\x25\x94\x4b\x63\xc7\x51\xfd\x39\x3b\x71\x8b\x83\x9d\x42\xde\x35\x8c\xf6\xc0\x96\x98\x91\x9f\x60\x0b\x6a\xe4\xc4\x2d\x88\x55\x54\x91\x00\xd7\xf3\xf3\x9b\xa9\xc5\xf1\x0a\x7c\x16\x1a\x25\xaa\x2c\x5a\xb6\x00\x91\x97\x10\x1b\xcd\xc3\xca\xe1\x75\xf6\x6b\x28\x7d\x6e\x47\x7a\x43\xe8\x59\x9f\x41\x68\x9c\xd5\xa9\x87\x93\x3b\x04\xbe\x6a\x2f\x29\x1b\x34\xaa\x09\x8e\xf0\xf3\xfe\x6c\x46\x95\xd1\x55\xca\x85\x78\x7a\xbc\x28\x72\xb9\x28\x0b\xa1\x3d\x68\x1d\x61\xcd\xfd\x7f\x8c\x9a\x48\xad\x2a\x35\xa7\x83\xf3\x5f\x4b\x4d\x4a\xf2\xa0\x45\x7c\xa9\x2d\x39\x6d\x8e\x3b\x82\x2b\xeb\x5e\x0b\xdd\x90\x5c\xac\xef\x07\x03\xc2\x05\x29\x7c\x8f\x22\x1d\xbe\xf8\xd4\xcf\xed\x49\x48\xd7\x24\x4d\x48\xba\xb7\x8f\x37\x79\xd5\x12\x54\x9d\xce\x51\x4d\xd9\xa9\x5a\xb0\x3b\xcd\xd7\x9a\x86\xa4\x6c\x12\xb2\xd7\x7c\x7a\x3f\xaf\x33\xdb\x7a\xf5\x33\x97\xff\x76\xa7\x11\x5a\xd7\x2b\x07\xe7\x97\xc3\xeb\xe9\xa0\xdd\x67\xf9\x98\x70\x67\x97\x15\x6a\x85\xf6\x8e\x43\x32\xf6\x3e\x20\xd0\x91\xb6\xe0\xfd\x32\xba\xe7\x20\x4a\x64\xa6\x05\x2a\xe3\x59\x52\xae\x64\xda\x63\x33\x05\x7e\x04\x1b\xd9\x93\x9a\x3e\xa1\x2e\xf3\x9f\xb0\x3d\xac\xc3\xa6\xf0\xb5\xd0\xe4\x10\x20\x65\x7f\x23\xd2\x12\xa3\x81\xf4\x96\x2e\xe5\xcc\x55\x8d\x81\xd6\xae\xb5\x6e\xd2\xe6\x95\xef\x9d\x9b\x0d\x0a\xf9\x5c\x67\x55\x4a\x0c\x76\x10\x5e\x38\x4b\x1d\x8c\xa8\x96\x4c\xe0\xbb\x51\x8f\xab\xc4\xcd\xec\x53\x90\x80\x5b\xac\xc9\x92\x6b\x0e\xe2\x2d\xfa\xc2\xd6\x7b\x93\x0f\x92\xf6\x0c\x5a\xf0\x0d\x2f\xa8\xab\x5d\xe7\x88\xf5\x21\x65\x24\x52\xd9\xd6\xac\xc5\xd2\x38\xc5\xbc\x02\x85\x4e\x5f\x08\x68\x56\xe0\x6e\x91\x7c\xd8\xf9\xff\x77\xf2\xd5\x85\x4c\xe7\x75\xed\x3e\x05\x54\x7c\x18\x20\x77\xe8\x7e\xad\x0c\xf3\xd3\x1a\x7c\xbc\xc0\x30\x76\xf7\xf0\x33\x1b\x88\x71\xc4\x44\xa4\xfb\xbd\x1b\x4d\x34\xc7\x66\xb9\x50\xe7\x87\x52\x12\x02\x59\x6c\x10\x22\x6b\xb9\x48\xa5\xfd\x12\x3c\x2f\x0d\x83\xdd\xa5\xc1\x12\x7e\x33\x7d\x32\x24\x62\x9d\xd2\x35\x0e\xd9\xab\x57\x0e\x11\xa0\x11\x40\x17\x29\x7f\x04\x53\x78\x94\x6d\x23\x4e\x9d\xa1\xdb\xcd\xb5\x0c\xd2\xc1\x65\xc1\x36\x72\xb5\x2e\x10\xae\x20\x15\x91\xd0\x9a\xa3\x00\x0c\x9b\xe0\x4b\xd7\xaa\xde\xba\xca\x25\x15\xa9\x6e\x55\x99\x1b\xea\xd9\x50\xc6\xc5\x70\xda\x7f\x7d\x39\xd8\x0d\x2a\x51\xef\xe3\x1a\xa9\x34\x16\xa9\xb6\x4e\x40\x76\x5c\xf0\x85\x3e\x61\x86\x7a\x10\xca\xb0\xa9\x93\x41\x4d\x59\xdf\x27\x20\x8f\xc6\x15\x9f\x89\x9f\xa7\xcb\x29\x07\x9b\x9b\xd6\x82\x55\x55\x1e\xb0\x4a\x60\x42\x1d\x1d\x67\xfc\x72\x98\x87\x09\x07\x38\x54\x4d\x5c\xfb\x6d\x9b\xbd\x47\x49\x33\xdd\xc0\x4b\x5f\xd4\xb3\xec\xcc\x04\x08\x4d\x93\x2a\xd2\x8a\x66\x59\xb0\xe3\x83\xdf\xf0\x2c\x57\xf7\xa6\xd5\xaf\xd3\x32\xaf\x9d\xd8\x01\xe4\x27\x33\xeb\x3f\x85\xcc\x40\x82\x6b\x09\x5a\xb7\x7b\x79\x58\x69\xc9\x1b\x4b\x9d\x25\x7c\xcb\xd6\x52\xe4\xa0\x6c\x44\x55\x9c\xb2\xb0\x8c\xd4\xa5\x9b\xd7\x6f\x39\x06\x35\x9b\x19\x4f\x45\xd2\xe2\x01\xa9\xb4\x35\x77\x53\xb4\xb1\xb6\x60\x8a\xc7\x50\x4a\xe1\x32\x1b\x55\x2a\x5c\x4d\xa3\x1f\x6d\x49\xc5\x2d\xa0\x55\x51\xf9\x91\xcf\xb8\xbd\x53\xf9\x27\xc6\x1d\x42\x12\x86\x9b\x31\xb5\x52\x87\x19\x94\xec\xc2\x8c\xd1\x65\xe7\x53\xf4\x02\x2d\x73\x28\x05\xf6\x9d\xc1\xc1\x9d\x08\x40\x4d\x3c\xdd\xb2\x8d\x8a\x45\x9e\xda\x61\x4f\x7a\x2e\xe4\x54\x6a\x0b\x24\x9d\xe5\x6a\xc1\x17\xd0\x57\xb4\xc0\x3c\xe3\x25\xd5\xa0\xa1\xa2\xe2\xbd\x67\x16\x30\x8d\xfd\x28\xad\x71\x53\xad\xaa\x03\x80\xaf\xd6\x8a\xa7\x13\x9b\x24\x68\x14\x65\x82\xb8\x6e\x2d\xe2\xf4\xa7\xa8\xc7\xfa\x3a\xa8\xcf\xc2\xdb\xbc\x52\xa9\xe9\x4a\x2a\x5b\x83\x80\x6b\x1e\x7e\xdd\x05\xf0\xef\xb4\xad\xd1\xcc\xca\x02\x62\x67\xd0\x4c\x00\x7c\x5d\x45\x0d\x93\x0b\xbc\x82\x35\x28\xab\xeb\xc9\xf8\xef\x46\xc5\x34\x46\x4e\x45\x0a\x40\xde\x1b\xd7\xae\x5a\x5c\xdb\x2a\xfe\x1d\x02\xa0\xe0\x0b\x1b\xb7\x83\x83\x6f\x5b\x8f\x1a\x96\xcc\x6d\xfa\x5f\x53\x1a\x12\x90\xa7\xb9\x02\x41\x6a\xd5\xea\x0f\x7f\x9f\x8b\xdc\x9d\xec\xc6\xe1\x1f\x8c\x6e\xae\xe6\x3f\xf6\x2f\x6f\x06\xd3\xf9\xf5\x60\x32\xbf\x1c\x8e\xda\x5d\x63\x04\x88\x15\x66\x19\xda\x84\x40\x97\x1a\x5d\x4d\x43\x03\xaf\x2f\x55\x58\x02\x1e\x59\x03\x3d\xb6\xd5\x87\x58\x81\x2c\xaf\xd4\xaa\x38\xa8\xf8\x44\x60\x27\xdb\x32\x03\x67\x25\x8c\x1a\x4e\x07\x53\x92\x40\x53\xc2\x3d\xa1\x37\x9b\x5b\xf2\x56\x8a\x3b\x6b\x3c\x1d\x56\xa4\xd2\x2c\x3a\xf8\xe6\xb3\x77\xa6\x95\xec\x66\x67\xbe\x09\x94\x7f\xc3\xa2\x30\xdd\x10\x3a\xd2\x1c\xd9\x26\xc0\x04\xf3\x6d\x88\x6b\x9b\x87\xe2\xca\x6e\x1f\xe5\xe6\xb2\x3b\x19\x17\x6b\x76\x2c\x53\x96\xc9\x7b\x91\x68\xe7\xe0\x05\xf9\x64\xe8\xe5\x51\x9e\xf0\x54\x69\x4c\x02\x3e\x88\x64\xcf\xbf\x7d\xd6\x24\xda\x8b\x6f\x3f\x3f\x3f\xd4\xae\x77\xfe\xd3\xf0\x22\xe8\x1d\xf2\xca\x0c\x5e\x69\x04\x33\x03\x58\x4e\x00\x7e\xfa\x69\x38\xba\x18\xff\x64\xef\xe6\x87\x40\x79\x54\x26\xd2\x10\xd1\x4e\xdc\x63\x4b\x4d\x6a\xc3\xa4\xa9\xe0\x95\x72\xfd\xcd\x6c\x5d\x5f\x9b\x20\x22\x78\x07\x12\xfb\x37\x3d\xd7\x6d\xeb\xdb\x51\x35\xf1\x66\x3c\xb9\xba\xb9\xec\xdb\x26\xbe\x0d\x2a\xe8\xdb\x55\x8d\x0a\xae\xb7\x52\xbc\x7c\xa1\x6f\x57\x84\x3a\x58\x05\x83\x5c\xc9\x62\x5d\x2e\x20\xad\x24\xe6\x77\x0b\x9e\x17\x2a\x3d\xa3\x2f\x9c\x20\x76\xd9\x27\x1d\xf1\x4c\xc0\xd7\x02\x87\x83\xfd\x7b\x4f\xe5\xab\x93\x4a\xf8\xc1\xc8\xdb\x32\xe1\xd0\x93\x6f\xfa\xe3\x5b\x5b\xbb\x18\x48\xf3\xeb\xd1\x5b\xdb\xb4\xbd\xa8\x97\x53\xcd\xa0\xf0\x95\xbe\x13\x64\xc1\x42\x36\xb9\xb9\xa9\x52\x19\x19\xcd\xa5\x60\x50\xd8\x66\x74\x5a\x88\x8b\x4b\x8a\xad\x5d\x5b\xec\x0e\x5b\x59\x95\x8b\x97\x2c\x4b\x57\x35\xa8\x56\x48\xfb\xbf\x5d\xb1\x63\x33\xa6\xa6\x41\x17\x65\x11\xee\x22\x14\x02\x20\x29\x2a\x94\x30\x54\x7c\xc0\x45\x9a\xa5\xab\xa7\x82\x82\xa8\xec\x38\xf0\x45\x96\xae\x0c\x63\x60\x81\x2f\xbd\xc0\x43\xa7\xc0\xb9\x57\x69\xc1\xb4\xfc\x05\x44\xed\x25\x9f\x89\xf7\x7e\x47\x7c\xcd\xbd\xf6\xdb\x12\xee\x42\x2d\x19\x05\xec\x3d\xb8\x23\xdb\xc6\xc7\x82\x09\xce\x74\x19\x19\xcb\x63\x59\x26\xc1\xcd\x91\x97\xf8\x4d\xdb\x64\x62\xc3\x53\x2c\x89\xa3\x96\x30\x46\x47\x32\xf3\x9a\x7f\xd5\x33\xbb\x43\xb3\x71\xf0\x97\x94\x16\x54\xcf\xcf\x00\x08\x0a\x95\xe3\xcd\xbf\x71\x36\xb6\x2f\xa9\x6a\x0a\xb5\xef\x1a\x42\xed\xdb\xa6\x48\x7b\xfe\xf9\x12\xcd\xef\xd6\x68\x36\x1d\xfe\xe7\xc0\x49\xb4\xe7\xcf\xfc\x8e\x09\x66\x1f\x9b\x4d\xfa\xa3\xe9\x75\x7f\x32\x18\xcd\xec\x26\xba\xdc\xdf\x7a\xbb\x43\x90\xf7\x40\xa0\x8a\xfb\x0a\xba\x96\xb8\xc3\x96\x53\x3b\xdf\x8c\x43\x9b\x63\x73\xc6\x7a\x6c\x56\xfb\x4b\xd0\xaf\xc3\x77\x07\x71\x9d\x3b\xcc\x80\xc3\x01\xfb\x53\xef\x19\xf6\xdf\x81\xe0\xa5\x7b\x8c\x52\xa7\xaa\x8a\x6d\xa3\x5d\x20\xe8\x47\xc0\x2e\x75\x7c\x86\x90\x1b\x62\x61\x6e\xfe\x3a\x13\x58\x21\xdc\x12\x68\xf3\x1c\x10\xf8\x51\x90\x2b\x35\x3a\x02\xc4\x72\xe9\xec\xf9\xdf\xaa\x98\xa9\x6d\xf3\xea\xf0\x8c\xf6\x99\xab\xfe\xf9\x64\x0c\x51\x3d\x5b\xa1\x63\x0c\x53\x3c\x90\x1f\x53\x71\x67\x01\x5e\xcd\xff\x3f\xe6\x22\xf8\x4b\xd0\x75\xac\x70\xd5\x02\xa9\xb8\xa3\x2f\xbb\x5a\xf8\xa0\x95\x99\x4c\x2b\x62\x17\x7a\x6d\x1a\xfa\x23\x84\x3c\x24\xd3\x3b\x68\x2b\x42\x54\x19\xfa\xfc\x21\xfa\x26\xc2\xaa\xd8\x74\x42\xbf\x5a\xbf\x12\xef\xd5\x74\xe8\x98\xd4\x19\xa3\x3f\x7b\xf7\xf7\xfe\xfb\xc0\x6e\xce\x05\x78\x0b\x6b\x02\x08\xcd\x9b\x2b\x5e\xac\xff\xce\xef\xeb\x57\x0a\x64\x3a\xf2\x62\xfd\x0f\x7e\x8f\xb7\x0a\x6a\x2e\xd0\xfc\x3a\x4a\xa4\x00\xb9\x13\x87\x65\x6d\xce\x5b\x85\x6f\xb3\x2a\xa2\xbb\x68\xf0\x75\x59\x2e\x4e\xf1\x01\x63\xa7\xc8\x62\xc3\x33\xdd\xf3\x22\x94\xac\xfb\xb0\x70\x0e\x66\xed\x7d\xa3\x22\xa6\x56\x3e\xce\x65\x83\x62\x08\xd7\x04\xb7\x52\x96\x1b\xb3\xa1\x8a\xaf\xe0\xee\x34\x23\x46\x5d\x7e\x08\xe6\x04\x6e\xf8\x16\xfd\x09\xf6\x44\x58\x37\xac\xa5\x8d\xd5\x42\x92\x2d\x55\x59\x21\x70\x99\xeb\x96\xb8\x46\xee\x90\x85\x37\x19\x19\xed\xc2\x7c\x32\xb8\x84\x8e\x6c\xbf\x83\x61\x12\x6e\x7e\x4b\x34\x1f\x2e\x10\xbb\xa4\x40\xf9\xad\x3a\x5c\xfc\xdc\x5c\x58\x1d\x72\x36\x03\x06\xf7\xda\x82\x1d\xcd\x12\xd7\xb6\x2d\x77\x84\x93\x85\xa8\x84\x47\x30\x3a\xa2\x43\xd6\x3a\x13\xe9\x19\x62\x50\x9f\xd1\x30\x80\x78\xed\xcb\x42\xdc\x21\xd8\xa1\x50\x18\x5a\x9c\x9e\x4f\xa7\xec\xd8\x61\xcd\x59\xe8\x73\x23\x38\xd7\x64\x69\x2e\xa0\xa9\x26\x16\x4d\x14\x72\x21\x13\x59\x6c\x4f\xba\x6c\x04\x19\x05\x57\x57\x97\xe4\x2f\x30\x53\xbf\xba\x44\xa5\x64\xfa\xe3\xdb\xdd\x1b\x66\x5f\x7b\xc0\xb6\x85\x1b\x53\xdf\x34\xcb\x27\x5e\xb1\xa0\x4d\xb3\xe3\xef\xdb\x3a\xd7\x39\xaa\x2d\x6c\x53\xef\x1c\xbc\xeb\x2a\xdf\xcb\xb7\x44\x04\x5d\xc8\x14\x47\x0e\x3d\xf9\xe4\x9c\x0a\x1d\x7e\x34\xd9\xde\x3f\x34\x01\x06\x56\x91\x2a\xba\x36\x3f\x94\x18\xa1\x32\x9c\xd4\xb8\x00\xa3\x1b\x15\xde\x7b\x80\xc5\x88\xb4\x9b\xad\xf7\x11\x78\x47\xcc\xe9\xae\x2f\xc2\x7b\xcf\x7a\xbd\x33\xcb\x7b\x2d\xfb\x5a\x05\x26\xa7\x35\x20\x1c\x2a\x64\xdc\x5d\x88\x44\x42\x4d\xf0\x48\x14\xe0\xab\x22\x7c\x3c\x73\x7c\x7e\x2e\x65\xf4\xc9\xd8\xcf\x74\x06\x28\xf3\xd5\x76\x4b\xf2\x9e\x7a\xa8\x1f\x43\xea\xb0\x77\xea\x4e\xdc\x1a\x4e\x45\x88\x2a\x5d\xe4\x2a\x5d\x35\xb1\x0c\xad\x40\xe2\x40\x98\xc4\x82\x62\xfb\x91\x50\x59\xdb\x21\xbc\xed\x35\x1d\x8b\x2c\x51\xdb\x8d\xcb\x0c\x6c\xe3\x6a\x3b\x44\x14\xa7\xbd\x7f\xe8\xd8\xac\x37\xef\xa5\xa2\x38\x4b\xb3\x8d\xa5\xdc\xdf\x5e\x3c\x11\xcf\xdb\xed\x71\x3c\x4f\x22\xc2\xbc\x3c\x94\x10\xf4\x6f\x12\x13\xf6\x72\xb7\xa3\x38\x3c\xce\x83\x61\x83\xfc\xc6\x7a\xf4\x51\x04\x13\xac\x77\x12\xa6\x73\x16\x97\x79\x78\x57\xba\x1b\x0e\x99\x9a\x9c\x6b\x01\xdf\x05\x53\x7a\xc5\x66\xe2\xfd\x59\xff\x6a\x6a\x16\x61\xff\x4d\xa6\xef\xd3\x50\x31\x78\x59\x25\x53\xc9\x7e\x7e\x3e\xbe\x18\xec\xcb\x00\xe7\x41\xeb\xe0\x7f\xf0\x5b\x8e\x67\x96\x65\x52\x44\xa0\xe8\x42\x3e\x61\x2c\x02\x58\x7d\x3b\x88\x4a\x6d\x99\xa0\x75\x76\x58\x2a\x61\x8b\x90\xb6\x2b\xa1\x92\xad\xf4\x39\x57\x02\xa1\x57\xc1\x46\x7e\x1e\x7e\xd5\x21\x84\x76\x84\x0c\x74\xaf\x9f\x6c\x0d\xfc\x74\xd0\x9f\x9c\xbf\x1b\x8c\xde\x5a\x57\x5f\x20\xa6\x77\x22\x36\x62\x21\xc2\x42\xdd\x3f\x60\x81\x63\xa8\x37\x81\xa8\x26\x7d\x41\x18\x85\x5e\xa0\x32\x16\xec\x96\xb9\xb2\x2e\x6c\x5c\xcf\x8b\x65\x10\x54\x90\xe8\x59\xf7\x84\xf7\xea\xb6\x82\x47\x5d\x03\x5f\xb7\xd9\xa7\x46\x2e\xe7\x49\xd7\x81\x16\x1c\x87\x81\xf8\x13\xe8\x92\x8d\x25\x70\x3a\xf8\x08\x4b\x89\x4e\x70\x75\xd4\x4d\x92\xca\xbe\x1d\x05\x5c\xbb\x1d\xa8\x48\x95\x00\x55\x66\xa7\xef\xb1\x47\x17\xc2\x61\x3f\xf4\xb0\xa3\x12\x75\x3d\xa1\x84\x0a\xbc\x19\x02\x82\x2c\xb8\xe1\xd0\x2a\xd1\x6c\x7e\x2d\x60\x20\x15\x81\x32\x68\x56\x3e\x1d\x4c\x7e\x1c\x4c\xe6\xaf\xfb\xd3\xc1\xc5\x1c\x77\x14\xb4\x43\xaa\xbf\x64\xdc\x86\x07\xac\x9b\xc3\x42\xd0\x06\x2d\xf6\x5c\xc3\xe3\xe0\x52\xfd\x24\xb6\x0b\xc5\xf3\xf8\x3f\x0c\x53\xfd\xa3\xdc\x64\xf6\x8a\x09\x38\xa0\xd4\x82\xfd\x85\x83\xf5\x6e\x9e\xff\x2b\xfb\x23\x9b\x9a\x0d\xb8\xb3\x6c\x5a\xf9\xd0\x01\xea\x6a\x5b\x03\x30\xa6\x84\x58\xdc\x5a\xd4\x7d\xf0\x56\x71\x14\xec\x1c\xb1\xbf\x9c\xcf\x26\x97\x7f\xed\xb2\xbf\xf4\x2f\x67\x7f\x3d\xfb\x0b\xde\xee\x7f\x85\xad\x5b\xa8\x62\x7d\xd2\x63\x43\x0f\xe1\x59\x9b\x1d\x4c\x22\x2a\x73\x0d\xfb\x7c\x67\x28\x07\x73\xb1\x6b\x72\x39\x01\xf4\x3d\xbc\x01\x35\xf9\xee\xba\xc1\xad\xe8\x40\x43\x29\xfe\x07\x0e\x55\x4f\x2e\xfb\x92\x4f\x62\xab\xff\xda\x63\xd7\xe0\x8e\xfe\xcb\x20\x85\xce\xbe\xe0\x77\x4d\x44\x84\x61\x8b\x42\x6c\xcc\xdc\xff\x22\xc0\x1d\x04\x9f\x46\x46\xc1\x48\x9c\x89\x65\xa6\x12\xf6\x2d\x75\x48\xc3\x2e\xd3\xda\x0c\x26\x62\x8f\x0e\x43\x6f\x97\x3a\x80\x33\xf5\xbb\x6a\xa8\xb1\x30\x2c\x21\x34\x4c\xf8\x2f\xd3\xb5\x5c\x16\x7f\xfd\x63\x48\x99\xbf\x12\xc6\x1a\x30\x7c\x9d\x74\xb0\x2a\xeb\x40\xc6\x85\xd8\xa9\x41\x3f\x62\x5a\x67\x6d\x55\x3c\x2a\xe4\xad\x11\x1b\x2a\xa7\x15\xa2\x07\xc8\xf6\x58\xdd\x67\x5f\x3c\x85\xc9\x5d\x91\x71\xa1\x81\x41\x26\x77\x20\x0c\x9b\xe7\xa8\x26\x13\x03\x3e\xa1\x93\xe9\xd3\x6a\xa4\x11\xe5\xd4\xa6\x0b\xb9\x82\xb3\x3b\xb1\x60\x5a\xe4\xb7\x22\x0f\x8d\x4a\xfc\x3b\x19\xa4\xf8\x68\x88\x16\x33\x33\x1b\x40\xe8\x32\xc5\x9d\x62\xcb\x84\xdf\xaa\x1c\xb2\x60\x82\xf1\x42\x31\x61\x46\xc0\x83\x05\x08\x8a\xa9\xf5\x7c\x0f\x26\xa3\xfe\x25\x2d\x05\x8b\x8b\x0b\xb8\xff\x61\xc9\x1e\x91\x66\x97\xa4\xbf\x7e\x77\x4d\x7a\x30\x5c\x62\xfe\x5d\x16\xbb\x23\xcc\x42\xf7\x9d\xc8\x84\x53\x9e\xe1\x3d\xb5\x79\xd4\xc9\x09\x43\xa0\x82\x09\x2e\x55\xf7\x92\x30\x09\x86\x44\x19\x42\xcc\x5a\x57\x3c\x74\xc0\xf1\x77\x35\xf9\x25\x3a\x47\xec\x8b\x81\x7d\x64\x48\x83\xa3\x61\x64\x87\xae\xb9\x29\x3e\xaf\xf5\x4c\xc8\x5d\x4d\xd6\x6b\x72\x54\xdd\xb6\xad\x93\xe7\xa1\x5b\x38\x55\x2c\x51\xe9\x2a\x68\xc3\x83\x71\xcd\x77\xd7\x66\x77\x5b\x76\x0a\x04\x23\xb0\x5d\x8b\x84\x33\xfc\x15\x94\xb4\xf1\xd4\x65\xd5\xb8\xce\x1e\xb5\x3d\xf0\x5d\x79\x21\x3a\x4b\x44\x86\x1d\x34\xf7\x72\x5b\x77\x23\xd2\x73\x6c\xf4\xa4\x72\x76\xc0\x70\x41\x95\x8e\x18\x27\xa4\xe6\xfc\x66\x72\x19\xb8\x83\xd4\xc2\x9a\x69\x5e\xaa\xd1\x4a\x7a\x95\xde\x4a\x7a\x2d\x33\x4d\x10\xea\x3e\x86\x4c\x93\x64\xc7\x86\xa0\xf4\xcb\x49\xc0\x71\x34\x27\x73\x77\x99\x27\x48\x02\x47\x2b\x69\x9d\x48\x86\x5a\x78\xe6\xe8\x7c\x41\x88\x88\x30\x3b\xab\xeb\x22\x60\x89\xce\x11\x7b\xcf\x33\xc9\xd3\x5a\x92\xea\x3d\xfc\x11\x54\x46\x6a\xbf\x54\x63\xe1\xff\x3e\x0c\x5c\x67\xce\x96\x94\x91\xc6\x96\x91\x06\xe4\xda\x3d\xf1\x1a\x75\xd6\x4a\x53\xb8\x3f\x94\x8f\x8e\xe5\xa8\x22\x17\xf2\x84\xda\xae\xe5\x87\x45\xca\xff\xc9\xac\x70\x20\x1b\x00\xbf\xec\x66\x84\xcf\x91\x56\xb5\x9d\xac\x5b\x0c\x6d\xe2\xcc\x90\xad\xbe\x1d\x40\x1f\x55\xac\x2b\x72\xbe\x4c\x91\xd4\xb1\x3f\xbe\x31\x2f\xb8\x99\x45\x28\x84\x7c\x9f\x2c\x7b\x31\xd4\xc5\x8d\x11\xfb\x3d\x04\xb5\x42\x61\x80\x6f\xbd\xe8\xcf\xfa\xbe\x9a\xa7\xa8\xa4\x70\x4a\x4d\x25\x86\xa4\x3c\xb9\xe6\x5a\xb5\xa3\x43\x65\x9b\x2f\x69\x82\x66\x7e\xbd\xfb\x83\xda\x70\x1c\x40\x58\x3f\x41\x77\x8a\xaa\xaf\x79\x12\x3a\x77\x8e\xea\x8f\x59\x64\x8c\x5a\x35\x18\x4f\xdb\x92\x36\x6d\x96\xb7\x45\xb8\xc6\xde\x0b\xd0\x10\x1a\xba\xc0\x2e\xac\x27\x0d\x0e\x15\xa5\x0a\xe1\x6b\xa8\xed\xfb\x14\x55\x44\xd8\xe0\x62\x9d\xab\x72\xe5\x31\x63\x7c\xf2\xba\xa6\x6c\x42\xf4\x82\x55\x94\xec\x05\x8f\x5c\x23\xce\x5c\xae\xd6\x85\x9b\xd4\xd3\x0a\x33\x9b\x65\xe9\x5c\x0f\x3b\x56\xd3\xf4\x3f\x20\xb9\x03\x55\xc5\xae\x33\x76\x38\xbd\x6e\x99\x08\xed\x5c\xac\x79\xea\xf2\xcb\x2c\xa6\x3e\xdc\x79\x80\xbf\x5b\xef\x41\x88\x76\x90\x05\xfa\xa3\x2e\xb0\x3c\x8e\x3d\x76\x33\xf8\x16\xeb\xd7\x2a\xe5\x5e\x41\xce\xab\x2d\xad\x75\x37\x38\x76\xf2\x36\xfb\x69\x33\x76\x81\x3a\xb7\x92\xb7\x30\x0c\x9a\x1e\x34\xec\x86\x67\x19\x19\x39\xa9\xf9\x85\x72\x2d\x63\xc2\xed\x57\x00\x8a\x42\x1e\x5b\xe7\xc3\xf5\xcd\x85\xaa\xd9\xb7\x44\xca\xa5\x2a\xd3\x98\x0c\x1c\x74\xd5\x4b\xfd\x12\x79\xb7\x65\x0f\x5e\x99\x5d\x30\xc7\xf9\xf9\xab\x44\x45\xcf\xed\x6f\x2f\xcc\x6f\x2f\x58\xaf\xf7\x04\x9c\xd1\xf2\xd6\x7f\x57\x87\x2a\x8c\x78\xfd\x4e\x2d\xaa\x2e\xfb\xb3\xf6\xce\x77\xed\x46\x41\x38\xb7\x7d\xad\xe8\x6a\xef\x68\x18\x5e\xe6\xbb\xf0\xd1\xc1\x7d\xaa\xf0\xed\xff\x7d\x1a\x55\x99\x6d\xbb\x7f\x8c\x81\x8a\x94\xa8\xb3\x5f\x85\x08\xa1\x85\x0a\xe3\x57\x49\x75\x7e\x75\xe1\x0b\xd9\x1e\xa8\x81\xa9\x44\x7a\xf1\x2a\x04\x35\x1e\x62\x92\x46\x33\x8f\x5b\xe3\xee\xa9\x2a\xbc\x63\xe9\x66\x3a\x98\x5f\x5f\xbc\x21\x16\x09\xa8\x20\x35\x4e\xad\x9a\xcb\xd8\x39\x7a\xf0\x1b\x59\xbc\xc4\x2f\x61\x53\x16\xe1\x40\x24\x11\xe2\x11\x7a\xef\xe1\x03\x70\xed\x44\x6b\xa5\x6d\x1e\x9b\xd4\x90\xaf\x67\xf5\x84\xc5\xd6\x0d\x86\xce\x54\xba\xd0\x23\x07\x92\xcc\xd3\x55\x09\xe9\x20\xd5\x36\x3f\x80\x8b\x6b\xe4\xe0\x42\x08\x0c\x1d\xc6\x72\x09\x7d\x79\x8b\x04\xe2\x30\x4d\x07\x95\xb3\xea\x6d\x45\x11\xb9\x68\x6a\x6f\x7a\x2a\x26\x70\xdb\xbb\x83\x09\xae\xfa\xdf\x0f\x10\xd8\xe0\x50\x46\xa8\xb3\x80\x3d\xca\x2e\x17\xda\x5c\xfd\xc0\x2d\x61\xf3\x07\x5a\x89\x3d\x8d\x2e\x3e\x46\x08\x82\x67\x50\xdc\xd3\x5b\xf0\xa2\xda\x84\x14\x17\x51\x99\xa4\x57\x2d\x78\x5e\x34\xf3\x3e\x6d\xbc\x16\xad\xce\xe3\x5e\x21\xee\x1b\x99\x51\x4e\x2d\x33\x2f\xc5\x4b\xee\xb3\xc9\xdd\x42\x48\x24\xb7\x7b\x47\xf5\xdc\x55\xd7\xf4\x38\x9a\x07\x39\x37\x0d\x9a\xbb\xb6\x93\xce\xcf\x9c\x2a\x50\x81\x74\xc2\xf5\x9a\x1d\x7f\x3c\x31\xca\xda\x52\xe6\xba\x60\xd1\x9a\xe7\x3c\x42\xcf\x59\xd0\xb7\x9e\x97\x85\xda\x38\x28\x60\x1e\x07\x40\x22\x56\x0a\xc4\x62\xff\xe6\xb6\xee\x49\xb0\x23\x7e\x97\x5b\x68\xd7\xb6\xc9\x3b\xb8\xa5\xfd\xca\x78\xc2\xad\x6d\xdb\xb0\xe6\xd6\xd2\x45\x78\x3e\xbe\xba\xee\x9f\xcf\xf6\xdf\x83\x1e\xfe\x0b\x82\x39\x10\x91\x8f\x0a\x97\xe8\x61\xb5\x1c\x4d\xaa\xf3\x86\x6f\x89\x3b\x8c\xf2\x0c\x4e\x99\x0d\x47\x2c\x4d\xaf\xff\x9a\x87\x1c\x4e\xb7\x91\x4b\x1a\xbb\xf5\x14\xb9\xc0\x5c\x52\xca\x5c\x7c\xaa\x04\x8c\x76\x7a\x55\x49\xd0\x5a\x17\x72\xdd\xbf\x1e\x4c\xe6\xb3\x0f\xd7\x0f\x26\x84\x67\x3c\x33\xba\xee\x36\x13\x8d\xbe\x50\x94\x0b\x99\x43\x23\xdb\x9d\x49\x11\xfc\x1b\x76\xfc\xe2\xf9\x33\x76\xcf\x5e\xfc\xf9\x7f\xb2\xcd\xe6\xa4\xcb\x12\x0c\x5d\x1c\x7f\xd7\xfb\x96\xdd\xb3\xe7\xcf\x99\x4c\xa3\xb5\xd0\xf0\xc9\x8a\x27\xf4\x41\xe7\x88\x3d\xff\xc6\x7e\x04\xa4\x15\xf7\x22\x2a\x41\x19\x38\xfe\x9f\xbd\x17\xf0\xdd\x67\xbd\x6f\xed\x23\xbb\x09\xca\x1f\x95\xd0\xdd\x4e\xd0\x80\x5a\xd5\x94\x16\xfe\x4d\xd5\xd6\xb8\xee\x9f\x7f\xdf\x7f\x7b\x78\xe7\x18\x3c\xce\x19\x8f\x3e\xf1\x15\x01\x06\x58\x18\xd6\xdd\x60\x42\x15\x95\x8d\x30\x2a\x70\x80\xba\x39\xcc\xfe\x51\x42\xb6\xc9\x62\x0b\x45\x71\x68\x3f\x07\xb5\xa1\xd4\xfa\x80\xe9\x6d\x5a\xf0\x7b\xc6\x2b\x09\x64\xee\x29\x7b\x22\x3e\x96\x5a\xb8\x37\xa1\x40\xec\xd9\xde\x8d\x40\x48\xb9\x11\x1a\x93\x41\x97\x61\x3b\x10\x97\xd9\x43\x04\xf0\x66\x81\x25\xd7\x2b\xfc\xaa\xca\xeb\x7f\xff\x27\x7c\xf0\xaf\x8e\xeb\x25\x8f\xce\x19\xc2\x59\x2f\x12\xb9\x91\x85\xf6\x33\xe5\x1b\x0d\xf1\x6d\x3b\xcb\xda\x9b\x5b\x5e\xfc\xbf\xdc\x28\xff\xfb\x9f\xf4\xed\x7f\xa1\x2c\x09\x5a\x95\xa5\x8a\x60\x6f\x68\x5c\xaf\xac\xda\xad\xf9\x7c\x16\xab\xf1\x0f\x0b\x7d\x35\xfe\xd2\x3a\xa4\xa7\x67\x26\x72\x0d\xc5\xc2\xc8\x27\xb6\x8d\xa6\xb2\x40\xe6\xfe\x5a\x70\xda\x37\x08\xba\x5e\xd8\x86\xb1\x96\x4b\x03\x10\xf4\x05\x98\xc6\x65\x5a\x48\x1b\xf4\xc9\x35\x35\x7c\xc8\xa0\xc7\xde\x70\x49\xa1\xbe\x47\xb4\x09\xb4\x8d\x40\x29\x1f\xf1\xf1\xdd\xdd\x12\xe1\x3b\xb8\x19\x7d\x72\x47\x27\x48\xf2\x5b\x52\x1e\x3f\x55\x78\xbb\x2b\x74\x9c\x62\xdb\xb7\x7a\x97\x3c\xdb\x94\x12\xf3\xfc\x3e\xa5\xd0\x79\x81\x17\x58\xfb\x97\x1b\x53\x58\xa6\xab\xff\x41\xa5\x92\x4b\x95\x24\xea\xce\xd0\xc8\xe5\x63\xa2\x8d\xee\x70\xbe\x37\x82\x43\x05\x5b\xd0\x9f\x10\xdf\xf0\x92\xfd\xa1\x90\x45\x22\xba\x9d\x23\xf6\x87\x98\x17\xc2\xf0\x7d\x17\xff\x69\x7e\xe0\xfa\xa8\x67\x65\x97\xfd\x81\x2e\x1d\x73\xa0\x83\xdf\xa0\x7c\x07\x86\xa0\xbf\x2c\x72\x29\x96\xfe\x81\x44\xad\x54\xcd\xa9\x6f\xcb\x3f\xf1\xf5\xfe\x24\x41\x1d\x7d\x00\x80\xea\xb3\x2a\xe1\x79\x00\xc2\x26\x41\x4f\xf9\x16\xe8\x17\xf1\x99\xa8\x16\x1d\x1e\xaa\xe1\x10\x7e\x1e\x9d\x0b\x41\x9f\xa9\xa7\x52\x0d\x1a\x1d\x32\x6b\xc7\xe6\x90\x0e\x99\xb5\x63\x13\xb4\xc7\x3c\xe4\xd8\xd0\xe3\xbb\x8f\x8d\x6f\x69\x47\x4d\xd4\x3e\xef\xd4\x04\x6d\x2d\x3b\x47\x55\xe1\xf0\xe8\xee\x9a\xf5\xde\x9a\x66\xdb\x1f\xd7\x5d\xf3\x80\x93\x44\xef\xd8\x73\x92\x9e\x88\x1f\x1a\x1d\x33\x6b\xfc\xf0\x34\xed\x0c\x91\x0f\x3e\xaf\x95\xa1\xed\x30\x82\x97\xf4\xe7\xb7\x32\xac\x77\x16\xfb\xbf\xb6\x63\xe0\x13\x71\x4a\x83\x13\x5a\x39\xe5\xf7\xee\x06\x58\xf1\x1f\xf9\xae\x4f\xd5\xbe\x80\x7e\xb5\xbf\xa6\x67\x5e\xe7\xe8\xf3\xba\xe6\xed\x27\x6a\xd0\x37\x2f\x44\x3d\xb9\xbe\x78\x33\x7f\xf7\xe1\x7a\x30\x81\x22\xb7\x36\x03\xcd\xeb\xb8\xcd\xc2\x6d\xc2\xcb\x31\xf7\x38\x95\xbb\x20\x1c\xb1\xa6\xa0\xf2\xf5\xc5\x1b\x76\x4c\x39\xfe\xfa\x45\x16\x2f\x01\x96\x9e\x7c\x4b\x27\x28\xab\xcd\x33\x0e\x67\x0f\x7b\xf4\xc0\x49\xc2\xea\xc0\x5a\xfd\x78\x90\x07\x77\x12\xa6\x7c\x40\x3b\x18\xb8\xd8\x44\x1a\x09\x32\x12\x81\xa8\x9f\x88\x9b\xe9\x60\xe9\x52\x22\x18\xe2\x12\x10\x67\xa0\x58\x16\xb2\xa3\x40\x99\xa2\xb4\x12\x33\x25\x04\xf1\x7d\xb2\xd4\x99\x1d\x96\x4c\x95\xfa\x0d\x17\x2e\x6d\x52\xd5\xed\xb7\xc7\x97\x6c\xa4\xbd\xf3\x05\x56\x1d\x52\x45\x48\x6e\xe4\xdf\x64\xeb\x4b\xba\xe8\xaa\xc5\x1e\x92\xd3\x56\xa4\x85\x2e\x8e\x58\x80\x3f\x78\x2d\x57\xe6\x24\xfd\x5c\xf2\x44\x16\x5b\x18\xf9\x40\x94\xc2\x27\x21\x5e\x85\x2a\x2d\x89\x47\x44\x3c\x3c\x04\xaf\xfb\xb3\xf3\x77\x57\xe3\x8b\xc1\x5e\xfa\xf1\x18\x1d\xda\x1f\x17\xbc\x88\xd6\x1b\x15\x0b\x2c\x10\xd8\x04\x98\x98\x75\xed\x83\x68\x06\xeb\x71\xdd\x38\xf2\xd2\xfa\x2f\xcc\xd7\x3e\x09\x91\xd9\xf6\x1d\x9d\x23\x73\xf3\x8a\x3c\x87\xb4\xa3\x28\x2a\xf3\x6e\x25\x7f\x49\x7f\xb2\x49\x6f\xa0\xb3\x19\x56\x5d\x8b\x24\xa3\x17\xf8\xc2\x52\xa8\x4f\xa1\xca\xe3\x3a\x9a\x5d\x50\x52\x08\xa7\xe6\xb7\xf5\x71\xd4\x89\x5c\xf3\x71\x54\xb6\xe2\xdd\xf0\x02\x4a\xf6\x87\xe7\x83\x9d\x0d\xa4\x6a\x69\x39\x85\xbd\xc1\xe9\xd2\x21\x2c\x11\xd4\xd9\xb4\x6f\x7f\xf1\xc6\x30\xf6\x10\x91\x03\xce\xd5\x26\x83\xd6\x9c\xf4\xbb\x28\xa2\x9e\x43\x89\xdd\x17\x61\x79\x3a\x9a\x54\x56\xbb\x93\x26\xd3\xf1\xcd\xe4\x7c\x00\xc9\xc4\x07\x91\xc4\x92\x03\x6f\x31\x60\xd0\x98\xec\x05\xf2\x1e\x98\xc3\x09\xcd\x0c\xd1\xc2\x69\xba\x2a\x1a\xe1\x09\xb8\x02\x61\x3c\xcc\x5d\xa2\xa6\x5b\x86\xc7\x02\xa7\x3d\xdd\x9e\xa8\x1b\x68\xea\x09\x65\x44\x2d\x2d\xe1\xf5\x64\xfc\xd3\xd4\xda\x12\xbf\x2d\x69\x43\xa2\x35\x5c\x6a\xc4\x91\xc3\xd7\xa8\x4e\xec\x73\x29\xa3\x4a\x44\xc8\x90\xde\xcc\x58\xc8\x45\x22\xd5\x2a\xe7\xd9\x7a\x4b\x7d\x27\xb2\x84\xcb\x34\xe5\x05\xa4\xc7\x4a\x21\x44\xe1\x14\xff\x87\xdb\xb5\xbe\x96\x0b\x43\x7e\x28\x27\x8c\x64\x51\xeb\xdd\xfa\x40\xb9\x72\x42\xed\x54\x9f\xe4\x88\x3a\x82\xb8\x23\x0a\xe3\x37\x38\xf2\xb3\xfa\x2f\xfe\x36\xad\x17\x47\x63\xdf\x79\x11\xab\x58\xd5\x26\xe3\xb9\x95\x95\x74\xc3\x87\xad\xfd\xf2\xf2\xe9\xf0\x9c\x1f\xa2\x6a\xa3\xad\x62\x93\x13\x07\x57\xe3\xbf\x0f\x83\xa8\xe8\x8e\x60\xac\x79\xed\xb1\x0b\xb4\xaa\x9c\x59\x54\xf9\x13\xa0\x69\xb1\xc6\xeb\xda\x43\x46\x88\x8d\xfa\x87\xac\x94\xe3\x53\x27\x0b\x0c\xdb\xee\x0c\xda\x76\xab\x41\x8e\x7a\x4d\x58\x45\xe7\x0d\x54\xdd\xaa\x2b\x0e\x0f\x49\xfb\xb3\x95\x8a\x90\x27\xd3\x65\x6b\x54\xfc\x77\xe5\x10\x4c\x66\x6f\x7e\xaf\x0c\x02\xf3\xaa\x83\xf3\x07\xfc\xbc\x2c\x62\x98\xff\x0b\x23\xdd\x61\x23\x7f\x21\x65\xfd\x27\x95\xc7\xec\xcf\xff\xd3\xc5\x4a\xcc\x45\x0b\xc5\xb2\x85\x52\x58\x30\xbb\xc5\x3b\x05\x85\xfe\x64\xf6\x06\xb2\x00\x78\x2c\x72\x7d\x26\x62\x59\xa8\x7d\xf0\x78\x9d\xca\x32\xda\x83\x1f\x93\xd9\x9b\x83\x33\x14\xcc\x28\xff\x7d\xf2\x13\xf2\x62\xf9\x18\xc6\x36\x93\xaf\xb3\x75\xb0\xf8\x6a\x2c\x23\x2f\x96\x2d\x61\xb4\x3d\xcc\xb0\x23\x88\x86\x1b\xf7\x7f\x44\x08\xad\x8d\x46\xe1\xe2\x5b\x78\x88\x48\x64\x28\xb9\xdf\x92\x05\x02\x36\xec\xd8\x9a\xe9\xb9\xde\x66\x22\x37\xf6\x27\x5b\x4a\x91\xc4\xba\x67\xf9\x34\x00\x84\xdf\x6b\xa6\xba\x8a\xda\xfd\x86\xea\x41\x66\xaa\xd1\x46\xaa\x86\x2a\x1c\x5f\xb3\x6d\x6a\x63\x1d\xbe\xf0\x27\x5b\xcd\x9c\x08\x7b\x58\x2b\xa0\x69\xac\x58\x2b\x8d\xcd\xfd\x61\x71\x81\xbb\xd0\x7c\xfd\x9a\xc7\xec\x18\x5c\xf7\x18\xe9\x83\x81\xb5\xad\xbe\xb7\x63\xc0\xb2\x7f\xcb\x4d\xaf\x6d\x67\x7d\xd3\x2f\x15\x27\xd7\x1d\xba\xb1\xc0\x0f\x28\x51\x56\xc3\x0d\x89\x90\xf1\x53\x54\x86\xcd\xe0\x72\x23\x13\x0e\x91\x07\x3a\x2d\x00\x5c\xdb\x96\x1c\x88\x10\x71\x4c\x0b\x68\xed\x09\x06\x99\x96\xab\x94\xce\xce\x07\x55\x42\xaf\x76\x42\xc3\x54\xb6\x78\x02\x1b\x86\x5a\x77\xbc\xee\xb2\x8d\xc4\x5a\xa0\x70\x6a\xd4\x6d\x80\xae\x11\x99\x57\x49\xe7\xb3\xd5\x09\xc9\xf0\xb1\xd1\x97\xb0\x6c\x21\x08\xba\x84\xfe\x3e\xea\x41\x8c\x03\x62\x47\x0d\x74\x11\x3f\xaa\xc3\xdf\xae\x1d\xf3\xae\x3b\x97\x8a\x0b\xde\xa6\xa9\x28\xe8\x2a\x85\xfe\xaa\xb9\x44\x50\xc9\x96\xfc\x08\xc2\x15\xe6\xa9\x95\xf5\xe4\xd6\x77\x3b\xd9\x39\x6a\xdd\xcb\x96\x9d\xec\xb1\x3e\x2b\xc4\x26\x33\x37\xb7\x2f\xea\xad\xe4\x2a\x57\x22\x09\x78\xaa\x2c\x6d\x4e\x85\x91\xc1\xfe\x7b\x6f\x1e\x09\x43\xbc\x8b\x44\xbe\x32\xb7\x4a\xa2\x40\x8c\x7d\x9e\x35\x08\xb6\x20\x41\xe9\x3e\x64\x0d\x06\xba\xc2\xff\x19\xb6\xe0\x4e\xa6\xab\xdb\x81\x4e\x4c\x3c\xa5\x6a\x76\xa0\x66\xb8\xe1\x29\x0a\xf9\xdf\x49\x3d\xbc\xea\x8f\x0e\x57\x0f\xed\xe4\x2c\xb8\x57\x94\x70\xad\xa9\x7d\xf1\x01\x8d\xc9\x3a\x95\xb7\xb6\x6b\x73\x57\xfd\xd1\xc1\xda\x9c\x9f\xce\xef\xa5\xce\xb1\x7e\xa0\xc2\x6d\x78\xfa\xb5\xf7\xd3\x53\x6f\xf9\x20\x9c\x16\x00\x4f\xbb\x14\x8e\xc5\x16\x2a\xfa\xed\x1a\x1f\xd6\x0f\x37\xfc\x51\x76\xbb\x21\x6a\x33\x8f\x6e\xd4\xae\x1f\x6e\x78\x1a\x92\xdc\x49\x15\x18\xb2\xd6\xa4\xd6\x03\x1a\x58\xd5\xa7\x92\xec\x1e\x9a\xed\x6e\x4b\x2a\x99\x73\x04\x0e\xe6\x6e\xa4\x58\x09\x8d\xea\x40\xc1\x73\x82\x2a\xe6\x04\x58\x49\x1d\xf8\xe1\xdf\x9d\x23\xf6\x35\xe4\xa6\xe6\x20\x34\x04\xa5\xa4\xc7\xaa\x60\xc7\xbd\x13\x0b\xe4\xb1\x10\x2b\x89\x6d\x8d\x2d\x58\x40\x63\x41\x20\xf6\xed\x05\xb2\x9b\x49\x7b\x5f\x3f\x05\xbd\xfd\xab\x03\x7a\xf7\xbe\x0e\xc9\x3d\xbd\x79\x7d\x31\x9c\xb4\xd1\xba\x15\xd8\xdc\x71\x17\xc2\x9b\x57\x58\xa8\x8a\x00\xe9\x8f\x04\x00\xd0\x1a\x75\x02\x2d\x79\x5a\x2c\x04\x0a\xcd\x43\x98\xde\x10\x72\xa4\x9f\xb6\xcb\x1b\xb0\x18\x94\x3d\x02\x75\x7b\x94\x1d\xbe\x8b\x3c\xb4\x76\x56\x0f\xe8\x0e\xfd\xe6\xed\x50\xc1\x41\xce\xb4\xd8\x2a\x3c\x25\x69\x89\xc5\xf7\xd8\xac\xab\x2a\xb9\x54\x2a\xc2\xd8\x2f\x50\xc0\x56\x32\x81\xef\x49\xa4\x85\x84\x5e\x03\xa8\x30\x78\xbd\x22\x17\xd0\x87\xc5\x12\xf6\x58\x9f\x58\x0c\xc3\x60\x3c\x8c\xd9\x81\x5a\x47\x97\xa8\xfd\xaa\xfb\x22\x16\x8d\x10\x72\x0b\x06\x53\x36\x6e\xcb\x6c\x7c\xe0\xce\x46\x10\x4b\x82\xdf\x52\xd8\x3c\x3b\x4c\xef\xca\x5c\x3e\xf3\xe7\x5f\x89\xbb\xf6\xa8\xaa\x34\xff\x9b\xaf\xc4\xf7\x41\x57\xd2\xa7\x7c\x7d\xcb\x6d\x68\x5e\x75\xf0\x6d\x18\x54\xe4\xfa\x96\x5e\x59\x51\xe6\x36\x00\xee\x50\xac\x95\x05\x53\x8f\x45\xd0\xd6\xd1\x18\xcb\x8f\x68\xcb\xd5\xa9\xcc\xb2\x25\x60\x35\x43\x5a\x1d\x7c\x7d\xbe\x77\x38\xf3\xff\x2d\xbc\x21\x87\x55\xf2\x55\x88\x50\x67\xdd\x60\xf5\x55\xd6\xa5\xf2\x3d\xda\x6e\xf3\xd8\xf5\x64\xfc\x76\xd2\xbf\xba\x1c\x4e\x67\xc3\xd1\xdb\xbd\x9b\x1e\x03\xbe\x06\x56\xe0\xad\x72\xbe\xa1\xcc\x05\xd0\x5b\x8f\xfd\x96\xb6\xa9\xcb\x80\xee\x96\x2b\xad\x4f\xad\xdd\x8e\x4a\xb4\x33\xc0\x4e\x7c\xeb\xb1\xf7\x01\x4c\x8b\xd7\xa7\xab\x68\xd4\x30\x1f\x63\x50\xca\xa5\x8c\x78\x5a\x24\x5b\xc3\x53\xb9\xb0\x77\xad\x96\xbf\x08\x44\xd7\xa9\x8d\xf8\x54\xe1\xd4\x5d\x94\xaf\x91\xb4\xc5\xc7\x62\x9e\x1a\x4d\xe7\x57\x83\xab\xd7\x60\xbc\xcc\xa7\xe7\xe3\xeb\xfd\x21\x55\xb2\x4f\xa8\x6b\x04\x36\xe1\xb2\x3d\x0a\x25\x09\x72\x1d\xa9\x0c\xda\x79\xdc\x89\x24\xe9\x62\xaf\x9d\x4a\x5b\x8a\xa7\x0d\xd9\xed\xa2\x41\xcb\xea\xfe\x7d\x82\xf3\x62\x7c\xfe\x7a\x3c\xfe\xfe\xf7\x12\x9e\xf6\x75\x07\x0b\xd0\x0b\x15\x2d\x94\xfa\x84\xb7\xa7\xcd\x94\xae\x05\xd9\xdc\xc3\xd7\x17\x6f\x0e\x95\x8f\x76\x22\x4d\xeb\x82\x3e\x39\x58\x44\xda\x19\x36\xc5\x24\xae\x7f\x77\x7c\xe4\x51\x62\xb2\x73\xe4\x9b\x1d\xef\x13\x93\x31\x4e\xe9\x31\xcc\x6a\xe9\xd1\xd2\x90\x3d\xa4\x86\x17\x97\xf4\x8e\x60\x93\xed\xa3\x8f\x14\x9b\xd5\xb0\x3b\xc9\xce\xcf\x93\x9c\x10\xbe\xaf\xc8\xce\x16\x5e\x7f\x8c\xf4\xc4\x56\xcb\x55\xf9\x69\xa5\x67\x6d\xd4\x27\x12\x1e\xfb\xf6\xa3\x46\xe4\xdf\x51\x80\xd8\xc4\xe0\x7e\x59\xa8\xb7\x22\x65\x17\x81\xef\xf1\x77\x92\x22\xfd\x9b\xd9\xf8\xed\x60\x34\xbf\x18\x3c\x22\x6e\x05\x30\x26\x6d\x93\x76\x58\xe8\x2f\xcf\xce\x78\x59\xa8\x95\x48\x7b\xa8\xa1\x2f\x55\xbe\x12\x80\x42\x78\x52\x69\xe5\x8f\x0a\x9c\x45\x7a\x7a\xb4\x0a\x57\x49\xe6\x93\xda\x76\xf6\x40\x04\x4a\x5d\x98\x59\x8b\xfb\x4c\xe4\x12\xbe\x92\x00\x8f\xcb\xd4\xb6\x4a\xb0\x26\xed\x46\x3d\x8c\xa8\x58\x95\x77\x21\xc9\xfe\x9d\x8a\xfa\xb5\xc8\x01\x3e\xba\x4c\x7e\x37\xf7\xd5\xf5\x60\x72\x79\x35\xbe\x78\x04\xa7\x84\xb3\xa4\x06\xf4\xd5\xad\xff\xd5\xba\x7b\xc5\x07\x1a\x6e\xfe\x6f\xb8\xf5\x76\xfd\xad\x21\x2d\xfa\xf0\xb1\x45\xe4\xe0\x86\xb0\xbd\xa6\x00\x0e\x94\x0a\x05\x21\xb7\xb7\x8b\x04\x44\x38\x23\x74\xfd\xf9\x32\x46\xaa\x38\xb2\xb6\x6b\x78\x79\x23\x92\xe0\x8f\x43\x8f\x14\xeb\x12\x07\x9b\x7c\xf3\x54\x92\xd6\x92\xa7\x91\x38\x59\xa1\xcc\xae\x90\xa0\x7d\xea\x7a\x32\x98\xcd\x3e\xec\x0a\x09\x36\x67\xef\x6e\xf7\x54\x46\x02\x2e\x18\xbc\xaf\xcc\x51\xd1\x00\xe9\x4c\x4a\x4e\xc6\x73\x0b\xd8\xc4\xd6\xe5\x86\xa7\x14\x70\x73\xf8\x1e\x0e\xdd\xa3\x02\x4d\x0d\x28\x11\xb1\xc8\x21\x07\x1d\x13\xef\xa5\x66\x2b\x85\xe8\x62\x3d\x36\xa6\x24\x35\x70\xaf\xaf\x79\x1a\x13\x32\xae\x2f\x2c\xa5\x35\x8c\xc6\x5d\xba\x9a\xc3\x4b\xef\x81\x05\x6d\xca\x68\x8d\x61\x5e\xaa\x30\x80\x67\xe1\x53\x58\x0b\xba\x5e\x20\x98\x59\x10\x0c\xc6\x93\x99\x1d\xfb\xf6\x92\x76\xa9\xd5\x2c\x46\x20\x56\x65\xe1\x81\x3f\x89\x20\x88\xd4\xa8\xaf\x35\xa7\x02\x58\xbd\x07\x4f\x02\xeb\x23\xce\x47\x96\x8b\xa5\xbc\x0f\xab\xe9\xb0\xa2\xc6\x46\x73\xd1\x6b\x64\x27\x74\xd5\xff\x7e\xf0\x63\x7f\x62\x26\xf6\x66\xf8\xbe\xbe\xa7\x86\xe8\xca\x17\xb8\xb7\xbd\x35\xe8\xe7\x60\x91\xdb\xf8\x26\x28\xdc\x8d\x55\xfa\xa5\xa1\xb7\x2d\xbe\x17\xe8\xca\x82\x7d\xff\x52\xfb\x25\x3e\x25\x95\xab\x8b\xfa\x77\xe5\xcf\x64\xb9\x20\xbc\x33\x95\xff\x56\x77\xcc\x60\x04\xcd\xa1\xae\x27\x83\xeb\xc9\xf8\x7c\x30\x9d\x1e\xa2\xf0\x0a\xc3\xde\x70\xcf\x40\x46\xc0\xf9\x69\x38\x53\xd2\xe4\xe5\x2d\x04\x50\xca\xd4\xf9\x18\x5d\xa8\x0c\x6f\x04\x50\x99\xf7\xc4\x53\x6c\x73\xa2\x96\x39\x36\x73\x98\x01\xe0\x1f\x7b\xe3\x4f\xc3\x7e\xeb\x3b\x57\x71\x9f\x41\xe7\x82\x24\x61\x1b\x1e\xe5\xca\xd5\xb2\x56\xe6\x4b\x60\xbc\xc3\x65\x28\x4e\xc0\x07\x1a\xa9\xd4\xb9\x46\xcd\xfd\x26\x13\x8b\x4a\x64\xd1\x18\x33\x91\x1b\x99\x28\xe2\x1e\xbb\x82\x37\xc0\x2b\x75\x00\x41\x13\x2b\xec\x43\xc5\x6d\xe7\xc4\xc4\x9c\x3b\xbe\x35\x47\xc1\xb7\xf0\xc2\x7e\xff\xf3\xf1\xe8\xf2\x83\xa1\x02\x28\x8d\x4f\xd1\xaa\xaf\x95\xb0\x4d\x4f\x69\x95\xac\xed\xb7\x48\xcb\x14\x0d\x71\x5b\xf6\x44\x7b\xc8\xa8\x5a\xbc\x96\xd4\xd1\x4d\x8d\x54\x52\x33\x28\x77\x0d\xc3\x87\xc6\x60\x0a\x02\x4e\x4e\x52\xe1\xab\x87\xa3\xc1\x05\xa1\x94\xd1\xbc\xfa\xd3\xb9\xfd\xbb\x99\xc2\xef\x41\xb7\x16\x8a\xb4\xd0\xcd\xa2\x1d\x8d\xce\x2f\x6f\x2e\xda\x52\xbd\xbb\x14\xa3\x08\xce\x8b\xaf\x2b\xa2\xaf\xcd\x01\x0b\xdc\x5e\x5f\x88\x98\x64\xa4\xb4\x31\xdd\x8f\xec\x57\x25\x1d\xc7\xcf\xbd\xaa\x0e\x5a\x7c\x7d\x59\x6d\xd7\x55\x65\xf2\x07\x16\x2a\x59\x2f\x81\xf4\x4d\xde\x6c\x8e\x51\x95\x44\xae\xaa\x0d\xf3\xe2\x2d\x94\x84\x86\xf8\x84\xaf\x61\xaa\xe0\x49\x5a\xfb\xdd\xcb\xb2\x83\x31\x9d\xfc\x4a\xeb\x94\xa8\xac\xb2\x16\x0c\xfa\x40\xb5\xde\xb6\x4a\xdc\x3e\x0b\x7e\xb5\xeb\xfe\x6c\x36\x98\x10\x34\xfb\x0e\x72\xdc\xc9\x24\x8e\x78\x8e\xa9\xc4\x45\x21\x72\x63\x0c\x42\x82\xd5\x57\xbd\x35\x1c\xc0\xaf\x7a\xeb\x2c\x3b\xc1\x10\x0b\x62\xf8\x96\x38\x77\x2c\xe2\x3d\xad\x31\x54\x40\xdf\x5a\x1a\x2b\xf2\xa1\x7b\x4b\xed\xec\x55\x67\xec\x25\xe0\x81\x69\xad\x07\xf1\x54\x3b\x75\x7c\xf5\x5b\x70\xf6\x0f\xe4\xa6\x40\xec\x87\x40\x67\x0e\x1b\xcd\xf7\xe8\xa9\x5c\x70\x60\xe8\x60\x3b\xe4\xe3\x20\xcb\xc6\x3c\x77\x7a\xe1\x8a\x1f\x97\x90\x09\xdf\x39\x62\xab\x28\xa2\x2a\x2e\x9e\xaf\xc0\x96\x72\xfd\x21\xf1\xb0\x73\xd7\x6b\x9d\x04\x9b\xed\x7d\xa7\xf2\xcd\x4b\x8b\x80\x40\x7e\xe1\x57\x3e\x51\x8a\x1d\xa7\x66\x59\x3c\x12\xfa\xc4\x75\x2b\x0d\x3e\xb6\x2d\x68\xbf\x78\xf5\x05\x9c\x04\xb5\x91\x05\x34\xdc\xfe\xe2\xd5\xf3\x2f\xd0\x54\xe7\x5a\x97\x70\x3d\xcd\x20\x95\xf5\xd6\xcc\x8d\x13\x59\x82\x91\xc0\x86\x59\x08\x2c\xa5\xb7\xd4\xb9\x95\x9c\x1d\xc1\xaf\x38\xbb\x5c\x44\x65\xae\xe5\xad\x48\xb6\x74\xb5\x42\xb6\x10\xd2\xef\xe5\x2b\xa6\x32\x6a\x61\x5e\x6b\x88\xe9\x3f\x79\x2a\x46\x09\x18\x61\x77\xfc\xb5\x7a\x2f\x21\xb2\x61\xf3\x96\xb5\xd7\x55\x33\xaf\x1f\xf3\xed\x1f\xaa\xce\xae\x6c\xea\x8e\x2e\x09\x44\x27\x9b\x87\xdc\xa0\xbc\x4d\x45\x68\x55\xa4\x2a\xb9\xe3\xae\x35\x97\x5f\x3d\x4d\xb0\xd6\xf1\x07\x0b\x9d\xbd\x4a\xde\xfe\xce\x4a\x95\xab\x3d\x13\xc4\x0d\xcd\xb9\x38\x74\x9f\x27\xbc\x38\x83\x2b\xbb\xb1\x7d\xd3\xef\x87\xd7\xf3\x37\x37\xa3\xf3\xd9\x70\x3c\xc2\xce\x4e\xfb\xaa\xa4\xbe\xd4\xd5\x43\x4c\xd9\xac\xb6\x8f\x1b\xe0\x0a\xd8\x84\x53\x10\x97\x04\xac\x8f\x6d\x85\xe9\x60\xba\x5b\x85\x27\x20\x47\x52\xd8\xe6\x54\x74\x21\xcf\x11\xe1\xa8\xcd\x50\x65\x96\x89\x1c\xf2\x42\x10\xee\x00\x43\xfa\x04\x6c\x16\xdb\x54\x10\x2d\x36\x32\x52\x89\x31\x66\xa7\x65\x88\xe5\x8f\x6f\xb3\xe8\xdb\x0e\xc5\xdf\xb6\x10\x62\x0b\x25\x13\x91\x9f\x62\xce\x9e\x21\x3d\xbe\xc1\xa6\xdc\x2e\x5d\xcb\x44\x63\xad\x42\x01\x3b\x36\x4b\xab\xe4\x37\xfc\xd6\x1a\x40\xdb\x16\x79\x0d\xe0\x29\xad\x99\x03\xcc\x29\x87\x52\xe9\x37\xf9\xc9\x2d\x2a\x43\xd2\x59\xff\xed\xa3\x0a\xaf\x0b\xdb\xb4\x93\xfa\xa1\x18\xcb\x16\x1b\x4e\x93\xcb\x2e\x40\xb3\xf4\x15\xed\xb8\x94\x5a\x47\x79\x27\x54\x20\x6d\xa9\x02\x6b\x09\x91\x69\xdf\x21\xd4\x75\xd6\x01\x67\x89\x1b\x1f\xee\x03\x4a\x99\x01\x1c\x4c\xb7\x98\x57\xf0\xb5\xe7\xf0\x5f\x0b\x75\xd9\x8f\xc1\x45\xe8\xbe\x6d\x9d\xea\x85\x6f\x43\xaa\xd1\xbc\x79\x78\x50\x04\xd3\xfc\x02\x87\x7e\x65\xc6\x7b\xf1\x05\xbd\x02\x23\x54\xf0\xb9\x61\x6f\xc0\xd9\x24\x9a\xb6\x15\xff\x40\x70\x0a\x10\x79\x6e\x26\x97\x1e\x93\x3d\x44\x68\xb9\x94\x29\x56\x8d\xaa\x1d\x64\xfc\xa2\x1d\x8a\x82\x2f\xac\xda\x54\x6a\x1b\x76\x76\x3b\xe7\x12\xc3\x07\xb4\x7b\x48\xe4\x4d\xa9\x0b\x0b\xa6\x42\x70\xa7\x70\x95\x1f\xfb\xc0\x1b\xfc\x0e\x69\x62\xa3\xf1\x2c\x08\x00\x93\xa6\xb5\x3e\xa1\xf2\x24\x37\xa6\xd4\x54\x7e\x12\xf1\x20\x79\x27\x08\x9d\xd9\x2c\x29\x6b\xe8\xc2\x2d\x95\x97\x29\xf6\x88\x86\x29\x61\xd6\x74\x50\x49\x05\x31\x3d\xd2\x5f\x0a\xbe\x82\x17\x99\x29\xf6\x3a\x1d\xb7\x59\xac\x79\x93\x02\x4a\x30\x41\x25\xc3\x42\x64\xa8\x15\x22\x86\x84\x6f\x0c\x8c\x03\xd5\x2c\x70\x6a\x0a\xc8\xe9\xa2\xf2\x5e\x6a\xa9\xab\xd8\xd6\xa1\x02\x2f\x0b\xf0\x1f\xd2\x06\xb7\x6c\x6d\x88\xf5\xfe\xd8\xcd\x85\x9c\xd4\xca\xe6\x76\xea\x6b\x68\xd1\x26\xfa\x97\x97\x16\xbe\xb6\xd5\x82\xe3\xe0\x6d\xa0\x29\x41\x3a\xa9\xbb\xbb\x8d\x92\x00\x3b\x49\xdb\x8e\x9f\x12\x88\x6e\x8b\xd3\x01\xa9\xb1\x16\x39\xd8\xc3\xd5\x41\xbd\x4a\x40\x89\x1b\x0f\x89\x7a\xf0\xb0\x57\x26\xbe\xd3\x43\xec\xa0\x79\xdf\x4e\xc6\x37\xd7\xfb\x57\x08\x9d\xb6\xeb\x4b\xf4\x7e\x15\x74\xba\xee\x5b\x64\x54\xe6\xa0\x9e\x50\xa1\xcd\x97\xba\x36\xec\x01\x4b\xac\x21\x2e\xd3\xec\xeb\xa6\x68\x7d\x91\xd7\x0e\x0c\xed\xa1\x35\x56\x63\xe5\xf5\x5d\xb4\x37\x4f\x46\xcd\x69\x1f\xb7\x50\x3f\x76\xe7\xc8\x8f\x7e\xf0\x3a\xaf\xab\x68\x5c\xff\x9e\x0b\xb7\xa0\x0c\xd6\x42\xa9\xe4\xb7\xf2\x5d\x9e\x5f\xf6\xa7\xd3\xf9\xc5\xb0\xff\x76\xd2\xbf\x6a\xdd\xb2\x5d\xd1\x31\x3c\x65\xb1\xe4\x94\xe5\x74\x4c\xf5\xff\x3e\xd0\x83\x2d\xb3\xfc\xb1\x2a\xd6\x20\x91\xa0\x92\xa8\xcc\x84\xfb\x28\x2c\xc1\x15\x64\xaa\x9b\x0b\x61\xcc\x8a\xd2\xd8\xc9\x28\x9f\xe1\x3d\xc6\xae\x5b\x36\x42\xa7\x64\x2e\x82\x54\xbe\x53\xf9\x27\x7a\xd9\xbb\xfe\x8f\x83\xf9\xc5\x78\x06\x36\xb9\xed\x91\xe2\x1b\x13\xed\xea\x72\x97\xa2\xc9\x15\xab\xa2\xcb\xb4\x4c\x23\x61\x9e\xdf\x42\x09\x13\x08\xbe\xce\x11\xcb\xd4\x9d\xc8\x97\xa5\x39\xa6\x3c\x5b\xef\x75\xbe\xd6\x88\xdc\x38\x3e\xd6\x87\x61\xdd\x2f\x6e\xb1\x1b\x6e\x8b\xed\x63\xc9\xcd\xd9\xb7\x9b\x51\x0b\x25\x87\xd8\x5e\x78\x7c\xb0\xed\xb2\xf9\x16\x16\x0e\xc5\x65\x24\x42\x42\x92\x07\x59\x8b\x1c\xc9\x41\xb7\x60\x75\x58\x34\xa8\x2e\x86\x7d\xef\x5f\xe2\xa0\x84\xc0\x4d\x58\x2b\x26\x0e\xea\x71\x3d\x64\xb8\xe4\x6c\x21\x53\x9e\x6f\x59\x2e\xb4\x8c\xf1\xa6\xb7\x3e\x11\x00\x1b\xc3\x95\x39\x33\x9a\xe2\x82\x15\xfb\xc8\x55\x17\x21\x8c\xb9\xb9\x6b\x7b\x9d\x8e\x9b\x56\xcb\xbd\xea\xc5\x04\x99\x30\x56\xde\x03\x4c\x11\x76\x51\x4d\x12\xbe\x50\x74\xf8\x70\x1b\x91\xc5\xd7\x32\xae\x3c\x4e\xfd\x6c\xf0\x5e\x43\x9d\xc9\x9c\x53\x69\xdd\x0f\xf9\x4a\x20\x42\x66\x1a\xa4\x04\xa3\x87\xc3\xe8\x19\x74\x50\xf6\xb1\x08\xa0\x37\xdc\x8c\x2e\xc6\xe7\xf3\xc9\xe0\xb2\x3f\xa3\x86\x7a\xa1\x84\x35\x34\xb7\x08\x9d\x96\xb5\xad\x63\xab\xbd\x5c\x07\x69\x5a\x91\x23\xa8\xcd\x38\xe8\x1f\x1f\x5a\x05\xaa\x92\xd5\x82\xcf\x21\x06\xac\x5a\xb2\xb7\x86\x3a\xb7\xf2\x97\x7a\x2f\xd5\xbb\xbb\xbb\xde\x8a\x3e\xc3\xd6\x17\x5d\xc6\x91\x96\xec\x56\xea\x92\x27\xf2\x17\x4e\x98\x2e\xea\xff\xa5\xee\xda\x7a\xdb\xc8\xad\xf0\xbb\x7f\x05\x8b\xa0\x6d\x8c\x2a\x86\x9c\xac\x81\x76\x1f\x0a\x4c\x6c\xd9\x51\x21\xc9\x86\x2c\xef\xb6\x40\x00\x81\x9a\xa1\xa5\x49\x38\x43\x81\x33\x92\xad\xfe\xfa\x05\xcf\x39\xbc\xcc\x45\xb2\x2c\xd9\x0f\xfb\x94\xd8\x9e\xe1\xf0\x72\x48\x9e\xeb\xf7\xc9\x9f\x29\x05\x72\xa3\xc9\xdf\x26\x78\x48\xac\x62\x91\x1b\x9b\xea\xab\x90\x92\x0d\xf8\x8c\xea\x1f\x31\x1c\x69\x0f\xc4\x94\xb0\xa3\xad\x96\x02\xea\x60\xae\x88\xe5\xd9\xc5\x2b\x1d\x58\x09\xf2\x23\xe1\x15\xb1\xfb\xe6\x76\x93\x58\x93\xa0\x4a\x1a\xd8\x64\x3a\x7a\x18\x4e\x27\xdf\xc6\xbd\xe8\xea\xde\x29\x67\x94\x82\x0f\xb5\x07\x66\x8a\x12\xf0\x90\xae\x49\x91\x2f\x02\xdd\x11\x77\x8c\x05\xc1\x33\x9b\x32\xcd\xcd\xcc\x72\x29\x85\x24\x66\x25\xea\x6f\xb7\xba\x7a\x33\x6e\x79\x89\x49\x83\x73\x9f\xc3\x0c\x29\x34\xc2\x03\x1c\x27\xe7\x4f\xd8\x14\xa5\xc8\xce\xdc\xb1\x62\x9a\x4f\x4b\x26\x9e\x97\x32\x8d\xd3\x52\x02\x77\x3a\xc7\xd9\x30\x2a\x80\x11\x61\xa2\x36\xe8\x5a\xd8\x1d\x0a\xa5\x80\x0f\x03\x1a\x9d\x71\x09\x9b\x67\x26\xca\x27\x21\x72\x76\x79\xf7\xc0\xa4\xe2\xc8\x5a\x4d\xbd\xc1\x48\x4b\xb1\x14\xad\xf4\xeb\xdd\x06\xfd\xfa\x97\xcf\x0d\xfa\xf5\x7d\xd8\xd7\xdd\xba\x35\xd3\xa2\xaa\xab\xe5\x16\xb4\xeb\x94\x6d\xe7\xc5\xe1\x21\x64\x38\x14\xa3\x23\x98\x4d\x1e\x1c\x86\x65\xe8\x08\xf7\x9c\x10\xbe\xa0\xa0\x0e\x71\x5a\x5a\x56\x7c\x50\xe6\xb1\xcc\xcf\x74\xea\xfa\x76\x34\x19\x45\xc3\x1e\xae\x89\x27\xc2\xff\x09\x96\xd5\x4a\xe3\xc7\x8c\xb0\xd4\x73\xf9\x4d\x6b\x1d\x32\x48\xc2\x78\xd7\x6c\xc3\x96\x2b\xbc\x34\xf1\xf8\xe6\x00\xb7\x46\x50\x73\xde\xde\xd5\x41\x14\x8c\x12\xd5\x26\xa6\x37\x70\x72\x8a\x7c\x9d\x6a\x95\x13\xaa\x23\xc6\x80\xf1\x15\x4f\x32\xe6\xfa\x8f\x57\x80\xaa\x9d\xf5\x14\x39\xb0\xad\x9b\xee\xee\x20\x2d\x16\x72\x2d\xca\x34\xe6\xc7\x2e\xb2\x9d\xcf\xca\xae\x75\xad\x87\x9b\xd7\x71\xf0\xb7\x39\x13\xe8\x30\x85\x94\x06\xa3\xbe\x20\x01\xef\xa9\xf7\x1d\xdb\x8c\x74\xb3\x3a\xfe\xaa\xaf\xc9\xf5\x2f\x0d\xb9\xfe\xfc\x4b\x43\xae\xcf\x8f\x16\x6c\x37\x92\x70\xcc\xe7\x20\xd9\x5f\x37\x3e\x05\x34\x3c\x41\x4a\x01\xc9\x47\xce\x65\x19\x5e\xa6\x30\x38\x5e\x0f\x42\x98\xeb\xbe\x22\xaf\xf6\xde\x71\x1e\x59\xef\xf4\x84\x16\x9a\x32\x1e\x32\x7a\x07\x76\x38\x69\x05\xa6\x37\x31\xd4\xd4\x98\xfb\xdd\x53\x96\xe3\xbc\xbc\xc5\x1c\xb5\x05\x89\x2a\xca\xee\xcd\x38\xba\xfb\xb6\x57\xb9\x6b\xa0\xed\xe2\xad\x86\xc5\x8c\xe0\x61\x0a\x2e\x7b\xd4\x84\x8b\x05\x82\xc0\xfa\xfd\x41\x3a\x16\xfd\x10\x6a\x20\x4e\x8d\x40\x3c\x76\x8f\x3f\x13\xde\xe7\xd0\x85\x47\x65\x6b\x84\x5a\x34\x75\xb8\xe3\x8e\xf5\x44\x6e\x9d\xd0\x70\xb6\x2a\x42\x57\x35\xfa\x2e\x6f\x07\x83\xe8\xeb\xed\x18\x74\x96\x83\x26\xd7\x1c\xa9\x76\x7a\x0f\x9d\xdc\x0a\xbb\x03\xf8\xda\xa1\x0e\x30\x8f\xcd\x5d\xfd\x31\x98\xfb\x8e\x3d\xb2\xcc\xd3\xe8\xf3\xc5\x4f\x04\xae\x6b\x97\x0f\x63\x8f\x02\x5b\xc1\x1a\x82\x94\xd4\xfb\xf8\x62\x0e\xc6\x11\x2b\xd1\x32\xc5\x2d\x2b\x01\xc6\x39\xfe\x79\x3f\xbc\xb3\x2d\xf2\x8d\x4e\x82\x4e\xdb\xa4\x93\xff\x20\x9c\xdd\x77\x1b\x75\x65\x38\xdb\xe5\xef\x61\x38\x98\x0e\x5e\x55\x4d\x50\xb3\x05\x20\xfe\x1d\x5a\x03\xce\xfc\x82\xa4\x12\xc2\x34\xa8\xc6\x28\x6f\x87\x37\x7f\x2f\xd8\x43\x8e\x27\xe7\x50\x25\x82\x88\x53\x06\x15\x1e\x91\x83\xb3\x23\xb6\x4e\x8a\x1b\x6d\xbb\xce\xba\x65\x4e\x88\x57\x0c\xe3\xcf\x88\xc6\x81\xa0\x2b\xa2\x5c\xa8\x24\xac\xbc\xaf\x70\xce\xa2\xcc\xe7\x36\x79\xc7\x63\x8a\x66\x3c\xdf\xd8\x76\x94\xf6\xcd\x00\x8e\x4b\xbe\x81\x57\x50\x45\x46\xb9\xca\xf8\x06\xdc\x30\xb1\x02\x1e\x0d\xc5\x66\xe9\x9c\x39\xcc\xf9\xc7\x95\x44\xc5\x1f\xba\xdd\x1f\xf6\x51\x8b\xbb\xee\xf7\x06\x57\x46\x6c\xb5\x28\x16\x4a\x26\x8c\x60\xdf\xb1\x6f\x5e\xf7\x4e\x4b\x91\x15\xfe\xec\x40\xda\x02\xd4\xae\xfc\xf5\x4e\xb1\xea\x9c\xcf\x05\x40\x9e\x7a\xd8\x49\xa3\x78\x63\xe2\x25\xd0\x72\x9a\x6f\xd4\x81\x56\x7d\x17\x08\xca\x46\x3c\xc7\x42\x50\xb6\xdd\x45\xf7\xaf\x61\x94\x1b\x1a\xc0\xc8\xb0\xc8\xe1\xf4\x4e\xce\xd8\xbd\x42\x1c\xb1\xd0\x84\xf3\x8d\x96\x8a\x9d\x77\x3b\x6c\x05\xd8\x37\xe7\x17\x76\x62\xcd\xb7\xf8\x72\x29\x38\x60\x7b\x83\xdf\xe2\x31\xb4\x03\xb0\x13\x05\x3b\xbf\xc0\x75\x2d\x15\xe4\x0a\x67\x6a\x85\xd1\x71\x6a\x86\xd6\x35\x4c\x00\x3a\xf9\x40\xfa\xc8\x8b\x1a\xfa\x79\xb7\xfb\xc6\xaa\x4c\xeb\x1a\x7b\x55\x86\x04\x78\xd2\x1b\xde\x0d\x00\x8a\xc1\x19\xc3\x5b\x0e\xb4\x16\xe3\xbe\xb1\xa1\x43\xf3\xbe\x00\x04\x93\x45\x68\xca\x5b\x93\xc6\x42\x78\x14\x36\xec\x9f\x02\x41\x0b\xf1\x19\x1c\x9b\xf0\xb4\x75\x46\x5a\xc6\xda\xb2\xa5\x6d\xe6\x06\x9c\x87\x9d\xf6\xf8\x21\x70\x8b\x36\x93\xa1\xc2\xf0\xfb\xc9\x87\x57\xdc\x05\x8d\xcb\x18\x0b\xe8\xfc\xb5\xe0\xb2\x5d\xea\xaa\x0e\xf9\xb1\xc2\x4b\xd8\x2a\xd5\x8e\xe9\xbf\x7e\x8d\x52\xc0\xee\xfd\x2e\x94\xca\x14\x6e\xbf\x50\xe8\xb1\xab\xe9\xd7\xff\x1d\x3c\xdb\xde\x09\xf1\x46\xb3\x4d\x9a\xf4\x9f\x6f\xb6\xfd\x34\x6e\x51\x1f\xa3\xc1\xe0\x30\x95\x3c\xc6\xcc\x59\x37\xec\x4d\x38\x95\x6b\xa1\x37\x6c\x2e\xd5\x8c\x4b\x9f\x13\x60\xbd\xd1\x74\x61\xd5\x2b\x31\xaa\xf5\x5f\xe4\x50\x7a\xa9\x88\x16\xf0\x25\x81\x0b\x5c\xaf\xa0\x2e\xfb\x5e\x19\xbd\x21\x53\x45\x09\xa8\x16\x45\x08\x7e\x48\xfc\xfd\x9e\x7a\xd2\x8c\xc1\x1e\x4f\x48\xf8\x8c\x74\xf0\x66\x75\xa8\xd7\x84\x16\xe0\xf9\xe9\xbf\x9b\x97\x70\xa8\x8e\x8e\xe5\x0a\xfc\xdb\x08\x2a\xed\xdb\xf4\xd8\x43\x3c\x86\xea\x91\xb4\x58\xe0\xa5\x95\x09\x9e\x17\xbe\x62\x05\x31\x05\xbe\x2f\xd2\x44\xb8\xc6\xdf\xeb\xb8\x0b\x16\x7c\x87\x06\x63\x9e\xea\x8d\x0f\x17\x0c\x80\x04\xf9\xd3\x8b\x86\xd0\x07\x09\x87\xd0\x2f\x88\x87\x6d\xf7\x50\x01\xa1\x0f\xbc\xa7\x88\xb8\xc5\xdf\x2a\x22\xf0\xe7\xfe\x65\x34\x98\x7e\xeb\xf7\xc6\xe6\x1c\x6e\xa9\xbc\x69\x93\x14\xd3\xf5\x34\x06\xb0\x8e\x45\x2a\x34\xd7\xf1\x62\x03\xeb\x24\x7d\x10\xb8\xc2\xf2\x5f\x8a\xe7\x72\xc5\x25\x53\xf9\xd1\x45\x2a\x3b\xac\x9d\xe6\x68\x5a\x8e\x4b\x57\x18\xfc\x8a\xad\x61\x55\x9e\xba\x59\xcc\x03\x3f\xde\x82\x07\x40\x5b\x61\x86\x30\xd8\x40\x6e\xca\xd8\x13\xdf\xd8\xb8\x50\xb0\xbb\xbc\x2e\x05\x9c\x1e\x16\xa8\xc6\x55\xa3\xb8\x04\xea\x16\xa5\x6b\x61\x8b\xeb\x8a\x7a\x2e\xc6\x7b\x1a\x97\xf5\x79\xac\xeb\x02\xd6\x7f\xd8\x1f\x46\x37\xbd\xe9\xf5\xed\x78\x18\xb5\xd3\x85\x90\x36\x0f\xb8\xb8\x3e\x57\xc8\xff\xae\xa8\x20\xbf\x01\xd9\x47\x89\x89\x4a\xc0\x85\xbd\x94\x3c\xaf\xa4\x26\x85\x0d\x99\x3e\x57\x98\xc4\x83\x92\x38\x7a\xa0\x2d\x70\xe7\x50\x81\x6c\xcc\xe7\xe3\xfe\x11\x9c\x53\x9f\x8a\x43\xae\xbf\x78\xa1\x54\x21\x58\xb1\x9e\xc3\x8f\xd6\x79\x6d\x06\x0e\x94\x3e\x90\x4a\x1c\x80\x28\x29\xf6\xbc\x28\x33\xe0\x99\x06\x16\x11\x0c\x7c\x38\x6b\xec\xfe\xb7\x1b\x5a\xed\x75\x8a\xc4\x71\x69\xce\xfa\x3d\xf6\xaf\x7f\xb0\x8f\x28\x7d\x00\x3e\x19\x20\x41\x22\xfc\x21\x26\x8b\xd2\x2a\x9b\xa1\x9e\x6e\x65\x9f\x5b\xe6\xf3\x0e\xfb\xb1\x9c\x77\xd8\x3c\x7d\xec\x98\x9e\x77\xcc\xef\x7e\x9d\x27\xf6\x5f\xf7\xdf\x98\xa7\x5a\x19\xf3\xca\xfd\x50\xfd\x13\x3d\x50\xf9\x73\xba\x94\xab\xc2\xb6\x04\x3f\x90\xd1\x11\xfc\xc6\xfe\xbb\x03\x6c\x3b\x3f\xda\xdb\x59\x91\x4d\x2b\xbf\xcb\x7c\x4e\xc7\x45\xe3\x11\xdf\x06\x4c\x89\x4b\xc2\xb5\xf7\x99\xa5\x91\x0b\xce\x12\x48\x04\xc2\xfb\xa8\x0a\x9b\x08\xfc\x7f\x1c\x4a\x94\x60\x49\x09\x17\x1a\x93\x3b\xa5\x54\x4f\xec\xff\x4a\x65\xb6\x36\x7f\xc9\x01\x76\xab\xb5\xf4\xd5\x0d\x9c\xb3\x4c\x25\x42\xe7\x56\x00\x42\xca\xed\xbe\xf9\x5c\x2e\x4a\xd6\x7b\x5e\x4a\xa5\xa1\xf0\x51\x50\x4a\x0c\xc2\x21\x69\x88\xdf\x98\xe3\xe7\x3a\xd5\xe2\x51\x3d\x77\xd8\xe5\x42\xab\x4c\x74\xd8\x3d\x7f\xe4\x3a\x45\x5f\xdf\xed\x52\x68\xee\x65\xdc\xec\x43\x14\xbe\x83\x64\x3b\x88\xe2\xb4\xca\xf6\x19\xbb\x95\xe6\x31\x62\x2a\x81\x0b\xb5\xdf\xab\x08\xb6\x79\x87\xb0\x4e\xdf\xeb\x3a\xed\x8f\x26\xbd\x71\x74\x39\xe9\xff\xd6\x9b\x9a\xcf\x6d\x89\x73\xbe\x54\x43\x52\xf3\xe4\x57\x4e\x18\xa7\x47\x10\x1f\x78\xad\xec\x01\x13\x2e\x5c\x88\xbf\xf9\x66\x35\xe0\x8f\x11\xfe\xe3\x36\xc7\xb6\xd4\x00\x1a\x2d\xac\xec\x55\x7f\xbc\x77\x96\xe9\x8e\xaa\x99\x5a\xa0\xb0\xc2\x03\xd5\x7a\x3e\x7f\xb4\xc7\xfa\xf7\x44\x95\xc4\x98\x4b\x3a\xd6\x3e\x0c\x47\xbb\xc6\xed\xc7\xd5\x32\xee\xe1\xfd\xe5\x5b\x8e\x3b\x2b\xe2\x43\xc7\x9d\x15\x71\x63\xdc\x27\x95\xee\xb5\x2d\x5b\x3f\x7a\xd3\x65\x4b\xf9\xc1\xcb\x96\xf2\x66\xf7\x2b\xdd\xab\x75\x1f\x62\xcf\xc4\x4f\x24\x79\x5e\xae\x32\x49\x35\xe1\x83\x68\x34\x79\x18\x0e\xa6\xff\x89\xc6\x7e\x07\xfa\x44\xe4\x96\x4d\x68\xf1\xf9\x71\x1b\xfe\xe0\x6b\xee\x23\x6a\xb0\x7b\xe8\x03\x67\x3f\xb8\x26\x78\xda\x5d\xdb\xd1\xdc\xa2\xe6\x8d\x07\x04\x2a\x32\x07\x13\x7c\xd4\x58\x44\x1c\x6a\x29\x93\x95\x46\xd3\xc1\x27\xff\x03\x10\x4b\x29\x96\x8d\xd4\xa3\xc0\x0c\x7b\xe2\x1a\xe2\xc2\x48\x11\x6e\x6c\xa8\x58\xad\x72\xe0\x51\xe1\xec\x3b\x54\xff\xac\x32\xc7\x35\xe7\xf2\x3c\x20\xe5\x1f\x2f\x54\x47\xca\xe2\xd1\xf9\x9c\xf6\x13\x26\x33\x9d\x9d\x9c\x34\xe7\xf1\x35\x93\x7f\x79\x7d\x83\x18\xb9\xbb\x8a\x51\x5a\x41\x9c\xa1\x2b\x6e\xc6\x83\x7e\xb8\x26\xf7\xe8\x87\x8f\xc1\x62\xfa\x35\xba\xeb\xa8\x02\xd1\x7c\x00\xc5\xb4\x06\x0e\x6a\xde\xfc\x8b\x43\xe4\x2d\x79\x89\xf4\x8c\xa0\xb0\xdb\xf6\xd9\x4c\xaa\xf8\x67\xd8\xaf\x6a\x2d\x5d\x78\x24\xa2\x26\x3c\x1d\x46\xff\x9d\x8e\x6e\xaf\xb6\xe4\xdf\x93\xba\x6b\xdd\xc4\xde\x0b\x0f\x2e\x7f\x0b\xcd\xe4\xca\x28\x29\xa6\xe0\x43\x01\xae\xc8\xaa\xf6\x66\x60\x67\x50\x9c\xa0\xa8\xa5\xa6\x80\x70\xc0\x95\x58\x8b\xeb\x94\x7a\x95\xc7\x16\x8e\x02\x5a\xb0\x09\x13\x69\xe1\xf2\x90\x2c\xd5\x9e\x91\x60\x51\x88\x1c\x61\x83\xe0\xdb\x8c\x17\x00\xcf\x94\xb0\x99\x7a\x0e\x5d\xff\x2e\x89\xa7\xde\x63\x3c\x54\x80\xcc\x31\x95\x89\x16\x4e\xe3\xd6\x4a\x95\xd8\x66\x30\x1c\xc8\x01\xd2\x82\x27\x9b\x70\x3c\x14\x69\x6f\xcc\xb9\xf5\x69\xe3\xbb\x6e\x07\xb8\xc9\x44\xfd\xea\x8c\x45\xb2\x00\x2d\xc2\x21\x0a\x96\x01\x22\x11\xaf\x98\xf7\xec\x71\xa5\x41\x95\xd2\xa2\x28\x75\x1a\x93\x21\x62\x3e\xea\xbb\x70\xd5\xbb\x9b\x7c\xdb\x37\x26\xd0\x12\x15\xb8\x38\x3a\xc1\xa1\x3e\x15\xa0\xa2\x5c\x74\x3d\x1a\x6a\xa3\xbb\xfb\x48\x68\x22\x96\xe5\xc2\xae\x0f\x3a\x53\x5a\x2d\xb2\x88\x9e\x74\x48\x5c\x5f\xc8\x07\x82\xa5\x5c\xb9\xb4\x51\x2d\x2d\x78\xbc\xa8\xe4\xce\x19\xbb\xc4\x2c\xfc\x6c\x13\x70\xc1\x72\x3c\xa4\xd7\x29\x37\x4b\x06\x3e\x9f\x2f\x4c\x24\x61\xc6\x33\x2c\xa8\x11\xb8\xc4\xce\x93\x84\x38\x99\x5d\x2e\x97\x9b\xe7\xc5\xca\xbe\x4a\x75\x88\xa1\xb4\x16\x61\x1a\x81\xe7\x52\x3b\x37\x36\xa3\x66\x9f\x21\x9c\x34\xd7\x82\x97\xd2\xec\x02\x97\x0f\x1a\xab\x6c\xb9\xa2\x0b\x0e\x1c\x56\x39\x46\xb6\xcc\xb1\x03\xe2\x8a\x70\x2d\x33\x0e\x89\xba\x46\xea\x4e\x3e\x78\xb9\x7b\xb5\xd4\xb5\x8b\xbd\x65\xbe\xe4\x7e\xb9\xba\x34\xfd\xb9\xa2\xdf\xd9\x66\x08\xfe\x72\x2f\x31\x7d\xdb\xf4\xb2\x36\x09\xf4\xe9\x65\xf7\x24\x78\xe6\x89\xc9\x38\x1a\xdd\xdf\x45\xe3\xde\xa8\x9a\xa1\x19\x00\xdd\x38\x22\x1d\x28\x63\x2b\x35\xcf\x8b\x25\xd7\x98\x0f\x39\xe3\xf1\xcf\xb9\x46\x4d\xda\x62\x81\xd8\x0c\x66\x10\x58\x1c\x54\xc7\x9c\x94\x9c\x72\x95\x99\xca\xd9\xef\x69\x9e\xa8\xa7\x22\x40\x4d\x16\x22\x43\xfb\xdb\x53\x32\xa4\x00\xd0\x65\x77\x84\x39\xf2\xc0\x2a\xfb\x1d\x6f\xeb\x5f\xd9\x15\xb8\x75\x10\x21\xc6\xea\x13\xe5\xa3\xd2\x19\x6c\xb1\x4e\xbb\x2b\xd4\x08\x97\x14\x9c\x82\x8b\x33\x9e\xc8\x0d\xe3\x79\x99\x7e\xe2\x32\x85\x22\x14\xc9\x67\x42\xba\x4c\x46\xdc\x07\x81\xc8\x7c\x04\x2a\x84\x72\x21\x36\x36\x4a\xbc\xe0\x9a\x5a\x33\x47\xe7\x0e\xa2\xf7\xe3\x6c\xa3\xfa\x6a\xd5\x6c\xa3\x70\x51\x87\x0f\x83\x49\x7f\x3a\x89\xc6\x37\xbd\xc9\x7d\x6d\x59\xd1\xe4\xa5\x54\x2b\x8f\x82\x6e\xe9\xa1\x1c\x97\xa7\xf3\x73\x19\x1d\x55\xaf\x72\x1a\xb8\x7b\xf0\x93\x02\x2b\xf5\xd3\xc4\xa5\xbf\xd2\x8c\x59\x2d\x49\xa6\xb9\x38\xad\xb1\x4e\x9a\xcf\x9a\xb6\x1e\x79\x51\x0a\x8d\x29\xee\x98\xb8\x8e\xc7\x96\x78\xaa\x99\xa0\x00\x8b\xfd\xef\xf3\xb3\x7f\x9e\x9d\x77\x4f\xad\x68\xc0\x15\x92\x16\x9d\x06\x20\x55\x8b\xe4\xbd\xe7\x6a\x54\xa7\xb9\xd5\xf1\xeb\x08\x9c\x7a\x37\xbd\x51\x0b\xa4\xd7\xd6\xc8\x80\x14\x73\x91\x63\x49\x09\x54\x38\x01\x23\x99\x75\x9d\x5b\x96\x6e\xda\x19\x6b\xae\x81\x85\x76\xa6\x9e\x29\x22\xcc\xb5\x36\xbb\x2b\x48\x0f\x0d\x01\xcd\xf7\x2a\x05\x38\xc2\x3f\x5c\x1b\xf4\x96\xe0\xa5\x99\xc1\xcb\x41\x2f\x1a\x3d\xb4\xf0\xa9\xd5\x14\x27\xaa\xdb\xc5\x08\x7a\x29\x74\x26\x92\xd4\xcc\x53\xa2\xbc\xa8\x3a\xd3\xa8\x01\xb3\x19\xce\xd1\x3b\x8f\x3d\x1c\x54\x23\x13\xe8\x8f\x00\x00\x00\xff\xff\xd1\x56\x89\xe8\x56\xab\x01\x00")
+var _dataJavaDoxyfile = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xe4\xbd\x6d\x77\x1b\x37\x96\x3f\xf8\x9e\x9f\x02\x1b\xf6\x9e\x48\x19\x8a\xb2\x9d\xa4\x4f\xe2\x19\xcf\x36\x4d\xd1\x36\xbb\x25\x52\x21\x29\x27\x9e\xbf\xff\xcd\x03\xb2\x40\x12\xe3\x62\x15\x53\xa8\x92\xcc\xee\x93\xfd\xec\x7b\x7e\x17\x17\x28\x54\xb1\x28\xc9\x8e\xd3\x73\x76\xd7\x2f\x2c\x89\xac\x02\x70\x1f\x70\x71\x9f\xd1\x16\x17\xe9\xc7\xfd\x4a\xc7\x4a\x3c\xed\xfe\xd0\x7d\xfa\x63\xab\xd5\x16\xb3\x8d\x36\x82\x3e\x8b\x94\x59\x66\x7a\xa1\x8c\xc8\x37\x4a\x18\x95\xe7\x3a\x59\x1b\x91\xa7\x62\xa1\x44\x61\x54\x24\x16\x7b\xfa\x2a\x4a\x97\xc5\x56\x25\xb9\xcc\x75\x9a\x08\xb3\x37\xb9\xda\xb6\xda\x22\x4a\x3f\xee\xd7\x2a\x11\x27\x77\x77\x77\x5d\xfe\xa3\x9b\x66\xeb\x53\xb1\x4a\x33\x21\xc5\x2e\x4b\xff\x5b\x2d\xf3\x6e\xab\xdd\x6a\x8b\x5e\x1c\x8b\x5c\x7d\xcc\x85\x5c\xe5\x0a\xdf\x46\x69\xb1\x88\x95\xd8\x48\xb3\x11\x27\xed\xf6\xa9\xd0\x46\x2c\xd3\xc4\xe8\x48\x65\x2a\x12\x52\x2c\xd3\x2d\x26\x15\x32\x89\xf0\xdd\x2e\x96\x4b\x15\x09\x9d\xb4\xda\x62\x95\xa5\x49\x2e\xd2\x15\xad\x6e\xd6\x7b\x2d\x74\x4e\x8f\x64\x6a\xa9\x22\x9d\xac\x8f\x4c\x69\x74\xb2\x2e\xa7\xbc\x7f\xc6\x3b\x1d\xc7\xc0\x83\x5e\x27\x69\xa6\xa2\x2e\x61\x4e\x01\xb2\xad\xc4\x64\xcf\xf1\x41\xef\xb5\x78\x21\x6e\x65\x5c\x28\xf1\xbf\xe8\x47\x47\x74\xbb\xdd\xff\xdd\x6a\x8b\x57\x69\x26\x62\x6d\x72\xd3\x11\x3a\x57\x5b\x23\x96\x32\x11\x32\x36\x84\x5b\xb9\xdb\xa9\x24\x52\x91\x28\xb0\x22\x37\xd2\xbf\x1d\x19\xea\x2d\xfe\x02\x8d\x64\x8e\xe5\xe6\x52\x27\xc2\xec\xe4\x52\x19\x61\x36\x69\x11\x47\x18\x92\xb1\xb3\x50\xf9\x9d\x52\x89\xf8\xb5\x48\x73\x65\xc4\xc9\xfb\xaf\xc4\xfb\xaf\x4e\xbb\xad\x56\xfb\xec\xcb\xfd\x6b\xb5\xc5\xb5\x25\xad\xc8\x54\x2c\x73\x15\x61\x5d\x2b\xbd\x2e\x32\xcb\x21\xe9\x0e\x3f\xcc\x97\x9d\xd4\xb1\x6e\x2e\xd7\xc2\xec\xd4\x52\xaf\x34\x73\xae\x4a\x96\x29\xa8\x6e\x79\x96\x78\x2f\x8e\xc5\x72\x23\x33\xb9\xcc\x55\x66\x84\x4e\xe8\xb9\xca\x22\xc1\x45\xd8\x04\x84\xd6\x55\x1a\xc7\xe9\x5d\x97\x28\x1c\xa9\x95\x2c\x62\x90\x58\xdc\xcc\x5e\x9d\xfd\x20\xee\x36\x7a\xb9\xc1\x9f\x44\xbe\xa3\x13\xb6\xda\x96\xbf\x17\x6a\x95\x66\x18\x57\x89\x95\xce\x4c\x2e\xd2\xe5\xb2\xc8\x32\x95\x2c\x95\xe5\x58\x0b\x43\x97\xf6\x26\xb6\x4f\x61\x94\x11\xb1\x5e\xe8\x65\x9a\xdc\x8a\x93\x34\xc3\xbb\xad\xb6\xb0\x7f\x2f\x0a\x8d\xc5\x24\x79\x8a\x67\x96\x76\x6f\x61\xf0\x3c\x93\x89\xb1\xeb\xe8\x8a\xa9\x52\xad\xb6\xd8\xe4\xf9\xce\x3c\x3f\x3f\xc7\x7e\x5c\x27\x05\xf6\xe2\xb9\x49\x57\xf9\x9d\xcc\xd4\xb9\x9b\xe1\xdc\x8f\x00\xfe\xc4\x92\x76\xa9\x31\x7a\x11\x97\x70\x19\xc7\xed\x0e\x17\x96\x2f\xb5\x79\x6e\x51\xd2\x6d\xb5\x2e\xc6\xbf\xbc\x7b\x35\xbc\x1c\xcc\x07\xa3\xfe\xf8\x62\x38\x7a\x2d\xe8\xdf\x0b\xfb\x40\x8b\xdf\xbf\x9e\x8c\xff\x3a\xe8\xcf\xe6\xa3\xde\xd5\x00\x40\x13\x16\xdd\x36\xbc\x4b\xb3\x88\xc0\x95\xc2\xa8\x5f\x0b\x87\x20\x7c\x6c\x84\x29\xb2\x2c\x2d\x68\x93\x2c\xf6\x24\x6b\x20\x2d\xce\x2c\x5f\x77\x44\x91\xc4\xca\x18\xb1\x4f\x0b\x21\x33\x08\x2b\x90\x1f\x08\xbd\xd3\xff\x90\x59\x74\x6a\x77\x0b\x6f\x0f\x1d\xa9\x24\xd7\xab\x3d\xe3\x95\xc5\x12\xa1\xc1\xd2\xf6\x50\xc6\x69\x23\xd6\x2a\x51\x19\x98\x1b\x6c\xa1\x8d\x48\xe4\x16\x28\xb0\x34\xb7\x1c\x05\x92\xeb\x3c\x26\xba\x6e\x53\x93\x97\xef\x88\x9d\x5c\x2b\x63\x45\x57\x22\xa4\x58\xa9\x3b\x91\xe6\x1b\x95\xd9\x8d\x7a\x0f\x82\xaf\xf6\x6e\x73\x75\x5b\xad\x0a\xfe\xca\x7f\x2f\x0e\xf0\x7b\x73\xf5\x72\x30\x21\x0c\x43\xce\x38\xf1\x9d\xa7\x42\x25\xb9\x0a\x64\xb1\x48\x33\x91\xa9\x5b\x6d\x00\x64\x52\x6c\x17\x2a\xb3\xe0\xb5\xda\x62\xe9\x64\xc9\x46\x26\xd1\x9e\xd0\x23\xb3\xe5\x46\xdf\x02\xb7\x40\x51\x09\x5d\x15\x59\x69\x26\xf4\x4a\x98\x74\xab\xc4\xad\xca\x30\x34\x8d\x96\xe4\x59\x1a\xf3\x69\xe1\x10\x17\xc2\x64\xd7\xec\xfe\x11\x4c\x37\xc6\xcd\xe5\x9e\x7a\x39\x19\x0e\x5e\x11\x60\x69\xa2\x48\x88\xee\xb2\xf4\x56\x47\x4a\x48\x27\x67\x64\x4c\xdf\xc5\x3a\x71\x47\xda\xce\x6d\xf0\xf0\x18\xb2\x2c\x01\xd1\x2b\x33\x23\x64\x4e\xd3\xe4\xe9\x0e\xc4\x53\x72\xb9\x21\x92\x11\xc5\x98\x6d\xd6\xfa\x56\x89\x5b\xad\xee\x80\xc0\x56\x5b\xfc\x5a\xe8\xe5\x07\xa1\x23\x25\x85\x5c\xa4\x85\x1d\x60\x57\x64\xbb\xd4\xf0\xce\x56\x6e\xae\xae\xf8\x9b\x52\x3b\xfa\x24\x58\x11\xc4\x75\x16\x92\xd5\x02\xe7\xff\x11\x0a\x7e\xd6\xf9\xa6\x82\x81\xcb\xf1\xeb\x71\x05\x01\x56\xf8\xed\x85\x14\x71\xba\x4e\x41\x51\x99\x90\xb0\xb0\x10\x6a\xc8\xbb\x65\x5c\x44\x2a\x82\x10\x49\x0e\xb9\x1b\x14\x57\x62\x2b\x3f\xea\x6d\xb1\x15\x1b\xa5\xd7\x1b\x7f\x98\xd2\x90\x8c\x80\x24\xcd\x85\xfa\xb8\x54\x2a\x12\xdf\x7f\xdf\x6a\x8b\x9d\xfe\xa8\x62\xcb\xd4\x79\x30\xc0\x9d\x8e\xf2\x4d\xc3\x3b\xcf\x9e\x3c\xe1\x57\x4a\x59\x47\xa7\xea\x32\xdd\x61\x4b\xfb\xe9\xf2\x94\xa6\x4e\x8b\x7c\x57\xe4\x22\xd2\x99\x5a\xe6\x69\xb6\x0f\x10\x45\x38\xa8\x21\x0a\x30\x8c\x6f\x66\xd7\x37\xb3\xf9\xc5\x70\x32\xe8\xcf\xc6\x93\x77\x4e\xc6\x38\xee\x77\xa8\xc2\xf0\x27\x74\x56\x81\xa6\xc0\xd8\xc2\xa4\x71\x91\xab\x53\xb1\x93\xf9\x86\xf0\x94\xa7\x2c\xe9\xef\x63\x75\xa7\x15\xdc\x65\x3a\xcf\x55\xd2\x15\xc3\x95\x90\xc2\x8f\x8c\xc1\x04\x6d\x26\xda\x77\x2a\xc2\xe9\xef\x5f\xf2\x8f\x31\xc0\x71\xba\xe4\x51\x37\x2a\x53\x5e\x9d\xba\x93\x46\x98\x5c\x66\x24\x7a\x86\xab\x56\x5b\xc4\x6a\x95\x8b\x45\x2c\x93\x0f\xf4\x9e\x3d\x4e\x02\x4c\xf9\x19\x78\x8b\x1d\xa0\x85\xd1\x46\x87\x49\x74\x1e\xa5\x4b\x03\x56\x1b\xe2\x34\x52\xa2\x3f\x19\xf4\x66\x83\xf9\xf4\xe6\xe5\xc5\x70\x32\x75\x28\x34\x2a\x07\x06\xdf\x0d\xa6\x78\x28\x29\x57\x87\xa9\x96\x99\x92\xb9\x12\xdf\x3d\xf9\xf1\xcf\xc2\x14\x8b\xb3\x56\xdb\x2f\x06\x87\xf2\x89\x4e\xc4\x33\x11\xab\x5b\x15\x9b\x53\x01\x29\x9e\x35\x52\xd8\x6f\x3d\xfe\x82\x95\x2b\x99\x80\x73\x09\xa6\x48\x9b\x3c\xd3\x8b\x22\x57\x35\x09\x04\x05\xd6\x88\xf4\xd6\x8e\x6c\x54\x38\x7f\x57\x0c\x12\xb9\x88\xad\x28\x21\x6a\xa4\x76\xff\x95\xb2\x71\x55\xc4\xe2\x0e\x60\xad\x14\x29\x8c\x1e\x3c\x29\x36\xc5\x5a\x09\xb9\x4d\x8b\x84\x36\x85\x49\x8b\x6c\x89\xa3\x3c\xc6\xb9\x43\x94\xc2\x56\x28\x48\x59\x16\x32\x8e\x0f\x16\xc5\x5b\xce\xc8\x6d\xb9\xaa\xbd\xb8\xa3\x2d\x45\x67\xc0\x9d\x36\x10\x65\x38\xf7\x31\x94\xca\x08\x6e\x1c\x7f\xbb\x2c\x5d\xc4\xd0\x16\xdd\x11\x8d\x69\x59\x84\x1e\x3f\x32\x46\xe3\x6e\xab\x55\xa3\xa2\xdf\x2a\x62\x34\x0e\x68\xdd\xbb\xbc\x1c\xff\x3c\xbf\x19\x0d\xfb\xe3\x8b\x01\x1d\xcb\x0d\x04\xef\x54\x89\x2d\xa1\x1b\x89\x24\x4d\xce\x7a\xd3\xfe\x70\xd8\x6a\x87\xaa\x55\x9e\xb2\x48\x75\x92\x06\xc7\xa4\x01\xe2\x6a\x68\xa1\xad\xc2\x73\x8c\xc6\x9d\x63\xe3\x39\x4e\x56\x66\x29\x77\xd8\x3f\xc0\x84\xfa\x28\xb7\xbb\x58\x89\xf9\xc7\xc1\xb7\xf3\x8f\x3f\x3c\x9d\x7f\xfc\xe1\xbb\x0a\xcf\xd3\x61\x75\x93\xe8\x65\x1a\xe1\x84\xbf\xf9\xb7\x6f\x9f\x7c\xf7\xdd\x03\x08\x6b\x42\x45\x80\xb0\x40\xbc\x5c\xf6\x46\xaf\x6f\x7a\xaf\x07\xf7\x49\x97\x58\x26\xeb\x02\x07\x88\x4e\x58\x8c\x58\x95\xb0\x2a\x3e\x4a\x9c\x2c\xf6\x1e\xc9\xda\x94\xe2\xa4\x22\x27\x0b\xa3\x1c\x03\xeb\x84\x78\x84\xb8\x38\x4f\xfd\x38\xc4\x7f\x30\x9a\x72\x09\x6e\xb5\xbb\x88\x09\xb1\xcb\xd2\x9d\xca\xfc\xc2\x80\x8d\x6b\xa7\xe8\x11\x2a\x0c\x54\xa7\xe7\xa2\xb7\xca\xf4\x07\x29\x13\xd3\x11\xbd\x4c\x2e\xf4\x12\x3f\xb7\x2a\xd1\x32\xe9\x88\x97\x99\xfc\x87\x8e\xe9\xd7\xbe\xcc\x65\x4c\xbf\x6c\x74\xa2\x8c\xea\xb4\xda\xee\xd7\xb3\x59\x26\x23\x8d\xc5\xc9\xb8\x23\xfa\x59\x2a\x73\xfb\xca\x3f\xd4\x72\xd3\x11\x17\x32\xd1\x06\x3f\x8b\x1c\x7f\x0e\x92\x75\xac\x61\xf4\xdd\x24\x1a\xa8\x98\xe6\x32\x57\xe6\x14\xe3\x0d\xcc\x4e\x65\x32\xc9\xd3\x8e\x78\x25\x33\xa3\xc5\xc9\x35\x14\x0a\x99\x9c\x76\xc4\x2b\x9d\xd8\x61\x5e\x41\x97\xde\x74\xc4\x6b\x85\x5d\xd3\x11\xaf\x33\xa5\x3e\x74\xc4\x9b\x22\x59\xcb\x0c\xf3\x82\xdd\x93\x28\x4d\x14\xde\xec\x88\x61\x2e\x2d\x04\x7f\x95\x3b\x89\xe5\x96\xbf\x9d\xc1\x7a\x75\x7f\x88\x3b\x1c\xbd\x6e\x75\x5b\x65\x0c\x74\x38\x5a\xd7\xdf\xd2\x4c\x61\x04\xfb\x93\xde\xb2\xbf\x1e\x7b\x47\x5c\xca\xfc\x96\x26\xbd\xd4\xf9\xa6\x90\x09\xaf\xeb\x0a\x56\x5a\x4a\x7f\x89\x51\x9a\xdd\xa9\x35\xfd\xca\x50\x8a\x13\x82\xfa\xb4\x23\xae\x53\xa0\x08\x3f\xb3\xbc\x58\x17\xb4\xe8\x49\xba\xb5\xe3\x88\x49\x61\x0c\x0f\x38\x55\xd9\x02\xbf\xba\x5f\xce\xfa\xfb\x4c\xc7\x31\xa8\x38\x8d\xd3\x5b\xf9\xc1\xfe\x54\x89\xea\x88\xe9\x8e\x09\x31\xbd\x53\x11\xfd\x32\x2b\xb2\x0f\xf8\x05\x7b\xe6\x43\x26\x35\x86\xa7\xf3\xfd\xad\x56\x39\xed\x64\x75\x7c\x17\x31\xd4\xe5\x79\xe3\xf7\x89\x97\x3d\xfc\x48\x6d\x3f\xcd\x06\xbf\xb8\xc3\x69\x38\x1e\xdd\xb7\xab\xac\xf8\x04\xd3\xff\xeb\xb7\x55\x39\x68\xe3\xbe\xf2\x4b\x3b\xba\xb1\x46\x29\x90\x7e\x39\x9b\x74\xc4\x64\x76\x49\x68\xed\xa7\x09\x6c\xc4\x7b\x24\x53\x9a\xa8\x12\xa1\x35\x44\x41\x36\xa5\x89\x0a\xc4\x39\x29\x90\xf3\xab\x01\x34\xe9\xf9\xc5\x60\xda\x7f\x50\x98\xb3\x6a\x28\x16\x99\x56\x2b\xb1\x55\xb0\x00\x5a\xed\x50\x4b\x35\xec\x2e\x01\xac\xf6\x7b\xf6\x3d\xc0\xd0\x82\xc9\xe8\xcd\x1f\x3a\xef\x08\xae\x65\x2c\x8d\x39\x20\xcb\x89\xd1\x5b\x1d\xcb\x0c\x54\xfd\xab\xbc\x95\x51\xba\x3c\x85\xa9\xca\x67\x00\xfe\x8f\xb4\x91\x10\x48\x10\x73\xc7\xb1\xf2\x6e\x30\xed\xb6\x5a\x87\xc0\x32\x97\xbd\x1b\x4c\x03\x9c\x4c\x06\xd7\x83\x5e\x68\x38\xdc\x87\x8e\x5d\xa6\xe0\x8a\xa1\x17\x09\x25\x55\x5c\xe0\x1c\x93\x8c\x05\x28\xda\xab\x22\x21\x9a\x87\x46\x7e\xa4\x72\xa9\x63\x15\x85\xef\x91\xfb\x69\x94\xe6\xea\x39\x4e\xbd\x45\x9a\x6f\xc4\x9b\xe1\xc5\x60\x7e\x33\xba\x18\xf7\x19\x84\x29\x61\xee\x10\x28\xa0\x99\xd7\x3b\x1a\x77\xd8\xcc\xa4\xb5\x85\x33\x94\x27\xe5\x32\xc5\xd9\x98\xab\x78\x2f\x4c\xb1\xdb\x65\xca\x18\x15\x3d\x84\xcb\x0a\x92\x78\xbb\x86\xb8\xf4\x9e\x16\x8d\xc1\x41\x52\x18\xee\xbf\x16\xd2\xe8\x33\x9d\xe4\x2a\x8e\xf5\x1a\x2a\xe8\xc1\xba\x84\x5c\x2c\x60\x60\xca\x9c\x34\x18\xb2\x47\x5a\x6d\xbf\xb9\xb1\xdd\x00\x92\x75\x95\xe8\x44\xdc\xca\x4c\xa7\x05\xfc\x1f\x06\x0a\x95\xe9\x8a\x01\x6c\x31\x68\x7d\xc9\xda\xf2\x99\xb6\xdf\x76\x60\x64\xae\xe0\x13\x68\xb5\x85\x04\x4f\x2a\x11\x2b\x49\xda\x1b\x8d\xc6\x16\xcc\xc1\x92\x3a\x1e\x55\x18\x75\xb7\x83\xa2\x96\xa5\xe5\x32\x5a\x6d\x6f\xd2\x64\xca\x14\x71\xde\xe1\x1d\xb0\xcb\xd2\xa5\x32\xde\x24\xbd\xdb\xa4\xb1\x72\x4b\x61\x79\xc5\xeb\x90\x49\x92\xe2\x14\x8b\xd8\x09\xd4\x15\x63\xa7\xe8\x75\x9a\x17\x15\x8c\x70\xa6\xad\x72\x54\x6a\xf9\x8e\xea\xd6\x2d\x85\xf9\x4b\xb9\x62\xe7\x3d\xf9\x93\x73\x47\xc8\x22\x4f\xb7\x32\xd7\x4b\x19\xc7\x7b\x91\x29\x76\x04\xde\x39\x33\x92\x9e\x4b\x57\x6c\x6f\xc1\x11\x92\xef\x4f\x9f\x63\x9f\xd9\x21\x68\xeb\x76\x44\xf9\xc1\x9d\x8e\xd6\x2a\x0f\x3f\xc1\x36\x07\xc8\x1d\x67\x7b\x1b\x9c\x3c\xde\x07\xd7\x71\x5e\x49\xd3\xc1\xfc\x99\x32\x60\x97\x8e\x90\x1d\xc1\xa7\x49\xbe\x81\x50\xeb\xbd\x7c\x39\x19\xbc\x1d\xc2\xd6\x08\xf9\xee\x45\xb0\x77\x7b\x97\x3f\xf7\xde\x4d\xe7\x17\x83\x59\x6f\x78\x39\xb8\x98\x4f\x07\x7d\xa2\x4d\x7d\x4f\x93\x84\xb5\xfb\xaa\xdc\xda\x00\x37\x09\xdc\xd2\x77\x3a\x90\xe2\x42\x96\x1b\xd5\xf0\x99\xa2\x6e\xa1\x7d\xd1\xcc\x19\x04\x8d\x48\x93\x18\x66\x75\x83\x20\x78\x50\x97\x3c\x5c\xf7\xa1\xf2\x3d\x1c\x5d\x0e\x47\x83\xf9\x70\xf4\x66\x30\x19\xce\x06\x17\x24\x08\x1e\x14\x51\x66\x93\xde\xf1\xa9\xa7\x93\x8d\xca\x48\x67\x72\x82\x99\xe4\x13\x91\xd0\xc9\xe4\xaa\x08\xa6\x3d\x01\xbf\x31\x3d\x22\x8d\x05\x37\x35\xe0\x2e\x37\xc6\x1d\x8c\xcf\x34\x8b\x74\x22\xb3\x3d\x3f\xc9\xdf\x75\x45\x1f\xca\x65\x56\xc0\x86\x31\x1d\x08\x20\xf7\x07\xd1\x45\x1a\xa3\xd7\x09\xa6\x6b\xb5\x05\x8e\x45\x6c\x7b\xe3\x7c\x09\x0b\x69\x98\xbf\x14\x8b\x2b\x78\x07\xb0\x0f\x37\xe9\xdd\x43\x28\x6d\x46\x56\x1d\xa5\xaf\x6e\x2e\x2f\xe7\xd7\xbd\xd9\x9b\x47\xda\x32\xa1\xbc\x5f\x15\xf8\xc0\x9a\xff\x2c\xcf\xc1\xe9\xce\xd3\x17\x1c\x71\xd8\xf3\xce\x97\x87\x0f\x37\x4a\xc2\xa4\x6d\xb0\x6b\x78\xe3\x92\xa3\x47\x99\x9c\x86\xb7\x14\xd8\xca\x0f\xec\xae\xc6\x6b\x76\x8e\x22\xd1\xbf\x16\xca\x8b\x27\xec\xec\xa3\x48\xb1\x67\x60\x1d\xde\x43\xb9\xad\xc4\x74\x36\x19\x5e\xcf\x5f\x4d\xc6\x57\xf4\x64\x93\x57\x90\x04\xa1\x90\x90\x25\xd9\x59\xa4\x56\x3a\x21\x97\x65\xe6\x85\x28\xd6\x0d\x02\x4d\xf1\xe0\x0e\x12\xc8\x6d\x0f\xe8\xd5\xe0\x22\xfc\xe0\x87\x9d\x24\x88\x58\x6c\x1b\xb1\x95\xf9\x72\xc3\xe0\x42\xac\x9d\xc1\xa5\x08\x5b\xb7\x3e\x05\x01\xdb\xb4\x40\x30\x7d\xc5\xad\x62\x0e\x28\x82\xf5\x55\xc4\x66\xa0\x32\xc2\xe2\x26\x21\x6f\x6d\x31\xc7\x03\xda\x88\xac\x48\xea\xd2\x1b\x2b\x11\x79\x0a\xba\x01\xdc\xae\x3f\xbd\x2d\xe5\xe0\x64\xae\xf8\xdd\xd8\x77\xc4\xcb\x82\x47\xa0\x23\x16\x45\x6e\xc3\x3b\xd5\x45\xc3\x63\xa0\x97\x9b\x56\xfb\xd0\x09\xe4\xcf\xa0\xc0\x47\x50\xf1\x03\xe9\xd2\x0d\x14\x9e\xc8\x99\xfa\xb5\xd0\x99\x0b\x08\xe5\x8c\xbf\x3a\x63\x54\x36\x41\xb7\xd5\xaa\x33\x85\x63\x9c\x06\xa6\x19\x8e\xfa\x9f\xcd\x38\x44\xe3\x1c\x96\x50\x02\x11\xab\xa2\xa3\x62\x89\xc5\x16\x23\x48\x40\xa5\x30\x7c\x06\xd3\xe6\x72\x78\x0b\xf6\x1a\xa0\x71\xfa\xab\x86\xdb\x19\xcf\xe5\x29\x16\xe3\xa4\x60\xed\x20\xb5\x2c\x7b\x78\x0c\x86\x83\xf2\xe1\xe5\x4e\x79\x5a\x15\xf6\x9f\x4e\x74\x78\x50\x07\x47\x3a\x79\x62\xad\xbf\xb3\xd5\xae\x7a\x01\x38\xa2\xe2\x56\x69\x39\xc4\x6b\xcf\x09\x6c\x78\x1c\xd3\x3b\x69\x18\xa1\x34\x65\xba\xdd\xe9\x98\xf4\xf0\xc2\x6b\x1b\x67\x43\xb1\x8a\xe5\xba\x4a\x3a\x4f\x9a\xda\xb9\x39\x7d\x33\x9e\xcc\x1e\x29\x02\xfd\x89\xb8\x2d\xa0\x68\x91\xa4\xca\xc4\xc9\xa2\xc8\xc9\xd3\x68\x0c\x51\x00\x5a\xf9\x69\x29\xaa\x0c\x07\x41\x4a\x76\x80\x07\x4d\x53\x04\x86\xf1\x68\xbd\x54\x46\x44\xa9\x32\xc9\xd7\x18\x0c\xea\x68\x9a\xe5\x22\x4e\x93\x35\xfb\x85\x62\xfd\x41\x89\x34\x11\x17\xe3\x69\x47\x5c\xc9\x65\x07\x5a\x75\xff\xe2\x6c\x32\xbe\x7a\xe0\x3c\x08\x41\x0c\xfe\xd5\xce\x83\xbf\xf6\xde\xf6\xa0\x62\xf7\x6e\x66\xe3\x23\x16\x40\x83\x3b\x13\x1a\x6d\xb6\xcb\xf0\x08\x89\x6f\x1b\xb7\xa3\x80\xc2\x49\x91\xe4\x3a\x66\xc1\x83\x4f\xa3\x34\x3f\x05\xb3\x4b\x67\xd2\x9c\x99\x7c\x1f\xab\x32\x76\x6c\x8e\x19\x13\x75\x0f\x18\x1e\xab\x8e\xc1\x32\x62\x23\x6f\x95\xf8\xef\x82\x96\xf0\x01\x1b\x62\x5d\xc0\x8a\xfa\x29\x87\x9f\xb5\x32\x9b\x11\x27\xf9\xa6\x30\x2c\x13\xc0\x3a\x32\x11\xea\xe3\x2e\xd6\x4b\x9d\x8b\xbf\xd0\x22\x68\x65\x38\xbc\xe0\x23\x6b\x56\x6e\x4e\xef\x47\xfd\x21\x52\xab\x87\x4e\x0d\xf7\x2f\x7b\xa3\xd1\x60\xf2\x89\x88\x97\x84\x6e\xc0\x07\xae\x94\x30\x26\xcf\xbf\xa9\xfe\x83\x9e\x6e\xc4\x42\xb9\x1d\xb2\x50\x6b\xb8\x84\x92\xf5\x7d\xe4\xf8\x6a\x21\x93\x44\x65\x5f\x35\x20\xbf\xd5\xfe\x24\xf4\x7b\x94\x03\x97\xce\xab\x6f\x15\x9a\x56\xbb\x84\xa4\xe2\x84\xe8\x3e\x0e\xb1\x8c\xb1\x23\x3c\xfd\xd3\xec\xf7\xb0\xb3\x65\xdb\x56\xfb\x41\x76\xfe\x29\xaf\xa1\x4e\x9a\x66\xd3\x85\x83\x12\x55\x64\x8a\x9f\xf2\x47\x61\xb1\x3e\x0b\x73\x70\xab\x7d\x84\x87\xdf\x1f\xe5\xe1\x4f\xe1\xe0\x0a\x0a\xc5\x01\xa2\x41\xa2\xab\x9b\xcb\xd9\x90\x54\xce\xfe\xf5\xf5\x7c\x38\x2d\x8d\x0d\x77\x06\x86\x38\x4f\x49\x9f\xf3\x68\xcf\x11\x0f\xa1\x20\xe1\xb6\x88\x73\x7d\x06\x5e\x16\xfd\x7f\xfb\x37\x7b\x38\xc8\xd8\xe3\x74\x11\xa7\xcb\x0f\xe2\x44\x77\x55\x17\x86\x06\xfd\x95\xae\xc4\xf9\xf9\xff\x01\x41\x78\x7e\x7e\xee\xf1\x72\x6a\x37\x41\x13\xb0\x56\x14\xbb\x23\x79\xe1\xdc\x10\x96\xc5\x08\xf3\x3a\xa5\xb0\xae\x12\x89\xba\xf3\xdf\x90\x21\x8e\xd0\x93\x5d\x6c\x7d\xa9\xd5\x25\x4a\x13\x5a\x4c\x95\xd9\xc9\x81\x63\xfd\x67\x10\xae\x8c\x12\xbd\xc2\x59\x20\x76\x99\x5a\xb9\x68\x0f\x45\x93\xed\x6a\x84\x4e\x4c\xae\x64\x54\xd7\xad\x38\xe9\xc8\x67\x45\xb8\xd1\x48\x95\xda\x2a\x99\xf0\xe9\x69\x13\x4b\x64\x2c\x32\x04\x5b\x1d\x8e\x70\xa8\xb6\xda\xb4\x09\x33\xb5\x4c\xd7\x89\xfe\x07\x2c\xea\x64\x2f\xb6\x69\x76\x8f\xe7\x92\x36\xdf\x11\x82\xd7\x36\x1f\xdb\x1f\xf3\x8b\x71\xbf\xe1\x68\xc5\x23\x30\x71\x11\xe3\x62\x05\xc7\x1b\x67\xce\x5a\x33\x2c\x6b\xaa\x1a\x10\x69\x7f\x32\xd9\x8b\xc3\xf7\x08\x60\x0d\x90\xce\x4a\xef\xcb\x71\x60\xac\x7e\x57\x59\xa7\x10\x07\x86\x01\x83\x33\x1d\x5c\xf7\x26\xb0\xc0\xd9\xe3\x74\xdd\x7b\x3d\x98\x3e\x4a\xa8\xec\xb2\x34\x2a\x96\x4a\x48\xf0\x14\xa9\x79\x6b\xca\x86\xb2\x01\x3c\x6b\x2f\x36\x9d\x70\x55\xb0\x43\x7f\x1a\x0b\x0a\x32\x0a\x60\x3e\xae\x58\x2c\xc5\xea\x9c\x94\xb0\x73\x52\x19\x90\xe8\x54\xc9\x7d\x32\x42\xdf\xe7\x40\x05\x69\x9b\xa1\xac\x6c\xf7\x59\xef\xe5\x7c\x3a\xfc\xaf\x41\xa3\x92\xcb\x92\xd3\xe6\x47\x60\x61\x9c\x6e\x45\xa9\x1c\xb9\x5c\x78\x87\x32\x69\x6c\xa4\x8a\x6b\xc3\x8b\xc8\x53\xe7\x84\x11\xb9\x5c\x18\x1c\x06\xe5\xdb\x88\x4f\x89\x55\x26\xd7\x9e\xa6\x57\x3a\xa1\x28\x3c\xbd\xfc\x5c\x3c\xed\xf8\xb0\xba\xfb\xe4\xcf\x9d\x2a\x98\xcf\xc5\x77\xdd\x56\xcb\xaf\xdf\x51\xda\xd1\xfb\xbb\x8a\xfb\xae\x0e\x99\x33\x61\x38\xf7\x03\xb0\x21\x38\x62\x9c\x39\x21\x97\xa4\xc1\xb0\xb8\x85\xcd\xd5\x6a\x1f\x92\xb1\x2b\x7a\x48\x66\xd3\xd2\x20\x97\x8e\xbe\x87\x63\x0f\x89\x6c\xa0\xd9\x0b\x5a\x27\x27\xc1\xb9\xd8\x9d\x8c\xe0\xa9\x6b\xb5\xc5\x57\xc8\xb7\x53\xab\x95\x5a\xe6\x2f\xfe\xb2\x93\x99\x98\x22\x9b\x63\x40\x1f\x98\xe7\xef\x93\xaf\x9c\xb1\x64\xe3\x8e\x10\x2a\x79\x8a\x80\xab\xd3\x95\xe9\x20\x78\x5f\x8e\x42\x39\x44\x7f\x29\xff\x3e\x65\x9b\xa3\xbe\xe7\x9c\xa9\x41\x7c\x67\x3d\x7d\x78\xf2\xd0\x98\x91\xeb\x4c\xee\xf0\x5c\xbe\x21\x4b\x81\xd7\x5d\x59\xe7\x57\x5d\xf1\x8e\xed\x42\x2c\xed\x7d\xf2\xb5\x37\x50\x09\x7a\x6f\x14\x49\x87\x29\x32\x5e\x8c\xca\xa0\x19\x27\xea\x0e\x72\xd7\x46\xca\x4b\xc7\x23\x24\xa1\x0d\x36\x9c\x56\x87\xff\xfb\xdf\x8f\x0d\xde\x6a\xd7\x87\x07\x71\xed\xf0\xa0\xa4\xc6\x86\xdb\x6d\xf6\x06\x0e\x42\xff\xc5\x9d\xf4\xab\x4d\x33\xbd\xd6\x89\x8c\x49\x89\xc7\xbe\xfa\x19\x5b\x1f\x58\x4f\x90\xf8\x01\xbd\x2c\x57\x99\x8c\xc5\x3f\x71\x42\xfd\x86\xff\x3a\x0f\x40\x8a\x97\xe9\xf8\x27\x9b\xda\x46\x72\x81\x99\x2d\x36\x83\x15\xea\x40\x8c\x58\xc8\xe5\x07\x13\x53\x32\xe6\xfb\x53\x48\x0b\x36\x30\xe0\xd5\x05\x3c\xcb\x34\x59\xc5\x7a\x99\xc3\x79\x04\x0b\x9d\x74\x73\xcf\x9a\xef\xff\x49\x2e\x99\xf7\xbf\xb9\x60\xb9\x51\x9c\x06\x2a\xa3\x5b\xcd\x0c\x0f\xd3\x90\x56\x8a\x00\x5b\x9a\x88\xbf\xd8\x97\xfe\x42\x70\x14\xe4\x06\xf3\x99\xa8\xbc\xd0\x93\xf7\x6e\xe4\xf7\xbf\x9d\xb6\x5a\xbd\xcb\x61\x6f\x5a\xb5\x39\xbc\xe1\x3c\x65\x41\x31\xbe\x9e\x0d\xaf\x86\xff\x35\x98\x73\xdc\xe6\xd5\x78\x32\xef\x1f\x1e\x8e\x99\x4f\x45\x42\xc8\x56\x9b\x9c\xf0\xd0\xe7\x14\x03\x9c\xa7\x30\x57\x6b\xd1\x2a\x3a\x61\xbc\xcd\xc6\xa1\x28\x97\xe8\x83\x63\x4e\xe0\x8c\x4e\x33\x0e\x82\xf7\xbb\xd8\x75\xa4\x90\x9a\x5c\x26\x4b\xd5\xb1\x89\x59\x2c\x5d\xb1\x3d\xdd\x56\x77\xbe\x64\x27\x87\x23\xbd\x5a\xa9\x4c\x25\xb9\x55\x1d\x9c\x2d\x6b\x5d\x8f\xde\x59\xc8\x0f\xa7\x5b\x24\xc0\x44\x1d\xa1\xf2\xe5\x03\xd2\xb8\x19\x3b\x5e\x1a\x1f\x43\x22\xac\x8e\xc7\xe2\x10\x7a\xbc\x20\xb0\xaf\xf7\xf9\x06\x39\xca\x16\xa5\xbf\x0f\xa1\x9c\x41\x46\xd8\xf2\x61\x74\xa0\x37\x40\xae\x3f\xa3\x4a\xd4\xb0\x07\x1c\x9b\xc7\x88\x9d\x5c\x7e\x40\x48\x18\x1e\xf3\x5f\x0b\x19\xb3\x9f\x6c\x99\xee\x9c\x4f\x34\x4e\xd3\x0f\x25\xee\x3f\x07\xa1\x84\xa9\xe0\xac\x3f\xc0\x28\x30\xfe\x6a\x3c\x99\x4d\x7a\xa3\xc7\x62\xf4\x55\x9a\x21\xc1\x14\x7a\xbe\x45\xe5\xa7\x60\xb1\xc2\x91\x3c\xd2\x63\x61\x0a\xd7\xfa\x30\x93\xbc\x7d\x73\x71\xf9\x58\x90\xf0\xec\x17\x80\x07\xc3\x7c\x22\x81\xf0\xca\x23\x80\x99\x5e\x0e\xfb\x83\xc7\x42\x33\x8d\xf5\x52\xb5\xda\x5f\x86\xd3\x3d\x9f\xb7\xda\x9f\xcd\xe9\xdb\x34\x2a\x28\x47\x2a\xdf\x7b\xde\x26\xd3\xd8\xa8\x9d\xc4\x0a\xe0\x0b\xcc\x53\xbb\xc5\xd6\x59\x5a\xec\xcc\xe7\x30\xbb\x45\x92\xc7\xa5\x03\xd8\xa8\x18\xe7\x32\xfb\x73\x33\x53\x7a\x06\x23\xf2\xf8\xd3\xd1\x9a\xd0\xd7\xea\x63\xae\x12\xc3\xda\xa8\x53\x39\xa1\x55\x92\x56\x9b\x19\xf0\x06\x67\x61\xb2\x16\xe5\x3c\xc0\x36\xdc\xc1\x6a\x44\x75\x16\x20\x50\x52\xca\x28\x42\x50\x7e\x8a\x92\x22\x50\x94\x24\x25\xe0\xe5\x67\x3a\x11\x5b\xb9\xdb\xe9\x64\x6d\x1d\xc7\x6e\x7c\xa4\xb3\x65\xd0\x32\x48\x6f\xca\x11\xa8\xd0\x79\xe0\x15\x74\x99\xe3\x95\x42\x04\x3c\xf9\xc2\x11\x8d\x33\xd4\xf0\x19\xbe\x92\x74\xaa\x97\xcb\x41\x44\x2e\x0a\x68\x8c\x67\x02\x47\xbe\x05\xc9\x38\xc7\x5d\xc5\x95\xf1\x5c\x0c\x2f\x2e\x91\x39\x73\x2b\xed\xff\x53\x32\xbe\x21\xd7\xfa\x66\x23\xb3\x9d\x38\xe9\xb7\x4f\x3b\xa2\xdf\x81\x35\xd9\x11\x17\x1d\x71\xfd\xe6\xba\x23\xb6\x91\x38\xb9\x92\xd9\x87\x28\xbd\x43\x16\xcf\x78\x01\x4e\xd6\xb7\xea\xac\xdf\x61\x61\x8d\x0c\x15\xbd\x54\x1d\x81\x6d\x82\xf1\x58\x62\x88\x93\x95\xfe\x68\x37\x1d\x4a\x2e\xf8\xd3\xe7\xee\x97\x57\xf8\xb2\x23\x56\x99\x72\xc8\x00\x8b\xb9\xa7\xca\x61\x5e\x65\x4a\x21\x61\xfc\x43\x92\xde\x25\x0d\x4f\xba\x5f\xba\x62\x98\x70\x2a\x17\x52\xa6\x97\x92\x55\x07\x8b\x14\xa8\xc1\xc8\x2d\x04\xbd\x91\x8f\x63\x80\x68\x04\x0e\x59\x2b\x8d\xb0\xfd\x85\x5d\x70\x9a\xd5\x57\x05\xbd\x9f\x15\x1c\xed\xed\x41\xe6\xf7\x40\x46\xd2\xd6\x21\x92\x99\xd3\xea\xf6\x3b\xe2\x71\xe8\xea\x64\x69\x9f\x87\x22\x63\xfc\x38\xf4\x91\x38\x71\x53\x68\x03\x5a\x9c\x12\xf5\x45\x77\xc5\x0c\x2f\x8d\xe8\x57\x9e\xe1\xb7\x29\xe3\xa9\x30\xea\x39\xf2\x88\x5f\xf8\x21\x5f\xf4\x4b\xe3\x9d\x88\xc0\xc3\x40\x37\x43\x36\xb4\x67\x32\xcf\xce\xd8\x17\x49\x3a\x2f\xbf\xa0\x2d\x40\xb6\xd1\x26\x8d\x23\x95\x95\x03\xba\x32\x8c\x4c\x2c\x0b\x93\xa7\xdb\x72\x34\x4e\xf2\x87\x3f\x20\x51\xa5\x7d\x46\xb5\x07\xd7\xbd\xd9\x6c\x30\x19\x4d\xcb\xb4\x4a\xb6\x56\x18\x40\x72\xb9\xc3\x90\x96\x55\xa7\x5c\x6b\xf0\xcb\x6c\x30\x9a\x0e\xc7\xa3\xf9\x55\xef\xfa\xba\xac\x5e\x08\x2c\xe5\xab\xde\xe4\x6f\x17\xe3\x9f\x47\xf3\xe9\xcd\xf5\xf5\x78\x32\x73\x46\xb2\x42\x6a\xa9\x8a\xaa\x16\xf2\x2e\x53\x67\x9c\x2d\x00\xc4\xc6\xde\xe1\x03\xa5\x4e\x2e\x97\x14\x5d\x5d\x3b\x7f\xbf\xdb\x0e\xcc\x20\xce\x2e\x21\x7b\xc7\xa6\x7e\x92\x8c\x74\x6e\xf8\xba\x1d\x83\x14\x1a\xe5\x6b\x3d\x22\x09\x9f\xd9\x4a\x67\x6a\x21\xe3\xb8\x9b\xa8\xfc\x9c\x8f\x0b\x73\xbe\xe5\x89\x6c\xc9\x87\xf5\xe6\x78\x4f\x02\x9f\x07\x28\x5d\x70\xeb\x09\x12\x1e\xc0\xcc\x45\x06\xb4\xba\x4f\x2b\xd2\x00\x5a\xa5\xa3\x33\xbc\x5e\xfa\x63\xf9\xcd\x9b\xd9\xd5\xa5\x65\xb5\x5f\xae\x2e\x9d\xc1\xc6\x3a\x7c\x0d\x74\xa4\x7c\x74\xc5\x05\x27\x01\xe1\x08\xb3\x16\x27\x6d\xbe\x74\x45\x16\xc2\x9d\xcc\xb0\x81\xb6\x3b\x99\xeb\x85\x8e\x75\x8e\x5d\xa8\x8d\x29\xd4\x83\x4e\x91\x03\x1a\xd6\x9c\x22\x64\xea\x60\xff\xce\xc6\xfd\xf9\x70\xd4\xbf\xbc\xb9\x18\xcc\xdf\x0c\x7a\xa8\x67\xa9\x7b\x45\x24\x65\xac\xfe\x43\x65\xa9\xe0\xf2\x2c\x19\xc7\xce\x3c\x34\xa2\xd8\xb1\x73\x0d\x7c\x4c\x99\xcf\xa4\x60\x57\xb3\x33\x38\x2c\xe4\x83\x62\xb9\x05\x1b\xee\xcd\x24\x87\x47\xa0\xe3\x72\x12\x30\xd8\x46\x01\xdd\xc4\xc1\x64\x4d\x21\xcb\x3f\x12\x32\xe7\x84\xe8\xae\xdf\x8b\x64\xed\xaf\x94\xcc\x8b\xcc\x27\x87\x23\x91\x61\xb7\x8b\x35\x6b\x06\x58\x9a\xc7\xbd\x5b\x74\x83\xff\xe1\xc9\x81\xff\xe1\xc7\x1f\x0f\xfc\x0f\xdf\x3f\x22\x1e\x79\x80\xfa\x8a\x7f\x09\x0e\x8c\x26\x94\x83\x36\x4f\x3c\x65\xdc\x56\x63\xce\x2a\x05\x70\xac\x93\x0f\x5c\x27\xc4\x9e\xa1\x2c\x53\x66\x97\x22\x8b\x27\xf5\x9b\x85\x14\x78\x4e\x3f\xa0\x38\x53\xa0\xc1\xd8\x9d\xa8\xb3\xe0\x55\x68\x08\xf5\x7d\x46\xb1\x08\x78\xce\x3f\x30\xa3\xa3\xd2\x2e\x03\x8d\x38\x0b\x4e\x27\x91\xbe\xd5\x51\x01\x17\x2f\x79\x51\x16\xfb\x32\x37\x5c\xfc\x9f\x82\xf4\x05\x9d\x54\x0b\x16\xb1\x72\x6b\xad\xac\xe3\x14\xbb\x76\x8f\x9d\xe5\x5c\xa1\xf0\xf5\x5f\x0e\x47\x7f\xf3\x98\x23\x27\xfb\x43\xac\x7e\xf0\x56\x8d\xd5\x87\xa4\x4a\xc2\xe0\x13\xd3\xd9\xa5\xcf\xca\xb0\x9e\x68\x93\x47\xcf\x9f\x9b\x1c\x82\xa4\x63\xff\xb8\xa5\x18\xba\xd5\xcf\x4e\x49\x47\x61\x4e\xbc\x93\x94\xeb\x11\x44\x62\x4f\x24\x11\x1c\x22\x57\xac\xd2\x0c\xa5\x57\x08\x27\x5f\x7a\xcd\x94\x7c\x0d\xbb\x22\xc7\x01\xc8\xae\x05\x2e\x16\x31\xcd\x9e\xe4\x20\xb4\x1b\xab\xdc\x93\x9f\x92\x0a\x7c\x0a\x9e\x11\x91\x5a\xc6\x50\x2c\xe9\x0f\xab\xd7\x94\x61\x5b\x9c\xcf\x70\x15\xcb\x6c\x4d\xcc\x60\x9c\xfb\xb0\x0a\xbe\xea\xae\xbb\x34\xe4\x49\x80\x83\xd3\x7f\x6f\xb5\xa9\x92\xa9\x30\x87\xdf\x89\x7f\xfe\x76\xca\x1e\x78\xeb\xa4\x46\x1c\x00\x20\xb3\xbb\x97\x4e\x6b\x08\xbf\x65\x1a\xc7\x72\x91\xfa\x6a\xc3\x48\xc3\xaf\xb4\x65\x96\xd5\xc9\x6d\x1a\xdf\x56\x89\x41\x52\xdf\xa5\xf3\x91\x00\x95\xcb\x25\xca\x15\x1f\x72\x65\xbf\xbc\x19\x5e\xce\x86\xa3\xf9\x74\x76\x19\xd2\xdf\xe9\xc8\x01\xed\xaf\xf4\x32\x4b\x51\x12\xf8\xb5\x81\x9a\x76\xde\xbf\x1c\x7a\x65\xb0\xd3\x44\x1a\xae\xb1\x72\xb4\xb1\x1e\x1d\xda\x97\xa4\x2b\x83\x61\x59\x5b\x7c\x60\x8d\x70\xb2\xf7\x2f\x87\x35\xfe\x3c\xb0\x89\xa6\xc3\x6b\xff\xc8\x23\x2d\x21\xa3\x77\xe2\xc4\x28\xf5\xbc\x56\x01\x99\xe9\x5b\x95\x2d\x64\xf2\x01\x28\x2d\xb0\x25\xbb\xcb\x74\x5b\x16\x44\x1a\xbd\x3b\xd7\x28\x52\x3b\x6d\x36\xa2\x9c\x1f\x12\x70\xb2\xff\x8a\x42\x59\x36\x8a\x0f\xf4\xd1\xc6\x20\x43\x47\x1a\x53\x6c\x95\x3d\xf9\x99\x9a\xd8\x6a\xbb\x62\x11\xeb\xa5\x0b\x7e\x58\x27\xf7\x2e\xd3\xb7\x32\xaf\xf2\x0b\x95\xa2\x24\x69\x19\xf6\xda\x65\x69\xce\x59\x6a\x1f\xd4\x9e\x04\x86\xad\x6f\x46\x6e\xdd\x03\x98\x0e\x51\x18\xfc\x73\x98\x86\xe6\x16\xb2\xc1\x10\x16\x34\x25\x9e\xe0\xc0\x42\x76\xf3\x1a\xf1\xd8\x24\x02\xb2\x77\x30\x19\xfd\x91\xc3\x5e\xc6\x48\x2f\x65\x0e\xcb\x6e\xad\x72\xa8\xca\xe0\x54\x48\x2f\x95\x89\xad\xca\x37\x69\x64\xd8\x1c\xc2\x00\x2a\xcb\xf7\xd0\x58\x82\x38\x4f\x95\xa5\x08\x7f\xd0\x6d\x83\xd4\xbd\xd0\x89\x4e\x65\x3f\xb9\x9b\xc4\xcf\xb0\xd8\x07\x13\xb8\x03\xb5\x2a\xbf\xdd\x29\x45\x33\xd0\x29\x78\x97\x66\x1f\x38\xe3\xcf\x0f\x04\xa8\x75\x12\x41\xb7\x5c\xf3\x2a\xd3\xcc\x4b\x63\x29\x0c\x05\x61\x20\xa2\xf6\x3b\x45\x11\x0e\xa7\xc7\xe3\x64\xc6\x48\xd0\x56\xe8\x80\xc1\xfe\x81\x80\xf4\x19\x4c\x95\x79\x92\xfd\x9d\xdc\xd3\x26\x6b\xb5\xef\xd9\x66\x0f\xcb\xfa\xe1\xc5\xe5\xfc\x7a\x32\xbe\x1e\x4c\x66\xef\x02\x4a\x87\xb2\xde\xba\xeb\xac\x99\xcd\xea\x5c\x50\xb7\x5a\xc5\x93\xcf\x7e\xbd\x18\x22\x0b\xe8\xe5\xcd\x6c\x30\x7f\x3d\x19\xdf\x5c\x23\xe8\xd5\x6a\xd7\xf4\xa0\xe6\xe8\x50\xa6\x9c\xa7\xb5\x3a\x74\xba\xaa\xc4\xa0\x79\x59\xbc\x0a\x5a\x9d\x38\x81\x8b\x3a\xd9\x97\x85\xcd\xa4\xcc\x7b\x87\x23\x8f\x40\xcf\x76\xc5\xcb\xbd\xc3\x0a\x14\xeb\x38\x0e\x1f\x93\x16\x5c\xb1\x45\xd0\x79\x51\xae\x44\x45\x7e\x5f\xc5\xfb\x07\xf6\x4e\x13\x0a\x82\xbd\x33\xb4\x79\x6f\x32\x8a\x60\xc9\xd8\x5c\x48\x10\x9e\x24\x38\xa8\xee\xd6\x60\x51\x5a\xd2\xb5\x34\x1c\xf8\x14\xc4\xd9\x01\x10\x92\xbd\x48\x14\xe5\xd4\xdb\x31\xc0\x7a\x76\x58\x1c\x30\x11\xea\x9f\xd9\x6a\xa0\x7a\xb2\x03\x34\x84\xb3\xc0\xeb\x6b\x5c\x4a\x3d\xe2\xa0\x11\xad\x16\x9e\x07\x2c\x2d\x8a\xfc\x54\xe9\x76\x87\x44\x6a\x13\x20\x46\xdc\x6a\x29\xde\xeb\xc4\xce\x70\x3f\x96\x2c\x77\x8c\x06\x53\x24\xb1\xf6\xc7\x57\xd7\xe3\x9b\xd1\xc5\xf4\x50\x96\xdf\xbc\xa4\x27\x61\x5f\x05\xb2\x1c\x6b\x81\xa9\xc3\x58\x0b\x59\xd5\x93\x9b\x80\x85\x35\xdc\x6a\x8b\x93\x55\x68\x08\x3b\x0c\x43\x92\x5a\xe9\xea\x35\x82\x53\x0e\x87\x93\xcc\x22\x02\x15\x0b\xff\x30\x7b\xb7\x30\x26\x1f\xfb\x65\x5d\xe2\xb5\x1d\xe8\x95\x1b\xc8\x65\x08\x73\xb5\x82\xe6\x80\x26\xfe\x67\xed\xaf\xd5\xf6\x83\xe3\x5c\x11\xbd\x38\x57\x59\x42\x45\xa0\xf1\x3e\x08\x65\x10\x1b\x26\x0a\x45\x7e\x0c\xae\xcf\xf0\x6a\xb5\xc5\xfb\x24\x0d\x46\x71\xa6\xd2\x71\xe4\x73\x2a\x5f\x80\x56\x27\xdc\x1b\xad\x1a\x4e\xa1\xa5\x87\x41\xa8\xcb\xde\x74\xda\x9c\x20\xc6\x67\x56\x87\x79\x0f\xc2\x2a\x12\x45\x02\x5c\x80\x49\x51\x91\x80\x94\x5d\x1c\x64\x70\x4f\x95\x9b\xd7\x97\xe5\x90\xb5\x62\x45\x29\xdb\x38\x8c\x64\x02\xd7\xf1\xd5\x29\x07\x26\x10\xe0\x49\xb1\x97\x84\xf4\xee\x41\x1b\x51\x26\x62\xc3\x82\xa4\x25\x5c\x21\xbc\x06\xf7\xf9\x29\x76\x99\xcb\xdb\xa6\x67\x2e\xe5\x4c\xfd\xc2\xa9\xfb\x93\xd9\xab\xd3\xba\x2f\x21\x0f\x8d\x22\xe4\xa4\x59\xf5\x95\x0e\x00\x04\x62\xb7\x0b\x9d\x70\xf5\xad\xa6\x14\xe0\xc6\xa0\xf1\x03\x1b\xe1\x08\x86\xdd\x46\xa8\x53\x62\x3a\xbc\xba\xbe\x1c\xcc\xa7\xb3\xc9\x4d\x7f\xd6\x48\x08\xc6\x7f\x40\x91\x0a\x25\xc8\x86\xa6\x93\x8c\x79\x3f\x92\x39\x7c\x7c\x2a\x8e\xac\xe8\xa0\xb3\x8a\x4e\xaa\x48\xad\xdc\x17\xce\x5d\xeb\x68\x48\x21\xc0\xe6\xc8\xae\xdf\x7f\x08\x50\x34\x50\xd7\x45\x49\xad\xdd\x00\x9d\xbf\xc3\x41\x5f\x32\xac\xe8\x14\xb4\x9c\x51\x19\xf6\xd4\x97\x0e\xb0\x58\xe4\xf1\x8d\x7f\xcc\xd6\x3a\x03\x17\x58\x16\x2c\x9e\xfb\x91\x11\x30\xe5\x43\x5c\xd4\x6a\x3f\xc8\x47\x21\x17\x3d\x82\xde\x35\x3a\x7a\xc1\x47\xf4\x9e\xbd\xbb\x1e\x5c\x0c\x5e\xcd\xdf\x0c\x2f\x06\x53\x7e\xc6\x91\xda\x9f\x01\xd2\xd3\x88\x22\xa1\x16\x52\x78\x29\x35\xfc\xa1\x64\x1b\xaa\xa4\xd8\x56\x31\x04\x0f\xdf\xc1\x93\xf6\xb9\x7a\xb9\x07\xfd\xce\x53\x74\xc5\x34\x65\xc9\x07\xa6\xe0\xe3\x65\xb6\xdf\xa9\xa9\xf8\xe7\x6f\xf4\xcb\x8c\x6b\x64\xaa\xa5\xbc\x35\x2d\xa1\x3c\xf2\x1c\x27\x82\xee\xf6\xfd\xae\x85\xdd\x9f\x3d\xc1\xf4\x95\x91\xc9\x03\xc8\x12\x9f\x20\x6f\x66\x21\xce\xe5\xed\xb1\x62\xc2\x4b\x76\x7c\x8c\x67\x23\x9a\x77\xca\x96\x18\x9c\xe7\xf9\x7e\xc7\x6e\x16\x8a\x3a\x70\x8a\x2a\x48\xdc\x67\xff\x6c\x52\x3a\x75\xb9\x7f\x0b\xfa\xa1\xa0\x24\x26\x4d\x44\xa4\x97\xa8\xe0\x61\xf3\x8c\x5d\x78\x3b\x2e\x37\x02\x28\xd6\x9f\xc8\x40\x7d\xed\xcf\xd7\x78\x5f\x01\x16\x19\xe6\xc8\x80\x42\x37\x93\xa8\x23\x12\xc5\x15\xea\xc4\x01\x58\xf8\x03\x1c\xd6\xc8\x3d\xa5\x02\x82\x37\x8d\xfe\x87\x27\xb1\xd9\x6f\x17\xa9\x8d\x21\x16\x3b\xb1\x94\xcb\x8d\x0a\xd3\xd5\xec\x61\x73\x39\x1e\xff\xed\xe6\x7a\xde\xef\xf5\xdf\x40\x06\xfd\xd7\xa0\x6c\xf9\x41\x2f\x04\xc9\x64\x99\x32\x64\x96\xda\x71\x8d\x0d\x68\xb0\xab\x04\xab\x27\xa0\x69\xf3\x76\xc5\x54\xc3\x74\x09\x8e\x3a\x92\xc6\x50\x29\xe0\xb9\x45\x06\xbc\x75\x1c\xd2\x3b\xe9\x2a\x67\x59\x48\x47\x3b\xaf\xdb\x75\xe3\xa0\x54\x34\x92\x5c\x7a\xeb\x2b\xe8\xb1\x3e\xf2\x9b\x3b\x4d\xf3\x83\x52\x3b\xf0\x8f\x05\x13\x0a\x40\xa6\xce\x78\xc5\x91\x5b\x32\x6b\xe8\xaa\x84\x2d\x4f\x53\x61\xb6\xae\x6e\x34\x50\x5a\x17\x6a\x89\xd0\xb8\x89\xd3\x3b\x95\x35\xbf\x17\xcb\x0c\x41\x95\xad\xda\x22\x3f\x1f\x25\xa5\xd2\x70\x4b\x18\xe5\xf1\x47\x04\xd1\x0e\x59\xd4\x33\x0b\x67\x4f\x9a\x6d\x8b\x58\x3e\x17\xcf\xfe\x7e\xf2\xf4\xcf\xff\x76\x40\x04\xf2\x22\x50\x0e\x85\x8e\x44\x26\x93\x35\x06\xd4\x46\x3c\xe9\x76\x7f\xe4\xd4\x29\x66\x11\x7c\xd8\xa9\x79\xaa\xa0\x44\x85\xd3\xa7\x2b\xf1\xec\xef\x4f\xff\xfc\xe2\xcf\xdf\x7f\xff\xed\x9f\x5b\xed\x12\x1b\xbd\x9c\xb0\x81\x58\x12\x6d\xb8\xac\x38\x50\xdd\x29\x49\xbb\x04\xbd\x30\xbe\x11\x4a\xb1\x5e\x2b\x52\xdc\xf1\x6d\xba\xcb\xf5\x56\xc6\xe1\xac\x36\x8b\x0d\x69\x45\xc8\x9c\x49\xb5\xf5\x71\xa1\x67\xca\xe3\x7c\x8b\x07\xae\xc5\x27\xdd\x56\xeb\x00\x53\x4e\xbf\x79\xf2\xc5\xdb\x58\xbd\x44\x3f\x8c\x7f\x71\x13\x2b\x66\xb3\xc1\x2f\xb3\x49\xaf\x3f\x9b\xf7\x2e\x2f\x9b\x14\x81\x0a\x89\x02\xef\x02\xa4\x95\xf5\x80\x27\xf5\xb8\x00\xc9\x27\xf7\x89\x8a\xbc\x2f\x59\x24\xa5\x4f\x94\x35\x1e\x88\xe1\x5b\xa9\x63\x9c\x46\x5d\x71\x6d\x3d\x12\xce\x5b\xea\x6d\x2a\xec\x5b\x03\xbd\xc0\xc6\x98\xfc\xe7\x4e\x0e\x6f\x74\x14\xa1\x7f\x95\xed\xc5\x64\x55\x5a\x07\xd6\xf5\x64\xf8\xb6\x37\x1b\x08\xb0\x2c\x8e\xdb\x5b\xd4\xa7\xba\x2f\xa7\xb3\xde\x6c\xd8\x2f\x0b\xf9\x4a\xc0\x6b\x4e\x6d\xce\x03\x33\x61\xa1\xb0\x12\x77\x32\x43\xda\xb7\xe1\x36\x3c\x0d\xb9\x97\x4e\x8a\x73\x7a\xa8\xab\xba\xb0\x29\x8c\x91\x75\xb7\xfc\xdc\x9b\x8c\xc8\xcf\x5f\xc1\xfc\x03\xe2\xd9\x41\x00\xaa\x05\xff\x9c\x78\xae\xd1\xd6\x21\xa1\x81\xbe\x38\x60\x9c\x27\xa8\xa1\x98\x0f\x80\x5b\x77\xb3\xd7\xa7\x9b\x0e\xe5\x47\xae\xd6\xad\xe3\xe1\xd5\xce\xdf\x0e\x27\xb3\x9b\xde\x11\x96\xf4\x58\x76\x2b\xbf\xd5\x59\x5e\x48\x9b\x1f\x64\xbd\x1c\x35\x18\xbe\x24\x04\x7e\x6d\xc7\x21\xe8\xf5\xff\xd6\x7b\x7d\x14\xdf\x0e\xcf\xa4\x2c\x71\x62\x0e\xb4\x0d\x2a\xff\x48\x08\x0c\x3a\xda\xbe\xec\xca\x79\x4d\xf7\xe3\xbe\xdc\x11\x4d\x0b\xe7\x4d\x58\xe1\x13\xda\x91\xbc\xd0\x56\xfb\x8b\x2c\x95\x17\xf1\xc0\x52\x2f\xc7\xfd\xde\xe5\x23\x8c\x49\x71\x62\xe5\x07\x54\x37\x73\xea\x2c\x07\x24\x1f\xa4\x2e\x1a\xc6\x7e\x57\x1f\x48\x7e\x0c\xd6\xab\x29\xc6\x9c\x33\xe7\xe7\x74\xf6\x89\x4e\xc2\x1a\x2f\xe7\xe4\xb3\xe3\x22\x27\x43\x99\x30\xae\xb6\x17\x36\x73\x94\x13\xbe\x90\xdd\xe0\x5c\xc2\xc7\x51\x47\xc2\xa2\xd5\x8c\x95\xd2\x18\x27\x31\x86\x42\x2e\x5f\x40\x19\x28\xa6\x41\x32\x04\xa9\x3a\x21\x70\xc4\xb5\xe8\x10\x15\xbb\xbd\x05\x35\x99\x23\xc5\x81\x25\xc6\x48\xf2\x99\xe2\xdc\xa3\x93\x4d\x1c\x38\xa8\x61\xf7\xba\xa7\xc0\xe9\x2b\xe4\x26\xdb\xcc\xf9\x4f\x41\xb5\x45\xb4\xdb\xe7\x0f\x0c\xf8\x48\x8e\xb3\xbc\x74\x35\x98\xbd\x19\x5f\x04\x76\x94\xf3\xb1\x3a\xbc\x85\x28\x09\xdb\x52\x60\x23\x24\x69\xb2\xdf\xa2\x83\x80\xb7\x20\x3c\x27\xc1\x88\xfa\x98\x53\x67\x21\xab\xad\x3f\x68\xde\xf8\x31\x04\x58\x94\xb8\xf5\x6b\x3f\xc3\xdc\x7f\xfb\x4f\x30\xd5\x6f\x5f\xbb\x64\x9b\x70\x2b\x36\x54\xe0\x53\x19\x34\xdb\x65\xac\x4a\x95\x9d\x27\x7d\x52\x3b\x56\xd4\x00\x4c\xc5\xd7\xd7\xf0\x3d\xbb\x67\xec\x51\xfc\x48\xac\xf7\x46\xe3\xd1\x7c\x34\xbd\xee\xf5\x07\xd3\xc3\x7d\xde\xd0\xa8\xe2\x21\xfd\x64\x03\xa7\x90\x55\xb0\x1b\x0f\x63\x76\x1b\x05\xdf\xb8\xfd\xea\xb2\x48\xaa\xbc\x06\x1c\x55\x4b\xd4\x8f\x08\x64\xd7\x3d\x02\x89\x53\xd0\x3a\x8d\xcd\xa7\xaa\x2b\x3c\xad\xb6\xdf\x10\x0d\x8d\x1d\xd9\x45\x0b\x37\x29\x42\x30\x24\x09\xa0\x36\x79\x74\x5d\x5e\x06\xb6\xfb\x03\x38\x6e\xc0\x9e\xb8\x17\xc7\xf7\x08\xd2\x47\xe1\xd8\x61\xf2\xb0\xc8\x14\x5d\x1e\xa1\x32\x31\xae\xe8\x41\xb1\xd1\x2a\x43\x57\xc7\xfd\xa1\xbb\x85\x90\xee\x25\xe9\xa3\x51\x5e\xc1\x62\xab\xfd\xe5\xf1\xe8\x85\x6a\xe8\xe4\x0c\x11\xf9\x6a\x32\x1c\x8c\x42\x47\xf4\x63\x71\x29\x56\x99\x56\x1c\x38\x2e\x63\xc9\x55\x5e\x74\x78\xa9\x44\x9b\x8f\x20\xe7\x20\x53\xe7\x11\x30\x1e\x2c\xbe\x91\x57\x86\xa3\xf9\xcb\xf1\xc5\xbb\x23\x65\x48\x8d\xe0\x25\xfb\x03\x03\x81\x4a\xbb\x60\x99\x16\x49\x14\xfa\x72\x17\x69\xb4\x67\xad\x82\x5d\xe0\x8d\x38\x68\xb5\xdd\x08\x0e\x7e\xdf\xc8\x98\x63\x14\xce\x15\xff\xb5\x09\x6a\xc7\x0e\x97\xf0\x18\xda\x57\x20\xae\xe8\x23\x78\x73\x38\x42\xe2\x57\xef\xb2\x44\x48\xa4\x72\x95\x6d\xa9\xa8\x42\xaf\x6a\x93\xfa\x3c\x5e\x38\x69\x5c\x37\x68\xf8\xdf\x9d\xe6\xe7\x5c\xef\x78\xc8\xd1\xd4\x3b\x04\xaa\xe8\xb6\xd2\xa9\xe1\x04\x71\xe1\xe2\x85\x42\xd3\x4c\x1e\xa2\x0c\x1f\x70\xe4\x83\x47\x2f\x0f\xcf\x44\xc6\xd5\x71\x1e\x40\x4e\x15\x72\xff\xaf\xc6\x34\xfd\xde\x74\x30\x9f\x0e\x46\xd3\xe6\x26\x7f\x0e\x88\x0a\xdb\xd0\xf9\xee\xd3\x85\x71\x44\x39\xbf\x1c\xd8\x9b\x9c\x24\x67\xe4\x40\x8b\x29\xd6\x5b\xd9\x27\xc4\x84\xc5\x6e\x57\x7b\x84\x04\x92\x0b\x75\x21\xe6\xe3\x85\xae\xf5\x39\x51\x11\x39\x85\xf6\xad\x2a\x56\x3f\x16\x38\x89\x83\x7b\x0a\x62\x7d\x36\x51\x9e\x34\x0d\xeb\xcd\xc3\xb9\xae\x57\x07\x75\xe8\x2e\x2b\x01\x15\x25\x06\xce\xb0\xc4\x68\x58\xa1\x95\x92\xf6\x9f\x75\x12\xa5\x77\xc8\xd1\x3b\xb1\x74\x81\xb7\xac\xbf\x5f\xdf\xe9\xe4\x14\x0a\x83\x41\x89\x3a\xd6\xe9\x20\x29\x0b\x4b\x3e\x31\x70\xcb\x8b\xb2\xb9\xc8\x14\xc2\x6f\x1d\x90\xa8\x91\x90\xb4\xfb\xa7\xfd\xf1\xf5\x27\x10\x12\x7e\xfe\xe0\xe8\xa4\xe8\x86\x75\xe1\x51\xcb\x13\xee\x05\x93\x44\xa5\x16\xe1\x0a\x12\x74\x03\x5f\x1f\x90\x99\x5b\x9c\x54\x2c\xa5\x47\xe9\x1f\x07\xb0\x1c\x87\xd8\x89\xc3\xf9\x64\xf0\x6a\x30\x19\x8c\xfa\x83\x43\xc0\x5d\xea\xea\xe9\x21\x0a\xc0\x6e\x24\x9a\x10\xf5\xe4\xc6\x84\xb6\xe5\x14\xf9\xcd\x10\x23\xf8\xda\xd8\xde\xcd\x1d\x57\x71\x2e\xfa\x84\x97\x89\xf3\xde\x06\x70\xb7\xda\xae\x28\xd2\x3b\x85\x4b\x2f\xef\x67\xe0\xe0\x10\xba\x1a\x0e\xa6\x6f\xc6\x3f\xfb\xec\x38\xa4\xba\xd6\xe9\xce\xcb\xa9\x11\x9e\x02\x9f\xae\x70\x27\x50\x35\x03\xed\xc0\x49\x37\x64\x9c\xb1\x11\xd9\x44\x74\x17\x31\xf5\x55\x61\xf7\x06\x24\x0f\x57\x5b\xcf\x3d\xf3\x40\xb9\x50\xd9\xd5\xe0\xea\x25\x20\x3c\x06\x97\x4b\x9b\x27\xb8\x64\x14\xf9\x52\x53\xa4\x9d\x20\xc0\xe4\xd5\x4b\xf8\x9c\x9d\x54\x85\xa1\x4c\x56\x90\x0b\x9a\x57\x80\xea\x50\x97\x11\xd7\x3e\x00\xe9\xb5\x94\xd7\x6d\x6d\xaa\x87\x1a\x8c\x94\x76\xc7\x03\x14\x6e\x06\xb3\xce\xe5\xaf\xc6\x93\xfe\x80\xad\x46\x46\xdd\xe3\x68\x4c\xd4\xe5\x45\x72\x0f\x7b\xf6\x68\x70\x19\x1b\xb7\xfe\x6f\xa4\x6a\x26\x39\x65\xdd\x75\xae\xb4\x99\xfb\x8b\x4c\x2e\x3f\xa8\xfb\x8a\x8c\x09\xb2\xc6\x35\x1f\x40\xc6\x31\xb2\xe1\xe8\xd5\xf8\x18\x40\x92\xbe\xf8\x5f\x36\x12\xf9\xbf\xf1\x84\x2d\x5d\x3c\xaa\x48\x11\xf5\x39\x70\xc9\x82\xed\xc1\x24\x99\x60\x19\xee\x90\x6c\x62\x4a\x74\x15\xe1\x88\x6f\xb3\x72\xd5\x40\x03\xc3\x0e\x73\x1c\x1c\x4e\xcf\x39\xad\xad\x38\x5d\xd5\xda\x23\x32\xf7\x20\x3d\x7c\xb7\x91\x0b\xe5\x92\x82\x17\xfb\xb2\x0d\x23\x04\x72\x83\xea\xe5\x5f\xad\x05\xe9\x02\x9d\xd4\x32\xeb\x43\x48\x39\x80\xf6\x1e\xa4\x50\x39\xfc\xa7\xe3\xa4\xa9\xc1\x08\x59\xea\xc0\x46\x50\x45\xf4\x2f\x46\x4c\x99\x19\x40\x72\xb1\xe2\x5b\xd6\xc9\x2a\xb6\xd7\x0f\x60\x48\x7a\xde\xc5\xd7\x9c\x42\xc2\xbb\x89\xfe\xf4\xcd\xa7\x9a\x31\x4d\x5b\xa5\x8e\x41\xcf\x7d\xa3\x71\x33\xf3\x4d\xe7\x68\x60\x3e\x9d\x3f\x9d\xce\x3e\x09\xdd\x60\x41\xc2\x38\xe1\xf3\x1e\x66\xac\xa2\xda\x70\xa6\xfa\x32\x68\xef\xc6\xb1\xf2\x4a\x8f\xb7\xb2\xe5\x27\xb5\x11\xa9\x92\x80\x0f\xc4\x60\x84\x1a\x19\xec\x16\x29\x43\x00\x96\x16\xa5\x97\x6e\xb1\x3f\x60\x35\x40\x51\xe7\xd2\x32\x28\x30\x5c\x1d\xbc\x50\x22\x6a\x34\xae\x68\x64\x90\x2a\xf6\xee\x16\x92\x1e\x40\x18\x44\xbf\x63\x4f\x3e\x3c\x2a\x78\x3a\x9c\x28\xdc\x2b\x9f\x38\x53\xab\x5d\xda\x40\xc7\x26\x7b\x88\x83\x0e\x79\xa3\x89\x83\x38\x1d\xab\x41\x3d\x7c\x60\xab\x7a\x67\x00\xdc\xa8\x2b\x4e\xe9\x71\x1a\x7f\x9e\x56\x36\xa4\xdb\x47\x07\x0c\x10\xbe\x15\xe8\x5e\x9e\x03\x74\xe6\xe9\xfd\x80\x8c\x2a\xe1\x0e\x21\x3a\xbe\x73\x5e\xbe\x0b\xb4\xc9\x43\xc8\x3b\xb5\x2d\xeb\x15\x35\xe3\x6a\xdb\x70\x7e\x16\x71\xbc\x3f\x2b\x2b\x73\x09\x8e\x0e\x6b\x01\xda\xf5\xad\x82\x8e\xdc\xe4\x17\xa8\x8f\xde\x6a\x07\xe3\x93\x3d\xb5\x70\x1d\x15\x31\x4e\x87\x7d\xb1\x6e\x6c\x5f\x91\x8d\xf1\x91\x4f\x9d\x97\x1c\x18\xba\xa6\x38\xd7\xf4\x56\x65\xde\x7d\xac\x57\xe2\x40\x9d\xae\x40\xdf\x3c\x52\xbd\x26\xa4\x0a\x83\x5d\x1f\x7f\x1c\x12\xbf\xd5\x7e\xbc\xd0\xab\x10\xa5\x89\x74\xb3\xc9\x10\x71\x91\xc9\x78\x36\x9e\x5f\xf5\x66\xfd\x37\xc8\x0f\x2c\x21\x65\xff\x10\xa4\x91\x67\xdb\x95\xd4\x31\x02\xf2\xa8\x87\xb1\x39\xbf\x9c\x94\x81\xf8\x22\x9a\xf1\xb1\x90\x43\xb0\x04\xc5\xa6\x5b\x95\x97\x81\x12\xf6\x49\xf8\x9e\x4d\x99\x82\xd7\x1d\x79\x28\x54\x50\xc0\x57\x26\xb1\xba\x8c\xc4\xeb\x14\x49\x1e\x3e\x2b\xb6\xe6\x59\x0f\x3b\x98\xf8\xa1\xeb\x9d\x44\x5d\x58\x82\xaf\xec\x88\x74\x84\x68\x19\x74\x18\xca\xeb\x4c\x17\xb7\xe4\x43\xb3\x9a\x67\xf9\x00\x7a\x1e\x6c\x52\x94\x2d\x2c\x50\xfa\x03\x16\x41\x4f\x21\xce\xea\xe2\x9e\xb8\x54\x06\x61\xdd\xc2\x94\x6d\x83\xa7\x9a\x71\x5a\xdd\xf4\x39\xef\xcd\xe5\x52\xed\x0e\xa0\xaf\xc1\x5d\x83\x19\x91\x1a\x18\x49\x30\xa5\x1f\x52\x12\x9b\x97\xe2\x99\x00\x12\xef\xf5\x60\x34\xa0\x4c\xbb\xd9\xf8\x62\x7c\x39\xe4\x13\xaf\xd6\xaa\x84\x8b\x0c\x4e\xde\x0d\xa6\x94\xb4\xe5\x02\xc0\x27\xa3\xf1\x29\x5f\xdd\x12\xa5\x8e\x2f\xad\x0b\x12\xbf\x02\xbd\xf6\x76\x8a\x28\xac\xc5\x79\x8f\xa7\x9d\x97\xa7\x59\x39\x3e\x0e\x17\xed\xa6\xd6\xe1\xaa\xab\xba\x53\x15\xb2\xc1\x74\xf6\xf9\x90\x29\x93\x7f\x02\x64\xe8\x33\xfa\x85\x20\x73\xab\xbe\x07\xb2\x97\x37\xaf\x3f\x1b\xb0\x45\xb1\x7e\x3c\x5c\x8b\x62\xfd\x85\xc0\x72\x4b\xbe\x0f\xac\x8b\xc1\xf5\x64\xd0\xef\xcd\x06\x17\x9f\x03\x1d\x0b\x8f\x08\xdd\x8f\x51\x1b\x11\x3d\x16\xc8\xe0\x8d\x00\xd6\x56\xfb\x77\x40\x5b\x85\xa4\x02\xed\x60\xd4\x7b\xc9\xed\x89\xd1\x50\x7e\x7a\x0f\x98\xcb\x34\xf1\x8e\x93\x23\xb1\x14\x83\x7c\x9e\xec\x83\x25\xdb\x7b\xbd\x12\xff\xc1\x5f\xcc\x63\xb9\x50\xf1\x7f\x8a\x6e\xb7\x2b\xde\xa3\xf6\x1e\x61\xba\x48\xbc\xc7\x98\xf5\x87\x5a\x6d\xff\x18\x7d\x6d\x1d\xcc\x08\x56\xd5\xd7\xca\xd4\x73\xb0\x5c\xf5\x7e\x99\x0f\x47\xc3\xd9\xb0\x77\x39\xfc\xaf\xc1\x64\x0e\x6b\xef\xc0\x13\x1c\x5e\x53\x54\x76\x3c\x8a\xf9\x3b\xe9\x34\x68\x6a\xf5\x29\x63\xc6\x29\x49\x78\x84\x39\x88\xde\x69\x26\xb6\x72\x99\xa5\xe2\x9c\xd5\x18\xe2\x0b\xf2\x56\x42\xd9\xb3\xce\xdd\xba\xca\x5b\xa5\x9c\x3b\xf9\x78\x1a\xfd\x0f\x95\x55\x0a\x98\xa8\xee\xcb\x2f\xca\x35\x9a\x85\x36\xc2\xb7\xcd\xe8\xbc\xee\x64\x12\x37\xa8\x6d\x2b\x17\xfc\x04\xab\xa0\xb0\x46\x30\x89\x09\x7a\xc3\xbb\x8c\x35\xbb\x52\xc9\x77\xad\x95\x4d\x7c\xd2\x55\x58\xcb\xe8\xa0\xb7\x6e\x42\x82\xdf\x78\x04\x04\xe9\x7e\x7c\xc9\x57\xec\xae\x32\x14\xef\xe1\x7b\x0c\xe1\x4c\x33\xf1\x1e\xeb\x0a\x3f\x63\x4e\x3f\x82\x2e\xb4\x0a\x94\x59\x44\x39\x45\xee\xbe\x3c\xae\xc6\x79\x54\x52\xd9\xd3\x27\x4f\x9e\x3c\x39\x48\x2c\xfb\x16\x99\x65\xcd\x3c\x23\x5e\x88\x6f\x9f\x54\xea\x16\xe0\xc1\xb9\x99\x0e\x2e\xd8\x99\xc5\xb5\x0b\xf5\x1b\x0b\xca\x16\x39\x30\x6d\x83\xb8\xa2\x60\x23\x13\x81\x95\x1c\xc5\xec\x8c\xe9\x66\x9b\x8c\x91\xcc\x89\x12\x47\xbc\xad\xa5\xfe\xba\xe5\x7c\xd5\x9a\x6f\x8f\x9a\x78\xbb\x3d\xec\x9d\xf8\x9f\x2c\x45\xea\xa0\x0b\x51\x51\xe1\x2a\x18\x7a\x00\x39\xbc\x08\x06\x15\x6f\xbd\x22\x34\xc1\xf9\xea\x13\x50\x59\x21\xdb\xa6\xb7\x2a\x78\x44\x25\xb9\xeb\xdf\x8c\xf7\x7e\xa2\x8b\xd7\x86\x49\xa4\x3e\x12\xaa\xfc\x17\xaf\xa8\x8b\x80\x98\xa1\xd9\xc2\x5b\x4d\x6d\xe9\x50\x4d\xe4\xf7\xcf\xe9\xa3\xc0\xad\x40\x5a\x3d\x22\x2a\xf0\x42\xa1\xe5\xa0\xf9\x27\x00\x3d\xf2\xf6\x03\x37\xcd\xe3\x53\xa1\x0e\x79\xf9\xdc\x63\xc1\x6f\xb5\x0f\x10\xf0\x39\xe0\x07\x60\xd5\xc1\x87\x9c\x05\x76\xe6\x6f\x07\x13\xea\x96\xf0\x6a\x78\x39\x1b\x4c\xee\xef\x2c\xb7\xcb\x52\xd4\xb9\xe2\x88\xb4\x4d\x23\x9d\xd7\xc5\xa9\xa3\x5c\xfa\x86\x0a\x58\x94\xce\x82\x5d\xf3\xca\xbd\x66\x7c\x89\xa0\x77\xf9\x5a\x47\xda\x49\x99\xe0\x0d\x0c\xf0\x0e\x73\xcf\x56\xaf\x1b\x3c\x2d\xfb\xae\x70\x47\x54\x3b\x97\x55\xf0\x69\x79\x8b\xbd\x50\x1f\xd5\x92\xea\x42\xc5\xc9\xad\x86\xa2\xbd\x4b\x77\x2a\x39\x39\x3d\x75\x9e\x7e\xe8\x1e\x81\xb4\xda\x15\xf9\x19\x96\xe2\xf2\x3e\xfc\x37\xa6\x2a\x48\x99\x36\xcd\x88\xeb\x88\xea\x60\xee\x6d\x97\xb8\x4f\xde\x6c\x38\xf2\xe9\x4b\x57\x35\xd1\x6a\x07\xcd\x17\x90\x6c\x2f\x73\x9f\x59\xee\x20\xba\xc3\xd5\x00\x64\x25\x99\x5c\x26\x11\xda\x26\x70\x8f\x07\x97\xe0\xed\x7a\xf5\xd9\xd0\x1e\xa3\xce\x36\x1a\xa1\xbe\xc1\xb6\x53\x9f\x51\x8d\x82\xa3\xd5\xc4\x09\xe1\x89\x7c\xd9\x7b\x37\x46\xf3\xb3\xe1\xe5\xe0\x7e\x0e\x89\xe5\x3e\x75\x00\x06\x2d\xf9\x16\xdc\x6b\xa5\x0e\x2c\xc6\x0e\x5f\x61\x52\x5b\x58\x6c\x05\x3d\xa7\x99\xa1\xff\x41\xba\x0a\x37\x22\x61\x8e\xb1\xc0\x6d\x7e\x12\x04\x0c\xdc\x47\xdc\x44\x27\xf1\x81\x39\x71\x27\xf7\x5d\x31\x4b\x59\x5b\x13\x79\x75\x72\xe2\x3a\xf4\x23\x55\x5c\x8b\x6b\xdc\x3a\x29\xe4\x4d\x47\x8e\xe9\xd4\x92\xac\x39\x0f\xe8\x2c\x66\x2b\xd7\xf7\xf6\xf3\x37\xd3\x51\x3e\x5c\x89\x20\x1f\xa3\xe4\x58\xb5\xcb\xbe\x46\x6d\x08\x5a\xef\xdb\xde\x6b\x8e\xc5\x2f\x69\x79\xdd\x8f\xdb\x38\x08\x41\x87\xf4\x76\xac\x55\x83\xa5\x5e\x31\x65\xa3\xa8\x95\xb5\x73\x7e\x77\xd9\xa1\x9e\xd3\x95\xb0\x67\x78\x99\x3e\x51\xea\x60\x35\xb5\xac\x04\x5b\x46\xad\xf3\xda\x8d\x25\x81\xe9\x1c\x72\x50\x59\x77\x8a\xfb\x03\x84\xda\xee\x72\x5c\x08\x19\xf2\x58\xf0\xcf\xf3\x60\x7f\x08\x7d\x7f\xf8\x92\x85\xfa\x3d\x6c\x08\xd3\xdc\x75\x5a\x59\xe8\x05\x9f\xa5\x25\x7c\x2c\x5f\xca\x30\x1f\x69\x7f\x9a\x33\x44\x48\x84\xbb\x42\x53\xe9\x15\x81\xae\x1f\x09\x8f\x28\xfa\x3b\x6c\x07\x75\x78\x61\x8b\xcf\xa0\x28\xe9\xca\xa3\x07\x7d\x35\x68\x85\xb9\xfa\x28\xf2\x34\x05\x91\x6d\x99\x23\x0a\xe9\x08\xf9\xa8\x52\x44\x6e\x90\x49\x7d\xfd\xbb\x4a\xba\x77\xfa\x83\xde\xa9\x48\x4b\xba\x06\x18\x7f\x9d\xbf\xd4\x8b\x99\xfa\xa5\xec\x30\x83\xeb\xaf\xba\x5c\x12\x6e\xeb\xa0\x40\x05\xdb\x05\x9a\xf9\x65\xa1\x17\xb1\x86\x7c\xd9\x6d\xf6\x0e\x95\x75\xad\xaf\xd5\x16\x97\xbd\xd9\xe0\x17\x42\xfc\x74\xf6\xee\x12\x05\x26\x2e\x6a\x16\x54\xe1\xf9\xa6\x92\x0c\x0d\x04\xe1\x4e\x65\x71\x99\x8b\x5e\xaa\x84\x46\xc1\x31\xc9\x97\x44\x78\xf0\xde\x2f\x75\xce\x7a\x77\xb2\x4a\x05\xe2\xdc\xb9\xdf\xaa\x9e\x54\x06\xed\x06\xaa\x8c\xe0\xfe\xbd\xf8\xe2\x25\x04\xfd\xa6\xa2\x01\x5f\x52\x80\x4b\x45\x6d\xb6\xba\xab\xa8\x5f\xe3\x82\x26\x7f\x53\xdc\x17\xaf\x2e\x00\xd7\xfd\x74\x33\x1c\x34\x9a\xb0\x79\x91\x25\x22\x4d\xce\xd3\x95\x2b\x81\xb7\xab\x28\xc3\xc3\x5e\x6c\xda\xa8\x77\xfd\x20\x09\xc5\xf2\x70\xc5\x13\x55\xdc\x8e\xdc\xe4\x6a\x6b\xdd\x8c\x81\x8d\xe5\xe7\xc2\x34\xe9\x6a\x75\x5c\x37\x21\x37\xb0\x1d\xfa\xe0\x5f\xe8\x46\xf2\x09\xfc\x8f\x80\xd4\x11\xe1\x00\xe2\x56\xbb\x02\x73\x09\xb1\xca\xb2\x34\x13\x68\xfd\xa1\xb2\xec\xb4\x0e\xb9\x9f\xbb\x02\xbc\x0b\x2d\xd5\xc1\x0f\x2a\x16\x00\x3c\xb4\x70\x40\xaf\x77\xcf\xc5\x0c\x0b\xf5\x5f\xa3\x1a\x75\x03\xe1\x8f\xb3\xdc\x79\x88\x3f\x49\x81\xf7\x0b\xab\xe3\x8d\x55\x3a\x36\x45\xf1\xd8\x7c\xf8\xca\x66\x2a\xde\x5c\x0d\x46\xb3\xc1\xc5\xa1\x07\xbd\x21\x76\xe0\x90\xd5\x6a\x97\xab\x86\x3c\x69\xca\xfb\x24\x1e\xa9\x65\xff\xd5\x07\xf7\x79\xbe\xee\xec\xaa\x8a\xc7\x85\xf2\x35\x85\x8f\x81\xfc\x00\xa4\xe3\x90\x23\xb3\x70\x30\x99\x8c\x27\x0f\xa6\xd3\x39\x90\x2b\x00\x93\xb6\x88\x9e\x4d\x5a\xc6\x96\x57\x9a\x9d\x54\x65\x7a\x09\x5c\xeb\xee\x2b\x90\x96\xda\xb2\x96\xce\x6a\x98\xf9\x88\x8c\xbb\x47\x54\xe4\xdd\xcb\x28\x08\xad\xbc\x1a\xb8\xb8\x89\xc5\xa2\x34\xf9\x1a\x0d\xd8\x60\x24\xa6\x99\x17\xca\xf0\xce\x14\xbb\xd2\xb1\x74\x97\xa5\xc9\x3a\xde\x3f\x16\x91\x25\x86\xaa\x2c\x44\xa7\x13\xa1\x71\x34\x9e\x5f\xf7\x26\xbd\xab\x8b\x71\x9f\x25\x9f\xdb\x87\xce\x89\xcf\xda\x7d\x85\x55\x1c\x58\xc6\x19\x06\xf5\xba\x25\xa4\xf0\x92\x83\xe5\xa0\x70\x09\x9c\x66\x43\x4a\x01\x0a\xe8\x4a\x73\x88\x36\x74\xed\x01\x2c\xf5\x40\x4d\x85\x9a\x14\xf9\xc0\x7a\xb8\x7a\x88\x90\x02\x04\xeb\xc4\x79\x4b\x5a\xed\x72\xf8\xea\x02\x5c\x7a\x71\xce\x2f\x83\xa1\xe4\xc2\xb8\xab\xeb\x2b\xcf\xf2\x35\x09\x8f\xe5\xff\xcf\xe6\x7e\x12\x97\x07\xe4\xb8\x67\xdb\xf7\xa6\xc7\x18\xbf\x61\xbf\xeb\xed\x16\x3a\x04\x5c\x48\xc2\xe0\xb6\x74\xd4\x4e\x81\x66\x6e\x37\x60\x04\x95\x2c\xd3\x02\xc9\x8c\x8f\x5b\xa7\x5f\x80\xa8\xfb\x17\x9c\x58\x9f\xbf\x1a\x4f\xae\x7a\xb3\x26\x77\x1e\xab\xec\xac\x9e\x34\xcb\x75\x86\xa0\xd5\x76\x17\xc6\x47\x05\xf2\xb9\x66\x1b\x1f\x44\x61\x1b\x94\xd5\x3d\x1a\xea\x4f\xd0\xdb\x3a\xe2\x4f\x70\xc4\x59\x23\xed\x4f\x36\x63\x4c\xae\x1b\xaf\x71\xa2\x3e\x86\xe4\xfb\x74\x36\x15\xbd\x84\xd7\x9d\xaf\x31\xb8\x81\x2a\x5c\x2c\xb7\xdc\xce\x49\x6d\x76\x61\x26\xf7\x25\x5f\x1f\x58\x9a\x05\x01\xd0\x5b\xe9\x35\x70\xf1\x27\x36\xdf\x5c\xf7\xc0\x32\xfc\x58\x5f\x1a\x3f\xe8\x34\x3a\x5a\x29\xfc\x04\x3a\x2f\xaf\xfc\x4f\x17\xb8\xc0\x4f\x45\xe8\x02\xd2\x6c\xbb\x1e\xbf\x61\x83\x10\xf7\x9c\xf0\xf6\xdc\xe2\xcc\x11\x9a\xa9\xe8\xa8\x4c\x84\xfe\xea\xf0\xf1\xaf\x2a\xa4\xbf\x1c\xbf\x7e\xd8\x84\x74\xd9\x5a\x0c\x3b\xe3\x0e\xb8\x24\x59\x1c\xea\x1b\x4c\xea\xda\xa5\xed\xc1\x1d\x51\xc1\xcd\xe4\xe5\x9d\xb1\xa1\x2e\xd0\x6a\xd7\xb4\x01\x07\x9f\x5b\xaa\xf8\x9f\xd4\x2f\xb1\xfc\xd2\x63\xf0\xc7\x68\x94\xc3\xd1\xf5\x8d\xcf\x4b\xa9\x53\xc3\x31\x15\xf9\x31\xcf\x53\x77\x35\x6e\x9a\x79\x15\x88\x79\x36\xf0\xf4\xaa\x88\x0b\xac\x9c\xb5\x04\x8b\x18\xec\x8d\xef\x38\x91\x37\xb8\x31\x6a\xbb\xc7\x27\xdd\xe5\x6e\x67\xfb\xe6\x85\x33\xd0\x03\xe7\x85\xc9\xce\x4d\xb6\x3c\xdf\xee\xb9\x45\x18\x8c\xa2\x9d\xf4\x5e\x50\xbe\x2f\xbe\xba\x38\xce\x8d\x75\xe1\x7d\x6f\x66\x54\x9b\x8b\x82\xab\x0e\x1a\x86\xfa\xe8\xba\xab\x55\x62\xe4\x90\xa1\x7a\xe4\x96\x7e\x12\xff\x30\x6b\x20\x26\x5b\x16\xa7\x07\xff\x5e\x88\x7f\xfe\x53\x74\xa7\xd9\x52\xfc\xf6\x9b\x3f\x6d\xef\xd9\x0b\x34\x95\xbb\x3f\x9d\x24\x71\xc4\x57\x22\xe1\x9b\x10\xc9\xad\x76\x45\x3a\xfa\x5e\xc7\x43\x4e\x43\x8f\xbd\x8a\xeb\x2e\xb5\x50\xe2\x66\xf6\xea\xec\x07\x3f\x6a\xe9\x55\xe3\xbb\xeb\x63\xbd\xd0\xe8\xa1\x40\x57\x30\xe0\x79\xfb\x17\x75\x39\x46\x7a\x3b\x5a\x35\x2e\x96\x65\x6b\x27\x34\xa7\x35\x6e\xac\x29\xfb\x99\xdc\x20\x07\x81\x00\x6a\xea\x56\x69\xe9\xb6\x4e\x0a\xb2\x66\x7d\x0f\x37\xf7\xee\x79\x39\x07\xdb\xe2\x38\xc4\xdd\xad\xcc\x6e\xfd\xf7\xc4\xae\x09\x50\x47\x95\xf9\x60\xd4\x1f\x5f\x84\xfd\x75\x5e\x58\x4c\x04\x47\x69\xe8\xed\x0b\xf6\x07\xb3\xba\x09\xf9\xac\x53\xe9\x88\x1b\x78\x07\x3d\x8b\xb1\x3f\xb9\xc9\x37\x71\xa7\xe3\x68\x09\x83\x64\x87\x56\xc2\x59\x62\xc4\x09\x71\xfc\x37\xb4\x1b\x6c\xca\xd6\x37\xdd\x0d\xf5\x3e\x5a\x69\xb4\x21\x12\x4e\x33\xb1\xc4\x3f\xf3\x7e\xaf\xf0\xb2\x41\xad\x4c\xdd\xff\xd3\xdc\x7d\x37\xcd\x28\x29\xc4\xbe\xc7\x77\x0c\xdb\xfc\x99\x86\x16\xbd\xad\x76\xa5\x49\xef\xc1\xae\xf1\x44\xf2\xef\x96\xad\x7b\x43\x39\x42\x65\x4c\xf0\x19\x1f\xb4\xee\x6d\x37\x5e\xfa\x58\x5e\x94\xeb\xb1\x04\xa5\x32\xa7\x76\x57\xcf\xbf\xe9\x2e\x3b\xe2\x9b\xee\xd2\xfe\xff\xf1\x23\xfd\xd8\xed\x50\x4f\xf9\x4d\x77\x89\x66\xd5\xdf\x74\xff\x9b\xfa\x5a\x7f\xd3\xd5\x9a\xfe\xb7\x0f\xe9\xdd\x8e\x7e\xd8\x47\x74\x12\xd3\x8f\x88\x7e\x44\xf6\x47\x6a\x7f\xd0\x15\xea\xdf\x74\x37\x1b\xfa\xc3\xbe\xbd\xb1\x6f\x6f\xec\xdb\x4b\x83\xff\x23\xfc\xb7\xdb\xec\xf8\xc7\x77\xfc\xf3\x7b\xfb\x33\xdf\xd2\x60\x3a\x59\xda\xe1\xb6\xf8\xcb\x75\xe7\xa5\xdf\xe9\xfd\x2d\x7d\x1e\xa5\x1f\xc5\x09\x37\xbc\x72\xbd\x75\xa4\x77\x47\x8a\xbe\x6b\x41\x4c\xed\x9c\xbf\xe9\x46\xe9\xf2\x91\x8f\x8b\x6f\xba\xf9\xc7\xfc\x9e\x87\x5b\xed\xda\xe3\xbb\x3d\x16\xb4\xdb\xdf\xe1\xc7\xea\xc7\x27\xf6\x07\x01\xb5\x7a\xf2\xad\xfd\xf1\x03\xfd\x78\x6a\x7f\xd0\x7f\x69\x86\x1f\xb7\x9b\xc8\x2e\xf0\x76\x63\x51\x59\x2c\x57\xf8\xf1\xab\x81\x2f\x3c\x02\x3a\x96\xca\x79\x9f\xfd\x86\x71\xfb\x52\xbc\x60\xda\xb9\x93\x6a\x32\xe8\xdf\x4c\xa6\xc3\xb7\xf7\xea\x0f\xae\x75\x37\xf3\xb6\x29\x16\xc1\xb6\x60\x85\x81\x53\xb9\xc8\x0d\xc5\x89\x7d\xc1\x29\x0b\x6c\xdc\xa9\x38\x7e\x40\xdd\x2d\x57\x53\xf9\x17\x98\x52\x28\x01\xa7\x4c\xea\xfb\xd6\x7b\xff\xe9\xea\x15\x9c\x56\xdb\x57\x1e\x95\xd1\x23\x2b\x9a\xaa\x87\x2d\x9d\x29\x77\x72\xef\xe5\x92\x92\x46\xc3\x17\x6b\xdf\xa6\x8b\xcc\x02\xa4\xec\x0f\xfd\xc0\x39\x42\x6e\xb6\x38\x27\x4b\x91\xd3\x66\x78\xb1\x3a\xac\x7c\xf5\x62\xb1\x2e\x6a\xaa\x97\xa4\xd2\x7e\xf7\x1f\xb1\x4a\xf3\xc0\x3d\xae\x90\x0d\x45\x82\x3c\x01\x46\x9f\xc3\x2d\xff\x7b\x41\xad\xf6\xa3\xf3\x28\x5d\x9a\x1a\xa6\xe7\xd3\x77\x57\x68\x95\xdb\xec\x1e\xa6\x7b\x04\xea\x1c\xe2\xb4\x87\x9a\xea\xe1\xdd\x66\xb6\xf7\x8c\x5e\x52\xab\x60\x23\x4e\xa4\xb8\x49\xf4\xc7\x4a\x3d\x12\x3b\x41\x4f\xe9\x79\x47\xa6\x56\xbb\x24\x14\x71\xd7\x03\xfc\x74\x00\x81\x68\xca\xb0\xfb\x9d\x47\x93\x9b\xe4\xf3\x4e\x27\xa4\x90\x7c\x74\xd5\x09\x4b\x95\x61\x4a\xc6\x1f\x83\x0a\xa6\x09\x96\x50\xe7\x8d\x7c\x53\x0e\x6b\x1d\x66\x94\xa9\x06\x09\xb4\xc6\xc9\xea\x6e\x35\x84\x97\x0c\x25\x0c\x95\xeb\x78\xa9\x2f\x39\xb9\x2e\x79\x11\x28\x3b\xa3\x84\xaa\x70\x4a\xb1\x0a\x2e\xa6\x62\xc0\x1d\x04\xe2\x9b\x73\x3c\x7e\xfe\x4d\xab\x75\x80\x08\x87\x6d\x68\x68\x03\x1e\xff\xb7\xdf\x1a\xd8\xeb\xe5\xf8\x72\x7a\xdf\x86\x0e\x51\xc8\xad\x90\x48\xdb\x45\x00\xbb\x4c\x38\x0d\x1a\x9f\x79\x9f\x89\x6b\xc9\x5c\xdd\xf9\x0e\xe5\x95\xe0\xb0\xb5\x69\xd8\xec\x2d\x27\x71\x6b\x42\xb1\x27\xec\xcb\x6a\xee\x6b\x07\x96\x7d\x9a\x45\xe4\x73\xb2\x9d\x52\xad\xd5\x6b\x95\x90\x6f\x9c\x01\x80\xe7\x4c\xb1\xb0\xe6\x74\x57\x0c\xec\x2d\x5f\xe6\xb9\xe8\xf9\x40\x76\x47\xf4\xfa\x48\x70\x80\x78\xb7\xbf\x3d\x7f\x5e\xf9\xd6\xff\xfe\xfc\xf9\x37\x33\xca\x79\xfb\x97\x33\xc2\xc3\xc4\x77\xd4\x74\x72\xa5\xa4\x76\x8f\x9a\xc0\x1d\xbd\x29\xb9\x81\xd4\x4d\x46\x88\xd3\xca\x79\x77\x7a\xb6\xac\x5e\x15\xe7\xfd\xd5\x9c\x3d\x8c\xfe\x8a\x2e\x4e\xfb\xbe\xac\x06\x62\x57\xdf\x29\x89\x8a\x60\x7d\x42\x54\xd6\xdf\x24\x27\x0e\xe1\x79\xb4\xb8\xf0\x2f\x7e\xb2\xb8\xf8\x72\xba\x6c\x4d\x29\xb4\x85\xf2\x7c\x6e\x06\x58\xab\x22\xa6\xb2\xab\x03\xc4\xb8\x47\xca\xe3\xfb\x88\xab\xac\xa6\x39\xb0\x73\xa8\xd5\x3e\xae\x37\x58\xa5\xaa\x30\xe1\x31\xe9\x08\x08\x34\xbd\x8f\x52\xb8\x87\x89\xa0\xde\x73\x0b\xdf\x70\x16\x96\x51\x34\xd0\xaf\xb2\xd6\x07\x8f\x91\x3a\x7c\x95\x63\x04\x6f\x0e\xaf\x7a\xaf\xbf\x3c\x73\xeb\x6d\x25\xf8\xe2\x03\x99\xcc\xd2\x8e\xae\x07\x56\x20\xb3\xda\x7b\x7a\x3f\xe4\xf2\x60\x99\xa2\xce\xe5\xde\x71\xe1\x92\x15\x1e\x95\xb6\x52\x31\x91\xbd\x2a\x58\x26\xab\x30\x5f\xfa\xdc\x94\x92\xb8\xc7\x73\x4e\xf8\x1d\x9e\xa2\xd5\x3e\x4c\x3e\x69\x4c\x3d\x79\x4e\x27\xe3\x7f\xd8\xb7\xff\x53\xfc\x47\x99\x34\xf2\x9f\xf4\x8d\xcd\x42\xf1\xdf\x6b\x73\xc8\x15\x75\xf8\xad\x63\x33\x1c\x89\xf3\x4f\x5a\xed\xe6\x0c\x94\x1a\x50\xc4\xf5\x4e\x68\xb2\xd3\xcc\x0b\x6b\xbb\x90\x56\xdb\xe3\xf2\x68\x46\x0a\xb9\xe0\xec\xaa\xca\x7d\x18\xea\x90\xdc\x42\x37\x2f\xe3\x42\xe0\x13\x5b\xce\xd3\xa4\x30\x30\x86\x29\x22\x0f\x85\x0d\x15\xa3\x14\x17\xa0\xcc\x32\xb8\x26\xcd\xbf\xbb\xdb\x01\xa9\x04\x02\xa7\xe6\x0a\x6c\x5b\x36\x1a\x04\x26\xcc\x12\xd7\x45\x73\x0c\x02\x03\xdd\xb9\x5a\x7f\x06\xd6\x3d\xe8\x82\x42\xb6\x6d\x29\x4d\xc0\x25\xe2\x56\xad\xf3\x93\x13\x28\x4a\xc8\x64\xb9\xf1\x15\x52\x18\x18\xd9\x2e\xd6\x83\x4c\x8d\xfd\x60\x5b\xff\xbf\xc1\x20\xb7\xd5\x17\xf1\xbe\xf1\x56\x18\xef\x3f\x61\x86\x6b\xd8\x90\x75\xa2\xdf\xb3\x27\x2d\x4d\x51\xab\x82\xed\xe9\xbc\x82\x7c\x72\x60\x13\x49\xa3\x4d\x8d\x3f\x11\xc2\x91\x59\xb9\x74\xcb\xd4\xa4\x1e\xd0\x6e\x75\xe7\x0e\x77\x03\x8a\xf7\xfe\xc6\x77\xcc\x15\x96\x70\xb8\x0a\x09\xbe\x6d\x8b\xd7\x22\xb3\x30\xdd\xc3\xb9\xe6\x9f\xbb\x55\xbd\xf0\x5b\x20\x38\xd8\x5e\x6c\xf7\xf3\xe5\x6e\x37\xb7\xdf\x9d\x5a\xc7\x57\x05\x4f\x20\x82\xbb\x6d\x07\x39\x19\x70\xf6\x13\x29\x12\xa4\x38\xf8\xe5\x19\x7f\x67\x63\xd7\x9d\x54\x4d\xe8\xf4\x5e\x48\xab\xc1\x25\xe5\x05\x5f\xad\x76\xa9\xa7\x93\x52\x5d\xc5\x53\xa7\xba\xaa\x72\xaf\xfc\x7f\x81\x31\xeb\x98\x6a\x38\xf6\xf9\x91\xe9\xf8\x06\x75\xce\x65\x06\x51\xe5\xe0\xef\x94\xc6\x1a\x93\xc5\x26\x5d\xba\x6e\xab\xad\x76\x05\x8b\xa7\x1c\xd7\xc3\x55\x21\x01\x8f\xf3\x9b\x95\xa1\xc2\x83\x11\x44\x76\x61\x66\xea\x5a\xe8\x92\x01\x42\x43\x1e\x2b\x5a\x64\xe9\x9d\x51\xdc\x8f\x99\x84\x15\x2f\xff\xe5\x64\xfc\xf3\x74\x30\xa9\x2e\xfe\x9e\x8c\xd0\xd1\xb8\x44\x52\x05\x03\x35\xc5\xa0\xfa\xc8\x63\xf6\x71\xb9\x64\xe2\x61\xb7\x93\x4b\x66\xec\x8a\x9e\xfb\x95\x63\xb4\xee\x1e\x3c\x82\x98\x6f\x62\x00\x43\x54\x89\x58\x5e\x1d\x60\xdd\xa0\x2c\xdf\x81\x6a\xef\xfa\x0d\xf2\x73\x2b\xeb\x70\x23\x4a\x5e\xa6\x5e\xfa\x25\x38\x32\x7e\xd3\xc5\xcd\x7a\xe2\xc4\xa4\xfe\x8e\xb3\x44\x6e\x7d\xea\x1b\xed\xe5\x87\x2f\x42\xaa\xe2\xcb\x32\x55\x85\x26\x07\x68\xf7\x38\x0d\x79\xf3\x66\x3a\x98\x5f\x5d\xe0\xf5\x79\x6f\x3a\xbf\xea\x0d\x47\xd7\xae\xd3\x21\xe5\x3d\xb9\x2b\x8d\xca\x53\xbc\xbc\xd6\xcb\x77\x1b\x83\x22\x63\xfc\x7d\xbd\x9e\xf9\x3a\x42\xe7\xc6\xdf\x41\xe5\x8f\x5b\x3e\x9e\x38\xd7\x7c\x0b\xfd\x0d\xb9\xdb\x10\x6e\xb8\x2f\xe3\x63\x77\x93\x6f\x63\x77\x17\x4e\x49\xfb\x7a\x4f\x17\xe9\x2f\x6d\xe1\x00\xbe\x6f\xe5\xff\x5a\xe7\x6f\x8a\x05\xc7\x40\xdd\xbd\x19\xe5\x4d\x12\x1a\x59\x66\x51\x41\x29\x10\x94\x39\x4d\x82\xc3\x0b\x06\xde\xda\x4e\xa3\x68\xb5\x8e\xa0\xe8\x7f\x20\x24\xc7\x9c\x46\x7b\x93\x78\xc9\xbd\xfc\x05\xfe\x05\x3c\x51\xdb\xe9\x47\x9b\x2d\xb8\xe3\xaa\xdc\x00\x15\x5b\x25\x50\x65\x2e\x58\xf3\x56\x51\xd9\x68\xd6\xb1\x03\x6a\x40\xcc\x99\x4f\xb2\x2b\xed\x17\xe3\xb6\x56\x10\x51\x78\x8e\x04\x40\xe4\x75\x67\x3a\x72\x85\x99\x3c\xbd\xeb\xc5\x5d\xc9\xd2\x65\x2a\xa2\x98\xe3\x83\x12\xa6\xc8\x3c\xc3\x12\xcd\xdf\x0e\x26\x2f\x7b\xb3\xe1\x15\xdd\x14\x36\x98\x84\x3b\x68\x34\x7e\xb0\x2a\xb5\x82\x25\x2f\xf8\x59\xa6\x95\x37\xd3\x94\x6d\xfa\x09\xaf\xde\x90\xf5\x97\xd4\x38\xb3\x2c\x6c\x99\x55\x3a\x66\xca\xcb\xc6\x88\x9f\xd1\x96\xdd\xd9\xce\x74\xad\x5d\x9e\x1e\x1a\x37\x0f\x2c\xbd\xb6\x9e\xfb\x96\x8e\xb2\xcb\xeb\x79\x7f\x6c\x7b\xe7\x20\xfb\xa9\x69\xfd\xdc\x48\xdd\x6d\x1d\x57\x33\x64\x7b\x85\x91\x20\xe4\x5e\x58\xc8\x84\xe6\x5e\x5f\xe4\x51\x2a\x09\x15\x52\xb1\xbc\xc9\x5e\x8c\xf8\x52\x24\xba\xfd\x93\xe5\xb1\xbb\x3a\x92\x07\x64\x56\x92\xf1\x9d\xdc\x63\xbb\x90\x44\xe1\x26\x75\x0f\x56\x2a\x34\x80\xd7\x90\xdf\xe2\xfb\xe9\x5c\xcc\x5f\xbe\x9b\x4f\x06\x97\x3d\xd4\x8e\x1d\x1e\xe2\x78\x38\xa8\x2f\x70\x34\x21\xcd\x1d\x39\x56\xf9\x9e\x38\xb6\xfc\xbc\xa4\xb3\x4f\x33\x05\xe6\x83\xc2\x2c\x6c\xb2\x07\xd3\x5f\x8e\xac\xcf\x11\xb4\x0e\xc5\xf4\x73\x40\xf0\x4b\x6d\xb5\xeb\x40\xf8\x3d\x8d\xf4\x22\x15\x9d\x17\xac\x25\x61\xab\xf9\xd7\x3e\x13\xa2\x60\xad\x42\xdc\x03\x11\x3c\xef\xcc\xd1\x0d\x40\x61\xe7\x1c\x15\x6b\xad\x76\x05\x76\x0c\xbc\xd9\x23\x81\x98\x9c\xee\xc4\xa7\x0e\x08\x4a\xc6\x6b\x5a\x9b\xe5\xcc\x23\x74\x00\x0d\x99\x4b\xe1\xc8\x77\x47\x6a\xc0\xf2\x5d\x31\x0e\xb5\xd0\xbd\x4b\xbb\x09\x1f\x7f\xe4\x0e\xb7\x8c\x7d\x04\x33\x21\x63\x33\x3e\x66\xe3\xf1\xe5\x6c\x78\x3d\x0d\x6b\xe4\x4f\x82\x36\xf1\xdc\x06\x6b\x83\xc6\x8c\xe0\x4d\x59\x22\x87\xc5\xad\xbf\x2d\x9a\x40\xe1\xb2\x70\xe4\x50\x4b\x4a\x2c\xcf\xf5\x8e\x1d\xa9\x65\xcf\xac\xd0\x1a\x71\x89\x8c\xbe\x54\x1c\x8e\x5d\x6a\xa7\x11\xf6\x7a\x71\x49\x50\x1f\xbc\x46\x52\x26\xce\x73\x4d\x7f\x88\x9e\xe0\x16\x01\x67\xe9\xfb\x1b\xf5\xe8\x02\x0f\x52\x5e\xa8\x0d\x3f\x8a\x54\x22\x11\xa7\xd2\x25\x38\xd0\xa7\x7c\x9c\x49\xb1\xd0\x39\xb7\xf2\x77\x77\x6c\x41\x27\x49\x77\x79\xa8\x03\x86\xf9\xe8\x0f\x90\xe6\x41\xcd\xae\xc6\xa7\x15\x46\x2e\xcf\x1d\x4f\x36\x2f\xbc\xab\x22\x0b\x5a\xcb\x9b\x59\xef\xf5\xf4\xd8\x16\xaf\x54\x21\x98\x40\xbd\xf0\x44\x84\x26\x4d\xbd\xf7\x19\xe1\x84\xb7\x52\x62\x73\x1a\xd8\x06\xed\xd6\x4f\x9e\xc2\xb9\x9a\xc6\xe1\x2d\x3c\xee\x34\x70\x77\x53\x97\x6c\x42\x0a\x0c\x9a\x7e\xcc\xdc\xfb\xfc\x72\xa9\x44\xbe\x1e\xdd\x7c\x6d\x7c\x15\x8e\x7d\x2d\x97\xeb\x35\x48\x64\x23\x5f\xd0\x17\x4d\x70\x61\x6d\x63\x1e\x87\x1d\x80\x7f\x38\xc5\x12\x39\x41\xc4\xa4\x64\x29\x72\x1a\x5b\xab\x2d\xbe\xeb\xfe\xd0\xfd\x33\x9c\xa5\x1b\xbd\xde\xb8\x5b\x84\xb9\xe0\x40\x23\xbf\xa5\x9a\x13\x80\x7b\x01\xcf\xc4\x10\x5a\xa7\xf5\x60\x09\x28\x6a\xa6\xac\x33\x4b\x57\x0c\x00\x3d\x38\xa0\xbd\x55\x97\x42\x32\x89\x02\x52\xb1\xfa\x52\xbd\x30\x80\x8d\x9a\x33\x71\x15\xe8\x30\x6c\xe9\x5b\x02\xf9\x2d\x41\x31\x53\x56\xc1\x19\xd7\x88\xa8\xd2\xdb\x93\xa0\xfe\x86\x5a\xa9\xe2\x60\x25\xc5\xaa\xc9\xbd\x68\xa9\x42\xad\xb6\x89\x39\xf9\x3b\x9b\x00\xcf\xcf\xac\xf1\xcc\xa9\x8d\x88\x70\x1f\x4f\x90\x31\x28\x60\xf1\xf5\x17\x2e\x7c\xe4\x5c\x90\xe4\xd9\x62\xeb\x92\x81\x0e\x2a\x33\xf8\xd2\x25\x70\x47\xa6\x4c\x11\xe7\xcf\x43\xbe\x0a\x80\x63\x46\x0a\xf4\xbd\xd2\x36\xef\x70\xd6\x0e\x64\x38\x45\xee\xea\xec\x2d\x92\xf4\xae\xca\xe0\xec\x8b\x4b\x57\x16\xfc\xe3\x1b\x79\x34\xfe\xfd\xfb\xb8\xa4\x7a\xe5\x5f\xed\x84\x3b\xd0\x50\xc3\xdd\xbc\x51\xe5\x76\x6e\x4e\x2d\x47\xc4\x9d\x6e\xab\xcc\xf5\x56\x2c\xd3\xdd\xde\x21\x30\xe8\x62\x5e\x1e\xf6\x14\x16\xa4\x3f\xb9\x1f\x78\xd9\x01\x8f\xaa\x6a\xbd\x1b\x15\xbe\xa6\xbc\xa9\x52\x55\x43\x57\xf7\x09\x6f\xcf\xc5\x94\xdb\x23\xbf\xa7\xa1\x1f\x90\x8c\xad\x03\x60\xab\xa2\xcd\x99\x2a\xff\x22\x6b\xab\xde\xac\x86\xd1\x43\x36\xea\x1f\x65\x7a\xf5\x2e\xaf\xdf\xf4\x5e\x0e\x66\x43\xdb\x0b\xef\x62\xf0\xcb\xa1\xf8\x86\xaf\xbd\xba\x30\x5a\x12\x9b\x43\xad\xb6\xef\x25\xc9\x2a\xc7\x22\xb0\x87\xba\x4e\x0c\xd1\x81\xc5\xe5\x71\xce\x8e\xf6\x91\x39\x29\xe2\x94\x73\xdd\x7c\xa4\x98\xeb\xc8\xf9\x86\x28\xf2\xca\xf9\x26\xed\xe6\xc1\x5b\x84\x0f\xc1\xaa\x9c\x59\x60\x8a\xfe\xf8\x72\x3a\x1f\x8e\xe6\x84\x82\x00\xf6\x23\xde\x9f\x7c\xe3\xd3\xa1\x51\xf1\x9e\xc6\xc5\x36\xe1\x1e\x17\x65\x66\x74\x03\x96\xca\x8a\x77\x74\x6c\xda\xc5\x3a\x6f\xa8\xfe\x7f\x7a\x50\xfd\xff\xec\xb0\xf4\xff\x31\xd7\x55\x37\x00\x5e\xa1\x25\x2a\xca\x1a\xe0\x26\xdc\x7c\x4f\x62\x80\x2f\x97\x0a\x6f\x80\xd5\x49\xe0\xfc\x30\xb9\xcc\x72\xd6\xaf\x40\xf9\x6d\x8a\xcc\x74\xb5\xd2\x1f\x3b\x95\x97\xf8\x40\xe7\x6b\x0c\xcb\x3b\x0a\xe9\xda\x24\x96\x06\x3a\x39\x82\x35\x7b\x52\x0f\x5f\x8f\xc6\x93\xc1\xfc\x7a\x32\x78\x35\x24\xbe\x6c\xb5\x8f\x51\x47\xf2\x1a\x28\x81\xb3\xf4\x14\xd8\x0f\x95\x39\xc8\x33\xe0\x10\x8c\x25\x5e\xec\x19\xd6\x59\xa1\xb4\x08\xbe\x79\xc1\x7c\x19\xb4\x57\x81\xf9\x9f\x4e\xb4\x26\xd5\xca\x1e\x3f\x7f\x94\x68\xf1\x8d\x5c\x68\xae\x06\xa9\xd2\x7c\x82\x54\x56\xf6\xb8\x4e\x31\xf4\xca\x81\x57\x61\xc6\x60\xce\xc7\x37\xb3\x7b\xb2\xbe\x6d\xf0\xd1\xe3\x04\x79\x56\x7e\xbb\xba\x08\x1f\x4e\xb5\x4a\xaa\x17\xa8\x0b\x53\x34\x53\x51\x35\x56\x69\xe7\x9a\x5f\x0c\x27\x03\xf4\xba\x7b\x17\x0e\x15\xde\x9f\x0e\x0f\x66\x5e\x97\x62\x2e\xe0\x8c\x0b\xba\x9e\x0b\x28\x8f\x8f\x60\xbd\x2a\x16\xea\x5c\x17\x22\x20\xf8\xf7\x82\x46\xaf\x60\x89\xfc\x8d\x3e\x7c\xf1\x90\x24\x84\x8e\x18\x84\x34\xdc\x89\x1e\x7a\xe1\x68\xd8\xe0\x1a\x43\x4e\x08\x79\x2e\xa0\x16\x77\x04\xd2\x46\x3b\xa2\x2b\xcd\xee\x1e\x67\x7e\xf7\xcb\x21\xa1\x06\x1f\x21\xa1\x7b\x80\x05\xeb\x97\xbb\x0f\x7a\x09\x0e\xca\xce\x5c\x2b\x40\x8c\x5d\x57\x6e\xe0\x7c\x81\x7a\xd3\x80\x0a\x1f\xf0\x62\x6e\x0c\x52\x3d\x8e\x2b\x54\x3e\xe8\x6c\xe7\xf1\x76\x02\xfc\x92\xf6\xda\x35\x1a\xbf\xae\x66\x11\x9b\xb0\x3e\x85\xac\xcb\x3d\xf7\xb1\x70\x7d\x5a\x50\x1d\x6d\x36\x4a\xe5\x3e\xbd\xc1\x2d\x01\x76\x8a\xab\x15\x02\xab\x97\x5d\x05\xd2\x26\x83\xc1\xc9\x18\x42\x15\x5d\x97\xca\x45\xe7\xdc\xee\xa6\x24\xcf\x6c\x32\x18\xbc\x1d\x0e\x7e\x3e\xed\x8a\x21\x05\x39\x60\xf9\xc4\x7b\x91\xe1\x06\xbb\xad\x6f\xc1\x1f\x9e\x31\xad\xb6\xe7\x0b\x06\xce\xc5\x35\xdc\x6a\xcf\xee\x88\x9b\x45\xa2\xee\xe6\x8c\x21\xff\xf7\x2a\x4d\xf3\xf0\x6f\x82\x9a\x80\xee\x2e\x0d\xe0\x7e\x97\x16\x59\x9f\xa0\x41\x57\x97\xb2\x8e\x29\x11\xdb\x34\xaa\x70\x7b\x6d\xf8\xa0\xcc\x82\x3b\x41\x89\xaf\x9c\x61\x43\x37\xcf\x7d\xc5\xb7\xf0\x1c\x06\x42\x0f\x5b\xdb\x54\x01\xac\xd2\x82\xaf\xc1\xb0\x57\x41\x9a\xb0\x1d\x62\x79\x8e\x1a\xdc\xe2\x4b\xdd\x00\x51\x46\xbe\xc1\x25\x7c\xb0\x92\x90\x16\x5b\x76\x1b\xa1\x52\x44\xba\x1b\x31\x9c\xfc\x10\xbf\x14\x7d\x2b\x76\xeb\x4c\x96\x37\xf4\x25\xea\x4e\x65\xa1\xa1\xc9\xcb\xe3\xa6\x1b\xa1\xe3\x04\x49\x03\xac\xe7\xb9\xe0\x15\x22\x38\xee\x22\x36\xf2\xc6\x72\x9b\x4d\x97\xf9\xd5\xe0\x69\xfa\xbd\xdb\x9d\x37\xb2\xa8\x9e\xb4\x81\xb8\x1b\x8f\x67\x9f\xbe\xd1\x2d\x3b\x3d\x20\xee\x3e\x7d\x8f\xfb\x1d\x4e\x2c\x83\x29\x2c\x77\x85\x90\x54\x9a\x1d\xdc\xc3\x4f\xd2\x91\xd3\x8f\x45\x2c\x7d\x07\x9e\x0a\x3d\xe3\xd4\xa2\x2d\x04\x3d\xb8\x47\x23\x5c\x03\x07\xb9\x8f\xf0\xf8\xa7\x72\xb8\x1d\xb9\xd5\x6e\xe6\x71\xcf\xe1\x5f\x40\xdc\x5b\xfa\x1e\xa5\x3f\xb5\x77\x98\xbe\x19\x0c\x66\x9f\xc0\x03\x4b\x69\x96\x76\x53\x90\x1c\x01\x5a\x20\x4a\x58\xd2\xb2\x00\x44\x43\x1e\x48\xff\x90\x1f\xf2\xfa\x04\xe0\xa9\xb3\xbc\x48\xb8\x72\x28\x4d\x3f\x58\xf3\xa7\xa6\x9b\xd5\xd3\x01\x8f\xf1\x8f\x43\x6f\x20\xd4\x43\xbb\xf8\xf7\x52\xaf\x01\xda\xa3\xa4\x43\x76\xc5\x73\x96\xee\x35\xb1\x8e\x80\x28\x80\xc3\x31\x3c\xe9\x85\x24\xa8\x78\x5b\x2c\xe9\x3b\x42\x1a\x1f\x0b\x27\xc6\xcf\xd2\x05\xd2\xa2\xfc\x95\xf4\xd8\xbb\x27\x35\x62\x72\x9a\x02\x1b\x16\xab\x02\xfe\x51\xbe\x1f\x15\x72\x09\x99\xb5\x2a\x57\x5f\x86\xc5\x82\xf5\x37\xb2\xd8\x01\x94\xf7\x31\x5a\xe9\xaa\x0e\x39\xae\xd5\xae\xf3\x1c\x9f\xd8\x65\x86\x05\x1f\xf0\xee\x3a\x18\xac\xdf\xeb\x0b\xe1\x1b\xad\x76\xd8\xb8\x91\xc9\xd7\x15\x37\x7c\x8d\x7b\xd9\x5a\x97\x7b\xbd\x0a\xf8\xdf\xb3\x22\x56\x3e\xf9\xdd\x8e\x26\xb1\x37\x5c\x8b\x7d\xd8\xf4\x70\xa4\x23\x94\x04\x5d\x18\xef\x70\x07\xbd\x3a\x8a\x0c\xf9\xc7\xc9\xad\xc9\x97\xd6\x71\x7d\xaf\xf3\xe8\x37\x2c\x5a\x94\x5d\xa9\x32\x9b\xe5\x56\x61\x04\xce\x9e\x66\x2a\x17\x3b\x34\xa7\xa5\x85\xd5\x52\xa9\x76\xfb\x92\x8b\x79\x60\x9f\x77\x12\xb8\xe1\xbc\xfa\x5d\x3d\x63\x2b\x7d\xd4\xe9\x1e\xb6\x86\x91\x80\xbe\x95\xd0\x5b\x5c\xd5\x42\x39\x01\xa4\x06\xb1\x93\x16\x19\xe1\x95\x77\x98\x3d\xad\x95\xca\x68\x36\x15\x8d\xc9\x9d\xa0\x99\xb2\x1d\x76\xd3\xc4\xa7\x0b\x73\x17\xbd\xd3\xc7\xf6\xbb\xfa\x12\xac\x7e\xc0\xca\x8d\xac\xfe\x49\xbd\x8a\x2c\x26\x39\x91\x96\x74\x66\xca\x8b\xaa\x05\xf7\x49\x03\x2d\xcd\xf6\x65\xba\xd3\x8d\x46\x2c\x07\xa8\x41\x3c\x52\x8f\xdc\x09\x43\xf7\x3d\xb9\xc1\xd8\x14\xab\x0e\x42\xb7\xda\x1d\x19\xe9\xc6\x27\x87\xff\x29\x53\x31\xd2\xf1\xff\xce\xba\x0c\xd3\xa2\x72\x42\x73\x5d\x53\x78\xfc\x78\x36\x43\xb4\xc7\x3b\xad\xb9\x7a\x69\x18\x0c\x11\xa0\x16\xdf\x76\x7c\x35\x47\x99\xfc\x87\x86\x0f\x5d\xd1\x43\x1a\x41\xe2\x33\xd8\x7c\xd6\xdf\x21\x7c\xd2\x9c\x69\xf3\xef\x9c\x07\x08\x49\x91\x04\x8d\x83\xd3\xac\x54\xca\x9c\xd3\xfc\x4b\xb2\x8a\x4f\xb9\x3a\x90\x8a\xfd\xf1\xe5\x78\x42\xe0\xce\xdf\xdc\x70\x75\x5b\xd8\x08\x6d\x99\xc6\xa9\xdf\x6e\x01\x5d\x7c\x82\xa4\x0b\xaf\xc9\xe8\xbf\x0b\xae\x9d\xa0\x77\x7c\x3a\x7d\xb8\xd3\x20\x40\x16\x72\xf9\x01\xcd\xdd\x21\x4b\x2c\xbb\xc9\xe5\x32\xcd\x58\xb9\x75\xbd\x6e\x68\x8c\xae\x78\x43\x96\x27\x73\xac\x45\x23\xb9\x3f\x93\x35\x7a\xa8\xc2\x11\x46\x0f\xde\x6d\x94\x8a\x3b\xd8\x72\xad\xb6\x8f\x08\x35\x37\xab\xc2\x88\x4d\xca\x9b\xdd\xbf\x3e\x89\xc8\xbb\x11\x5a\x6d\xf1\x24\xec\xcb\x96\x21\x8b\xf8\xcf\x4f\xb0\xaa\xbd\x42\x79\x68\x47\x3c\x7d\x46\x7f\xae\x33\x85\xb0\xc3\xd3\x1f\xe8\xaf\xe5\x5e\x26\x1d\xf1\xec\x3b\xfa\x63\x11\x17\xaa\x23\xbe\x7d\xf2\x04\x31\xb5\x22\xdb\xc5\xdc\x0a\xe2\x5b\x3b\x50\xe6\x8a\x4f\x1e\xd5\x89\xf4\xdb\xef\x0f\x2f\xb8\x7e\xf6\xec\xc9\x97\xe1\x97\x1a\x3f\x80\x5f\xc4\xb3\x67\x4f\x8e\xf1\xcc\x94\xbb\x69\x70\xef\x2e\xcc\x07\x0f\x63\xa6\xf3\x3d\xf9\xfe\x0c\xaa\xd3\x48\x39\x3e\x75\x4c\x44\x04\xe3\xbe\x34\x07\x4c\x05\x1a\x54\xfb\xcf\x96\x07\x02\x1d\x1f\xd8\x88\xeb\x4c\xee\xcd\x52\x62\x2f\xf3\x2e\x74\x2d\x5a\x30\xc7\xb3\xef\xbf\xe7\xce\x70\x94\xe6\xc5\x57\xec\xa4\x08\xcc\xa1\x0f\x2d\xcf\xff\x28\x4c\x3f\xfb\xfe\xfb\x03\x4c\x3f\x7d\xf2\xe5\x31\x0d\x2c\x12\xa6\x9f\x3e\x39\x8a\xe9\xd7\xbd\xab\xab\xde\x21\xae\xd7\x72\xbb\x95\x2e\xd7\xbb\x6c\xc5\xef\xe4\x29\x4e\xa7\x62\xab\x13\xe2\x69\x64\x31\xa7\x09\xf9\x0d\x56\x0d\x1b\xb5\x42\x88\xb7\x40\xa7\x11\x0b\x15\xa7\x77\xb4\x2c\xdc\x8f\x24\xa3\x82\x94\x4b\x1f\x4a\x67\xca\xc4\x7a\xbd\xc9\x55\xc6\x0d\x2c\xa5\xb1\xc4\xa0\x8b\xb4\x6f\x15\xde\x2e\x83\xef\xde\x95\x18\x91\xbc\xf3\x97\xd5\x17\xa8\xbb\xb9\xd5\x7c\x85\x14\x5e\xe1\xba\x03\xb9\xc4\x55\xd0\x0c\x27\x03\x47\x31\xc7\x1f\xc2\x6d\x09\x4f\x01\x3f\x03\xb6\xe9\xfe\xd0\x09\x06\x7e\xf6\xac\xb2\x83\x83\x07\x9f\x75\x9f\xd9\x8d\x88\x09\x9d\x26\xd4\x6a\x3b\x9b\xdd\x23\xb8\x81\x5b\xbe\x6b\x60\x97\xef\x0e\xa3\x04\x3f\x7c\x79\x6e\xb1\x9c\x20\x5e\x88\x1f\x9e\x04\xfe\x5d\x7a\x6a\x36\xbc\x1a\x4c\x67\xbd\xab\x6b\x67\xec\x36\x45\xfd\xd9\x0c\x4d\x57\x4d\x6e\x31\x08\x28\xf8\x08\x59\x57\x2b\xfb\xd8\x40\x9b\x23\x54\xe5\x7a\xab\xca\xfa\x05\x72\x28\xde\xc9\x4a\xe5\x42\x99\x40\x46\x61\x43\x9e\x1f\x4a\xc8\x46\xc5\x3b\x00\x86\x76\xce\x76\x0c\x67\xb8\x60\x88\x58\x9a\x1c\xe5\xb7\x6c\x53\x14\x2e\x42\x75\xd0\xc1\x01\x7e\x63\x1a\x07\x8b\xfa\x9d\x61\xda\x47\xe0\xbe\xc4\x6a\xdd\xa9\x1d\xe2\xfe\xe2\xdd\xa8\x77\x35\xec\xcf\xaf\x06\xa3\x9b\xe9\x7d\xf8\x3f\x40\x78\x15\xb8\x0a\xe6\x51\x2d\xa0\x13\x0e\x80\x90\x07\xee\x56\x65\x36\x32\x93\xc8\x5b\xbd\xb6\xaf\x6c\x55\x52\x54\xdb\x5e\xed\x91\x52\x6c\xfd\x4c\xce\xcc\xb8\xd5\x92\xae\xa0\x9e\x92\x67\x88\x4c\x59\x4e\x5f\xe1\x5a\x92\x60\x40\x37\x1f\xc7\x13\x83\x9e\xe2\x45\x9c\x6b\x94\xb7\xc6\xea\x56\xc5\xa4\x65\xe7\x72\xc1\x28\x85\x56\x83\xab\xd2\x78\x62\x74\x6b\x8b\xb8\xfe\x0a\x5d\x65\xf7\x21\x83\x75\xc5\x45\x10\x35\x76\xc6\x0e\x58\xc3\x36\x82\x70\x49\x22\x3c\x74\x94\x96\x17\x6b\x97\x40\x20\x53\x88\x0a\x22\xc0\xa0\x3f\xe5\x96\xbd\xf8\xc5\xdf\x9b\x86\xf3\x08\xbe\xa8\x52\xbc\x12\xd1\x6c\x62\x8c\x4a\x7b\xfc\x2f\xc0\x1b\xec\x45\xe0\x75\xb3\x8e\x6f\x6f\x4c\xa4\x1d\x84\x4d\x96\x94\x76\xa8\xdb\xd9\xb8\x90\x18\xfa\xaf\x8a\xfe\xf8\x9d\x73\x00\xba\xdf\x39\x3f\x83\x97\xf1\xa2\x8d\xcf\xcd\x47\x37\x57\xf3\xc1\x68\x36\x19\x0e\xa6\xde\xe4\xe5\x83\xce\x99\x5e\x6c\xd4\x96\x51\x5f\x34\xb4\xb6\xd5\x80\x16\xd4\xda\x55\xc2\x48\x7d\x29\x3b\xf8\x82\x0d\x23\xbd\x24\xbb\x8d\x7a\xc6\xc7\x7b\xd2\xc6\xa1\xda\x67\x34\x9d\xfa\xb8\xb3\x69\x7c\x40\xde\x32\x8d\x63\xb9\x33\xca\x4d\x52\xd9\x52\xc8\x11\xc8\xd0\x70\xd1\x29\xc1\x56\xe1\xb0\x03\xd0\xa0\x34\xb7\x4d\x3b\xa1\xf4\x36\xbb\x5f\x78\x9b\xe4\x62\x9b\xb2\xaa\x5c\x2a\xb6\x07\x60\xd1\x46\x76\x97\x2d\x9f\x14\x09\x3a\xd7\xb7\xda\xbe\xbe\xda\x2d\x30\xb2\x73\xc9\x18\x3d\x4d\x50\xff\xb7\x44\xb4\xc0\xee\x2a\xb9\x45\xff\xb4\xd3\xae\x98\xa6\xde\x94\xad\x04\xce\x5b\x6d\x3f\xdb\xd3\xaa\xd2\x24\xf9\x52\xcf\xea\x2c\x0b\x7f\x69\x78\x57\xd0\x09\xcd\x75\x0e\xee\x92\x83\x56\xbb\x3c\x68\x31\x19\x75\x9d\xa6\x34\x3d\xd5\x04\x20\x1c\xa1\x98\xd4\x66\xf9\x40\x0f\xe1\x69\x2d\x26\xc9\xe1\x52\x9f\xf7\x31\x7a\xdb\x8f\x3f\xfe\xf8\xe3\x1f\xa7\xb8\x1d\x32\xac\x57\xdc\x78\xdb\xfb\x41\x70\x95\x18\x7b\x9a\x2a\x43\x75\xaa\xc9\x90\x10\xb6\x15\xa3\xb1\xe2\xc9\x0e\xb7\xb7\xeb\xbf\xcc\xd5\x3d\x69\x26\x7a\xbb\x5d\xac\xbe\x36\xe2\x17\xca\xdb\xfb\x16\x19\xe1\x6a\x6d\x8f\x97\x08\x3c\x97\xee\x20\x3e\x88\xd0\xb7\x3a\x4b\x13\xfc\x55\x6b\x81\xc4\xcf\xa9\xac\x0b\xfd\x4a\x75\x97\xe9\xf6\xfc\x23\x86\x3b\x3f\xed\xf8\x72\x09\x57\x59\x3c\x9e\xfe\xd2\x6a\x8b\xa7\x4f\xba\xdf\x8b\x93\x4b\x95\xee\x64\x16\x9d\x86\x9d\xad\xcb\x56\x93\x9c\xde\xa9\xf2\x5a\xab\x44\x07\x19\x05\x93\x90\xd4\x16\x5e\x17\x7a\xc4\xf4\x9f\x14\x09\xb5\x62\x23\x3d\xf2\x40\xbb\x47\x94\x98\xfc\x38\xde\x93\xed\xde\x24\x41\x98\x85\x2f\x73\x7b\x63\xe7\x98\x2c\xb3\xf7\xc2\x41\xfe\xef\xf3\x4b\xbd\xc8\x64\xb6\x3f\xbf\x70\xb8\x39\x9f\x6e\x64\xa6\xa2\x73\x57\xcb\x40\xd0\xe1\xaf\xa9\xca\xcb\x8b\xef\x7e\x29\xd3\xcb\x56\x1a\x16\x2e\xb6\x3b\xa4\x00\x42\x68\xc5\xce\xfa\xf0\xef\xc3\x7b\xcc\xf3\x22\x19\x4e\xdf\xaa\x73\x4e\x2a\x8d\x24\x4e\xfd\x58\x99\x73\x48\x1c\xcb\x1e\x34\xd7\xf9\xbc\x2c\x9a\x69\xb6\x6b\xff\x48\x21\x5f\x67\xf4\x9a\x7a\xe4\xc7\xae\x35\x5b\x74\xc5\x44\x01\xda\x57\x4a\x45\x28\xd8\xaa\x32\x0f\x3e\x75\xad\x82\x20\xe7\xd1\x21\x60\xbb\xc8\x54\x1c\x4b\xce\x61\xb1\xc9\x3e\x5e\x2d\x39\xe0\x3d\x4e\xe0\x96\x02\x5e\xd0\xb2\xf3\x3d\x1c\x5c\x27\x2e\xf3\x18\xf6\xd3\x76\x87\x88\x6c\x9a\x31\x5f\xe5\xc2\x14\x3a\x57\xa7\x6e\xdf\xc1\x61\xb1\xbb\xef\xc4\x74\x07\x41\xb9\x6d\x01\xd8\xa7\x20\x98\x51\x58\x47\xb1\xfd\x78\xfe\x6a\x30\xb8\xf0\x57\x9f\x11\x8a\xbf\x6a\x9e\xf2\xab\x56\x38\xa7\x3b\x5f\x00\x24\xf7\xa8\x0c\x53\x6f\x8a\x44\xff\x5a\xa0\x07\xa7\x8e\x54\x92\xbb\xf8\x6a\x05\x89\x60\x5e\x95\x8b\x45\x91\x44\x31\x5f\xb0\x11\x24\xee\x48\x91\x41\xb5\x43\xeb\x95\x14\x9a\xea\x19\x91\x96\x5d\x3e\x34\x5f\x47\x70\x04\x7a\x99\x6e\xbb\xdb\x3d\x63\xba\x7b\xb5\xb7\x5b\xa7\x76\x84\xf2\xf5\xca\x5d\xe6\x0a\x76\x0a\x62\xd0\xe3\xa8\x4f\xb3\x75\x97\x05\x4c\xf7\x9a\xbb\xf4\x7d\x31\xbc\xbf\xbc\x19\x5d\x5c\x0e\xe6\xc3\x0b\x8f\xf7\x86\xe9\x9c\xcd\xce\xef\x5c\xdf\xbc\xbc\x1c\x4e\xdf\x0c\x26\x78\xed\x73\xa8\xd0\x6a\x1f\xd2\x41\xec\x8a\x45\xac\x0d\x12\x93\x3f\x81\x08\xad\x76\x85\x0c\xc7\x88\x50\x99\xe9\x91\x98\xf6\xcb\xf9\x62\xb8\xae\xe0\xed\x10\xd7\x6e\xc2\xa3\xd8\x2e\xef\x6b\xb4\xdc\xac\x95\xb9\x17\x8f\x47\xe1\xf4\x53\xfd\x11\xb0\xd1\x2a\xc5\x0b\x51\x81\xa7\xae\x38\xbc\x99\x5d\x5d\xbe\x19\x5c\x1e\xb5\xe3\xa3\xda\xde\xc7\xa2\x6c\xa6\x77\xa0\x58\x60\x90\x50\xbb\x78\xce\xd9\x7c\x1b\xa4\xfa\xb8\x5f\x97\xee\xe6\x10\xfb\xe7\x07\x77\xa3\x93\xfb\x60\x07\x88\xca\x7c\xc3\x32\x95\x85\x25\xa3\x6b\xa5\x77\xa5\x51\xc9\x97\xae\xf2\xaf\x0d\xd9\x2e\xe2\x0d\x8c\xb1\x9f\xd3\xec\x83\xd9\xa4\xbb\x56\xbb\xa6\x73\x20\x5d\x7f\xeb\x5e\x21\x8d\x43\x25\x67\x85\x39\x47\x4b\x3a\x58\x27\xe7\xf6\xce\x59\x83\x8c\xa4\x8f\xff\x97\x8e\x5e\x3c\x7b\xfa\xf4\xdb\x1f\x4e\x05\x25\x34\xf0\x05\xfb\x65\x9a\xf8\xe1\x84\xce\x4a\x72\xd2\x5d\xc7\x2e\x93\x83\x2d\x0b\xd8\xd1\x94\x21\x19\x68\x1c\xad\x76\x63\x2a\x39\xdf\x5a\xea\x8e\x10\x1e\xce\xe5\x20\x00\x21\x27\xdd\xe5\x66\x7b\xda\x15\xfd\xf0\x2b\x1f\x09\x80\x22\x8f\x64\x73\xa7\xbc\x81\x27\x19\x04\xf1\xe3\x0f\xd6\x6a\xb5\xe7\x75\x27\xd4\x89\x7d\x38\x4e\xa4\x71\x54\x42\x1d\x3e\x2f\x4e\xba\x9b\x78\x07\xac\x10\x28\xee\x89\x5d\x2c\x73\x3c\xe0\x5d\x7b\x36\x26\x67\xd7\x87\x8b\x02\x48\xb3\xf6\xcb\xe7\xe2\x66\x67\x57\x4a\xb6\xff\xd1\x8b\x29\x27\x13\x9d\x32\x6c\x6d\x19\xaf\x5d\xa1\x6b\xd7\xc3\x49\xfb\xb6\xa2\xfc\x2e\xcd\x8e\x5d\xa9\x57\x12\xe9\xce\xd1\x27\x9c\x12\x44\xba\xd5\x94\xd3\x62\x87\x5a\xfa\x75\x96\x48\x36\xc7\xf7\xeb\x97\xd4\x64\xfc\xce\xab\x69\x32\x4a\xf4\xdf\x5c\x3d\x78\x0b\x4d\x2d\x46\xb4\xe2\x32\x1b\x98\x37\x50\x5f\xc1\x26\xcc\x16\xfe\xb6\x16\x98\x02\x48\xe8\xa5\x3c\xc6\x32\x25\xb1\x0c\x37\x71\x9a\x36\x1b\x49\x2c\xf6\xe1\x0d\xa1\x32\xdb\xa0\xb1\x30\xde\x20\x3d\xf0\x40\x7f\xfe\x44\xec\x10\xfc\x75\x0c\x79\xf0\xf9\x24\x3c\x08\x27\xbd\xe9\xd3\xc5\xf3\xb3\x47\xe4\x2b\xc6\xe9\x92\xbb\xf0\x54\xba\x6b\x51\x50\xc0\xdd\x62\xeb\xd1\xe8\x23\x07\x9c\xdc\x17\xef\xca\x1d\x7d\xb2\xd9\x2c\xbb\xea\xa3\x3a\x25\x7f\x56\x92\x26\x67\xd4\x29\xb7\x13\xe4\xa3\x91\xa2\x8f\x8b\xbf\x90\x6b\x55\x24\xc7\x46\xe2\x54\xba\x72\xff\x7b\x01\xe8\xf8\x8e\x16\x04\xef\x09\xfa\x23\x84\xa6\x3b\xd9\x45\x64\xb7\x22\x26\xf9\x45\x90\x5d\x41\x66\x03\xb2\xfd\x08\xfd\x37\x43\xba\xe7\xa0\x8c\x0a\xa0\x79\x81\x30\xae\x49\x71\x77\xb9\xd1\xc1\x19\x50\xe9\x31\xd3\x6a\x97\xd7\x4e\xd2\xfa\xb4\x67\xaf\x86\xf6\x49\x5b\x69\xe0\xf5\x00\x0b\xb3\xd0\x1b\x8d\x4f\xbf\xe4\x9e\x6c\x44\x44\x05\x50\x21\x0e\x2d\x0c\xbb\x2f\xad\x4d\xee\xfb\xeb\x06\x89\xc5\xd4\xa4\x57\x89\x37\xf9\x36\xa6\x13\xc9\xe2\xe2\x64\xb3\xf9\x70\xda\x71\x52\x8d\xd8\xe8\x94\x3d\x3f\x95\x93\x8e\x1f\xf8\x22\xa0\x34\xac\xb3\x42\xd3\x97\xc3\x51\x6f\xf2\x6e\x3e\x1b\xf7\x6b\x14\x75\x5d\x23\x51\x8c\x98\xc8\x6c\x7f\x28\x95\x8f\x92\x55\x72\x46\x50\xc3\x2b\xb8\x60\xd4\xd1\xd6\x13\xb5\x2b\x5e\xd9\x86\x31\x36\x3c\x8a\x4c\x09\x7b\xe7\x02\xa0\x55\xe2\xda\x25\x40\x00\x51\x23\xb4\xb1\x5f\x14\x79\x9e\x26\xe6\x0f\xe7\x83\x00\x39\xe2\x08\x1f\xcc\x70\xb1\xc4\x2f\xd7\xbd\xd1\x05\xe3\xcf\xca\x9f\x72\x18\x8c\x06\x61\xcb\xb9\x0e\xb9\xda\xd2\x9d\x1b\xd6\xb8\x73\xb7\x8b\xb8\x18\xb4\x6a\x40\xd9\x81\x1c\xaa\x6a\x96\x40\x0a\x8b\x61\xf2\x5a\xe1\x48\xfb\xc3\x11\x13\x40\xdd\x84\x98\xba\x62\xf9\xd3\x9b\x26\x9d\x12\x2b\x5f\xa4\xf9\x46\xfc\xf4\x86\xef\x47\xc7\x85\x84\x5c\xd5\x8c\xcf\xde\x0e\x27\xb3\x9b\x1e\xf2\xf8\x2e\x29\xb7\x02\x9e\x7e\x38\x76\x64\x12\x2a\x9c\x81\x9c\x61\x47\x56\xc9\x95\x2e\x3c\x71\xd4\x91\xf5\x13\x34\xc7\x5f\x81\x56\x7e\x27\xcd\x2a\x99\x6f\x52\xfc\x94\x07\x0a\x0c\xa9\x7b\x90\x60\xdd\x5f\x97\x1b\x7f\x44\xb8\xa7\x59\x6f\xa8\x6a\x21\x5f\x90\x14\x07\x64\xa8\x20\xf8\x28\x19\x7e\x7a\xf3\xba\x7a\x46\xd6\x9b\x93\x29\xf1\x53\xff\xcd\x43\x5a\x46\xab\xfd\xa0\x9e\xf1\x2b\x5f\x6f\x48\xda\x3c\x9d\xad\xe5\x3c\x58\x7b\xd0\x16\xb7\xd5\x3e\x70\xbc\xad\xd2\xd8\xdf\xa8\xff\x38\x9c\x80\xad\xea\x28\xf1\x90\x88\x2a\x67\xba\xfd\x5a\xe5\xb5\xaa\x9d\xec\xac\x7e\xea\xe9\xe9\x92\x15\x29\x60\xc8\x14\x06\x94\x3f\xe5\x8e\x09\xd8\x0e\xe7\xf5\xdb\x54\x8e\xba\x23\x4c\xec\x62\x85\xe4\x22\x64\x39\xf3\x9b\xfe\xbd\xf3\xf2\x9e\xcc\x03\x4b\x25\x4a\x97\xdd\x5f\xf3\xae\x4e\x9d\x4f\xce\x9c\xff\x9a\x9f\x7d\xd7\xfd\xe1\xfc\xd7\x1c\xdc\xca\xe7\x05\x65\xa1\xb7\xfd\x9a\x4f\x1f\x69\x45\x7f\xba\xbf\xa2\x11\xd5\x15\x54\x0a\xf1\x18\x67\x45\xc3\xb6\xfe\x2c\x1a\xb4\xda\x55\x5c\x7e\x3e\x0d\xde\xea\x0c\xb1\x7e\x7f\x01\xa9\xf9\x3d\x94\xb8\xb5\x83\xa1\xd4\xd3\xb2\xb3\xb9\x87\x24\xa0\xf1\x17\x20\x41\x0d\x9d\xa0\xc1\x0b\x58\x42\x15\x09\x70\x3d\xef\xdf\x4c\x5d\x1f\xaf\xc0\x67\x41\xe3\xa1\x67\x52\x03\x09\xb0\xc3\x25\xf7\x46\x2b\xdb\xca\xd9\xe3\xec\x73\x30\xdd\x77\x23\xbd\xe2\xee\x59\xbf\x03\xd1\x76\x55\x67\x65\x3b\xb9\xd3\x2f\x81\xca\x03\x1c\xd5\x04\x47\xf8\x7d\x6f\x36\x9b\x4c\x1b\x98\xd7\x95\x1d\x62\x52\x99\xe7\x99\x5e\x14\xf0\x94\xf8\xa6\x75\xdc\x6b\xee\xff\x67\xd8\xb4\xd8\xaa\x62\x13\xf1\xd9\xcf\xc4\x26\x27\x79\x30\x10\x5f\x1b\x5e\xb7\xcf\x71\xa7\x86\x80\xe8\x0e\x7f\x88\x37\x8b\x2e\xd1\x2b\x07\x23\xc4\xb5\xda\x9f\x87\x3a\x3b\xf1\x59\xb9\xb6\x2f\x82\xba\x43\xd4\x84\xa8\xab\x1d\xe7\x8f\x31\x79\xd3\x15\xa9\x3a\xad\x76\x4d\xd9\xa9\x5a\xb0\x47\xcd\xd7\x9a\x86\x94\xba\x24\x64\xfe\x48\x45\xa2\xfb\xeb\x66\xe7\x6e\x62\xfd\x9d\xe0\x07\xe0\xb9\x33\x85\xc1\x67\x71\xe6\xdf\x1f\xf4\x2f\x87\xd7\xd3\x41\xb3\xcf\xf2\x53\xc2\x9d\x1d\x91\xa7\x6b\x6b\xef\xf8\x4e\xc6\xa5\x0f\x88\x74\xa4\x3d\xf4\xc5\x2d\xf2\x40\x07\xcb\x58\x23\x48\x0f\x9c\x88\x5d\x5c\xac\x75\x82\x58\x64\xab\x1d\x44\xf6\xb4\xe1\x6f\xf8\xd2\xf9\x0f\xf6\xb6\x58\xdf\x9b\xa2\xac\x85\x66\x87\x00\x2b\xfb\x48\xaa\xb1\xd1\x40\x9e\xa5\xc3\x39\x73\x55\x63\xc0\x3b\x59\x9c\x33\xcb\x05\xd9\x69\xd1\x98\xf2\x17\xb7\x78\xba\xc4\x84\x33\xa2\xca\xc4\x60\xdf\xc2\xcb\xae\xb2\x6c\x8c\x4d\xcd\x1a\x94\x9d\xdb\xea\x71\xa1\xb2\xe7\x9f\x82\x0f\x48\xe7\x1b\x9d\x1c\x19\xa4\xb4\xe8\x7d\xbd\x37\xfb\x20\x99\x66\xf0\xca\xcf\x87\x17\x7c\xc9\x1d\xba\xaa\x53\xca\x08\xda\x45\x00\x16\x87\x63\x5a\x3c\x2f\x3d\x53\x54\x13\x58\x69\x77\x6b\xfd\x29\x08\xe0\xc8\xec\x5f\xe4\xab\x0b\x99\xae\xd4\xb5\x7b\x1c\x50\x29\xc3\x00\x99\xef\xee\xd7\xc8\x30\x3f\x43\xa7\x61\x86\x71\xd4\xb3\xc4\x68\xb5\xab\xb8\xe6\x4e\xf7\x0f\x12\x9a\x71\x4e\x3e\x4e\xca\x59\x62\x29\x89\xd7\xbb\x62\x10\x76\xd6\xf2\x91\x4a\xe7\x7a\xa1\xe7\xd1\x39\x91\x52\x5a\x3c\x08\x7f\x98\x3e\x19\x22\xb1\x8e\xe9\x1a\x87\x3c\xa8\x57\x0e\xed\x5d\xcf\xd4\x74\x91\xf3\x47\xc8\x87\x41\x65\x15\x5c\x7e\x41\x17\x45\x33\x13\x97\x42\xcb\x97\x67\x19\xec\xce\x2d\x12\x4a\x6d\xbb\x82\x44\xa1\xed\x2a\x79\x3d\xc2\x4e\x23\xae\x29\x00\x36\x98\x73\x95\x6b\x2e\x52\xdd\xa7\x45\x26\xd2\xbb\xc4\x85\x32\x2e\x86\xd3\xde\xcb\xcb\xc1\xf1\xa6\x12\xf5\x6b\x5d\x97\x29\xca\x6b\x8d\x73\x02\x8a\x13\xe4\xb8\x9d\x0a\x68\xe3\x14\xca\x70\xa9\x93\x7e\xd1\x88\x5c\xfb\x04\xe4\xd1\xb8\xe2\x33\x29\xd7\x59\x96\xa6\xc3\xe6\x66\x58\x20\x0f\x74\x5e\x36\xac\x02\x75\x16\xde\x8d\x6e\x5f\x66\xc9\x52\x6e\xe0\x50\x35\x91\xa6\x96\xbd\x07\xa6\xcd\x94\x2a\x9b\xea\x83\xb9\x6a\x59\x76\x58\x00\x77\xd3\xe4\x8a\xb4\xfc\xb0\x2c\xd8\xf3\xc1\x1f\xb8\x97\xab\xb4\x39\xb0\x9b\x2b\xbe\xce\x72\x5d\x47\x7b\x07\xd0\xb9\x21\x09\xfe\x33\xca\x0c\xe4\x76\x2d\xc1\x4d\xee\xbc\xcd\xaa\xbe\x09\x62\xad\x5d\x2c\xf7\x62\xa3\x55\x46\x7a\xda\xb2\xda\xa7\x2c\x2c\x23\xf5\xe9\xe6\x15\x68\x10\x2f\xa1\x9a\xcd\x9d\x4c\x54\xec\xce\xb6\x60\x96\xe0\x80\x08\x96\xe8\x62\x6d\xc1\x12\x4f\xa8\x94\xc2\x67\x36\x22\x1f\xce\xd5\x34\x96\xa3\xad\xb8\xb8\x85\xb4\x2a\x2e\x3f\x62\x31\x83\xeb\xa1\xd3\xec\x83\x90\x2e\x8b\x92\xc3\xcd\x36\xb5\xd2\x04\x69\xa0\x1d\x71\x81\x31\x3a\xa2\x3f\xb5\x5e\xa0\x15\xee\x3f\xa5\x32\x32\xa6\x26\xb9\x13\xa9\x51\x13\x12\x24\xb6\x69\x84\x3e\xd7\x3c\xec\x69\xd7\x07\xa5\x90\x45\x67\xfb\x26\xef\xb2\x74\x21\x17\x74\xcd\x28\x9a\x62\x0b\x6c\xaa\x82\xcb\xda\x94\x0b\x3b\x91\xfc\x75\x0d\xd3\xc4\x5b\xed\x8c\x9b\xb0\x2e\xcb\xaa\x83\xa2\xb1\xe2\xe9\xd4\x25\x09\xb6\xda\xbe\xc5\x75\x63\x11\x67\xb9\x8b\xba\xa2\x67\x82\xfa\xac\x4e\xa5\x10\xb6\x5a\x52\xd9\x18\x04\xdc\xc8\xf0\x75\x1f\xc0\x47\x40\xce\xd6\xfc\x22\x65\x09\xb1\x33\x14\xb5\xd8\xac\x9f\x7a\x4f\x2e\xd0\xbc\xde\xca\xea\x7a\x32\xfe\x2b\x54\x4c\x18\x39\x15\x29\x80\x67\x17\xd2\x70\xca\xe1\x86\xca\x2f\xef\x13\x00\xb9\x5c\xb8\xb8\x1d\x6d\x7c\x77\x13\x29\x58\x32\x73\xe9\x7f\x87\xd2\x90\x1b\x79\xe2\x08\x24\xa9\x55\xab\x3f\xfc\x43\x37\xff\xe1\xce\x3e\xd8\xfc\x03\xe4\xd9\xbd\xed\x5d\xde\x0c\xa6\xf3\xeb\xc1\x04\xdd\x0c\x9b\x5d\x63\x2a\xaf\xa6\x36\x52\xa7\x54\x57\x88\xc0\xbe\x47\x47\x4a\xda\x9a\xd6\xeb\xcb\x15\x96\xd4\x8f\x8c\x25\xec\x03\x3e\x44\xae\x46\xe4\x5c\x4e\x46\x08\x8a\x0e\x5c\x76\x2e\xd8\xc3\x5e\x6c\x5b\xec\xc8\x59\x49\xa3\x86\xcb\xb1\x29\x49\xa4\x29\xd9\xde\x09\x3c\x33\x4e\x49\x38\x8d\x9d\xf1\xf4\xa8\x64\xc7\x86\xd6\x44\xdf\xfd\x6e\xca\x34\xa2\x1d\x07\xff\x77\x81\xf2\x0f\x76\xa6\xe5\x86\xad\x23\xe1\x70\x38\xa0\xeb\xa9\xcb\x70\xd6\xa6\x4e\xbc\x56\x3b\x24\x1f\xe7\xe6\x8a\x3b\x1d\xe5\x1b\x71\xa2\x13\xb1\xd3\x1f\x55\x6c\xbc\x83\x97\xe4\x13\x4e\xc7\xb2\xcb\x13\x96\x41\x00\x20\x09\xf8\x51\x28\x7b\xfa\xfd\x93\x43\xa4\x3d\xfb\xfe\xf7\xe7\x87\x3a\x78\xe7\x3f\x0f\x2f\x82\xbb\x43\x5e\x88\x67\xdf\x87\xf9\xa1\x83\x5f\x66\x60\xe4\xbf\x51\xe3\xa7\x9f\x87\xa3\x8b\xf1\xcf\xee\x6c\xae\x8c\x58\x4b\x9c\xc4\x5d\x1e\x61\x47\x3b\x34\x7c\xc9\xe0\x77\xb7\x77\x3d\x19\x2e\x78\xe5\x5c\x7f\xac\xd6\xdf\x6b\x13\x44\x04\xef\x48\x62\xff\xa1\xfb\xba\x09\xbe\x60\x5f\x33\x16\xb8\x32\x73\x72\x75\x73\xd9\x73\x77\xfa\x1e\x60\xc1\xdc\xae\x6b\x58\x70\xa5\x98\xbb\x68\xf5\xcc\xdc\xae\xb9\xeb\x60\xb5\x19\xe4\x5a\xe7\x9b\x62\x41\x69\x25\x91\xbc\x5b\xc8\x2c\x4f\x93\x73\x7e\x81\xe2\x62\x40\xe2\x52\xee\x94\x38\xa9\xd6\x0c\xba\xcf\xbb\x69\xb6\x3e\xad\x84\x1f\xa0\x70\x15\x31\xce\x00\x23\xa6\x6f\x5f\xbb\xda\xc5\x40\x9a\x5f\x8f\x5e\xbb\x3b\xdc\xbd\x59\xe0\x9c\xa1\x33\xd4\x9a\xba\x77\x82\x2c\x58\x14\x68\xd0\x49\x95\xe8\x25\x34\x97\x5c\x50\x61\x1b\x74\x5a\x8a\x8b\x6b\x8e\xad\x5d\xbb\xde\x1d\x2c\x41\x64\xa6\x9e\x8b\x5d\xb2\xae\xb5\x6a\xc5\x09\x6e\x6e\xd7\xe2\x04\x63\x1a\x1e\x14\x77\x8b\x07\x54\x04\xb8\x8c\x8a\x0a\x26\x80\xc5\xd3\xe3\x6c\xb1\x4b\xd6\xdd\x56\x5b\xcc\x36\xfa\xff\xe1\xee\xdb\xbb\xdb\x38\x8e\x3d\xff\xc7\xa7\xe8\x2b\x64\xd7\x64\x02\x41\x0f\xdb\x9b\xc4\x6b\xf9\x2c\x44\x42\x12\x12\x90\xc0\x02\x90\x23\xef\x6a\x0f\xcf\x00\x68\x00\x63\x0d\x66\x70\x67\x06\x22\xe9\x7b\xf6\xbb\xdf\xf3\xab\xae\xea\xc7\x60\x40\x82\x22\x29\x27\xd7\x39\x27\x36\xc9\x99\x9e\xee\xaa\xea\x7a\x3f\xbe\x9c\x2e\xea\x30\x4e\x74\xb1\x49\x97\xb8\x1e\xa6\xc0\x97\x09\xcf\xb5\x4e\xc1\xe2\x0b\x64\x45\x14\xf1\x6f\xa4\x61\xf7\xa3\x89\xfe\xe0\x30\xe2\x6a\xee\x0b\x87\x16\x1f\x0b\x21\x0b\x37\xf6\x1e\xc9\xc8\xba\xf5\x4d\xc1\x04\xc6\x90\xcd\x60\x79\x2c\xb6\x89\x27\x39\xf2\x6d\x4a\x4a\xb3\x0c\x99\x58\x47\xa9\x29\x89\xe3\x91\x30\xd0\x91\xb0\xaf\x8b\x3f\xb6\x81\x1d\xde\x8d\x6d\x7f\x09\x00\xd8\x11\x6a\x9e\x59\x89\xd1\x0d\x19\xea\xac\xcb\x95\x5e\x5b\x1b\xdb\x0a\xa2\x1a\xa6\xf6\x97\x1d\xa6\xf6\xfd\x2e\x4b\x7b\x71\x7f\x8e\xe6\xb0\x75\x3e\x19\xf7\xfe\x8f\x04\x17\x90\xf1\xee\x30\xa6\x95\x3c\x36\x19\x75\xce\xc7\xc3\xce\xa8\x7b\x3e\x11\x24\xda\xdc\xdf\xea\xf4\x43\xbc\x67\x00\x14\xb8\xaf\xa0\xd7\xba\xcb\x96\xf3\x74\xdf\x4d\x44\x53\x8f\x71\xc7\xda\x6a\x52\xf9\x8d\x37\xaf\xc3\x4d\x07\xb1\x93\x3b\xb0\x60\xaf\xab\xfe\x47\xfb\x79\x8b\xee\x02\x1e\x76\x8f\x71\xea\x54\xa8\xd8\x16\x55\x39\x7f\x09\x92\xa1\xaa\xc0\x8a\x7e\x14\x50\xc3\x5c\x43\xf2\x57\x89\x40\x98\xf0\x2e\x5f\xf0\x28\xc0\xf3\xa3\xd0\x67\x74\x61\xc6\x1c\xe8\xc5\xc2\xda\xf3\x8f\x55\xcc\x54\x87\xbc\x6a\x7b\x46\x79\xe6\xac\x73\x32\x1a\x50\x54\x8f\xf3\xe8\xe0\x09\xe1\x0b\xf9\x31\xd5\x97\x5c\xd2\x4e\xb6\xc4\xc7\x5c\x7b\xbf\xe1\x7f\xb3\x7d\xc2\xd5\x02\xa9\xbe\xe4\x97\xe5\xcf\x4c\xfd\xdc\x03\xc7\xa3\x84\xc2\x8c\xde\x04\xfc\xa9\x69\x51\x41\xc9\xf4\x0c\x54\xf1\x36\x3f\xe9\xb9\xfc\x21\x7e\xf3\x09\xfe\x4b\x49\x3a\x61\xa3\xb1\x7b\x12\xe7\xd5\xb4\xdd\x31\x79\x32\x46\x67\xf2\xee\x6f\x9d\x0f\x82\x6a\x78\x60\xd1\x3d\x25\xe7\x2d\xdb\xbd\x19\xf3\xe6\x2c\x2a\x57\x7f\x8b\xae\xaa\x22\x85\x32\x1d\xa3\x72\xf5\x6b\x74\x65\xa4\x8a\xd1\x5c\xd0\x5b\x47\xcd\x92\x18\x54\x0d\x15\xdd\x33\xca\xac\xb7\xca\x7c\x4d\x54\x44\x2b\x68\xcc\xe7\x36\xb9\x7e\x6a\x1e\x80\x1b\x2e\x2e\xd7\xd1\xa6\x90\x1e\x09\x68\x04\x62\xac\x7b\xbf\x70\x8e\x76\xed\x7c\xa3\x68\x10\x92\xcb\x73\x32\x27\xc3\x9e\x09\x12\x04\x55\x57\x65\x19\x87\xfd\x15\x98\x76\x0d\x1b\xb5\xf9\x21\x26\x27\x10\xa3\xd6\xc9\x9f\x20\x37\x82\x3f\x65\x61\x23\x5a\x48\x72\xcd\x55\x56\x68\xf5\x25\x2d\x8d\x39\x05\xac\xcc\xe0\x02\xb2\x26\xa3\x62\x2c\xa0\xd9\x3a\x4d\x45\xfc\x0a\x86\x89\x8f\x7c\xef\x1f\x51\x60\x70\x72\x7b\x24\x4f\xf9\x0d\x1d\x2e\x6e\x6f\x7c\xdb\x39\x67\xd3\x23\x70\xa7\x2d\xc8\x6a\x02\x5c\x99\x62\x2e\xbf\x2f\xe2\x52\x07\xe1\x11\x13\x1d\x29\x7c\xd2\x7a\xa6\xd3\x67\x28\x4d\x2b\xca\x67\xbc\x0c\x42\x4a\xc7\xae\x2c\xc4\x5e\x82\x3d\x0a\x05\x60\xf1\x14\x9e\x80\x23\xdb\x6b\x4e\x5a\x9f\x83\x71\xae\xd8\xd2\x9c\xd2\x50\x4d\x4a\xc2\x8b\xca\x78\x1a\x27\x71\x79\x7d\xdc\x52\xe7\x94\x51\x70\x76\xd6\x67\x7f\x01\xb6\x7e\xd6\x37\x4a\xc9\xf8\xe7\xb7\xfb\x11\x26\x9f\x3d\x00\x6d\x3e\x62\xaa\x48\xe3\xdf\x7b\x8a\x05\x23\x4d\xd6\xbf\x0d\x75\xa9\x0e\xfd\x49\x41\xd8\xc6\xcb\x98\xb8\x51\x94\xdf\x4a\xb7\x0c\x84\xa2\x8c\x53\xd2\x47\xdc\xbb\x10\x5f\x2b\x31\xdb\xad\xc3\x8f\x37\xdb\xfe\xb5\xe0\x86\x81\x61\xa7\x8a\x96\xe4\x87\x32\x21\x04\xcb\xa1\xa5\x21\xe2\x4e\xd0\x8d\xb8\xe0\x10\xe6\x93\x29\x46\x8c\x8a\xea\x9d\x76\xef\x92\x77\x04\xb1\x8f\xea\x21\x9c\xf7\xac\xdd\x7e\x26\xb4\x57\x83\xd7\xb0\x31\x39\x9f\xc1\xb4\x43\x45\xf8\x44\x9d\xea\x24\xa6\x9a\xe0\x73\x5d\x92\xaf\x8a\xfb\xe3\xe1\xfa\xfc\xfb\x36\x9e\x7d\x4a\xae\x6d\x07\x1d\xce\x7c\x95\x69\x49\xcc\x53\x0c\x5b\x14\xe8\xa8\x77\xd9\x25\x2a\x51\x5a\xdc\xa2\xaa\x28\xf3\x2c\x5d\xee\xf6\x32\x14\x86\x84\x1e\xc7\xf0\xf0\x71\x53\x6c\xb7\x12\x12\x70\xd7\xfb\x98\xb7\x84\x3b\xe6\x7a\x93\x64\xd7\x6b\x9b\x19\x58\x47\xd5\xb2\xc4\x6c\x9e\xb6\x7f\x2d\xe6\x38\x6f\xde\x4e\x75\xf9\x2c\xdd\xac\x05\x72\xff\xeb\xe5\x03\xd1\xbc\xa0\xc7\xd2\x3c\xb3\x08\x7c\xdc\xdb\xbf\x7c\x97\xd9\x84\x08\x77\x59\xc5\xf6\xe3\x3c\xb8\x6d\x10\x03\x8d\x8d\x51\x33\x50\x0d\x41\x0e\x3e\x82\xa3\x16\xb9\x67\xf3\x6d\xee\xcb\x4a\x2b\xe1\x0c\x51\xb3\x73\xad\xd1\xac\xdb\xd2\x2b\x35\xd1\x1f\x9e\x75\xce\xc6\x38\x84\xfc\x37\x9b\xbe\x0f\x03\x45\xef\x63\x56\x25\xf0\xe1\x83\x39\x38\xb7\x65\x80\x47\xde\xe8\x60\x4c\xff\x37\x77\x56\x6d\x62\xc4\x31\xa0\xfb\x23\x05\x73\x2e\x6a\x25\xfb\x88\x69\x91\x2c\x95\x32\x41\x71\x76\x08\x94\xf0\x42\xbd\x48\x08\xb2\x95\xee\x23\x12\xb8\x7b\x55\xa3\x79\xef\xfe\x55\x87\x00\xda\x02\x92\xc9\xd5\x72\x66\x9c\x6f\xdc\xed\x8c\x4e\xde\x75\xcf\xdf\x8a\xab\xcf\x63\xd3\x81\x27\x40\x6c\x06\xf8\xdd\x4d\x21\xc2\x34\xbb\xba\xc1\x02\x37\x25\x83\xc9\x35\x08\x90\x5f\xd0\xe9\x12\x96\x09\x29\x63\x1e\xb6\x20\xb2\x4e\x25\xae\xe7\xd8\x32\x31\xaa\x2c\xad\xf1\x84\xb7\xab\xb6\x82\xeb\xba\x46\x71\x51\xe0\x29\x50\x39\x10\x28\x3f\x6e\xd9\xa6\x05\xee\x8f\xff\xfb\xdd\xf0\x98\xa6\x64\x9b\x12\xb8\xc2\x7b\xcf\x54\x18\x21\x4f\xd9\x4d\x93\xe4\xb2\x6f\x0b\x01\x3b\x6e\x87\x2a\x52\x61\xa6\xa4\x36\xe4\xe1\x7a\x8f\x4e\xb5\x84\x9f\xa8\xa4\x11\xb8\x37\x53\x4f\x38\xa1\x02\x28\xd5\x3e\xf9\x4e\x23\x50\x68\x08\x34\xbe\x00\xd0\x10\xac\xb8\xb0\x2e\xe4\x71\x77\xf4\x73\x77\x74\xf1\xba\x33\xee\x9e\x5e\x18\x8c\x92\x76\xc8\xf5\x97\x08\x4d\x98\xf0\x80\xb8\x39\xa4\x05\xad\x37\x62\xcf\x0e\x3c\xf6\x94\xc1\x4f\xfa\x7a\x9a\x45\xf9\xfc\x7f\x82\xa8\x7e\xdd\xae\x37\x22\x62\xf8\x49\x50\x00\x3c\x44\x3f\x46\x64\xbd\xab\x4f\xfa\xfa\x27\xf5\x27\x35\x06\x02\x2e\x85\x4c\x83\x3f\xda\x86\xba\x85\xb4\xd3\x1d\x70\x42\xac\xb1\x05\x8d\xee\x63\xa4\x8a\x85\x20\x06\xe8\x9e\x4c\x46\xfd\x9f\x5a\xea\xc7\x4e\x7f\xf2\xd3\xb3\x1f\x8d\x74\xff\x89\x50\x87\x54\xda\xe3\xb6\xea\xb9\x16\x9e\x95\xdd\xe1\x57\x3f\xce\xb6\x79\x41\x78\xbe\x84\xa0\xa5\xbd\xc8\x99\xe2\x34\x3c\x95\x91\x80\xf0\x1c\xc1\x77\xd7\xf2\xa4\xa2\x1d\x91\xc7\xf1\x3f\x72\xa8\x3a\x70\xc9\x47\x3e\xe9\xeb\xe2\xa7\x36\xf2\xb7\x8b\x42\xfd\xd8\x45\xaf\xec\x9f\x00\xb9\x42\x27\xc8\xc5\x41\xd8\xa2\xd4\x6b\xec\xfd\x47\x4d\xee\x20\xfa\xeb\x0c\x0a\x46\xc2\xb7\xc9\x1c\xc1\x9f\x5b\xca\x1a\x8d\xdd\x82\x59\x0c\x85\xe2\x72\x95\xf9\xeb\x71\xe1\xb5\x33\x75\x58\x05\xae\xa6\x20\x09\x5d\xd0\x86\x7f\x1c\xaf\xe2\x45\xf9\xd3\x9f\x7c\xc8\xfc\xc4\x3d\xd6\x88\xe0\xab\xa0\xa3\x53\x89\x03\x19\xdf\xb6\x23\x14\x09\x81\x72\xce\xca\xa9\xa2\x59\x19\x7f\x86\x4b\x2f\xa3\xbe\x0f\x33\x9d\x48\x3a\xad\xe9\x85\x7a\x9b\x7d\xf1\x10\x26\x77\xc0\xe3\x44\x6a\x7b\x26\xb7\xc7\x0c\x77\xef\x51\x85\x27\x7a\x74\xc2\x37\xd3\xa5\xd5\xc4\x60\xe5\x3c\xa6\xcb\x50\x45\xa4\x2e\xf5\x54\x15\x3a\xff\xac\x73\xdf\xa8\x34\xbf\x67\x83\xd4\x3c\xea\x2c\x52\xc2\x7a\xae\xb9\xbb\x4c\x79\x99\x21\xc3\xfe\x33\x06\x0f\x67\x0b\x7f\x3d\x9f\x4d\x60\x05\xd3\xa9\x1a\xff\xc5\x57\x0b\x12\x76\x74\xde\xe9\xf3\x51\x88\x16\xa8\x2f\x05\x7b\xe5\x84\x2b\xb5\xf6\x72\xfa\xe1\xbb\x21\xeb\xc1\xe0\xf4\xde\xb7\xa4\x77\x07\x47\x53\x49\x0a\xbb\x49\x64\xda\x2a\xcf\xf4\x9d\xca\x3e\xaa\xe0\xa4\x25\x8c\x82\x19\xa5\xfe\x81\xfc\x24\x18\x66\x65\x14\x24\xb4\xae\x78\x78\x51\x0b\x27\xab\xd9\x2f\xd1\x68\xaa\x27\x5d\x79\xa4\xc7\x8b\x13\x8d\x8e\x65\xe9\x8a\x9b\xe2\x5e\xc6\x6d\x40\x5d\xbb\xa4\xb7\x4b\x51\x55\xdb\xb6\x0a\x9e\x9b\xa4\x70\x9a\xa9\x24\x4b\x97\xde\x18\x1e\xda\xc7\xf0\xdd\xb0\xd1\xac\xc5\x14\x31\x46\x22\xbb\x1a\x0e\x07\xfa\xf2\x4a\xda\xa2\xd4\x66\xd5\xd8\xc9\x1e\x15\x1c\xb8\xa9\xbc\x51\xea\xf0\x40\x18\xd4\x79\xa5\xbc\x9c\x27\x17\xb1\x9e\xc3\x8f\x86\x77\x87\x0c\x17\xdc\xaa\x4c\x08\xc7\x87\xe6\xc5\xfb\x51\x9f\xd9\x1e\x9e\xc8\xa6\x62\xa6\x35\x9a\x95\x93\xb4\x83\xd9\x4a\xc5\x2a\xde\x14\xdc\x42\xdd\xc5\x90\x79\x93\xea\x08\xc4\xce\x3f\xb0\x13\xdf\xdf\x13\x64\x17\x9e\x60\x0e\x3c\x5b\xc6\xe2\x44\x02\xb4\xcc\x9d\xe3\xfb\x45\x21\x22\xee\xd9\x19\x9e\x8b\x1b\x4b\x34\x9a\xea\x43\xb4\x89\xa3\xb4\x92\xa4\x7a\x45\xbf\x84\x8d\xf3\x8c\xc7\x2f\x55\x48\xf8\x9f\x87\x80\xab\xc4\xa9\x76\xa3\xc6\x3b\x28\x63\x0d\xc8\x8e\x7b\x8a\x2a\xd0\x59\x65\x05\x87\xfb\x7d\xfe\x68\x49\x8e\x2b\x72\x29\x4f\xa8\x86\x68\x2f\x6f\x66\x29\xff\xca\xa4\x70\x20\x19\x34\x9a\x37\x12\xc2\x7d\xb8\x55\x05\x93\x8c\x6c\xcb\xaa\xea\xd8\x19\xc0\x56\x45\x07\x5d\x15\x94\x38\x09\x13\x03\x8c\xb6\xa9\x81\xfb\xdc\x5d\xdf\x79\x54\x46\xc0\x9d\xcf\x84\xdc\x9c\x2c\x11\x0c\x55\x76\x03\xb6\x8f\x64\x18\x93\xb0\x0a\x68\xd0\x57\x4f\x3b\x93\x8e\xab\xe6\xf1\xb3\xf9\xc9\x47\x4b\xcb\x8a\xf2\xc4\xc9\xd6\x3b\x3d\x46\xb8\x6c\xf3\x07\xde\x20\xf6\xd7\xbe\x5a\x27\x0f\x03\x58\xb7\x41\x7b\x8b\xc2\xcf\x3c\x08\x9c\x1b\xcd\xea\x63\xd2\x19\x83\x0f\x2f\xd5\x60\x51\x5a\x97\xb4\xc9\x46\x09\xf7\xbd\xa0\x0c\x27\x19\x08\x8d\x10\xc4\x7a\x2a\x9e\x34\xba\x54\x9c\x2a\x44\x67\x97\xb1\xef\x63\x9c\x9b\x11\x5c\xae\xf2\x6c\xbb\x74\x3d\x63\x5c\xf2\x7a\xc1\xd9\x84\xc6\x0b\x16\x28\xd9\x68\x5a\x2b\x96\x46\x8e\xe4\x44\xbb\xa9\x7b\xa2\xa1\x06\x2e\x96\xbe\xc1\x40\xf7\x9c\x66\xd7\xff\x60\xc0\xcd\x87\xc4\xad\x94\x73\xb2\xb0\xf6\x8f\x69\x5a\x3b\x97\xab\x88\x39\x45\xca\xd3\x34\x45\x59\x8a\x8b\xca\x48\x11\xd0\xa0\xb1\x83\xa4\xd1\x1f\x54\x41\xf4\x6b\x99\xb3\x5f\xcd\xfa\x16\xab\x62\x95\x73\xaf\x28\xe7\x95\x37\xe0\xb4\x28\x0a\x71\xa1\x0d\x94\xcd\xd8\x25\x22\x45\xab\xc2\x5d\xc0\x00\xff\x96\x95\xad\xa3\xcd\x86\x8d\x9c\x54\x21\xf4\x41\x27\xa1\xa9\xd0\xc6\x20\x88\x5c\x8d\x9b\xf5\xe1\xba\xe1\x42\x81\x53\x43\x40\xb9\x40\x53\x62\x36\x70\x8c\xab\x3e\x2e\xe0\x6e\xaf\xc7\xc1\x2b\xdc\x31\x5c\xe7\x17\xaf\x92\x6c\xf6\x42\x7e\x7a\x89\x9f\x5e\xaa\x76\xfb\xbe\x17\xb4\xfe\xab\xbf\xd7\x84\x2a\x8a\x1d\xb1\x33\xe5\x61\x37\xe0\x12\x42\xac\xc9\xd4\xef\x4c\x38\x51\x37\x00\xca\x3e\xa3\xc0\xdf\xdb\x7e\xc5\xa3\x92\x72\x66\xbe\xb1\x63\x78\xe1\x5d\xfa\xd3\xc1\x73\xaa\xcc\xd7\xff\x79\x06\x55\xc1\xb5\x7b\x75\x00\xf1\x55\x20\x51\x25\xbf\x00\x08\x02\x26\x00\x8a\xd6\x0f\x41\x75\x72\x76\xea\x0a\xd9\xf6\xf8\x45\x1d\xa8\x38\xd2\x6b\x72\xe9\x49\x8d\x47\xa4\x82\x34\xf3\x79\x6d\xdc\x1d\xc9\x02\xd6\xb1\x04\xf7\xe2\xf0\xf4\x0d\x93\x88\x07\x05\xc4\x3a\xb0\xb5\x30\x97\xb1\xd1\xbc\xf1\x8d\xcd\x7c\x41\xe7\x21\xce\x4f\x2f\x72\xd4\x05\xbf\x85\x7b\xa0\xd0\xbc\x2a\x89\x9d\xd9\x2a\x2b\x24\x8f\x0d\xbe\xb5\xcf\x3a\x17\x3d\x61\x7a\x6d\x17\x33\xbe\x6c\x16\xe8\x33\x26\x4c\x95\x44\xe9\x72\x8b\x6c\x07\x96\x88\xb2\x0b\xc0\x8b\xf8\xe0\x54\x6b\x13\x3a\x9c\xc7\x0b\x9a\xcb\x5b\x26\xd7\x70\xf5\xec\x3a\xa8\xac\x55\x2f\x15\x45\xec\xa2\xa9\x7c\xe9\xa1\x88\xc0\xa2\x77\x0f\x11\x9c\x75\xfe\xde\xa5\xfc\xed\x83\x09\xa1\x4a\x02\x72\x95\x6d\x2e\x34\x44\x3f\x51\x8b\x3f\xfc\x21\x2e\x82\xdb\x68\xe3\x63\xdc\x41\xf0\x19\x15\xf7\xb4\xa7\x51\x19\x0e\x21\x35\x87\x08\x36\xe9\x54\x0b\x4c\xb6\xe2\x85\x64\x13\x73\xc1\x18\x5b\x9d\x47\xed\x52\x5f\x1d\xef\x55\xcb\xf0\x51\x23\xe4\xee\x0d\xee\x1a\x40\x1a\x70\xdb\x6f\x84\xf7\x2e\x3c\xd3\xdd\x60\xee\xe5\xdc\xec\xc0\xdc\x8e\x9d\xb4\x7e\xe6\x34\x23\x15\xa8\x48\xa2\x62\xa5\x8e\x3e\x1e\x43\x59\x5b\xc4\x79\x51\xaa\xd9\x2a\xca\xa3\x99\xf1\x9c\x79\x73\xeb\xa3\x6d\x99\xad\x6d\x2b\xe0\x48\xfa\x00\xfb\x5c\x60\xae\x6f\x47\x6e\x2d\x4e\x3c\x8c\x38\x2c\xd7\xc0\xae\x0e\xc9\x7b\xa8\xa5\x5e\x64\x3c\x20\x6a\xeb\x10\xb6\x8b\x5a\x16\x84\x27\x83\xb3\x61\xe7\x64\xc2\x4b\x31\x58\xea\xe4\xa0\x80\x87\x47\xf2\x50\x44\x7e\x56\xda\x44\x0f\xd1\x72\x0a\x56\x9d\xe1\x80\x37\xd4\xb1\xd8\x26\x84\xef\x02\xc3\xcc\x9c\x62\x88\x3b\x89\x87\x6c\x9f\x6e\xf0\xa5\xc2\x4c\xeb\x41\x9a\x2d\xfc\xb8\xfc\xd1\x64\x3f\xd8\xce\x07\xf7\x87\x57\x08\x02\x55\x67\xe4\x0f\x3b\xc8\x4c\x9e\xfc\x32\xac\x67\x37\x9c\x83\xb1\x89\x36\xa8\x7f\xb8\xde\xb0\x44\x11\x02\x33\xce\x1d\xd2\x8a\xe1\xf0\xc9\xf7\x26\x45\x44\xdf\xa9\xa3\x97\x2f\x9e\xab\x2b\xf5\xf2\xaf\x7f\x56\xeb\xf5\x71\x4b\x25\x26\x74\x71\xf4\x97\xf6\xf7\xea\x4a\xbd\x78\x81\x5e\x39\x2b\x5d\xd0\x5f\x96\x51\xc2\x7f\x40\x9b\xd4\xef\xe4\x4f\x24\x60\xf4\x95\x9e\x6d\x49\x25\x3d\xfa\x73\xfb\x25\xbd\x8b\x3e\xaa\xfc\xc8\x7e\x80\x46\xdf\xdd\x1f\xa0\x1e\xb4\x04\x98\xf8\xdf\x2b\x15\x7d\x27\x00\x35\x1a\xe7\xb0\x73\xf2\xf7\xce\xdb\xc3\x27\xc7\x10\xb1\xa9\x4d\x34\xfb\x84\xba\x07\x70\x74\x3b\xc0\x70\x7f\x33\xa1\x40\x65\xa3\x8f\xcb\x02\x55\x73\x58\xa1\x16\x06\xa5\x60\xd7\x2a\x2e\x0b\xb6\x9f\xbd\xda\x50\x1e\x7d\xa0\x8a\xeb\xb4\x8c\xae\x94\x6d\xcf\x44\x5b\xb6\x4f\xc9\x8d\xf8\xb8\x2d\xb4\xfd\x92\x61\x88\x6d\x99\xdd\x88\x7d\xa1\xb9\x3d\x92\x78\x51\xbf\xe6\x25\x59\xb8\x52\x2a\x06\x80\x33\x0b\x04\x5c\xaf\xcc\xab\x59\x5e\xfd\xfd\x7f\xd0\x1f\xfe\xbf\x9b\x25\x8f\xef\xb0\x0b\x31\x4e\xcb\x24\x5e\xe3\x5c\x76\xa7\xd1\xba\x40\xfc\xd6\x02\xb4\xf2\xe5\x9a\x0f\xff\x5f\xbb\xca\xff\xfb\x0f\x7e\x1b\x5f\x0b\x47\x95\xa5\x19\xb7\xbd\xe1\x75\x9d\xb2\x2a\xa8\xb9\x3f\x89\x85\xfb\x12\x0a\x73\xb6\x2c\xbd\x25\x03\xf4\x6e\xa0\xae\x48\x6d\x74\x5e\x50\xb1\x30\xe1\x4d\x26\x21\x32\x3f\x0f\xb2\x45\xad\xf6\x4d\x31\xea\xb6\x3f\x86\xb1\x92\x4b\x83\xec\x90\x6b\xd4\xc9\x2e\xd5\x36\x2d\x63\x09\xfa\xe4\x44\x5d\xb3\x55\xb4\x01\x13\x80\x29\x62\x42\x7d\x07\x8c\x79\xb3\x33\xb2\x78\x0e\x25\xe7\x23\x5a\x4f\xd9\xc1\xd3\xdd\x12\x5d\xba\x8f\xe4\xf1\xbe\x49\x90\x65\xe6\xe7\xf1\x73\x85\xb7\x15\xa1\x83\xd4\x8c\x7d\xab\x4e\xc9\xe3\x97\x39\xcf\xef\x13\x1a\x11\x52\x20\x14\x84\x05\xa7\xd8\x3c\x8b\xd3\xe5\xbf\x71\xa9\xe4\x22\xc3\x48\x1b\xc0\x88\xd5\x05\x4e\x43\x75\x7d\xbe\xd7\x3a\xa2\x0a\x36\x6f\x3e\xa1\xf9\xc2\x0f\xea\x0f\x65\x5c\x26\x1a\xbd\xd7\xfe\x80\xc1\x0c\xa0\xfb\x96\xf9\x4f\xfc\xcb\x00\x83\x67\x56\xb6\xd4\x1f\x58\xe8\xe0\x42\x7b\x3f\x51\xf9\x0e\x2d\xc1\xbf\x99\xe6\xb1\x5e\xb8\x07\x92\x6c\x99\x55\x9c\xfa\x52\xfe\x69\x3e\xef\x6e\x12\x75\x82\xf3\x1a\xa0\x8a\x3a\xc0\xcf\x83\x5e\x84\xd1\x73\xbe\x85\xf1\x8b\xd8\x93\xdb\xee\xf0\x54\x0d\x67\xda\xcf\x83\xb5\x65\xfe\x9c\xa9\xfb\x5f\x9b\xe0\x5a\xa8\x7d\xd7\xe6\x90\x09\x99\x95\x6b\xc3\x83\x3e\x0e\xbd\x36\xfc\xf8\xfe\x6b\xe3\x46\xda\x25\xd1\x03\xdc\x1a\x6f\xac\x65\xa3\x19\x32\x07\x9b\x9e\x52\x7f\x5f\xbc\xd5\xe4\x8a\x54\x66\x6b\x36\x9a\x96\x60\x2d\x3a\x7d\xb0\xed\x4e\xd7\x3c\xe0\x26\xf1\x37\x6e\xb9\x49\x0f\x44\x0f\x8c\xef\xbd\xf4\x50\xad\x88\xbc\x91\x32\x5c\x8b\x2e\xff\x40\x80\x3a\x11\x4a\x50\x74\x69\x47\x79\x58\x99\xed\xf0\x6e\x71\x17\xbc\xc1\x13\x46\x8c\x90\xbe\xff\x28\xc3\xea\x64\xb1\xff\xb2\x13\x03\x1f\x88\x52\x76\x28\xa1\x96\x52\xbe\xf6\x34\xc0\xc0\x7f\x64\xdb\xeb\x3a\x14\x79\x4e\x1e\x69\x66\xe6\x74\x12\x5e\xea\x96\x99\x79\x8d\xe6\xfd\xa6\xe6\xdd\x0e\x54\x6f\x6e\x9e\xdf\xf5\x64\x78\xfa\xe6\xe2\xdd\x2f\xc3\xee\x88\x8a\xdc\xc4\x6e\x75\xab\xb4\x3c\x1d\x77\xb7\x70\x9b\xfb\xe5\x40\x8e\x73\xb9\x8b\x69\x47\x5c\x70\x50\x79\x78\xfa\x46\x1d\x71\x8e\x7f\xf1\x72\x33\x5f\x00\x2d\xe2\x5b\x3a\x36\xbc\x1a\xcf\x70\x9e\xa1\xcc\xe8\x21\x6e\x9d\xc4\xe9\xa7\xa2\x5a\x3f\xee\xe5\xc1\x1d\xfb\x29\x1f\x98\xc3\x63\x04\x9b\x4e\x67\x9a\x8d\x44\x02\xea\x27\x5d\xf8\x17\xab\xd8\xc6\xa6\x19\x22\xf8\x72\x96\x52\xb1\x2c\x25\xbe\x61\x8f\x92\x56\x82\x2d\xa1\x1a\x54\xe7\x0f\x96\x3a\x53\x8f\xa0\x0a\xf4\x77\x5c\xb8\x8c\xa4\xd0\xed\xb7\xdf\x86\xb6\x45\x85\x02\xe2\x8a\x43\x8a\x11\x4e\x7c\x82\x59\x4c\x72\xed\x4a\xba\x58\xd4\x82\x89\x40\x94\xd5\x75\x5a\x40\x4b\x1b\x9a\x0e\x8f\x48\x07\x46\xac\xeb\x5c\xfd\xfb\x36\x42\xda\x3c\xad\x5c\x93\x61\xf9\x68\xc0\x0b\xa0\xa2\xf6\x02\x8f\x20\x7f\xf1\xba\x33\x39\x79\x77\x36\x38\xed\xde\x0a\x3f\xb4\xd5\xc4\x7b\x1f\xa7\x68\xf7\x84\x62\x2a\x22\x4a\xba\xaa\xc2\x11\xdc\x0d\x08\x60\x06\xa2\x73\xd3\x38\xf2\xad\xf8\x2f\xf0\xda\x27\xad\x37\x32\xbe\x03\xbe\xc1\x85\xd2\x79\x4e\x69\x47\xb3\xd9\x36\x6f\xf9\xc4\x1c\x15\x9f\x24\xe9\x0d\x32\x8e\xee\x15\xdc\x18\xfc\x01\x46\x48\xcc\xbd\xae\xb9\xf2\xb8\xda\xcd\xce\x56\xbf\xc4\x29\xe9\x78\x8f\xeb\xe3\xa8\x02\xd9\xe2\x22\x28\x93\x65\xc5\xa8\x77\x4a\x4d\x32\x7a\x27\xdd\xf1\x2e\x36\xc2\xa6\xf0\x04\x4c\x38\xcd\x59\x82\xb3\xd0\xe1\x5e\x22\x46\x67\x2b\xdc\xf8\x0b\x4c\xda\x37\xc1\xfc\x16\xf5\xd7\x44\x14\x4c\x7e\xd6\xe5\xac\x6d\xbb\xc4\xde\x16\x61\x79\x38\x98\x04\xa7\xdd\x0b\x93\xf1\xe0\xfd\xe8\xa4\x7b\x71\x52\x4b\xa0\x35\x20\x11\x70\x98\xfc\x18\x22\xd0\x39\xdb\x0b\xec\x3d\xc0\xe5\xa4\x61\x86\x20\x86\x3a\x57\xc5\x4e\x78\x82\x44\x20\xad\x47\x35\x34\x32\x74\x0b\x34\xe6\x39\xed\x59\x7a\x1a\xdd\xa0\xe0\x99\x50\x10\x03\x7c\x84\xd7\xa3\xc1\x3f\xc6\xdd\xd1\xd7\x00\xad\x0f\xb4\x00\xb4\x4e\x61\x78\xdd\x7b\x6d\x46\x45\xdf\xa4\x2e\x38\x85\xac\xa4\xeb\xe4\x59\xe7\xd3\x78\x9a\xc4\xd9\x32\x8f\x36\xab\x6b\x9e\x3b\xb1\x49\xa2\x38\x4d\xd1\xe0\x1e\x46\xb0\xd6\xba\xb4\x8a\xff\xcd\xe3\x5a\x5f\xc7\x53\xf0\x0a\xb0\x91\x8f\x33\x58\xc6\x81\x69\xb0\x1f\x60\xf4\xc1\x87\x82\x99\x03\x88\xbd\xa2\xb4\xfe\x0e\x45\xba\x49\x81\x5f\x30\x7f\x51\x06\xb4\xb1\xd2\xfb\x40\xa3\x17\xcf\x07\x6e\xf2\x22\xbd\x4a\x4e\x62\x9e\x41\x62\x25\xbc\x3f\xda\x2f\xdf\xa6\xc5\xd7\xa0\x44\x07\x2c\xb5\x8f\x12\xbb\x67\x83\xbf\xf5\xbc\xa8\x28\x43\xb5\x8e\x16\x8f\x6c\xa0\x15\x85\x0c\xdc\x55\x1e\xb9\xf2\x14\x77\xa5\xa2\x1e\xd7\x32\x42\xaf\xb3\x5f\x63\xd1\x6f\x45\xe9\x44\x26\x3d\x99\xb2\xd1\xde\xa0\x6d\x2b\x0c\x72\xd8\xc7\xea\x74\x5e\x4f\xd5\x0d\x5d\x71\x86\x1b\xd7\x3f\x6b\x97\xc6\x11\x1f\x0a\xd0\x55\x28\xfe\x5e\x39\x04\xa3\xc9\x9b\xaf\x95\x41\x80\x4f\xdd\xa6\xb3\xc8\xb5\xf1\xf6\x25\x1d\xc3\xdc\x6f\x14\xeb\x0e\xeb\xf8\x37\x56\xd6\xff\x91\xe5\x73\xf5\xd7\x3f\xdb\x58\x09\x04\x2d\x15\xcb\x96\x59\x06\xb5\xbe\x2c\xaf\x8d\x4c\x31\x4c\x7f\x34\x79\x43\x59\x00\x70\x91\x15\xcf\xf4\x3c\x2e\xb3\xfc\x96\xeb\xe5\x92\x14\xb0\x0f\xa5\xea\xee\xc7\x68\xf2\x46\x88\xe7\xd6\x0c\x05\xac\xf2\xcf\x93\x9f\x90\x97\x8b\xbb\x10\x36\x36\x5f\x25\x6b\xef\xf0\x02\x1b\xfc\xef\x95\xca\xcb\x45\x4d\x18\xed\x16\x62\xd8\x13\x44\x33\x88\x13\xdd\xfc\x9f\x3a\x84\x56\x07\x23\xff\xf0\x35\x34\xc4\x20\x1a\x4d\x02\x5b\xaa\x06\x4a\x42\x40\xbb\x76\x6c\xc5\xf4\x5c\x5d\x6f\x74\x0e\xfb\x53\x2d\x62\x9d\xcc\x09\x60\xe6\x55\x6b\xa8\xde\x6e\xa6\x36\x9a\x07\x1b\xaa\x07\x99\xa9\x8d\x66\xd5\x50\xa5\xeb\x8b\xc8\x67\xb6\x16\x87\x2f\xfd\x4a\xaa\x99\x13\x2d\x97\x95\x63\x58\x3c\x8f\xb6\x44\xea\x07\x3c\xe4\xe6\x70\x9e\xbb\x10\xaf\x0f\xa3\xb9\x3a\x42\x2a\x88\x36\x49\xbb\x74\xf7\x0b\xa9\xbe\xe7\x6e\x13\xe6\xd8\x8f\x89\xf4\x0a\x3a\xab\x48\xef\x67\x11\xbb\xee\xc8\x73\x07\xba\xa3\x86\x4b\x59\xca\x3d\x4a\x80\xa9\xb6\x1a\x1b\x65\x18\x8b\xc7\xeb\x38\x89\xa8\xd3\x34\xdf\x16\x6a\x5c\x5b\x97\x1c\x48\x25\xdf\x48\xab\xa6\x69\xa2\x64\x90\x15\xf1\x32\xe5\xbb\x83\x59\x37\x98\xd5\x6e\xa2\x09\x65\x26\xc5\x13\x8d\xa6\xef\x8e\x2f\x5a\x6a\x1d\x9b\x5a\x20\x7f\x6b\xa4\x57\xdb\x69\x70\x71\x1e\x82\xce\x65\xab\x73\x27\xc3\xbb\x46\x5f\x84\xa2\x59\x30\xcb\xe2\xbe\xbf\x8f\x67\x10\x13\x04\x78\xa2\x86\x09\xb6\x14\x0f\x81\x31\xe7\xba\xb3\xa9\xb8\x90\xd0\x50\xe6\x58\x94\xd2\x7c\xd5\x3c\x06\x71\x33\xa3\x67\xd5\x90\xf7\xce\x7d\x85\xa3\x54\x78\x3d\x47\xc3\x2c\x26\x1b\xcd\x5a\x5c\xd6\xa4\x79\xa2\x95\x65\xa9\xd7\x18\x21\xa5\x5d\x51\x6f\xc1\xe3\x50\xc8\x16\x08\x22\x09\xe6\x56\x09\x6c\x9e\x6a\xf0\x60\xf7\xde\x9b\x3b\xb6\x21\xde\x07\x22\x57\x99\x1b\x82\xc8\x63\x63\xbe\x61\x73\x77\x6b\x90\x6c\x41\x6e\xa5\x7b\x93\x35\xe8\xe9\x0a\xff\x1a\xb6\xe0\x5e\xa2\xab\xda\x81\x96\x4d\x88\x5e\xf5\x15\x35\xc3\x75\x94\x1a\x73\xe6\x2b\xa9\x87\x67\x9d\xf3\x5d\x1a\xd9\xa7\x1e\xca\xe6\xa4\xb9\xd7\x2c\x89\x8a\x82\xc7\x17\x1f\x30\x98\xcc\x69\x73\xf8\xaa\x52\x15\x68\x73\x06\xdd\xf9\xc1\xda\x9c\xdb\xce\xd7\x52\xe7\x54\xc7\x53\xe1\xd6\x51\xfa\xad\x7d\x5c\x66\xcb\x7b\xe1\x34\xf7\xa4\x4b\xe1\x98\xa2\xd6\xc4\x9d\xb1\x0a\x2f\xf7\x0a\xc8\x7b\x1d\xa5\x77\xa1\x6f\x00\xb5\x4a\xdf\x1e\x38\x05\xda\x92\x6c\x95\xfa\x20\xb7\x5c\xa5\x6e\x48\xad\xe5\x61\x56\xf5\x09\x92\xdd\x2d\x07\x6c\x34\x1d\x4a\x82\xcc\x39\x6e\x0e\x66\x25\xd2\x3c\x43\x0b\xf0\x0c\x92\x05\x59\x87\x64\x23\x44\xdc\xb0\x92\x27\xf0\xd3\x7f\x37\x9a\xea\x5b\xc8\x89\x4d\x4e\x0e\x24\xcd\x29\xe9\xf3\xac\x54\x47\xed\x63\x69\xe4\x31\xd5\xcb\x38\x4d\xbd\x50\xd3\xee\x81\xc8\x99\x2f\x02\x64\x3f\x91\xb6\xbf\x7d\x08\x78\xbb\x4f\xdb\x7f\x5e\xa9\xf6\xb7\x3e\xb8\xc7\xef\x5f\x9f\xf6\x46\xb7\x0e\x04\xb6\xd4\x20\xd4\x05\x48\xc5\x69\x40\x42\x61\x07\x48\x77\x25\xa0\x2a\x50\x74\x7f\x4e\x06\x0e\x1f\xb6\xe0\x56\x6d\x9c\xde\xe0\x53\xa4\xdb\xb6\xcd\x1b\x90\x1e\x94\x6d\x6e\xea\x36\x7f\x08\xf0\xf0\xd9\x05\x34\x55\x01\x86\xad\xec\x51\xc1\x49\x9f\x14\xce\x24\x54\x07\x43\x24\x65\x6e\x49\xb4\x83\x02\x6e\x09\x98\xca\x43\x14\xd2\xf3\x3a\xef\xe3\x15\x5b\xc9\x44\xbe\x27\x9d\x96\x08\x42\x88\xc2\xe0\xf4\x8a\x5c\x47\x89\x47\xd8\x47\x85\x89\x3b\x15\xc1\x7a\x58\x0b\x22\x0d\xad\x5a\x48\xec\xd9\x57\xed\x8b\xa6\x68\x84\x3b\xb7\xc0\x02\xa2\xfe\x76\x8c\x32\x89\x0f\x5c\x4a\x04\x71\xcb\xed\xb7\x32\x33\x3c\xdb\x4f\xef\xda\xd8\x7c\xe6\xfb\x8b\xc4\x7d\x38\x0a\x95\xe6\xdf\x59\x24\x7e\x38\xeb\x7f\x2d\x69\x88\x4f\x1d\x2c\x0d\xbd\x8a\x5c\x37\xd2\x6b\x83\xb6\xd5\x1c\x00\xb7\x5d\xac\xf9\x3e\x43\xb1\x62\x65\x0b\xdc\x0a\xc6\xf2\x81\x01\xaf\x50\x7a\x62\x97\x35\x01\x2b\x10\xc4\x87\xb3\xbe\xf0\x85\x5b\xc5\xe7\x07\xdb\x67\xfe\x6b\x89\xcf\x1b\xa5\xdd\x61\x95\x7c\x01\x10\xaa\xa4\xeb\x9d\x5e\xa0\x83\xff\xbd\x52\x5c\xbe\xc7\xe8\xc6\x63\xc3\xd1\xe0\xed\xa8\x73\xd6\xef\x8d\x27\xbd\xf3\xb7\xb7\x22\x7d\x8e\x5e\x14\x5c\x81\xb7\xcc\xa3\x35\x67\x2e\x90\xde\x7a\xe4\x50\x5a\xa7\x2e\xe3\x5e\x63\xba\x6f\xf1\x54\xec\x76\xfc\xd6\x33\xc0\x8e\xdd\xe8\x31\x47\xea\x7e\x56\x80\xad\xf8\x28\x6d\x80\x10\x06\x25\x4a\x2a\xa2\xb4\xc4\xcc\xf2\x14\x02\x82\x65\x2d\x5a\x83\x42\xda\x2d\xaa\x2b\xee\xa5\xae\x3b\x86\x53\xf7\x41\xbe\x02\x52\x5f\xb3\xf3\x00\x7f\x3e\xbe\x38\xeb\x9e\xbd\x26\xe3\xe5\x62\x7c\x32\x18\x76\x6f\x05\x3e\xdb\x27\x3c\x35\x82\x66\x93\xd9\x19\x85\x31\x33\xf2\x62\x96\x6d\x34\x72\x5e\x2f\x75\x92\xa0\x23\x68\x29\xb5\x7f\x61\xf7\x9b\x07\x62\x9c\xfb\x60\x50\x73\xba\xdf\x8f\x71\x9e\x0e\x4e\x5e\x0f\x06\x7f\xff\x5a\xcc\x53\x3e\x77\x30\x03\x3d\xcd\x66\x53\xb8\x8b\x81\x40\x9b\x29\x5d\x09\xb2\xd9\x87\x87\xa7\x6f\x0e\xe5\x8f\xb2\x91\x5d\xeb\x82\xff\x72\x30\x8b\x94\x1d\xee\xb2\xc9\x46\xf3\xe6\xf8\xc8\x9d\xd8\x64\xa3\x69\x19\xe5\xad\x6c\x72\x6e\xb6\x74\x17\x62\x15\x78\x54\x09\xb6\x02\x0d\x86\x97\x19\xd4\x86\x6f\x78\x48\x96\x47\xef\xc8\x36\xc5\xb3\x20\xf5\x05\x08\x85\xae\xef\xc7\x39\x1b\xcd\x2a\xef\xac\xa1\xf5\xbb\x70\xcf\x46\x73\x97\x7f\xaa\x2c\x38\xb6\xac\x7a\x23\xfd\x3d\x1c\x3e\x2a\x40\xfe\x8a\x0c\x84\x23\xd7\xaa\xb3\x2d\xb3\xb7\x3a\x55\xa7\x9e\xef\xf1\x2b\x71\x91\xce\xfb\xc9\xe0\x6d\xf7\xfc\xe2\xb4\x7b\x87\xb8\x15\xb5\x31\xa9\xdb\xb4\xed\x85\xfe\xc3\xb3\x67\x28\xc4\x42\x3e\xa6\x71\x73\x2d\xb2\x7c\xa9\xa9\x0b\xe1\x71\x30\xca\xdf\x28\x70\x2c\x8f\xdd\x20\x92\x43\x55\x38\x8f\xf0\x48\x5a\xf3\x64\x0f\x10\x19\x5a\x31\x62\xd7\xfa\x6a\xa3\xf3\x98\x5e\x49\x88\xc6\xe3\x54\x46\x25\x88\x49\xbb\xce\x6e\xee\xa8\x18\xf2\x3b\x1f\x64\x96\xdf\x09\x8c\x1f\x93\x5c\x2a\xf2\x66\xa8\xf3\x04\xdd\xe0\xb6\xc9\x57\x73\x5f\x0d\xbb\xa3\xfe\xd9\xe0\xf4\x0e\x94\xe2\xef\xb2\xd1\xac\x41\xfd\x17\xeb\xee\x8d\xe6\x1e\xe4\x3f\x22\xea\xe5\xfc\xa1\xa8\x63\x28\xf1\x1f\x39\x22\x7e\x30\x88\x70\x5c\x3b\x6b\xaa\xd1\x74\x85\x82\x94\xdb\xdb\x32\x00\x34\x3d\xa4\x8c\xeb\xcf\x95\x31\x72\xc5\x91\xd8\xae\x76\x49\xe4\xf6\xe1\xc9\xd3\x9f\x7b\xae\x53\xac\x4d\x1c\xdc\xa5\x9b\x87\xe2\xb4\x02\x9e\xe0\xdc\x28\x01\x0b\x20\xa3\xd4\xcd\xf0\x1b\x8e\xba\x93\xc9\x2f\x75\x00\xac\xdf\xbd\x95\xee\x69\x3c\xd3\x09\xfc\x2b\x46\xd7\xc7\x55\x29\xa8\xa5\x33\x2b\x39\x9b\x28\x97\x86\x4d\x6a\xb5\x85\xa7\x20\xe7\xfa\x19\xee\xef\x21\xd1\xd5\xb0\x35\x35\x75\x89\x40\x10\xbd\x94\x24\x7f\x3c\xba\x44\xce\xbd\x42\xfb\xc0\x01\x27\xa9\x91\x7b\x7d\x15\xa5\x73\xee\x8c\x1b\x17\x95\x33\x9c\x0f\x5a\x2c\x9a\x7d\xa1\x77\xc3\x81\xd6\x48\xd7\xa2\x30\x2f\x57\x18\xd0\xb3\x74\x5c\x3a\x8b\x71\xbd\x50\x30\x53\xda\x60\x3c\x98\xd9\xc1\xd8\xd8\x8b\x4b\xc6\x52\xad\x59\x8c\xd2\x1a\x5b\xdb\x82\x68\xa9\x17\x44\x62\x3f\x8f\xd0\xea\x9c\x6e\x05\x91\x7a\x9b\x9e\x24\xd2\x37\x7d\x3e\x36\xb9\x5e\xc4\x57\x7e\x35\x5d\x51\x52\xfe\x10\x47\x73\x8d\xd7\x48\x36\x84\x12\xd7\x9f\x3b\xa3\x8b\xe1\xa8\xfb\xa6\xf7\xa1\x8a\x53\xc4\xa1\x32\x57\xe0\x5e\xf7\x55\x6f\x9e\xc3\x94\xd3\xcc\xe0\x1d\xb4\xf7\x71\x9e\xa5\xdf\x00\xde\x52\x7c\xaf\x4d\x1a\x15\x45\x5c\xbf\x29\xdc\x11\x1f\x12\xca\xe1\xa1\x7e\xaf\xfc\x19\x38\x84\xf3\x0c\x2c\x0a\x29\xf9\xf2\xf6\x03\xfc\xe3\xdd\xfe\xee\x39\x0d\x87\x1a\x8e\xba\xc3\xd1\xe0\xa4\x3b\x1e\x1f\xa2\xf0\x6a\x90\x37\x42\x25\x11\x65\x04\x9c\x3c\xf5\x77\xca\x9a\x3c\xe6\xde\x9a\x0e\x2b\xe2\x63\xb4\xa1\x32\x23\x11\x48\x65\xbe\x25\x9e\xc2\x75\x86\x75\x7b\xf4\x68\x9f\x8f\x42\x0d\xfe\xcd\x6c\x7c\xcf\x25\x7e\xc3\x29\xae\x36\xe0\x2c\xd0\x6e\xd6\xd1\x2c\xcf\x6c\x2d\x6b\xb0\x5f\x6e\xc6\xdb\x5b\xc8\x42\x60\x27\xe4\x03\xc5\xd4\x40\x71\x8d\xce\xb2\xf5\x26\x4e\xb8\xeb\x8c\xed\xc6\xb8\xd1\x39\x78\xa2\x9e\xb7\xd5\x19\x7d\x81\x3e\x59\x78\x2d\x68\xe6\x70\xd9\xd2\xbc\x1e\x9e\x9c\x88\x0e\x4a\x97\x28\xdb\xbe\x96\x90\x21\x15\x80\x62\xde\xff\xc5\xe0\xbc\xff\x0b\xee\x19\x34\x20\x21\xd9\x7b\x49\x90\x5a\xe4\x07\x20\x23\x6f\x76\x08\xd6\x7a\x29\x52\xb3\x45\x00\xb7\x06\x27\x85\x6b\x19\xe5\xbe\x23\xfd\x64\xb1\xd4\xba\x02\x2a\x94\x99\xa1\xdc\xd5\x5d\x0c\x7a\xc2\x9b\xa4\xef\x38\x95\xf9\x74\xef\xbc\x7b\xca\x5d\xca\x78\x5f\x9d\xf1\x85\xfc\x1e\x5b\xf8\x1a\x70\xab\x81\x48\x0d\xdc\xb8\xdf\x4f\xef\xfc\xa4\xff\xfe\xb4\x2e\xd5\xbb\xc5\x31\x0a\xef\xbe\xf0\x85\x02\xf0\xcd\x6b\x17\x34\x27\x41\xc4\x97\xe9\x98\x04\x2e\x0d\xd3\xbd\x29\xaf\xc6\x7c\x1d\xef\x2b\xaa\x0e\x3a\x7c\xf5\x58\x75\xe2\x2a\xd8\xfc\x81\x85\x4a\xe2\x25\x88\x79\x57\x5e\x3e\x52\x08\x22\x5b\xd5\x86\x7c\x9c\x38\x95\x56\x12\x18\x2a\xe2\xd7\xa9\x33\xd7\xb2\xb2\xa7\xd1\x0c\xb8\xee\x01\xe0\xa8\x9e\xb4\x0a\x89\xe0\x94\x95\x60\xd0\x2f\x5c\xeb\x2d\x55\xe2\xf2\x2c\xf9\xd5\x86\x9d\x09\x3a\x62\x8d\x65\xae\x4e\x1d\x38\x2e\xe3\x64\x3e\x8b\x72\xb4\xb3\xdb\x40\xf3\xca\xd3\x42\x1d\x51\x1d\xd0\x1f\xdb\x2b\xdc\x00\xf5\xc7\xf6\x6a\xb3\x21\xff\x81\xf4\xf0\xdd\x96\x5e\x11\xef\xd3\x0a\x41\x79\xf0\xad\xa4\xb1\xb6\x38\x07\x99\xbf\x52\xb9\x7b\xe1\x8e\x1d\x07\x3c\x30\xad\xf5\x20\x9a\xaa\x87\x8e\xab\x93\xf4\xee\xfe\x81\xd4\xe4\xb1\x7d\xbf\xd1\x99\xd4\x7f\x7a\x33\x7a\x02\x01\x07\x14\xf3\x38\xe4\x23\x2f\xcb\x06\xf0\x79\x7a\xca\xc2\x1c\x6a\x18\x32\xe1\x91\x3b\x33\x9b\x71\x15\x57\x94\x2f\xc9\x96\x12\x1d\x8d\x2f\x7b\x44\xbe\x22\xfc\x92\x19\x1b\xff\x19\xb2\xe3\x07\xe9\x80\xc0\x7e\xe1\x57\x73\x6b\xf7\xab\xa3\x14\x29\xd1\xd1\x0c\xbd\x24\x84\x9f\x78\x7f\x96\x11\xb4\x4f\x5e\x3d\xa1\x9b\x90\xad\xd1\xb9\x68\xde\x52\x4f\x5e\xbd\x78\x02\x31\x57\x20\x67\x6b\x4b\xe2\x69\x82\x0c\x2d\xfd\x19\x7b\x8b\x98\x01\x7b\x2b\x91\x0d\x33\xd5\xd0\x01\xd1\x52\xdd\x40\x07\x4d\xdb\x9a\xf4\xa3\x29\xcd\xcb\x35\xda\x38\xc7\x9f\x31\x09\x91\x98\x37\x82\xe7\x42\xd9\x3f\xbc\x52\x98\xce\x44\x23\xcc\xbd\x44\x3f\xfc\xc9\xfd\xe5\xa1\x08\xc5\x23\x04\x55\xbd\x72\x0c\xa6\xaa\x5c\x02\xac\x6a\x18\xb6\x15\x57\xbb\x79\xfd\x14\x6c\xbf\x81\xce\x2a\x48\xf5\xa9\xcc\xb1\x1f\x81\x93\xe4\x21\xef\x40\x5e\x52\x11\x6a\x15\x29\x61\xf8\x74\xc9\xec\x68\x2e\x77\x7a\xde\x60\x68\x56\x71\xa1\xb3\x53\xc9\xeb\xbf\x19\x54\xb9\x36\x9a\x01\x35\xec\xee\xc5\x76\xf7\x79\x40\xc1\xe9\x89\xec\x1d\xf4\x8d\xff\xde\x1b\x5e\xbc\x79\x7f\x7e\x82\x51\xf5\x17\x84\xcc\xf1\x2d\x49\x60\xdf\x14\x01\x67\x97\x6c\x56\x99\xe3\x96\x24\x5e\xc2\x29\xde\x97\xc6\xfa\x4f\x89\x9d\xf2\xc5\xb4\x52\x25\x4a\x88\x0d\x43\x71\x43\x75\x64\x8b\xf2\x1c\xd1\x23\xd1\x4c\x17\xdb\x6e\x36\x3a\xa7\x8e\x4a\x40\x7b\x8b\x43\xfa\xdc\xd8\x8c\x59\x26\x72\x27\xd7\xf1\x2c\x4b\x60\xcc\x8e\xb7\x7e\x2f\x7f\xf3\x35\xe9\xbe\x6d\xbb\xf8\xcb\x08\x21\x35\xcd\xe2\x44\xe7\x4f\x4d\xce\x1e\x40\x6f\xbe\x20\x29\xb7\x0b\xb9\x75\x64\xad\xd2\xc8\x27\x33\x2c\x2d\xc8\x6f\x78\x6c\x0d\xa0\x0e\x45\x4e\x03\x10\x53\xe4\x2b\x99\x53\xb6\x4b\xa5\x43\xf2\x83\x5b\x54\x90\x41\x93\xce\xdb\x3b\x15\x5e\xdb\xa1\x9d\x3c\x0f\x05\x96\x6d\x19\x2d\x9d\xcb\xce\xeb\x66\xc9\xfc\xd2\x1e\x25\xf0\xcc\x79\x4c\x85\xd2\x96\x82\xb6\x96\x14\x99\x76\x13\x42\x25\x3f\xc3\x38\x4b\xec\xfa\x90\x44\x05\xa7\xcc\x50\x1f\x4c\x7b\x98\x57\xb4\x9b\x17\xf4\xff\xd2\xea\xb2\x33\x27\x17\xa1\x7d\x5b\x9c\xea\xf6\x44\xa0\x08\x32\x6f\x6e\x5e\x14\xed\x33\x5f\xa8\x27\x78\xe5\x25\xba\x0d\x66\xb3\x97\x4f\xf8\x13\xa6\x7d\x27\xfd\x1d\xe4\x4d\x7d\x36\x19\xa6\x75\xc5\x3f\x14\x9c\x42\xf9\xba\x7a\x3f\xea\xbb\x9e\xec\x7e\x87\x96\x7e\x9c\x9a\xaa\xd1\x6c\x0f\x18\x9f\xd4\xb7\xa2\x88\xa6\xa2\x36\x6d\x0b\x09\x3b\xcb\x39\xdb\xec\x11\xfd\xc1\xcc\xf9\xb7\x40\x5e\xc3\x3f\xc4\xcd\x54\xb8\xdd\x29\x98\x81\x3a\x72\x81\x37\xfa\x99\xd2\xc4\xce\x07\x13\xd1\xf1\xd9\x0a\xc2\x61\x8e\xb9\x3c\xc9\xae\x49\x9d\xd9\x4a\x3b\xe3\x89\x79\xb0\x28\x6d\xd7\x2e\x4b\x4a\x0c\x5d\x92\x52\xf9\x36\x35\x33\xa2\x69\x4b\x26\x6b\x9a\x69\x51\xbe\x24\xfa\x4b\x19\x2d\xe9\x43\x80\x7c\xbb\xd1\xb0\xc8\x52\xbb\x92\x94\xba\x04\x73\xab\x64\x3a\x48\xec\x6b\x85\xa6\x87\x84\x75\xb8\xf0\x42\x15\x0b\x1c\xf1\x7c\x78\x11\x58\x50\x39\x2f\x75\x5c\x84\xbd\xad\x7d\x05\x3e\xc6\x74\xd3\x68\xce\x08\xae\x41\x6d\xa3\xf9\xe5\xc8\xa5\x9c\xd4\x00\xb9\x8d\xea\x19\xd4\xae\x38\xea\xf4\xfb\xd2\xbe\xb6\xd6\x82\x83\x48\xb0\x5b\xa2\x74\x52\x51\x91\x49\x49\xd0\x73\x37\x2d\xd2\xfc\x95\x9b\xe8\xd6\x38\x1d\xf0\x4c\x9c\xae\x34\x4a\x0f\xe6\x95\x45\x9d\x4a\xc0\x89\x1b\x37\xb1\x7a\x18\xba\x8d\x60\xe3\x4a\xed\xb5\xed\xb9\x35\xef\xdb\xd1\xe0\xfd\xf0\xf6\x13\xd2\xa4\xed\xea\x11\x9d\x5f\xc5\x38\x5d\x6f\x3b\xe4\x6c\x9b\xa3\x25\xa6\x14\xda\x7c\x53\x54\x96\x3d\xe0\x88\xa2\x4e\x84\xbb\xaf\x9a\xa2\xd5\x43\x0e\x6d\x33\xb4\x9b\xce\x18\xc6\xca\xab\x58\x14\xc9\x63\x9e\xba\xeb\x41\xdd\xda\x8d\xa6\x5b\xfd\xe0\x73\x0e\xc3\x6e\x5c\xbf\x8f\xc0\x2d\x39\x83\x15\x8d\xd2\x1f\x5c\xd2\x32\xca\x4e\xfa\x9d\xf1\xf8\xe2\xb4\xd7\x41\x8e\x53\x2d\xca\x02\x6e\x63\xe3\x34\x11\xdf\xb2\x79\x1c\x71\x96\xd3\x11\xd7\xff\xbb\x40\x8f\x99\xa2\xe8\xae\x55\xb9\x22\x8e\x04\xe9\x52\x6c\x37\xda\xfe\xc9\x2f\xc1\xd5\x6c\xaa\x43\x20\x0c\x14\x86\x07\x14\xcc\x9f\xe9\x3b\x70\xcd\x2f\x76\x42\xa7\x6c\x2e\x12\x57\xbe\xcc\xf2\x4f\xfc\xb1\x77\x9d\x9f\x11\x42\x9f\x34\x9a\xde\x8c\x14\x37\x98\x68\xdf\x94\xbb\xd4\x98\x5c\xf3\xac\x6c\xa9\x22\x46\x5f\x9b\xb8\x54\xd7\x54\xc2\x44\x8c\x0f\x8e\x82\xec\x52\xe7\x08\xb7\x50\xc1\xf6\xad\xce\xd7\x0a\x90\xab\x64\x65\x7d\x18\xe2\x7e\xb1\x87\x5d\x47\x52\x6c\x3f\x8f\x23\xdc\x7d\x41\x46\xc0\x93\xc3\xde\x5e\x10\x04\x2b\x1e\xbb\x8c\xb7\x4c\xe1\xd0\x7c\x3b\xd3\x3e\x20\xd9\x83\x5c\x68\xb4\xf9\xb4\xcd\x79\x2a\xcb\x1a\x83\xea\xb4\xd7\x71\xfe\xa5\x88\x34\x1b\x92\x84\x9e\x3a\x16\x4a\x50\x27\x9c\xf1\x7d\x74\x9a\xcf\xaf\xd1\x15\x3e\x9e\x1b\x49\x2f\x3e\x11\x6a\x36\x86\x0d\x79\x66\x34\xc7\x05\x03\x5b\x4d\xc0\xca\x6d\xcc\x21\x6b\xdb\x8d\x86\xdd\x96\x80\x93\x81\xca\xc4\xed\x48\x98\xa5\x1f\xf1\x7b\x0c\x67\xe2\x29\xaa\x49\x12\x4d\x33\xbe\x7c\x06\x8d\x04\x3e\xb5\x8a\xe7\xc1\xe3\x3c\xcf\xc6\xc8\x35\x62\x4a\x74\x4f\x79\x82\x64\x89\x11\x60\x24\x6c\xb7\xa9\x40\x8f\x87\xc4\x1a\x3d\x83\x2f\xca\x6d\x24\x42\xdd\x1b\xde\x9f\x9f\x0e\x4e\x30\xb3\xb5\x33\xe1\x81\x7a\x96\x44\x7a\xc6\x00\x95\x0e\x9d\x42\xda\x7c\x5b\x76\x2c\x35\xee\x30\x42\xae\x89\x80\x8f\x98\xba\x26\xdb\xfa\xc7\x85\x56\x09\xaa\x6c\xb5\x98\xe7\x4c\xa3\xdf\x6c\xa1\xde\x02\x3a\x9f\xe3\xdf\xaa\xb3\x54\x2f\x2f\x2f\xdb\x4b\xfe\x1b\x75\x1f\x38\x6e\xa9\x48\xd1\x6f\xd4\xe7\xb8\x40\xb7\x96\xdf\x22\xee\xe9\x92\x25\x9f\x62\x0e\xe4\x76\x26\xff\x7d\x42\x20\xed\x6f\x67\x3a\x85\x4d\xf5\x5a\x27\x89\xea\x47\x53\xae\x7f\xa4\xb0\x94\x65\x88\x31\xf7\x8e\x16\x2d\x85\xd4\x41\xb4\x66\xa4\x29\xcf\x36\x5e\xc9\x3c\x80\x8e\xc7\xb8\x3f\x1f\xec\x05\x3a\x49\x6e\x0b\xc4\x0a\x05\xb1\xe4\x06\xba\x4e\x07\x93\x8b\xf3\xf7\x67\x17\x93\x77\xa3\x6e\xe7\x74\x6c\x95\x33\x4e\xc1\xa7\xda\x03\x68\xb2\x60\xd3\x18\x94\x33\x63\xea\x10\x34\x80\xb0\x71\x63\xa4\x09\x5e\xbe\xc5\x2d\x07\x64\x11\x19\x4d\x78\x82\x13\xef\xf7\x79\x88\x3d\xe2\x95\x3c\x8f\x2e\xfc\x1c\x38\x90\x18\xe1\x5e\x1f\x27\xb9\x2f\xc5\x75\x51\xea\x75\xdb\xb2\x15\x2c\x1f\x97\x4a\x5f\x6d\x92\x78\x16\x23\x0d\x94\xba\x22\x12\x09\x42\x05\x00\x09\xf3\x68\x83\xe7\xd2\x76\x87\x43\x29\xe4\xc3\xa0\x8f\x4f\xa3\x84\x2e\xcf\x54\x97\x97\x68\xba\x7d\x32\x7c\xaf\x12\xd4\x46\x02\x93\xbc\x1b\x13\x69\x29\x36\xba\x76\xfc\xfa\xf3\x9d\xf1\xeb\xdf\xbe\xdc\x19\xbf\x7e\xc8\xf4\x75\x8b\xb7\x40\x52\x81\x25\x54\xb0\x65\x11\xfa\xdc\x2a\xdb\xd6\x8b\xe3\xb9\x6f\x92\x6b\x2a\x46\x87\x00\xa2\x2e\xa6\xc2\x76\x32\x51\x97\xfd\x52\x46\xaf\x90\xaf\xd8\x69\x71\x6a\xa7\xe2\x93\x32\x6f\xca\xfc\x40\x42\x6f\x06\xe7\x13\xb4\x57\x36\x38\x91\x99\xb9\xeb\xe8\x13\xf8\x6b\x81\xc4\x0f\x22\xa0\x42\xed\xe4\xf2\x63\xb5\x16\x1b\x24\x6c\xb7\x91\x41\x88\xd6\xe9\x5b\x23\x34\x21\xcd\x52\x32\x00\x6c\xab\x39\x6b\x51\x66\xb9\x17\x05\xe3\x44\xb5\x09\x76\x43\x9c\x53\xa7\x9f\xe3\x3c\x4b\xb9\xab\xa3\xa9\x95\x04\xeb\xa2\xda\x23\x79\x49\xf6\x4f\x6f\x94\x59\x85\xd7\x73\xe4\x40\x56\xc7\x76\xf7\xb3\xba\x77\x3a\xf9\xac\xcb\x78\x16\xdd\x17\xc9\x02\x4f\xa5\xfc\x91\xc5\xb2\xba\x7f\x79\xed\x0c\xfe\x3a\x67\x02\x33\x53\x4a\x69\x80\xfa\x42\xa3\x8e\x8a\x63\xf1\xaa\x2e\x6c\x46\x3a\xb0\xe3\x44\x7d\x85\xae\xbf\xdb\xa1\xeb\x97\xdf\xed\xd0\xf5\x8b\x7b\x13\xb6\x3d\x89\x9c\x18\x9c\xea\x05\x51\xf6\xeb\x6b\xfb\xb9\x80\x83\x94\xe0\xab\xf3\xcc\xba\x2c\x7d\x61\x4a\x87\x8b\xaa\x41\x88\x46\xd3\x42\x0d\xf0\x25\xdb\x19\x44\x2e\x04\xee\x3b\x3d\x69\x85\x5d\x1a\x77\x97\x42\x97\xac\xca\x43\xb6\xb0\x56\x80\xdd\x00\x0d\x44\xe0\xde\xc8\x72\x43\x0b\x0f\x01\xa3\xba\x20\x51\xa0\xec\xbe\x1d\x75\x86\x76\x3c\xdb\x1e\x4f\xe7\x8e\xb6\x4b\xd8\xe7\x62\x46\xca\x9d\xf0\x84\x3d\x09\x78\x38\x8f\x2e\xe5\x1a\xf0\x34\x1c\xb0\xc6\x38\xe5\x1f\x7c\x0d\xc4\xaa\x11\x38\xb0\xdf\x7f\xc6\x97\xe7\xb4\x85\x45\x26\x35\x42\x35\x9a\x3a\xc9\xb8\x5b\x34\x8b\x2f\x07\xa8\x0f\x2d\xa5\x76\xb5\x56\x01\xea\xa0\xdf\xef\xbc\x1e\x8c\x48\x67\xf9\x22\xe0\x36\x9a\x0e\xbc\x5f\x0a\xdc\x60\xba\x03\x14\x7e\x53\x07\x98\xce\x10\xe5\x3c\xf2\x60\xdf\x12\x96\x85\xa7\x8d\xcf\xd7\xe0\xcf\x73\x5d\xdb\x7c\x18\x61\x05\x52\xc1\xea\x37\x29\xa9\xee\x51\x17\x8f\x87\x89\x1a\x10\xd7\x60\x82\x5c\x0b\xe6\xcf\xe3\x3b\xa2\xc0\x21\x80\x90\x01\xdf\x43\xab\x0e\xe8\xec\x3f\xf0\xa1\xfb\x68\xa7\x0e\x8e\xb3\x9f\xfe\xde\x9f\xf5\x2f\xfa\x77\xaa\x26\xf0\x6f\xa2\xc9\x70\x08\xad\x01\x6b\x7e\x51\x52\x09\xf7\x34\x08\x63\x94\x83\xb3\xb7\xdf\x14\xea\x3d\x72\xc3\xf5\x5c\x9d\x65\x18\x55\x4e\xca\x4f\x3f\x98\x23\xf2\xc5\xd9\x11\x7b\x81\x62\x4f\xab\x54\x78\x29\xcf\x07\x37\xc0\x84\xe7\x8a\xb5\xb8\x7f\x37\x99\xb2\xa0\xfc\xb5\x2e\x57\xd9\xdc\xaf\xbc\x77\x55\xc9\x96\xe6\x53\x49\xde\x71\x3d\x45\xd7\x51\x7a\x2d\xeb\x64\xb9\x5b\x06\x4b\xe2\x4f\x78\x05\x27\xd2\x4c\x57\xeb\x08\x1a\xc5\x14\x06\x37\x4a\x15\x33\x35\x8d\x97\x6c\xe9\x99\x1c\x37\xa3\xf8\xd3\xe1\x7a\x67\x3d\xa3\xc5\xbd\xe9\x75\xfb\xa7\x20\xdb\x5c\x17\xab\x2c\x99\x2b\x6e\xfb\x6e\x82\x69\x56\x19\xa6\x29\xbc\x85\xe3\x1d\x66\x6c\x81\xd1\xae\x9c\x78\xe7\x58\x75\x1a\x2d\x35\x40\xe1\xb5\x9d\x84\xe2\x8d\x58\x41\x96\xa3\x55\x2a\x7d\x23\x74\x30\x68\x6f\x0b\xdc\xca\x46\x5f\xcd\xb4\xe6\x6c\xbb\xef\x9f\xff\x37\x3f\xca\x4d\x0b\xc0\x51\x87\xae\x40\xc4\xbd\xe7\x6d\x35\xce\x20\xfe\xd2\xc0\x84\x73\x8b\x96\x99\x7a\xf1\xbc\xa5\xb6\xd4\xfb\xe6\xc5\xf7\x02\x58\x7c\x2b\xda\x6c\x74\x94\xa3\x85\x16\xf9\x2d\x16\xbe\x1d\x60\x36\x51\xa8\x17\xdf\x1b\xbc\x96\x19\xe5\x0a\xaf\xb3\x6d\x4a\x66\x1b\x2f\xc3\x78\xf5\x13\x80\x30\x65\xe1\xf9\x41\x1a\xfa\x8b\xe7\xcf\x1f\x58\x95\xa9\xc5\xb1\x53\x65\x98\x80\x27\xdd\xb3\x21\x92\x91\x3d\x63\xf8\x86\xee\x70\xb7\x5d\x68\xdf\xbc\x07\x38\x9c\x7f\x91\x2c\x4c\x31\x69\xa4\x85\x87\x49\xaf\xa3\xb6\x29\x8d\xa6\x9d\x67\x50\x3c\xd6\x95\xae\x39\x6b\xcd\x95\x96\xcc\x0d\xe2\x87\xad\xfa\xf8\x21\xb6\x5d\xcd\xa5\xa9\x84\xdf\x1b\x4d\x07\xba\x5b\x65\xc1\x8e\x30\x86\x39\xe4\x8b\x85\x46\xb3\x5e\x1a\x8b\x1f\xcb\x17\xc2\xa2\x54\xdb\x49\xff\x55\x31\xca\x01\xbb\xc7\x13\x28\x01\x08\xf7\x0b\x14\x7e\xec\xf4\xe2\xf5\x2f\x5f\x0c\xed\x46\x73\x87\x50\xef\x07\x6d\xd6\xa4\xff\xf5\xa0\xed\xc0\x58\x0f\xed\x93\x4e\xbf\xcf\x7f\xdf\x73\xc3\xf7\x01\x0e\x63\x92\x7c\x4d\xef\xda\x07\x25\x3a\xf0\xab\x65\x92\x4d\xa3\xc4\xe5\x04\x88\x37\x9a\x05\x56\xb5\x12\x23\xac\xff\x62\x87\xd2\x6d\x45\xb4\xd4\x5f\x12\x7d\x84\xd0\x73\x17\xc0\x1c\x67\x30\xc7\xd7\x19\xe6\x3c\x45\x70\x7c\x7b\xcd\x0f\x79\x7e\xbf\x1b\x3d\x89\x33\x08\x7b\x02\x09\xc8\x38\xf8\x46\xd3\xee\x9a\xbb\x05\xb8\xf9\xf4\x1f\xf1\x12\xbd\x23\xdd\x82\xdb\xea\x34\x2e\x78\xef\x91\xb7\x26\x1b\xbc\xf0\x60\xce\x90\x5d\x9b\xc4\xc5\xca\x08\x2d\x4c\xa4\xb0\x44\x22\x3d\x05\x3e\xc2\xf5\x69\x17\x7f\x2c\x76\xe7\x21\xfc\x06\x0d\x06\x4f\x75\x47\x5f\x4e\x18\x3a\xff\xaf\x40\x1a\x3a\xff\x22\xe2\xd0\xf9\x2d\xe4\xa1\xf3\xfb\x11\x88\xce\x1f\x9d\x44\x2c\xf2\xf7\x92\x08\xd1\x46\xef\xa4\xd3\xbf\x78\xd7\xeb\x8e\x20\xf5\x7e\x39\x8c\x52\x70\x72\xa4\x03\xc1\x93\x1d\xeb\x1c\x41\x85\x6b\xc2\x53\xe2\x82\xc0\x5e\x82\x5d\xa4\x4a\x7d\x55\xa2\x2b\x4d\x96\xea\x47\x63\x97\x75\xa7\xa9\x61\x97\xb6\x30\xf8\x0e\x57\x43\x54\x1e\xff\x46\x40\x22\x47\x2c\xbc\x11\xb3\x59\x45\x5e\xa3\x2d\xf9\x35\x1e\x22\x1b\xc8\x82\x4c\x5d\x46\xd7\x12\x17\xf2\x6e\x97\xd3\xa5\x60\x1d\xd8\x46\x35\xb6\x1a\xc5\x26\x50\xd7\x28\x5d\xb4\x2f\x4e\x4b\x60\xc7\xab\xfb\xfc\xa3\x81\xbb\x0a\x47\x55\x91\x4e\xe2\x3f\xec\x9d\x75\xde\x76\x2f\xde\x0c\x46\x67\x9d\xc9\x4d\x3e\x44\xea\x8b\xeb\x72\x85\xdc\xef\x8a\xa0\xf3\xdb\x14\x8d\x63\x4a\x93\xa8\x44\xb3\xb0\x37\x49\xc4\x83\x98\xb3\xc5\xce\x42\xd8\x33\xdb\x30\x26\xf8\xe1\x95\xc4\xf1\x03\x75\x81\x3b\xf9\xbe\x8d\xf9\x1c\x1d\x1e\xc1\x39\x76\xa9\x38\xec\xfa\x9b\xad\xb2\xac\xd0\xaa\xf8\xbc\x54\xd7\x9e\xf3\x1a\xd0\x44\xb8\xd7\xb4\x67\x70\x2d\x81\xca\x4c\x5d\xad\xca\x35\x7a\xc5\x99\x29\x22\x8d\x66\x60\x8d\x8d\x7f\x7e\xcb\x5e\xf5\xcf\xb1\x19\x1c\x17\xa7\xaa\xd7\x55\x7f\xfd\x93\x3a\x32\x3a\x0a\x4d\x49\xf0\x3a\x41\x52\xc0\x87\x93\x45\x19\xcb\x50\x61\x8e\xf7\x4e\x9f\xdb\xa4\xcb\x96\xfa\x75\xb3\x6c\xa9\x65\xbc\x68\x61\xe7\x2d\xb5\x49\x97\x3f\x2c\xe7\xf2\x6f\xfb\x9f\xb3\x28\xce\x33\x98\x57\xf6\x87\xf0\x4f\xe6\xff\xfd\x5f\x2c\xe7\xf1\x26\xd9\x16\xb2\x12\xfd\xc0\x46\x87\xf7\x1b\xf9\xf7\x7e\xfa\xdd\xa4\xf7\xf6\x76\x06\xb4\x29\xf4\xbb\x49\x97\xcc\x2e\x76\x1e\x71\x6b\x10\x48\x6c\x12\xae\xc8\x33\x26\x6d\xf7\x1d\x4e\x04\x32\xf2\x88\x89\x98\xe9\x8b\xe6\xff\x45\x33\xca\x1f\x03\x4a\xb9\x2f\x34\xf1\x3a\x8a\x11\xab\xdf\xb2\x6c\x2d\xb5\xf9\x9b\x88\xda\x6e\x55\xa5\x2a\x90\xea\x0e\x1e\x29\xcc\x40\xc8\x53\x21\x00\x66\x46\x14\x97\xea\xe1\x73\xa9\x2e\x55\xf7\x6a\x93\x64\x39\x15\x3e\x6a\x4e\x89\xc1\x07\x10\xf5\xa7\x8f\xe5\x5a\xbd\x89\x73\xbd\xc8\xae\x5a\xea\x64\x95\x67\x6b\xdd\x52\xe3\x68\x11\xe5\xb1\xf1\xf5\x0d\x90\x41\xed\x68\x1c\xf7\xd0\x10\xdf\x17\xd1\xb6\x50\x76\xa3\x59\x4f\xdb\x6d\x35\x48\xf0\x18\x4f\x2a\x21\x81\xda\xeb\x06\x84\x0d\xe0\x71\xaf\xd3\xc7\x12\xa7\xbd\xf3\x49\x77\xd4\x39\x99\xf4\x7e\xee\x5e\xe0\x73\x7b\xe2\x9c\xb7\xd5\x90\x54\x3c\xf9\x01\x87\xb1\x7a\x04\xcf\x03\xaf\x94\x3d\xc4\x65\x10\xe2\xdf\x7d\x33\x4c\x88\xc6\x57\xee\x7b\x39\xf6\xa5\x06\xf0\x69\x89\x6b\x9d\xf6\x46\x07\x67\x99\xde\x50\x35\x53\x09\x14\x06\x73\xa0\x6a\xf9\xf3\x91\xb0\xf5\x8f\xf3\xac\xe4\x89\xb9\xac\x63\x1d\xdf\xf3\xdc\xee\x5c\x35\xe7\x3e\x1b\x9f\x3c\xe4\xb9\xd7\xc5\xec\x4b\xcf\xbd\x2e\x66\x3b\xe7\x6e\x04\xdb\xab\xd9\xfe\x69\xaf\xf3\x90\xdb\x47\xe2\xc9\x97\xa2\x2d\x8e\x76\xb7\x1f\x6c\xaf\xb2\x7d\x8a\x3d\xf3\x7c\xa2\x24\x4a\xcb\xed\x3a\xe1\x9a\xf0\x7e\xe7\x7c\x02\x9f\xd8\xdf\x3a\x23\x77\x03\x5d\x22\x72\xf5\x6c\x5c\x5f\xe5\xae\xe1\xaf\xd1\xe7\xc8\x45\xd4\xb0\xa4\x7c\xa0\xfd\x6b\x94\x13\x7e\x6e\xbe\x8e\x90\xa2\x78\xe3\xbd\x69\x54\x04\xc6\x44\x1f\x85\x45\x04\x53\x61\xae\xe6\x5b\x2a\x5d\x8e\xbc\xe4\x7f\xfc\x5c\x94\x7a\xb3\x93\x7a\x24\xaa\x8e\x8a\xd4\x65\x94\x83\xe9\x83\x5d\xa0\xd3\x9e\xd2\xe9\x0c\xae\x49\x48\xf6\x48\x7d\xa4\x86\x8e\xdb\xb5\x9d\x35\x67\xf3\x3c\x60\x19\xb1\x40\xb5\x43\x59\xec\xaa\x4e\xfb\x61\x4d\x91\x3c\xf5\xa8\xed\xde\x81\xe3\x5d\x80\x7f\xf2\xe6\x2d\xf1\xfa\x9b\xa8\x2a\xaa\x6d\xe2\x4c\x5b\xb1\x10\x6f\x34\x76\x97\x3c\x60\x1f\x2e\x06\x0b\xce\x27\xee\x3a\xae\x40\xc4\x07\xf0\x25\x3f\x54\x4b\x01\x7a\x00\xe0\xdf\x98\x62\x51\x4c\x55\xd2\x78\x73\xc0\x31\xb2\xeb\xab\x69\x92\xcd\x3e\xf9\xf0\x61\xb7\x0c\xc3\xc8\xdd\xad\xc1\xc4\x58\x14\x17\x67\x9d\x0f\x17\xe7\x83\xd3\x6e\xfd\x15\x63\x75\x57\xdc\xc4\xce\x0b\x4f\x2e\x7f\x69\xcd\x24\x46\xad\xc4\x14\x5c\x28\x40\x02\x09\xd5\x37\x3d\x3b\x83\xe3\x04\x45\x25\x35\x85\x88\x83\x44\x62\x25\xae\x53\xe6\xdb\x74\x26\xed\x28\x68\x05\x49\x98\x88\x0b\x9b\x87\x24\xa3\xf6\x40\xc1\xba\xd0\x29\x65\x4e\x44\xf4\x6d\x64\xec\xa3\x3d\xd3\x5c\x4d\xb3\x2b\xdf\xf5\x2f\x5f\x89\xab\x3b\x36\xb2\xa0\xd1\x54\xb3\x55\x9c\xcc\x73\x6d\x35\xee\x3c\xcb\x4a\xb3\xa6\x77\x1c\x5c\xb5\x04\xe9\xd6\xd7\xfe\x79\x1a\xcd\x7a\x98\x8b\x4f\x9b\x0e\xe2\xc6\x12\x59\x60\x82\x53\x25\x49\x5b\x75\x90\xec\x98\xca\x5e\x1b\xcd\xa0\x23\x91\x7c\x9a\xb1\xb7\xd8\xe6\xa4\x4a\xe5\x1a\x5d\x08\x66\x6c\x88\x00\xd1\x6e\x0b\xa7\xdd\xe1\xe4\xdd\xa1\x31\x81\x9a\xa8\xc0\xf7\xf7\x4e\x70\xa8\x82\x02\xd7\x46\x7d\xff\x5c\x28\xb4\x66\xbb\x87\x50\xe8\x5c\x6f\xe0\x6b\x5e\x38\xa0\xd6\x5b\x64\x1d\x7e\xd2\x76\xe2\xfa\x96\x7d\x20\x00\xaf\xf1\xb2\x00\xb1\x88\x13\x47\xb3\x55\x90\x3b\x07\xbb\x04\x88\x9f\x5e\x73\x91\x06\x73\x4b\x30\x69\x54\xf8\x45\xa5\xf1\xf9\x7c\xab\xf4\x5c\xb2\x92\x2d\x42\x41\x70\x73\x81\x53\x42\x71\x32\x41\x97\xcd\xcd\x73\x64\x25\xaf\x72\x1d\xa2\x4f\xad\x2e\xc9\xc7\x69\xf4\x88\x27\xc1\x66\xcc\xd5\x4b\x0a\x27\x2d\x51\x2c\x90\xe0\x16\xd8\x7c\x50\xb8\x7d\xb6\x2c\xe0\xc8\x61\x05\x25\x98\xd9\x0e\x91\x2b\xd5\xa5\x51\xe2\x6e\x61\xa8\xae\xd1\x74\x74\x77\x67\xaa\xab\x27\x7b\x99\x7c\x19\x39\x74\x3d\x67\xf0\xa7\x19\xff\x4e\x96\x41\x66\xea\xa1\x64\xba\x43\xa5\xf7\x22\xd2\x3a\x0a\x74\xe9\x65\x63\x26\x3c\x3c\x31\x19\x75\xce\xc7\xc3\xce\xa8\x7b\x1e\x66\x68\x7a\x8d\x6e\xec\x20\x1d\x2a\x63\x2b\xf3\x28\x2d\x30\xdc\x90\xf2\x21\xa7\xd1\xec\x13\x22\xed\xe9\xdc\xf5\x02\x91\x0c\x66\xdc\x5c\x3e\x54\x0b\x9c\x32\xe2\x5c\x65\x78\x6f\xfe\x11\xa7\x73\x64\xe7\xba\xae\xc9\x5a\xa3\x01\x65\x99\xd9\x71\x0a\x86\x3a\xb6\xd6\x47\x01\x96\x47\x56\xd9\x3f\x8c\xb4\xfe\x41\x9d\x92\x5b\x07\xd8\xe0\xb4\x43\x94\xc8\xc1\xb1\x41\x42\xa0\x55\xef\x0a\x05\x71\x25\xf0\x97\x91\xc5\x38\x8d\xe6\xc9\xb5\x8a\xd2\x32\x7e\x1a\x25\x71\x04\xd9\x91\x44\x53\x9d\x14\xb2\xa4\xb9\x07\x1e\xc9\x1c\xd1\x28\x84\x72\xa5\xaf\x25\x4a\xbc\x8a\x72\x5e\x0d\xac\xf3\xf8\xb1\x6c\xa3\x2a\xb6\x2a\xb6\x91\x8f\xd4\xb3\xf7\xfd\x49\xef\x62\xd2\x19\xbd\xed\x4e\xc6\x15\xb4\x1a\x93\x97\x53\xad\x2c\x8e\xed\x78\x28\xe9\x71\xc5\x82\x1c\xae\x91\x94\x86\x46\xf1\xc1\xed\x83\x4f\x33\xa8\x3e\xea\xe9\x84\x01\x6b\x21\x26\x5a\x12\x2a\x25\x8f\x2b\x53\x27\xf1\x59\xac\xb5\x88\x8a\x52\xe7\xa6\x7d\xb0\x49\x5c\x37\x6c\x4b\x5f\x56\x4c\x50\xbc\x70\xf4\xd3\x8b\xf6\x5f\xda\x2f\x9e\x1f\x0b\x69\x80\x4e\x56\x71\xd1\xda\x69\x48\x55\x43\x79\x8f\x89\x8d\x10\xcc\x01\x36\x58\x75\xb0\xd5\x44\xfd\xee\xdb\xee\x79\x4d\x4b\xaf\x50\x3f\xb0\xe8\x88\x54\xa2\x97\x3a\x85\x96\xb5\x84\x16\x8d\x84\x58\x2f\x77\x51\xa6\x74\xf3\xcd\x40\x12\x50\xb6\x2d\xa0\x14\x70\x44\x38\xca\xe1\x98\x70\xb6\x81\xd3\x4b\xe7\x92\xc2\xf4\x78\x0e\xcb\xea\xa1\xab\x0e\x4b\x86\x0d\x20\x78\xd2\xef\x76\xce\xdf\x0f\x6f\x4d\x88\xe1\xba\x5d\x1c\x86\xdc\x39\x6b\x8c\x91\x2b\xe9\x64\x8d\x66\xd5\x34\x12\xf9\x6a\xa1\xe9\xc3\xe8\x91\xcf\xee\x1f\xca\xfb\xe7\x95\xfa\xa5\x3b\x6e\xfc\xe7\x00\xae\xce\xe8\xd9\x5a\xab\x01\x00")
 
 func dataJavaDoxyfileBytes() ([]byte, error) {
 	return bindataRead(
@@ -113,7 +141,147 @@ func dataJavaDoxyfile() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "data/java.doxyfile", size: 109398, mode: os.FileMode(420), modTime: time.Unix(1698879908, 0)}
+	info := bindataFileInfo{name: "data/java.doxyfile", size: 109402, mode: os.FileMode(436), modTime: time.Unix(1792283723, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _dataPartialsHtml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x58\x4d\x8f\xdb\x36\x13\xbe\xfb\x57\x10\x42\x0e\x09\x10\x5b\xbb\x1b\xe0\x3d\x04\x5c\x01\x79\xb3\x49\x11\x74\x5b\x04\x49\xda\x3b\x2d\x8d\x2d\x36\x34\x25\x50\xf4\x66\x0d\x41\xff\xbd\x18\x7e\x89\xfa\x70\xec\xdd\x26\x41\x81\xe6\x24\x89\xe4\xcc\x3c\xcf\x70\x38\x33\x54\xdb\x92\x02\x36\x5c\x02\x49\x72\xc1\x9a\x26\x21\x5d\xb7\xa0\xe5\x25\xe1\xc5\x75\xd2\xb6\x64\xf5\x01\x36\xa4\xeb\x92\x0c\xdf\x7f\xe5\xb2\xb8\x65\x6b\x10\xa4\xeb\x08\x0e\xfc\xce\x76\x40\xba\xae\x6d\x89\x86\x5d\x2d\x98\x06\x92\xac\x59\xb1\x85\x26\x21\xab\xf1\x44\x53\xed\x55\x0e\x76\x82\xa6\xe5\x65\xb6\x18\x4c\x17\x50\x2b\xc8\x99\xe6\x95\xb4\x6b\x16\xb4\xce\xd0\x40\x53\xb3\x1c\x5e\x06\x7b\xe6\xd3\xa8\xa8\x8d\x86\x2f\x5c\x97\x64\x75\xcb\xe4\x76\xcf\xb6\x88\x86\xd6\x99\xff\xb2\x52\x6e\x71\xdb\x12\x90\x05\xf2\x1b\xd9\x6d\x72\xc5\xeb\xc8\x6e\x50\xfa\x7f\xd6\x40\x83\xd2\x75\xf6\xe6\x5e\x83\x2c\x9a\x97\x64\x20\x6b\x5c\xb6\x14\x5c\x7e\x6e\x92\x79\x3b\x16\xdd\x3b\xa9\x41\x6d\x58\xee\xb5\xbd\xdb\xd5\x02\x76\x20\xf5\x63\x15\xde\x80\xe2\x77\x80\x63\xb4\xce\xfc\x87\x11\x86\x87\xab\xec\xd7\xc2\x3d\x43\x64\xb8\xf0\x8d\x7b\x45\x7f\xe1\x22\xbe\x21\xab\xf7\xaa\xaa\x41\x69\x6e\x68\x2c\x68\x79\x95\xf5\x23\x34\x2d\xaf\xb2\x05\xd5\x6c\x2d\x20\x5b\x10\x42\x75\x09\xac\xc8\xa8\x56\x19\xd5\xa5\xd9\x48\x9a\xea\xd2\x7c\x7c\x3a\xd4\xfd\xc7\x4d\xef\x7f\x3b\x96\xa2\x48\x6a\xc5\x8d\xa2\x75\x55\x1c\x50\x25\x41\x62\x8a\xc9\x2d\x8c\xa0\x0c\x38\xd4\x16\xd2\x21\x44\xa0\x23\x4a\x08\x4d\x9d\x2a\x9a\x3a\x98\xfd\xac\xa7\xf8\x27\x13\xfb\x9e\x9e\xfd\x7a\x08\x35\x23\xf1\x0f\xb9\x05\x0c\x03\x5e\x77\x38\xfa\x70\x52\x4e\xe7\xeb\x4a\x36\x5a\xed\x73\x5d\xa9\x89\xe6\xbc\x9f\x1b\xeb\xef\x15\xfc\x06\xba\xac\x8a\x89\xec\xce\x0c\x1f\x17\x7b\x27\x4b\x50\x5c\x43\x31\x16\xe4\x7e\x62\x46\xd6\xbd\x2d\xa2\xe4\x24\xfd\xc9\x9f\x24\xa8\x57\x32\x2f\x2b\x15\x72\x94\x4b\x49\x24\x08\x84\x54\xf3\x5d\x12\x05\xc6\x8c\xf1\x2d\x93\x3a\x84\x4d\x18\xf8\xc1\x87\x22\x06\xf2\x4d\xcf\x84\xe2\xc8\x20\x3a\x16\x6e\xe0\x07\xf3\x8b\x81\x7c\x23\x7e\x4e\xf3\xdb\xbd\xcc\x11\xcc\x44\xf3\xc6\x4d\x8c\x35\xcf\x47\xa9\xaf\x7d\x26\x74\x96\xc6\x79\x37\xae\xac\x99\x13\x40\x68\x53\x33\x49\x4c\x4a\xbe\x4e\x58\x71\xbf\x34\x12\x04\xdf\x7c\xfd\x83\x22\xc9\xfa\x77\x9a\xa2\x84\x0f\xc9\xa5\x53\x6c\x43\xf9\xa3\x66\x6b\x2e\xb8\x3e\x9c\xd0\xec\x42\xdb\xd6\x70\x13\xe3\x5f\xd1\xc9\x65\x0e\x27\xf4\x35\xb8\x26\xc9\xcc\x83\x7c\x45\xe7\x9c\x87\x7c\x13\x30\x34\x6a\x06\xff\xf8\x70\x6b\x0c\xb3\xd8\xaa\x5f\x5f\x2a\xd8\xd8\x86\xc4\x30\xb1\xc3\x34\x65\xa7\x2d\x7a\x5f\x9a\x4d\x1c\x98\x1d\x6c\x8d\x8d\x75\x09\x64\x45\x12\xad\x30\xd3\x62\x6d\x8d\xa1\xf4\x9b\x92\x64\xbd\xe8\x7c\xe6\x38\x89\x6a\x50\x95\x1d\x2a\x1b\x8b\x4f\xb8\x2c\xe0\xfe\x39\x79\x02\xb6\x45\x20\x2f\xaf\x7d\xec\xf1\x8d\x9b\x25\x5d\xf7\x9c\x04\xc5\x18\xc6\xd0\x54\xe2\x0e\xc8\x53\xa3\xf7\x96\xcb\xcf\x41\xfe\xd9\x39\x70\xa2\x6c\xec\xce\x78\x9f\xb8\x37\xaa\xda\x91\x79\x1b\xab\xb7\xaa\xda\xa1\x01\x9b\x08\x42\x92\x1d\x54\x67\x5a\x47\x6d\x82\x71\xd7\x5f\x15\x97\xe8\xe7\xe7\x24\x99\x4b\xb9\x56\x47\x5f\x70\x68\x9d\xb9\x8f\x73\xa4\x67\xd8\x95\x1c\x14\x53\x79\x79\xb0\xec\xf6\x22\x5b\xc4\x69\x05\xb5\x08\x9e\x1d\xa1\xf8\x1a\x5f\x9d\x13\x2d\xb2\xd7\x25\x17\x85\x02\x39\xce\x15\x91\x99\x41\xb2\xa0\xa9\xe0\x11\x46\x9a\x22\x80\x79\xa4\x83\x1a\x33\x6c\xf8\x34\xe3\xc2\xa5\xa7\x00\x53\xf1\xbc\x24\x2b\xbf\xc3\xa2\x71\x55\x0d\x23\x32\xca\xb0\x67\x7a\xa9\x4f\xa2\x08\x52\xab\xb9\x0a\x8b\x19\x55\x17\x71\xa1\x7d\x4c\xef\xaf\x5d\x8e\x2f\x62\x9f\xaf\xb0\x40\x4c\xa7\x1f\x4a\x7f\xca\x3d\xb0\x1d\x20\x1a\xa4\x85\x1e\x96\x29\x44\x47\x1c\xe4\x3a\xb0\x87\x78\x67\x44\xc6\xb6\x76\x33\xe3\xe3\xdd\x3a\x05\x65\xd0\xb2\x21\xa0\xf2\x6a\x0e\x50\xd4\xf5\xc5\xf7\xb5\xa7\xa7\xd2\xcd\x7b\xa6\xd8\x0e\x34\xa8\xe6\x54\xe2\xf1\x62\x5e\xb5\x9b\xc3\x4c\xf3\xec\x31\xa1\xe1\xd2\xc8\x57\xf6\xe9\x8c\xa6\xac\x9f\xae\x03\x91\x64\xc4\x6a\xb8\x4e\x97\xaa\xfa\x82\xe8\x3e\x99\x97\x73\x2f\x46\xf3\x9b\xe3\x7b\xe2\xe3\xfb\x62\xd3\x99\x6b\xaf\x3e\x80\xde\x2b\xd9\xf8\xf8\x8f\x53\xed\x78\x2e\xd8\xfb\xb9\x9b\xd3\xdd\xe4\x1b\x22\x2b\xdd\xfb\xec\xe3\x67\x5e\x8f\xe1\x29\xeb\xcf\x24\xac\x8a\x9d\xfa\x1d\x43\xa2\x6f\x23\x8f\x07\x85\xef\x41\x7f\x86\xc5\x7f\x26\x2c\x62\xe4\x01\xad\xe1\x4f\xcb\x17\x59\x4f\x85\xa6\xe5\x8b\x1f\x78\xcf\x42\x00\xf8\xbb\x05\x7f\xde\x98\xa9\xbe\x50\xf9\xdf\x7f\xbe\x80\x45\x73\x21\x3a\x5d\x54\xce\x2c\x99\x74\x25\x7e\x85\xad\x75\x0e\xc5\x59\x97\xb7\x79\x87\x86\x8d\x74\x2e\x74\xdb\x79\xc2\x7f\x8f\x76\xd9\xd4\x41\x67\x38\x21\x2c\x39\xe5\x8c\x53\x77\x57\x4f\xda\x87\xe4\x34\x82\x6c\x35\xfb\x5e\xec\xcf\x09\x98\x7f\x43\x50\xf4\x47\x72\xea\x21\x7f\x46\xad\x8f\x86\x8c\x42\xf7\xf9\x89\x6b\x01\x93\xfb\xa0\x53\xbb\xd4\x38\x1b\x5d\xae\xe3\x4e\x9b\xd6\x0a\x62\x99\xbc\x2a\x20\xc9\x28\x3e\xe6\xfe\x48\xf9\xa5\xc2\x0d\x2d\xdb\x96\x88\xea\x0b\x28\xa3\x3a\x09\x7a\xd1\x42\xc9\xb7\xa5\xe0\xdb\x52\xe3\x0f\xa8\x02\x86\x7f\xb6\x52\xb4\x90\xd1\xb4\x56\x47\xfc\x33\xef\xa9\x60\x77\xc3\x85\x06\x15\x39\x6c\xab\xc9\x53\x01\x12\xbb\xed\x4b\x1c\xa5\x0d\x08\xc8\xb5\xa9\x63\x48\xcc\x4b\xda\x26\xb8\xb2\x9b\x69\x1a\xe6\xeb\x24\xc9\x5e\x09\x41\xfc\x8a\x86\xa6\x76\x7a\x7a\x09\x73\xe3\xc1\x93\xfd\xb7\xc3\x4a\x53\x6b\xf6\xf4\x9e\x6f\xaa\xca\x13\xa0\xf6\xdd\x99\x33\x3e\xbf\x63\x2a\x5e\xe2\x0c\xf6\x97\x88\x5f\x40\x82\xc2\xdb\x3d\x59\x1f\xf0\x17\x0a\xe6\xc6\x3b\x50\x0d\x92\xea\x3a\x52\x49\x1c\x58\xef\xb9\x28\x6e\x98\x86\xd5\xdb\x4a\xed\x98\x26\xc9\xd5\xc5\xc5\xff\x96\x17\x97\xcb\x8b\x2b\xa7\xd5\x01\xa3\xa9\x87\xd0\xb6\x04\x64\x41\xba\x6e\xf1\xf7\x00\xf3\x9c\xbc\x2a\x79\x19\x00\x00")
+
+func dataPartialsHtmlBytes() ([]byte, error) {
+	return bindataRead(
+		_dataPartialsHtml,
+		"data/partials.html",
+	)
+}
+
+func dataPartialsHtml() (*asset, error) {
+	bytes, err := dataPartialsHtmlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "data/partials.html", size: 6521, mode: os.FileMode(420), modTime: time.Unix(1792286819, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _dataSearchJs = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x94\x54\x4d\x6f\xe3\x36\x14\xbc\xfb\x57\x4c\x78\x58\x50\x48\x56\x09\x0a\xf4\xe4\xa8\xc0\x36\x5d\xa0\x0b\xa4\xed\x61\xf7\x66\xf8\xc0\x88\x4f\x16\x5d\x9a\x72\x49\x2a\xb6\xd1\xf5\x7f\x2f\x48\x89\xfa\x48\x85\x6d\x17\x01\x62\x89\x9c\x37\x43\xce\xbc\x27\x5e\xb5\xa6\xf4\xaa\x31\xe0\x19\xfe\x5e\x01\xaf\xc2\x42\x99\x63\xeb\x51\x40\x36\x65\x7b\x20\xe3\xf3\x1d\xf9\x8f\x9a\xc2\xe3\xcf\x97\x4f\x92\x33\x21\xcf\xef\x1d\x09\x5b\xd6\x2c\x5b\xf7\x45\x96\x5c\xab\xbd\xfb\x7f\x65\xef\x7b\xf4\x58\xae\x8c\xa4\x33\x0a\x9c\x94\x91\xcd\x29\x17\xf2\xfc\x39\x0a\x7c\x0a\xeb\x09\xa4\x85\xd9\xb5\x62\x47\xff\x25\x92\x70\x2c\x5b\xaf\x56\xc0\xfd\x3d\xbe\xd4\x34\x56\x57\x4a\x7b\xb2\xa8\x95\x24\x07\x5f\x13\x4a\x2d\x9c\x23\x87\xa6\x8a\xaf\x8d\xaf\x69\x14\x73\x2b\x40\x55\xe0\xe9\xbd\xf3\x09\xc3\x7e\x2e\xa4\xfc\xf8\x4a\xc6\x3f\x2b\xe7\xc9\x90\xe5\xac\xac\x85\xd9\x11\xbb\xc3\x5b\x77\xc3\x5f\xb8\x08\x75\x27\x9e\xb9\xf5\x57\x4b\xf6\xf2\x99\x34\x95\xbe\xb1\x1f\xb4\xe6\x6c\x23\x85\x17\xc3\x65\xb6\xe1\x36\x81\x00\xa8\x1a\x0b\x1e\x78\x14\x0a\x3c\xac\xa1\xf0\x38\x50\xe6\x9a\xcc\xce\xd7\x6b\xa8\xdb\xdb\x51\x74\xf4\x0f\xc5\x00\xdd\xa8\x6d\x88\xf6\x83\xf7\x56\xbd\xb4\x9e\x38\x9b\x09\x8e\x7a\x98\x95\xd4\x4a\x4a\x32\x28\x46\x07\x5e\x85\x6e\x09\x37\x45\x01\xc6\xf0\xee\x5d\xdc\x88\xaf\x73\x44\xa2\xbb\xf6\xbf\xc1\xd5\xd8\x6b\xd3\x73\xc6\x85\x5c\x2a\x77\x14\xbe\xac\xa3\xb1\xdc\xd0\x09\xdd\x13\x8b\xdb\x2c\x1b\x8e\xd6\x71\x5d\xe3\xfb\xb5\x4f\xea\x26\x82\xf0\xf5\x2b\x6e\x52\x57\x86\xe7\xd8\x62\x49\xca\x92\x6f\xad\xe9\xaa\x56\x18\x83\x52\x9e\x0e\x9c\x8c\xb7\x97\x84\x8c\xc6\xa9\x69\x52\xa5\x25\xe1\xa9\xef\x3a\xce\xb4\x4a\x4e\x05\xa8\xf8\x06\x52\x24\xa0\xc8\x6b\x4b\x55\x88\x22\x28\xe5\xad\xd5\x69\xdd\xd3\xd9\x3f\x35\xc6\x93\xf1\xc3\xb6\x11\x87\xde\x3b\xad\x72\x71\x3c\x92\x91\x4f\xb5\xd2\x92\x8b\x89\xae\x32\x55\xf3\x0d\x69\x77\x14\x26\xa9\x07\xe8\x1b\x21\x06\x86\xdb\x5e\xee\x4f\x65\x24\x6e\xc1\xa0\xcc\x64\xb5\x0c\xd8\xb3\x5f\x3c\x47\x20\xec\xb9\x3b\x5f\xa1\xd5\x60\x6d\x0c\x63\x61\x48\xe2\xfa\xe2\x8c\x04\x1b\xe3\x34\xa0\xe8\xdb\x21\x36\x58\xee\xad\x3a\xf0\x2c\xf7\xcd\x73\x73\x22\xfb\x24\x1c\xf1\x41\x35\xc6\x9c\x2b\x63\xc8\xfe\xfa\xe5\xb7\xe7\x70\x25\xd6\xdf\xb6\x02\x8f\x6c\xfd\x64\xe0\x11\x3f\x24\xa5\x69\x1f\xa4\x5e\xba\xbf\xc7\xef\xe2\x40\x38\x84\xfe\x23\x87\x5d\x83\x4a\x59\xe7\xef\xc2\xb7\xc1\x84\x7f\x90\xe4\x4a\xab\x8e\x71\xb2\x7b\xd8\x70\xf0\x97\x4b\xac\x2e\xb0\xd9\x8e\xe1\xbc\x5c\x7e\x99\x94\x8c\x7b\x4b\x83\x1c\xdb\x74\x79\x8a\x03\x55\xcc\x28\x1a\x23\xe9\xbc\x51\x3d\xd1\xfc\x13\x95\x66\x70\x71\x38\x23\x41\x3e\x40\xff\x3d\xa6\xa3\x1e\x10\x52\x57\x66\x79\x74\xc7\xe6\x9c\x47\x92\xc7\x1b\xfc\x51\x75\xae\x67\xf8\xa9\xc0\xc3\x94\xb3\x73\x28\x3f\xb6\xae\xee\x27\x6d\x60\x07\x69\x47\x13\xf2\x89\xd1\xdf\xab\x31\x31\x7c\x51\x6a\x92\x78\xb0\xb5\x6a\x5a\x23\x51\xa4\xc3\x95\x8d\x29\x85\xe7\x33\x9a\x2c\x77\x5a\x95\xc4\x1f\xee\xf0\xe3\x43\xf6\x26\xc0\x7d\x17\xe0\x1e\x8f\x1d\xd5\x10\xe0\x7e\x1a\x60\xea\xd3\xd9\xf0\x84\x2f\x4e\xac\xd9\xec\xb7\xe9\xc3\x16\x7c\xbe\x66\xeb\xd5\x35\xe3\xd9\x7a\xf5\xcf\x00\xdd\x74\x51\xef\xa6\x07\x00\x00")
+
+func dataSearchJsBytes() ([]byte, error) {
+	return bindataRead(
+		_dataSearchJs,
+		"data/search.js",
+	)
+}
+
+func dataSearchJs() (*asset, error) {
+	bytes, err := dataSearchJsBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "data/search.js", size: 1958, mode: os.FileMode(420), modTime: time.Unix(1792278346, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _dataSiteClassHtml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x64\x8f\x3d\xaa\xc3\x30\x10\x84\x7b\x9f\x62\x50\xff\xe4\x0b\xe8\xb9\x49\x1f\x52\xe4\x02\x8b\x3d\xfe\x01\xc9\x36\xb6\x08\x01\xb1\x77\x0f\x52\x48\x48\xe2\x6e\x19\x76\xbe\xfd\x36\x25\x44\x86\xd5\x4b\x24\xcc\x2a\x03\xff\x46\x4a\xc7\xcd\xc0\x9e\xbc\xec\xbb\x3d\x4b\x20\x54\x2b\x00\x70\xb3\xdc\x9a\x32\x01\x4e\x30\x6e\xec\xff\xcd\x34\x77\xbc\xdb\x31\x06\x6f\x9a\x94\x60\xaf\x53\xf4\xb9\xe1\x6a\x69\x50\xff\x6e\xa7\x84\x59\x02\xf7\x55\x5a\x5e\x64\xe0\xe7\x99\x12\x42\xf5\xc9\x39\xe6\x99\x58\x78\xae\x7e\x8b\x7c\xf9\xb7\xb9\xf2\x32\xcf\xd2\xc7\xef\xfa\x65\x89\xdc\x0c\x54\xab\xc7\x00\x9d\x9b\x89\xf9\xfb\x00\x00\x00")
+
+func dataSiteClassHtmlBytes() ([]byte, error) {
+	return bindataRead(
+		_dataSiteClassHtml,
+		"data/site/class.html",
+	)
+}
+
+func dataSiteClassHtml() (*asset, error) {
+	bytes, err := dataSiteClassHtmlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "data/site/class.html", size: 251, mode: os.FileMode(420), modTime: time.Unix(1792278049, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _dataSiteIndexHtml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x6c\x92\xcd\xea\xdb\x30\x10\xc4\xef\xff\xa7\x18\x44\x0e\x09\x24\x36\xc9\xb1\x38\xbe\x04\x4a\x0f\x6d\xe9\xa1\xf4\xbe\xb1\xd6\x96\x8a\x23\x1b\x49\xe9\x07\x42\xef\x5e\xa4\x46\x76\x42\x7b\xdc\x9d\xfd\xcd\x8e\x16\x85\x00\xcf\xb7\x79\x24\xcf\x10\x33\x0d\x7c\x50\x4c\x92\xad\x40\xf5\x55\xfb\x91\x11\xe3\x1b\x00\x34\xea\xd8\x86\xb0\x36\x9b\x5a\x1d\xdb\xac\x84\x00\xdd\x63\xf0\xd8\x8e\x6c\x50\x7d\x24\x33\xdc\x69\x60\xb7\xc3\x71\x85\x4f\xed\xd2\x6f\x6a\x75\xfa\x4b\x36\x73\xb2\xfc\x3e\xe9\x67\x0c\x62\x0f\x91\x17\xcc\x8b\x3f\x1b\xf9\x6c\xf5\x99\x6e\xec\x66\xea\x5e\xbc\xe4\xb8\x8c\x5b\x32\x03\x63\x63\x1c\xde\x9d\x51\xe5\xe9\x05\x97\xbe\x6d\x08\xca\x72\x7f\x16\x21\xc0\x14\xab\x2f\xf4\x40\x62\x14\x29\x55\xa2\x53\x08\x6a\x9b\x5a\xfa\xb2\x43\x26\x29\x3d\x73\xab\x8d\xe4\x5f\xd8\x54\x6b\x96\x44\xef\x10\x23\xba\x91\x9c\x63\x97\x91\x94\xe7\x80\x9f\xda\x2b\x14\xe2\x13\xdf\xae\x6c\xdd\x63\xc3\x1e\x0f\xc7\xea\xfd\xdd\x74\x5e\x4f\xb9\x8b\xbe\x14\xab\x7e\x99\x8c\xf3\x64\x7c\xd6\xbb\x52\xac\xfa\x37\xb2\x9a\xae\x23\x67\xfd\x47\x29\x96\xeb\x35\xb5\x94\xff\x3d\x68\xfd\x74\xb9\x1c\xb4\xfa\xa0\xd9\x92\xed\xd4\xef\x65\x46\x9d\xda\x4b\x7a\x15\x16\x69\xbd\xfc\xcb\x0f\x52\x45\x17\xa8\x0a\xbd\xee\xfb\xf7\xb3\xf5\xd3\xe4\xd9\x0a\xc4\xf8\xf6\x67\x00\x52\xeb\xe8\x98\x8a\x02\x00\x00")
+
+func dataSiteIndexHtmlBytes() ([]byte, error) {
+	return bindataRead(
+		_dataSiteIndexHtml,
+		"data/site/index.html",
+	)
+}
+
+func dataSiteIndexHtml() (*asset, error) {
+	bytes, err := dataSiteIndexHtmlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "data/site/index.html", size: 650, mode: os.FileMode(420), modTime: time.Unix(1792281955, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _dataSiteLayoutHtml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x74\x51\x31\x4e\xc4\x30\x10\xec\xf3\x8a\x65\xfb\x5c\x5a\x0a\x27\x0d\x50\x83\x04\x0d\xa5\xb1\x37\x67\xc3\x9e\x63\xd9\x1b\xe9\x4e\x56\xfe\x8e\x72\x71\x24\x38\x41\x65\xef\xce\x78\x66\x34\x2e\x05\x2c\x8d\x3e\x10\x60\xd4\x47\x6a\x1d\x69\x4b\x09\x61\x59\x1a\x75\xf7\xf8\xfc\xf0\xf6\xfe\xf2\x04\x4e\x4e\x3c\x34\x6a\x3d\x80\x75\x38\xf6\x48\x01\x87\x06\x40\xad\xf4\xf5\x02\xa0\x4e\x24\x1a\x8c\xd3\x29\x93\xf4\x38\xcb\xd8\xde\x23\x74\x15\x14\x2f\x4c\x43\x29\x70\x80\x65\x51\xdd\x36\x6e\x10\xfb\xf0\x05\x89\xb8\xc7\x2c\x17\xa6\xec\x88\x04\xc1\x25\x1a\xeb\xe6\x60\x72\xae\x4a\xaa\xdb\x0d\xd5\xc7\x64\x2f\x55\xc1\x87\x38\x0b\x78\xdb\xa3\xb6\xe7\x36\x93\x4e\xc6\x21\xc8\x25\x52\x8f\xfb\x14\x59\x1b\x72\x13\x5b\x4a\x3d\xbe\xd6\xe5\x9e\x6e\xe6\x9b\xd7\x6d\xa2\x3c\xb3\x64\x1c\x54\x37\xf3\xd0\x94\x02\x14\xec\x5a\x4a\x73\x5b\xd8\x38\x4d\x52\x0b\xbb\x86\xc9\x26\xf9\x28\x90\x93\xd9\xcd\x5b\x1f\x2c\x9d\x0f\x9f\x57\xb5\x0d\x1e\xfe\xe3\xfe\xc1\x2a\x05\x84\x4e\x91\xb5\x10\xe0\x2f\x37\xd5\x6d\x25\xa8\x6e\xfd\x99\x9f\x29\xbf\x07\x00\xc1\x58\xa0\x06\xd6\x01\x00\x00")
+
+func dataSiteLayoutHtmlBytes() ([]byte, error) {
+	return bindataRead(
+		_dataSiteLayoutHtml,
+		"data/site/layout.html",
+	)
+}
+
+func dataSiteLayoutHtml() (*asset, error) {
+	bytes, err := dataSiteLayoutHtmlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "data/site/layout.html", size: 470, mode: os.FileMode(420), modTime: time.Unix(1792278049, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _dataSiteNamespaceHtml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x84\x92\xc1\x4e\xfb\x30\x0c\xc6\xef\x7b\x0a\x2b\xf7\xa5\xda\xce\xd9\xa4\xbf\xfe\x1c\x01\x71\xe0\x05\x4c\xed\x36\x11\x69\x5a\x25\x01\x21\x59\x79\x77\xd4\x76\xcd\x18\x20\x71\x8c\xfd\xf9\xf3\x2f\x5f\x22\x02\x99\x87\xc9\x63\x66\x50\x13\xf6\xbc\xb7\x8c\xc4\x51\x81\x7e\xc4\x81\xd3\x84\x2d\x43\x29\x3b\x00\x00\x13\xf0\xfd\x6c\x10\x6c\xe4\xee\xa4\x5c\x20\xfe\xd0\x36\x0f\x5e\x9d\x45\x40\x3f\xbb\xec\x67\xa9\x69\xf0\x6c\x9a\x59\xba\x0e\xd9\xc3\xd2\xfe\xea\x06\x61\x3b\x98\xc6\x1e\x56\xdd\x0d\x88\xc7\xd0\xbf\xcd\x30\x9d\xf3\x79\x81\xb9\xbf\x54\xd2\x06\x23\x02\x11\x43\xcf\xf0\xea\x02\x25\xd0\xff\x3d\xa6\x74\x6d\x1b\x7b\xfc\x46\x65\x8f\x17\x20\xf2\x75\xe3\xea\xf0\x63\x96\x32\x10\x66\xdc\x6f\x18\x27\x25\x72\x45\x80\x52\xd4\x35\x06\x11\x68\xe7\xf1\x27\xec\x19\xf4\xd2\xdb\xae\x5b\xc3\xa0\xbc\xad\xa6\x3f\x8d\xe7\xc2\x1d\xa7\x36\xba\x29\xbb\x31\x2c\x1e\x44\x95\x98\x03\x55\xcc\x86\xfc\x6f\x75\x11\x70\x1d\xe8\x07\x1e\x5e\x38\x26\xfd\x2f\xb4\x76\x8c\x50\xca\x4d\xc2\xf5\x05\x54\x55\xae\x92\x8b\xd1\x8d\x78\xf9\x17\xdd\x38\x66\x8e\x0a\x4a\xd9\x7d\x0e\x00\xef\xea\x91\x4a\x35\x02\x00\x00")
+
+func dataSiteNamespaceHtmlBytes() ([]byte, error) {
+	return bindataRead(
+		_dataSiteNamespaceHtml,
+		"data/site/namespace.html",
+	)
+}
+
+func dataSiteNamespaceHtml() (*asset, error) {
+	bytes, err := dataSiteNamespaceHtmlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "data/site/namespace.html", size: 565, mode: os.FileMode(420), modTime: time.Unix(1792286957, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _dataStyleCss = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x94\x92\xdb\x8e\xa3\x30\x0c\x86\xef\xe7\x29\x2c\x55\x7b\xb7\x20\x98\x03\xed\xc2\xd3\x18\xec\xd0\x68\x72\x40\x89\x99\xd2\x45\xf3\xee\xab\xf4\xc0\x42\xab\xbd\x58\x71\x15\xfe\xdf\xce\x97\xdf\x6e\x3d\x9d\x61\x06\xe5\x9d\x64\x0a\xad\x36\xe7\x1a\x22\xba\x98\x45\x0e\x5a\x35\x60\x31\xf4\xda\xd5\x50\x00\x8e\xe2\xd3\x79\xca\x4e\x9a\xe4\x58\x43\x55\xb0\x6d\x60\x40\x22\xed\xfa\xe4\x28\xd3\xf9\xfb\x45\x8e\x3f\x41\x08\xe6\xbb\x94\x19\x56\x52\x5f\xd5\xd6\x07\xe2\x90\xb5\x5e\xc4\xdb\x1a\xca\x61\x82\xe8\x8d\x26\xd8\x11\x51\x03\xc2\x93\x64\x68\x74\xef\x6a\x48\x55\xa9\x9d\xc3\x2f\x98\x17\x8e\x92\x2d\x14\xe9\xb7\xf2\x5e\x38\xac\x94\xd7\xab\xd2\x79\xe3\x43\x0d\xbb\xfd\x7e\x9f\x6c\x3b\xa4\x29\x8b\x8c\xa1\x3b\xc2\x0c\x37\xf2\xb2\x28\x7e\xac\xc9\xf3\x37\xb6\x0f\xe6\x2c\x70\x1c\x8d\x44\x98\xc1\xe8\x28\x59\x94\xb3\xe1\x1a\x9c\x77\xdc\x3c\x3c\xac\xf8\x57\x69\x1c\xd0\xc1\xfc\x48\x94\x27\x67\x8b\xd4\xf3\x3d\xf7\xa8\x7f\x73\x0d\x45\x5e\x25\x8a\xcb\x24\x4e\xac\xfb\xa3\xa4\xeb\x82\x45\xb3\x41\xbd\x04\x90\xbf\xaf\xc2\x0c\x48\x7a\x8c\xcb\x33\x5a\xec\x3e\xfb\xe0\x47\x47\x35\xec\x98\xb9\x81\x2f\x0e\xa2\x3b\x34\xf7\x64\xad\x26\x32\xbc\xb0\x10\x0f\x81\x3b\x14\xa6\x15\x2c\xbe\xbd\x25\x43\x7a\xc2\xb3\x69\x73\x85\x22\xda\x38\x79\x1a\x38\x68\xcb\x4e\xd0\x3c\x79\x15\xef\xdb\x6d\xe3\x28\xd8\x1a\x7e\x34\x92\xa2\x85\x2f\xfa\x31\x74\xff\x11\xd6\x75\x55\xee\xc3\xc9\x3f\xd8\x2e\xad\x3a\x4f\x4f\x57\xa9\x4a\x1d\x14\x6e\x32\xbe\x0c\xc2\x7f\x71\x50\xc6\x9f\xb2\xa9\xbe\xed\xfe\xad\x09\x4f\x68\x07\xc3\x99\x68\x31\x7f\xb1\xae\xfb\xa1\x05\x8d\xee\x16\x86\xfb\x9e\x17\xf9\xeb\x8a\xe2\x93\xcf\x27\x1f\x36\x69\x57\xaf\x15\xbe\x2f\x86\x28\x41\xbb\x7e\xa5\x7f\x14\x58\xbe\xab\x45\xef\xbc\x4d\xf9\xae\x0c\x07\x4c\xdf\x2d\x90\x07\x96\x5b\x91\x1b\x6d\xcb\x61\x55\xf3\xeb\x50\x1d\x8a\xb2\x81\xef\x97\x3f\x03\x00\x51\x51\x87\x9e\x05\x04\x00\x00")
+
+func dataStyleCssBytes() ([]byte, error) {
+	return bindataRead(
+		_dataStyleCss,
+		"data/style.css",
+	)
+}
+
+func dataStyleCss() (*asset, error) {
+	bytes, err := dataStyleCssBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "data/style.css", size: 1029, mode: os.FileMode(420), modTime: time.Unix(1792283802, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
@@ -170,8 +338,16 @@ func AssetNames() []string {
 
 // _bindata is a table, holding each asset generator, mapped to its name.
 var _bindata = map[string]func() (*asset, error){
-	"data/default.html":  dataDefaultHtml,
-	"data/java.doxyfile": dataJavaDoxyfile,
+	"data/cpp.doxyfile":        dataCppDoxyfile,
+	"data/default.html":        dataDefaultHtml,
+	"data/java.doxyfile":       dataJavaDoxyfile,
+	"data/partials.html":       dataPartialsHtml,
+	"data/search.js":           dataSearchJs,
+	"data/site/class.html":     dataSiteClassHtml,
+	"data/site/index.html":     dataSiteIndexHtml,
+	"data/site/layout.html":    dataSiteLayoutHtml,
+	"data/site/namespace.html": dataSiteNamespaceHtml,
+	"data/style.css":           dataStyleCss,
 }
 
 // AssetDir returns the file names below a certain
@@ -216,8 +392,18 @@ type bintree struct {
 
 var _bintree = &bintree{nil, map[string]*bintree{
 	"data": &bintree{nil, map[string]*bintree{
+		"cpp.doxyfile":  &bintree{dataCppDoxyfile, map[string]*bintree{}},
 		"default.html":  &bintree{dataDefaultHtml, map[string]*bintree{}},
 		"java.doxyfile": &bintree{dataJavaDoxyfile, map[string]*bintree{}},
+		"partials.html": &bintree{dataPartialsHtml, map[string]*bintree{}},
+		"search.js":     &bintree{dataSearchJs, map[string]*bintree{}},
+		"site": &bintree{nil, map[string]*bintree{
+			"class.html":     &bintree{dataSiteClassHtml, map[string]*bintree{}},
+			"index.html":     &bintree{dataSiteIndexHtml, map[string]*bintree{}},
+			"layout.html":    &bintree{dataSiteLayoutHtml, map[string]*bintree{}},
+			"namespace.html": &bintree{dataSiteNamespaceHtml, map[string]*bintree{}},
+		}},
+		"style.css": &bintree{dataStyleCss, map[string]*bintree{}},
 	}},
 }}
 
@@ -0,0 +1,259 @@
+// The adx command line tool generates the documentation with the adx package
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/nuald/adx"
+)
+
+type arrayFlags []string
+
+func (i *arrayFlags) String() string {
+	return "the string flags"
+}
+
+func (i *arrayFlags) Set(value string) error {
+	*i = append(*i, value)
+	return nil
+}
+
+// inputFlags collect the inputs from the command line: -lang starts a new
+// input (unless the current one has no language yet), and the -src,
+// -exclude, -jsconf, -doxygen-xml and -jsdoc-json flags belong to the
+// current input
+type inputFlags struct {
+	inputs []adx.ProjectInput
+}
+
+func (f *inputFlags) current() *adx.ProjectInput {
+	if f.inputs == nil {
+		f.inputs = append(f.inputs, adx.ProjectInput{})
+	}
+	return &f.inputs[len(f.inputs)-1]
+}
+
+// inputFlag is the command line flag updating the current input
+type inputFlag struct {
+	inputs *inputFlags
+	set    func(input *adx.ProjectInput, value string)
+}
+
+func (f inputFlag) String() string {
+	return ""
+}
+
+func (f inputFlag) Set(value string) error {
+	f.set(f.inputs.current(), value)
+	return nil
+}
+
+func (f *inputFlags) langFlag() inputFlag {
+	return inputFlag{f, func(input *adx.ProjectInput, value string) {
+		if input.Lang != "" {
+			f.inputs = append(f.inputs, adx.ProjectInput{})
+			input = f.current()
+		}
+		input.Lang = value
+	}}
+}
+
+func (f *inputFlags) srcFlag() inputFlag {
+	return inputFlag{f, func(input *adx.ProjectInput, value string) {
+		input.Src = append(input.Src, value)
+	}}
+}
+
+func (f *inputFlags) excludeFlag() inputFlag {
+	return inputFlag{f, func(input *adx.ProjectInput, value string) {
+		input.Exclude = append(input.Exclude, value)
+	}}
+}
+
+func (f *inputFlags) jsConfFlag() inputFlag {
+	return inputFlag{f, func(input *adx.ProjectInput, value string) {
+		input.JSConf = value
+	}}
+}
+
+func (f *inputFlags) doxygenXMLFlag() inputFlag {
+	return inputFlag{f, func(input *adx.ProjectInput, value string) {
+		input.DoxygenXML = append(input.DoxygenXML, value)
+	}}
+}
+
+func (f *inputFlags) jsDocJSONFlag() inputFlag {
+	return inputFlag{f, func(input *adx.ProjectInput, value string) {
+		input.JSDocJSON = append(input.JSDocJSON, value)
+	}}
+}
+
+// The exit codes of the failure classes (the usage errors have the code 2 as in the flag package)
+var exitCodes = map[adx.Phase]int{
+	adx.PhaseConfig: 2,
+	adx.PhaseSource: 3,
+	adx.PhaseParse:  4,
+	adx.PhaseInput:  5,
+	adx.PhaseRender: 6,
+}
+
+func exitCode(err error) int {
+	var e *adx.Error
+	if errors.As(err, &e) {
+		if code, ok := exitCodes[e.Phase]; ok {
+			return code
+		}
+	}
+	return 1
+}
+
+// fail reports the error and exits with the code of its failure class
+func fail(err error) {
+	fmt.Fprintln(os.Stderr, err)
+	os.Exit(exitCode(err))
+}
+
+func printUsage() {
+	fmt.Println("Usage: adx [-project=(yaml-file)] [-conf=(yaml-file)] [-lang=(lang) [-jsconf=(jsdoc-conf)] [-src=(src-dir)]+ [-exclude=(pattern)]+ [-doxygen-xml=(xml-dir)]+ [-jsdoc-json=(json-file)]+]+ [-in=(xml-or-json-file)]+ -title=(title) -out=(out.[html|pdf|xml|json|md]|out-dir) [-format=(html|pdf|xml|json|md)] [-front-matter] [-template=(file-or-dir)] [-var=(name=value)]+ [-inherited] [-hide-deprecated] [-source-url=(url-pattern)] [-source-root=(dir)] [-verbose]")
+	fmt.Println("Produces the code's auto-generated documentation in HTML, PDF, Markdown, XML or JSON.")
+	fmt.Println("The output without an extension (or without the -format one) is a directory for the multi-page HTML site or Markdown.")
+	fmt.Println("The flags override the project file settings (" + adx.ProjectFile + " in the working directory by default).")
+	fmt.Println()
+	fmt.Println("Flags:")
+	flag.PrintDefaults()
+}
+
+func main() {
+	langDesc := fmt.Sprintf("the source code programming language (%s), "+
+		"repeat it for the mixed-language sources", strings.Join(adx.GeneratorNames(), ", "))
+	var inputs inputFlags
+	flag.Var(inputs.langFlag(), "lang", langDesc)
+	flag.Var(inputs.srcFlag(), "src", "the source code dir(s) of the language")
+	flag.Var(inputs.excludeFlag(), "exclude", "the source file or dir pattern(s) to exclude")
+	flag.Var(inputs.jsConfFlag(), "jsconf", "the JSDoc configuration file")
+	flag.Var(inputs.doxygenXMLFlag(), "doxygen-xml", "the existing Doxygen XML output dir(s) of the language (c, cpp, java)")
+	flag.Var(inputs.jsDocJSONFlag(), "jsdoc-json", "the existing JSDoc doclets file(s) (jsdoc -X) of the js language")
+
+	var inFiles arrayFlags
+	flag.Var(&inFiles, "in", "the input adx XML or JSON file(s)")
+	flag.Var(&inFiles, "xml", "the input XML file(s) (deprecated, use -in)")
+
+	projectPath := flag.String("project", "", "the project file (default \""+adx.ProjectFile+"\" if exists)")
+	title := flag.String("title", "", "the document title")
+	conf := flag.String("conf", "", "the configuration file for the custom languages")
+	out := flag.String("out", "", "the output file or directory (the output without an extension)")
+	tpl := flag.String("template", "", "the HTML template file or the directory overriding the embedded templates")
+	var vars arrayFlags
+	flag.Var(&vars, "var", "the template variable(s) as name=value")
+	format := flag.String("format", "", "the output format (html, pdf, xml, json, md), by default based on the output extension")
+	frontMatter := flag.Bool("front-matter", false, "add the YAML front matter to the Markdown output")
+	schema := flag.String("schema", "", "print the interchange format schema (xsd, json) and exit")
+	inherited := flag.Bool("inherited", false, "list the members inherited from the documented base classes")
+	hideDeprecated := flag.Bool("hide-deprecated", false, "remove the deprecated classes and members from the HTML, PDF and Markdown outputs")
+	sourceURL := flag.String("source-url", "", "the URL pattern of the view source links with the {path} and {line} placeholders")
+	sourceRoot := flag.String("source-root", "", "the dir the source paths are relative to (default the project file dir or the working directory)")
+	verbose := flag.Bool("verbose", false, "print the external commands before running them")
+	keepGoing := flag.Bool("keep-going", false, "report the unreadable sources and invalid inputs without stopping the build")
+	flag.Parse()
+	if *verbose {
+		adx.OnCommand = func(command string) {
+			fmt.Println(command)
+		}
+	}
+	if *schema == "xsd" {
+		fmt.Print(string(adx.RenderXSD()))
+		return
+	} else if *schema == "json" {
+		fmt.Print(string(adx.RenderJSONSchema()))
+		return
+	}
+
+	var p adx.Project
+	var err error
+	if *projectPath != "" {
+		p, err = adx.LoadProject(*projectPath)
+	} else if _, statErr := os.Stat(adx.ProjectFile); statErr == nil {
+		p, err = adx.LoadProject(adx.ProjectFile)
+	}
+	if err != nil {
+		fail(err)
+	}
+
+	// The command line flags override the project file
+	flag.Visit(func(f *flag.Flag) {
+		switch f.Name {
+		case "title":
+			p.Title = *title
+		case "template":
+			p.Template = *tpl
+		case "conf":
+			p.Conf = *conf
+		case "inherited":
+			p.Inherited = *inherited
+		case "hide-deprecated":
+			p.HideDeprecated = *hideDeprecated
+		case "source-url":
+			p.SourceURL = *sourceURL
+		case "source-root":
+			p.SourceRoot = *sourceRoot
+		case "keep-going":
+			p.KeepGoing = *keepGoing
+		case "var":
+			if p.Vars == nil {
+				p.Vars = map[string]string{}
+			}
+			parsed, err := adx.ParseVars(vars)
+			if err != nil {
+				fail(err)
+			}
+			for name, value := range parsed {
+				p.Vars[name] = value
+			}
+		}
+	})
+	p.In = append(p.In, inFiles...)
+	if inputs.inputs != nil {
+		p.Inputs = inputs.inputs
+	}
+	if *out != "" {
+		p.Outputs = []adx.ProjectOutput{{
+			Path:        *out,
+			Format:      *format,
+			FrontMatter: *frontMatter,
+		}}
+	}
+
+	if (p.Inputs == nil && p.In == nil) || p.Outputs == nil {
+		printUsage()
+		os.Exit(exitCodes[adx.PhaseConfig])
+	}
+
+	// In the keep-going mode the build fails at the end with the first error
+	var firstErr error
+	report := func(err error) {
+		fmt.Fprintln(os.Stderr, err)
+		if firstErr == nil {
+			firstErr = err
+		}
+	}
+	p.Report = report
+	doc, err := p.Parse()
+	if err != nil {
+		fail(err)
+	}
+	for _, output := range p.Outputs {
+		if err := p.Render(doc, output); err != nil {
+			if !p.KeepGoing {
+				fail(err)
+			}
+			report(err)
+		}
+	}
+	if firstErr != nil {
+		os.Exit(exitCode(firstErr))
+	}
+}
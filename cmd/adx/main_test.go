@@ -0,0 +1,29 @@
+package main
+
+import (
+	"flag"
+	"testing"
+)
+
+func TestInputFlags(t *testing.T) {
+	var inputs inputFlags
+	flags := flag.NewFlagSet("adx", flag.ContinueOnError)
+	flags.Var(inputs.langFlag(), "lang", "")
+	flags.Var(inputs.srcFlag(), "src", "")
+	flags.Var(inputs.excludeFlag(), "exclude", "")
+	err := flags.Parse([]string{"-src", "android", "-lang", "kotlin", "-exclude", "test",
+		"-lang", "swift", "-src", "ios"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(inputs.inputs) != 2 {
+		t.Fatalf("Wrong inputs: %v", inputs.inputs)
+	}
+	kotlin, swift := inputs.inputs[0], inputs.inputs[1]
+	if kotlin.Lang != "kotlin" || kotlin.Src[0] != "android" || kotlin.Exclude[0] != "test" {
+		t.Fatalf("Wrong kotlin input: %v", kotlin)
+	}
+	if swift.Lang != "swift" || swift.Src[0] != "ios" || swift.Exclude != nil {
+		t.Fatalf("Wrong swift input: %v", swift)
+	}
+}
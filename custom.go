@@ -1,8 +1,9 @@
-package main
+package adx
 
 import (
+	"errors"
+	"html"
 	"html/template"
-	"log"
 	"os"
 	"path/filepath"
 	"regexp"
@@ -15,6 +16,19 @@ var constructorToken = "Constructor:"
 var staticMethodToken = "Static Method:"
 var propertyToken = "Property:"
 var staticPropertyToken = "Static Property:"
+var valueToken = "Value:"
+var functionToken = "Function:"
+var constantToken = "Constant:"
+var variableToken = "Variable:"
+
+// kindTokens are the markers of the types other than the classes
+var kindTokens = map[string]string{
+	"Interface:": "interface",
+	"Struct:":    "struct",
+	"Enum:":      "enum",
+	"Protocol:":  "protocol",
+	"Trait:":     "trait",
+}
 
 // Language configuration
 type Language struct {
@@ -24,59 +38,133 @@ type Language struct {
 		Format    string
 		Parameter string
 		Return    string
+		// Throws is optional, its type and description groups document the exception
+		Throws string
+		// The optional annotations of the classes, methods and properties:
+		// the deprecation note (the optional description group), the since
+		// version (the version group) and the stability (the level group)
+		Deprecated string
+		Since      string
+		Stability  string
 	}
 }
 
 type custom struct {
 	language Language
+	excludes []string
+	onError  func(error)
+	root     string
 }
 
-func createCustomGen(language Language) generator {
+// NewCustomGenerator creates the generator parsing the docstrings of the custom language
+func NewCustomGenerator(language Language) Generator {
 	gen := new(custom)
 	gen.language = language
 	return gen
 }
 
-func (c custom) setConf(conf string) {}
+func (c custom) SetConf(conf string) {}
+
+func (c *custom) SetExcludes(patterns []string) {
+	c.excludes = patterns
+}
+
+func (c *custom) SetErrorHandler(handler func(error)) {
+	c.onError = handler
+}
+
+func (c *custom) SetSourceRoot(dir string) {
+	c.root = dir
+}
 
-func (c custom) genIntermediate(srcDir string) []byte {
+func (c custom) GenIntermediate(srcDir string) ([]byte, error) {
 	var content []byte
 
-	err := filepath.Walk(srcDir, func(path string, info os.FileInfo, err error) error {
-		if err != nil {
-			return err
-		}
-		if !info.IsDir() {
-			ext := filepath.Ext(info.Name())
-			for _, possibleExt := range c.language.Extensions {
-				if possibleExt == ext {
-					// #nosec
-					file, err := os.ReadFile(path)
-					if err != nil {
+	err := walkSources(srcDir, c.excludes, func(path string, info os.FileInfo) error {
+		ext := filepath.Ext(info.Name())
+		for _, possibleExt := range c.language.Extensions {
+			if possibleExt == ext {
+				// #nosec
+				file, err := os.ReadFile(path)
+				if err != nil {
+					err = withContext(err, PhaseSource, path)
+					if c.onError == nil {
 						return err
 					}
-					content = append(content, file...)
+					c.onError(err)
+					return nil
 				}
+				content = append(content, fileMarker(sourcePath(c.root, path))...)
+				content = append(content, file...)
 			}
 		}
 		return nil
 	})
 
 	if err != nil {
-		log.Fatal(err)
+		return nil, err
+	}
+	return content, nil
+}
+
+// sourceMarker starts the line with the path of the file in the
+// intermediate content (the concatenated sources)
+var sourceMarker = "\x00adx:file "
+
+func fileMarker(path string) []byte {
+	return []byte("\n" + sourceMarker + path + "\n")
+}
+
+// splitFiles splits the intermediate content into the files (the content
+// without the markers is the single file with the unknown path)
+func splitFiles(content string) (files []string, contents []string) {
+	file := ""
+	start := 0
+	for start < len(content) {
+		i := strings.Index(content[start:], "\n"+sourceMarker)
+		end := len(content)
+		if i >= 0 {
+			end = start + i
+		}
+		if end > start || file != "" {
+			files = append(files, file)
+			contents = append(contents, content[start:end])
+		}
+		if i < 0 {
+			break
+		}
+		rest := content[end+1+len(sourceMarker):]
+		n := strings.IndexByte(rest, '\n')
+		if n < 0 {
+			n = len(rest)
+		}
+		file = rest[:n]
+		start = end + 1 + len(sourceMarker) + n + 1
 	}
-	return content
+	return files, contents
+}
+
+// codeFence is the start and the end of the code block (the start may have
+// the language, e.g. ```swift)
+var codeFence = "```"
+
+func (c custom) CombineIntermediate(a []byte, b []byte) ([]byte, error) {
+	return append(a, b...), nil
 }
 
-func (c custom) combineIntermediate(a []byte, b []byte) []byte {
-	return append(a, b...)
+// docstringBlock is the docstring with the location of the documented code
+// (the line following the docstring)
+type docstringBlock struct {
+	lines []string
+	file  string
+	line  int
 }
 
-func extractBlocks(lines []string, begin string, middle string, end string) [][]string {
-	var result [][]string
+func extractBlocks(lines []string, begin string, middle string, end string) []docstringBlock {
+	var result []docstringBlock
 	blockStarted := false
 	var current []string
-	for _, line := range lines {
+	for i, line := range lines {
 		trimmed := strings.TrimSpace(line)
 
 		if strings.HasPrefix(trimmed, begin) && !blockStarted {
@@ -90,39 +178,36 @@ func extractBlocks(lines []string, begin string, middle string, end string) [][]
 
 		if strings.HasPrefix(trimmed, end) && blockStarted {
 			blockStarted = false
-			result = append(result, current)
+			result = append(result, docstringBlock{lines: current, line: i + 2})
 			current = nil
 			continue
 		}
 
 		if blockStarted {
+			// The indentation is kept for the code blocks, and the blank
+			// lines separate the paragraphs
 			if strings.HasPrefix(trimmed, middle) {
-				right := strings.TrimSpace(trimmed[len(middle):])
-				if right != "" {
-					current = append(current, right)
-				}
+				current = append(current, strings.TrimRight(trimmed[len(middle):], " \t"))
 			} else {
-				current = append(current, trimmed)
+				current = append(current, strings.TrimRight(line, " \t"))
 			}
 		}
 	}
 	return result
 }
 
-func extractLines(lines []string, begin string) [][]string {
-	var result [][]string
+func extractLines(lines []string, begin string) []docstringBlock {
+	var result []docstringBlock
 	var current []string
-	for _, line := range lines {
+	for i, line := range lines {
 		trimmed := strings.TrimSpace(line)
 
 		if strings.HasPrefix(trimmed, begin) {
-			right := strings.TrimSpace(trimmed[len(begin):])
-			if right != "" {
-				current = append(current, right)
-			}
+			// The blank lines separate the paragraphs
+			current = append(current, strings.TrimRight(trimmed[len(begin):], " \t"))
 		} else {
 			if current != nil {
-				result = append(result, current)
+				result = append(result, docstringBlock{lines: current, line: i + 1})
 			}
 			current = nil
 		}
@@ -145,8 +230,37 @@ func reSubMatchMap(r *regexp.Regexp, str string) map[string]string {
 	return nil
 }
 
-func findMethodTokens(context *string, method *Method, line string,
-	paramRe *regexp.Regexp, returnRe *regexp.Regexp) *string {
+// docstringRes are the regular expressions of the docstring tokens (the
+// optional ones are nil if not configured)
+type docstringRes struct {
+	param, returns, throws       *regexp.Regexp
+	deprecated, since, stability *regexp.Regexp
+}
+
+// findAnnotationTokens updates the annotation fields with the token of the line
+func findAnnotationTokens(fields *annotationFields, line string, res docstringRes) bool {
+	match := func(re *regexp.Regexp) map[string]string {
+		if re == nil {
+			return nil
+		}
+		return reSubMatchMap(re, line)
+	}
+	if deprecated := match(res.deprecated); deprecated != nil {
+		*fields.deprecated = strings.TrimSpace(deprecated["description"])
+		if *fields.deprecated == "" {
+			*fields.deprecated = "true"
+		}
+	} else if since := match(res.since); since != nil {
+		*fields.since = strings.TrimSpace(since["version"])
+	} else if stability := match(res.stability); stability != nil {
+		*fields.stability = strings.ToLower(strings.TrimSpace(stability["level"]))
+	} else {
+		return false
+	}
+	return true
+}
+
+func findMethodTokens(context *string, method *Method, line string, res docstringRes) *string {
 	if context != nil {
 		if *context != methodToken {
 			return context
@@ -154,8 +268,12 @@ func findMethodTokens(context *string, method *Method, line string,
 	}
 
 	if method != nil {
-		parameter := reSubMatchMap(paramRe, line)
-		returnValue := reSubMatchMap(returnRe, line)
+		parameter := reSubMatchMap(res.param, line)
+		returnValue := reSubMatchMap(res.returns, line)
+		var exception map[string]string
+		if res.throws != nil {
+			exception = reSubMatchMap(res.throws, line)
+		}
 		if parameter != nil {
 			method.Parameters = append(method.Parameters, Parameter{
 				Name:        parameter["name"],
@@ -167,6 +285,12 @@ func findMethodTokens(context *string, method *Method, line string,
 				Description: template.HTML(
 					strings.TrimSpace(returnValue["description"])),
 			}
+		} else if exception != nil {
+			// #nosec
+			method.Throws = append(method.Throws, Exception{
+				Type:        template.HTML(html.EscapeString(strings.TrimSpace(exception["type"]))),
+				Description: strings.TrimSpace(exception["description"]),
+			})
 		} else {
 			return context
 		}
@@ -176,16 +300,31 @@ func findMethodTokens(context *string, method *Method, line string,
 	return context
 }
 
-func findClassTokens(cls *Class, line string) bool {
+// classNames splits the names listed with the commas or spaces
+func classNames(text string) []string {
+	return strings.FieldsFunc(text, func(r rune) bool {
+		return r == ',' || r == ' ' || r == '\t'
+	})
+}
+
+func findClassTokens(cls *Class, line string, block docstringBlock) bool {
 	propToken := "@property"
 	ctorToken := "@constructor"
-
-	if strings.HasPrefix(line, propToken) {
+	extendsToken := "@extends"
+	implementsToken := "@implements"
+
+	if strings.HasPrefix(line, extendsToken) {
+		cls.Extends = append(cls.Extends, classNames(line[len(extendsToken):])...)
+	} else if strings.HasPrefix(line, implementsToken) {
+		cls.Implements = append(cls.Implements, classNames(line[len(implementsToken):])...)
+	} else if strings.HasPrefix(line, propToken) {
 		tokens := strings.SplitN(
 			strings.TrimSpace(line[len(propToken):]), " ", 2)
 		cls.Properties = append(cls.Properties, Property{
 			Name:        tokens[0],
 			Description: tokens[1],
+			File:        block.file,
+			Line:        block.line,
 		})
 	} else if strings.HasPrefix(line, ctorToken) {
 		var params []Parameter
@@ -198,6 +337,8 @@ func findClassTokens(cls *Class, line string) bool {
 		cls.Constructors = append(cls.Constructors, Method{
 			Description: strings.TrimSpace(line[len(ctorToken):]),
 			Parameters:  params,
+			File:        block.file,
+			Line:        block.line,
 		})
 	} else {
 		return false
@@ -221,39 +362,78 @@ func addProperty(cls *Class, property *Property) {
 	}
 }
 
-func appendClass(classes []Class, cls *Class, method *Method, property *Property) []Class {
+func addValue(cls *Class, value *EnumValue) {
+	if value != nil {
+		cls.Values = append(cls.Values, *value)
+	}
+}
+
+func appendClass(classes []Class, cls *Class, method *Method, property *Property, value *EnumValue) []Class {
 	addMethod(cls, method)
 	addProperty(cls, property)
+	addValue(cls, value)
 	if cls != nil {
 		classes = append(classes, *cls)
 	}
 	return classes
 }
 
-func updateDescriptions(line string, context *string, cls *Class, method *Method, property *Property) {
+// appendLine appends the line of the multiline description (the blank lines
+// separate the paragraphs)
+func appendLine(description *string, line string) {
+	if *description == "" {
+		*description = line
+	} else {
+		*description += "\n" + line
+	}
+}
+
+func updateDescriptions(line string, context *string, cls *Class, method *Method,
+	property *Property, value *EnumValue) {
 	if context != nil {
 		if *context == classToken {
-			if cls.Description == "" {
-				cls.Description = line
-			} else {
-				cls.Description += "\n" + line
-			}
+			appendLine(&cls.Description, line)
 		}
 		if *context == methodToken {
-			if method.Description == "" {
-				method.Description = line
-			} else {
-				method.Description += "\n" + line
-			}
+			appendLine(&method.Description, line)
 		}
 		if *context == propertyToken {
-			if property.Description == "" {
-				property.Description = line
-			} else {
-				property.Description += "\n" + line
-			}
+			appendLine(&property.Description, line)
+		}
+		if *context == valueToken {
+			appendLine(&value.Description, line)
+		}
+	}
+}
+
+// markdownDescriptions converts the Markdown descriptions into the plain text
+// and the details
+func markdownDescriptions(classes []Class, namespaces []Namespace) {
+	methods := func(methods []Method) {
+		for i := range methods {
+			markdownDescription(&methods[i].Description, &methods[i].Details)
+		}
+	}
+	properties := func(properties []Property) {
+		for i := range properties {
+			markdownDescription(&properties[i].Description, &properties[i].Details)
 		}
 	}
+	for i := range classes {
+		cls := &classes[i]
+		markdownDescription(&cls.Description, &cls.Details)
+		methods(cls.Constructors)
+		methods(cls.Methods)
+		properties(cls.Properties)
+		for j := range cls.Values {
+			cls.Values[j].Description = strings.TrimSpace(cls.Values[j].Description)
+		}
+	}
+	for i := range namespaces {
+		methods(namespaces[i].Functions)
+		properties(namespaces[i].Constants)
+		properties(namespaces[i].Variables)
+	}
 }
 
 func getAccessModifier(isStatic bool) string {
@@ -288,6 +468,29 @@ func newProperty(line string, isStaticProperty bool) Property {
 	}
 }
 
+// newValue parses the enum value with the optional assigned value (e.g. Red = 1)
+func newValue(line string) EnumValue {
+	tokens := strings.SplitN(line[len(valueToken):], "=", 2)
+	value := EnumValue{Name: strings.TrimSpace(tokens[0])}
+	if len(tokens) > 1 {
+		value.Value = strings.TrimSpace(tokens[1])
+	}
+	return value
+}
+
+// findClassDeclaration parses the class or the other type declaration
+func findClassDeclaration(line string) *Class {
+	if strings.HasPrefix(line, classToken) {
+		return &Class{Name: strings.TrimSpace(line[len(classToken):])}
+	}
+	for token, kind := range kindTokens {
+		if strings.HasPrefix(line, token) {
+			return &Class{Name: strings.TrimSpace(line[len(token):]), Kind: kind}
+		}
+	}
+	return nil
+}
+
 func findMethodDeclaration(line string) *Method {
 	isMethod := strings.HasPrefix(line, methodToken)
 	isStaticMethod := strings.HasPrefix(line, staticMethodToken)
@@ -299,29 +502,149 @@ func findMethodDeclaration(line string) *Method {
 	return nil
 }
 
-func findClasses(blocks [][]string, paramRe *regexp.Regexp, returnRe *regexp.Regexp) []Class {
+// memberDeclaration is the namespace-level function, constant or variable
+type memberDeclaration struct {
+	namespace string
+	function  *Method
+	property  *Property
+	constant  bool
+}
+
+// findMemberDeclaration parses the namespace-level member declaration (the
+// qualified names have the namespace, e.g. geo::distance)
+func findMemberDeclaration(line string) *memberDeclaration {
+	var member memberDeclaration
+	var name string
+	switch {
+	case strings.HasPrefix(line, functionToken):
+		name = line[len(functionToken):]
+		member.function = &Method{}
+	case strings.HasPrefix(line, constantToken):
+		name = line[len(constantToken):]
+		member.property = &Property{}
+		member.constant = true
+	case strings.HasPrefix(line, variableToken):
+		name = line[len(variableToken):]
+		member.property = &Property{}
+	default:
+		return nil
+	}
+	name = strings.TrimSpace(name)
+	if i := strings.LastIndex(name, "::"); i >= 0 {
+		member.namespace, name = name[:i], name[i+2:]
+	}
+	if member.function != nil {
+		member.function.Name = name
+	} else {
+		member.property.Name = name
+	}
+	return &member
+}
+
+func addMember(namespaces *namespaceIndex, member *memberDeclaration) {
+	if member == nil {
+		return
+	}
+	ns := namespaces.get(member.namespace)
+	switch {
+	case member.function != nil:
+		ns.Functions = append(ns.Functions, *member.function)
+	case member.constant:
+		ns.Constants = append(ns.Constants, *member.property)
+	default:
+		ns.Variables = append(ns.Variables, *member.property)
+	}
+}
+
+// findClasses parses the classes and the namespace members of the docstrings;
+// the namespace-level member declarations end the current class
+func findClasses(blocks []docstringBlock, res docstringRes) ([]Class, []Namespace) {
 	var classes []Class
+	var result AdxResult
+	namespaces := namespaceIndex{result: &result}
 	var cls *Class
 	var method *Method
 	var property *Property
+	var value *EnumValue
+	var member *memberDeclaration
+	// The annotated element is the last declared class, method or property
+	var annotated *annotationFields
+	// The examples of the last declared class or method, and the code block
+	var examples *[]Example
+	var example *Example
+	var code []string
 	for _, block := range blocks {
 		var context *string
-		for _, line := range block {
-			if strings.HasPrefix(line, classToken) {
-				classes = appendClass(classes, cls, method, property)
+		for _, raw := range block.lines {
+			line := strings.TrimSpace(raw)
+			if example != nil {
+				if strings.HasPrefix(line, codeFence) {
+					example.Code = strings.Trim(strings.Join(pyDedent(code), "\n"), "\n")
+					*examples = append(*examples, *example)
+					example, code = nil, nil
+				} else {
+					code = append(code, raw)
+				}
+				continue
+			}
+			if line == "" {
+				updateDescriptions(line, context, cls, method, property, value)
+				continue
+			}
+			if examples != nil && strings.HasPrefix(line, codeFence) {
+				example = &Example{Language: strings.TrimSpace(line[len(codeFence):])}
+				continue
+			}
+			newClassVar := findClassDeclaration(line)
+			newMemberVar := findMemberDeclaration(line)
+			if newClassVar != nil || newMemberVar != nil {
+				if member != nil {
+					addMember(&namespaces, member)
+				} else {
+					classes = appendClass(classes, cls, method, property, value)
+				}
+				cls = newClassVar
+				member = newMemberVar
 				method = nil
 				property = nil
-				cls = &Class{
-					Name: strings.TrimSpace(line[len(classToken):]),
-				}
+				value = nil
 				context = &classToken
+				if member != nil {
+					// The member descriptions and tokens are parsed as the
+					// ones of the methods and properties
+					method = member.function
+					property = member.property
+					if property != nil {
+						property.File, property.Line = block.file, block.line
+						context = &propertyToken
+						annotated = propertyAnnotations(property)
+						examples = nil
+					} else {
+						method.File, method.Line = block.file, block.line
+						context = &methodToken
+						annotated = methodAnnotations(method)
+						examples = &method.Examples
+					}
+				} else {
+					cls.File, cls.Line = block.file, block.line
+					annotated = classAnnotations(cls)
+					examples = &cls.Examples
+				}
+				continue
+			}
+			if annotated != nil && findAnnotationTokens(annotated, line, res) {
+				// The annotations finish the description
+				context = nil
 				continue
 			}
 			if cls != nil {
 				if newMethodVar := findMethodDeclaration(line); newMethodVar != nil {
 					addMethod(cls, method)
 					method = newMethodVar
+					method.File, method.Line = block.file, block.line
 					context = &methodToken
+					annotated = methodAnnotations(method)
+					examples = &method.Examples
 					continue
 				}
 				isProperty := strings.HasPrefix(line, propertyToken)
@@ -329,48 +652,117 @@ func findClasses(blocks [][]string, paramRe *regexp.Regexp, returnRe *regexp.Reg
 				if isProperty || isStaticProperty {
 					addProperty(cls, property)
 					newPropertyVar := newProperty(line, isStaticProperty)
+					newPropertyVar.File, newPropertyVar.Line = block.file, block.line
 					property = &newPropertyVar
 					context = &propertyToken
+					annotated = propertyAnnotations(property)
+					examples = nil
+					continue
+				}
+				if strings.HasPrefix(line, valueToken) {
+					addValue(cls, value)
+					newValueVar := newValue(line)
+					value = &newValueVar
+					context = &valueToken
+					annotated = nil
+					examples = nil
 					continue
 				}
-				if findClassTokens(cls, line) {
+				if findClassTokens(cls, line, block) {
 					context = nil
 				}
 			}
-			context = findMethodTokens(context, method, line, paramRe, returnRe)
-			updateDescriptions(line, context, cls, method, property)
+			context = findMethodTokens(context, method, line, res)
+			updateDescriptions(line, context, cls, method, property, value)
 		}
 	}
-	classes = appendClass(classes, cls, method, property)
-	return classes
+	if member != nil {
+		addMember(&namespaces, member)
+	} else {
+		classes = appendClass(classes, cls, method, property, value)
+	}
+	return classes, result.Namespaces
+}
+
+func (c custom) GenClasses(content []byte) ([]Class, error) {
+	classes, _, err := c.parse(content)
+	return classes, err
+}
+
+func (c custom) GenNamespaces(content []byte) ([]Namespace, error) {
+	_, namespaces, err := c.parse(content)
+	return namespaces, err
 }
 
-func (c custom) genClasses(content []byte) []Class {
-	var blocks [][]string
-	lines := strings.Split(string(content), "\n")
+// parse extracts the docstrings and parses the classes and the namespace members
+func (c custom) parse(content []byte) ([]Class, []Namespace, error) {
 	format := strings.Fields(c.language.Docstrings.Format)
+	if len(format) == 0 {
+		return nil, nil, &Error{Phase: PhaseConfig, Err: errors.New("docstrings should have a format")}
+	}
 	begin := format[0]
 	switch c.language.Docstrings.Type {
 	case "block":
 		if len(format) < 2 {
-			log.Fatal("Block docstrings should have a format as the begin and end tokens separated by space.")
+			return nil, nil, &Error{Phase: PhaseConfig, Err: errors.New("block docstrings should have a format as the begin and end tokens separated by space")}
 		}
-		var middle string
-		var end string
-		if len(format) > 2 {
-			middle = format[1]
-			end = format[2]
-		} else {
-			end = format[1]
-		}
-		blocks = extractBlocks(lines, begin, middle, end)
 	case "line":
 		if len(format) != 1 {
-			log.Fatal("Line docstrings should have a format as the single begin token.")
+			return nil, nil, &Error{Phase: PhaseConfig, Err: errors.New("line docstrings should have a format as the single begin token")}
+		}
+	}
+	var blocks []docstringBlock
+	files, contents := splitFiles(string(content))
+	for i, file := range files {
+		lines := strings.Split(contents[i], "\n")
+		var fileBlocks []docstringBlock
+		switch c.language.Docstrings.Type {
+		case "block":
+			var middle string
+			var end string
+			if len(format) > 2 {
+				middle = format[1]
+				end = format[2]
+			} else {
+				end = format[1]
+			}
+			fileBlocks = extractBlocks(lines, begin, middle, end)
+		case "line":
+			fileBlocks = extractLines(lines, begin)
+		}
+		for j := range fileBlocks {
+			fileBlocks[j].file = file
+		}
+		blocks = append(blocks, fileBlocks...)
+	}
+	var res docstringRes
+	var err error
+	res.param, err = regexp.Compile(c.language.Docstrings.Parameter)
+	if err != nil {
+		return nil, nil, &Error{Phase: PhaseConfig, Err: err}
+	}
+	res.returns, err = regexp.Compile(c.language.Docstrings.Return)
+	if err != nil {
+		return nil, nil, &Error{Phase: PhaseConfig, Err: err}
+	}
+	for _, optional := range []struct {
+		re   **regexp.Regexp
+		expr string
+	}{
+		{&res.throws, c.language.Docstrings.Throws},
+		{&res.deprecated, c.language.Docstrings.Deprecated},
+		{&res.since, c.language.Docstrings.Since},
+		{&res.stability, c.language.Docstrings.Stability},
+	} {
+		if optional.expr == "" {
+			continue
+		}
+		*optional.re, err = regexp.Compile(optional.expr)
+		if err != nil {
+			return nil, nil, &Error{Phase: PhaseConfig, Err: err}
 		}
-		blocks = extractLines(lines, begin)
 	}
-	paramRe := regexp.MustCompile(c.language.Docstrings.Parameter)
-	returnRe := regexp.MustCompile(c.language.Docstrings.Return)
-	return findClasses(blocks, paramRe, returnRe)
+	classes, namespaces := findClasses(blocks, res)
+	markdownDescriptions(classes, namespaces)
+	return classes, namespaces, nil
 }
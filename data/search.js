@@ -0,0 +1,61 @@
+(function () {
+  var input = document.getElementById("adx-search");
+  var results = document.getElementById("adx-search-results");
+  var index = window.adxSearchIndex;
+  var language = document.getElementById("adx-language");
+
+  // The language filter hides the classes of the other languages
+  if (language) {
+    language.addEventListener("change", function () {
+      var elements = document.querySelectorAll("[data-language]");
+      for (var i = 0; i < elements.length; i++) {
+        var lang = elements[i].getAttribute("data-language");
+        elements[i].hidden = language.value !== "" && lang !== language.value;
+      }
+      if (input) {
+        input.dispatchEvent(new Event("input"));
+      }
+    });
+  }
+  if (!input || !results || !index) {
+    return;
+  }
+
+  function item(entry) {
+    var li = document.createElement("li");
+    var a = document.createElement("a");
+    a.href = entry.url;
+    a.textContent = entry.name;
+    li.appendChild(a);
+    var info = document.createElement("span");
+    info.textContent = " " + entry.kind + " in " + entry.context;
+    li.appendChild(info);
+    return li;
+  }
+
+  input.addEventListener("input", function () {
+    var query = input.value.trim().toLowerCase();
+    results.innerHTML = "";
+    if (query.length < 2) {
+      return;
+    }
+    // Name matches go first, then the description matches
+    var byName = [];
+    var byDescription = [];
+    for (var i = 0; i < index.length; i++) {
+      var entry = index[i];
+      if (language && language.value !== "" && entry.language !== language.value) {
+        continue;
+      }
+      if (entry.name.toLowerCase().indexOf(query) >= 0) {
+        byName.push(entry);
+      } else if (entry.description.toLowerCase().indexOf(query) >= 0) {
+        byDescription.push(entry);
+      }
+    }
+    var found = byName.concat(byDescription).slice(0, 50);
+    for (var j = 0; j < found.length; j++) {
+      results.appendChild(item(found[j]));
+    }
+  });
+})();
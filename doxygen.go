@@ -0,0 +1,638 @@
+package adx
+
+import (
+	"bytes"
+	"encoding/xml"
+	"html"
+	"html/template"
+	"os"
+	"path"
+	"path/filepath"
+	"regexp"
+	"strings"
+)
+
+// doxygen runs Doxygen with the Doxyfile template of the language (java,
+// cpp or c) and parses its XML output
+type doxygen struct {
+	doxyfile string
+	// c optimizes the output for C (the structs and the free functions)
+	c        bool
+	excludes []string
+	root     string
+}
+
+func (d doxygen) SetConf(conf string) {}
+
+func (d *doxygen) SetExcludes(patterns []string) {
+	d.excludes = patterns
+}
+
+// The external tools process the sources as a whole, so there are no
+// recoverable errors
+func (d doxygen) SetErrorHandler(handler func(error)) {}
+
+func (d *doxygen) SetSourceRoot(dir string) {
+	d.root = dir
+}
+
+var refRe = regexp.MustCompile(`<ref refid="(\w+)" kindref="\w+"[^>]*>([^<]*)</ref>`)
+
+// doxyConstant checks whether the variable type is the constant: either
+// constexpr, or const and not the pointer (e.g. const char * is a variable)
+func doxyConstant(raw string) bool {
+	fields := strings.Fields(plainText(raw))
+	if len(fields) == 0 {
+		return false
+	}
+	switch fields[0] {
+	case "constexpr":
+		return true
+	case "const":
+		return !strings.Contains(strings.Join(fields[1:], " "), "*")
+	}
+	return false
+}
+
+// getText converts the references into the links
+func getText(raw string) template.HTML {
+	// #nosec
+	return template.HTML(strings.TrimSpace(refRe.ReplaceAllString(raw, "<a href=\"#$1\">$2</a>")))
+}
+
+func getPlainText(raw string) string {
+	return strings.TrimSpace(refRe.ReplaceAllString(raw, "$2"))
+}
+
+// Raw XML info
+type Raw struct {
+	RawXML string `xml:",innerxml"`
+}
+
+// ParameterName info (the exception names may have the references)
+type ParameterName struct {
+	Name Raw `xml:"parametername"`
+}
+
+// ParameterItem info
+type ParameterItem struct {
+	Names       []ParameterName `xml:"parameternamelist"`
+	Description string          `xml:"parameterdescription>para"`
+}
+
+// DoxyParameter info
+type DoxyParameter struct {
+	Kind           string          `xml:"kind,attr"`
+	ParameterItems []ParameterItem `xml:"parameteritem"`
+}
+
+// SimpleSect info
+type SimpleSect struct {
+	Kind   string `xml:"kind,attr"`
+	RawXML string `xml:",innerxml"`
+}
+
+// XRefSect info (the entry of the deprecated list or the list of the alias)
+type XRefSect struct {
+	Title       string `xml:"xreftitle"`
+	Description Raw    `xml:"xrefdescription"`
+}
+
+// ProgramListing info (the code block, e.g. @code{.cpp}); the code lines
+// have the highlight markup
+type ProgramListing struct {
+	Filename string `xml:"filename,attr"`
+	Lines    []Raw  `xml:"codeline"`
+}
+
+// Paragraph info
+type Paragraph struct {
+	Parameters     []DoxyParameter  `xml:"parameterlist"`
+	SimpleSections []SimpleSect     `xml:"simplesect"`
+	XRefSections   []XRefSect       `xml:"xrefsect"`
+	Listings       []ProgramListing `xml:"programlisting"`
+}
+
+// DetailedDesc info
+type DetailedDesc struct {
+	Paragraphs []Paragraph `xml:"para"`
+	RawXML     string      `xml:",innerxml"`
+}
+
+// skipDoxyMarkup skips the sections of the description having their own
+// fields (the parameters, exceptions, return values, annotations and examples)
+func skipDoxyMarkup(name string, attrs map[string]string) bool {
+	switch name {
+	case "parameterlist", "xrefsect", "programlisting":
+		return true
+	case "simplesect":
+		return attrs["kind"] == "return" || attrs["kind"] == "since"
+	}
+	return false
+}
+
+// details returns the rich description (the brief description followed by
+// the detailed one), if there is the detailed description
+func (d DetailedDesc) details(brief Raw) []Block {
+	blocks := parseMarkup(d.RawXML, skipDoxyMarkup)
+	if len(blocks) == 0 {
+		return nil
+	}
+	return append(parseMarkup(brief.RawXML, nil), blocks...)
+}
+
+// annotations returns the deprecation note (true if there is none), the since
+// version and the stability (the xrefitem aliases titled Experimental or Stable)
+func (d DetailedDesc) annotations() (string, string, string) {
+	var deprecated, since, stability string
+	for _, para := range d.Paragraphs {
+		for _, sect := range para.SimpleSections {
+			if sect.Kind == "since" {
+				since = plainText(sect.RawXML)
+			}
+		}
+		for _, sect := range para.XRefSections {
+			switch title := strings.TrimSpace(sect.Title); title {
+			case "Deprecated":
+				deprecated = plainText(sect.Description.RawXML)
+				if deprecated == "" {
+					deprecated = "true"
+				}
+			case "Experimental", "Stable":
+				stability = strings.ToLower(title)
+			}
+		}
+	}
+	return deprecated, since, stability
+}
+
+var spaceRe = regexp.MustCompile(`<sp\s*/>`)
+
+// examples returns the code blocks of the description (the language is the
+// extension of the block, e.g. @code{.py} is python)
+func (d DetailedDesc) examples() []Example {
+	var examples []Example
+	for _, para := range d.Paragraphs {
+		for _, listing := range para.Listings {
+			var lines []string
+			for _, line := range listing.Lines {
+				text := tagRe.ReplaceAllString(spaceRe.ReplaceAllString(line.RawXML, " "), "")
+				lines = append(lines, html.UnescapeString(text))
+			}
+			examples = append(examples, Example{
+				Language: strings.TrimPrefix(path.Ext(listing.Filename), "."),
+				Code:     strings.Join(lines, "\n"),
+			})
+		}
+	}
+	return examples
+}
+
+// Param info
+type Param struct {
+	Type    Raw    `xml:"type"`
+	Name    string `xml:"declname"`
+	Default Raw    `xml:"defval"`
+}
+
+// DoxyEnumValue info
+type DoxyEnumValue struct {
+	Name        string `xml:"name"`
+	Initializer string `xml:"initializer"`
+	Description Raw    `xml:"briefdescription>para"`
+}
+
+// Location info (the file as Doxygen reports it, the generator makes it
+// relative to the source root)
+type Location struct {
+	File string `xml:"file,attr"`
+	Line int    `xml:"line,attr"`
+}
+
+// MemberDef info
+type MemberDef struct {
+	Kind         string          `xml:"kind,attr"`
+	ID           string          `xml:"id,attr"`
+	Virt         string          `xml:"virt,attr"`
+	Name         string          `xml:"name"`
+	Type         Raw             `xml:"type"`
+	Description  Raw             `xml:"briefdescription>para"`
+	Parameters   []Param         `xml:"param"`
+	DetailedDesc DetailedDesc    `xml:"detaileddescription"`
+	EnumValues   []DoxyEnumValue `xml:"enumvalue"`
+	Location     Location        `xml:"location"`
+}
+
+// SectionDef info
+type SectionDef struct {
+	Kind    string      `xml:"kind,attr"`
+	Members []MemberDef `xml:"memberdef"`
+}
+
+// BaseCompoundRef info
+type BaseCompoundRef struct {
+	Ref  string `xml:"refid,attr"`
+	Name string `xml:",chardata"`
+}
+
+// CompoundDef info
+type CompoundDef struct {
+	Kind           string            `xml:"kind,attr"`
+	Ref            string            `xml:"id,attr"`
+	Name           string            `xml:"compoundname"`
+	Bases          []BaseCompoundRef `xml:"basecompoundref"`
+	Sections       []SectionDef      `xml:"sectiondef"`
+	Description    Raw               `xml:"briefdescription>para"`
+	DetailedDesc   DetailedDesc      `xml:"detaileddescription"`
+	TemplateParams []Param           `xml:"templateparamlist>param"`
+	Location       Location          `xml:"location"`
+}
+
+func genDoxyMethodReturn(member MemberDef, returnDesc template.HTML) Returns {
+	returnType := getText(member.Type.RawXML)
+	return Returns{
+		Type:        returnType,
+		Description: returnDesc,
+	}
+}
+
+func genDoxyMethod(member MemberDef, sectionKind string) Method {
+	var returnDesc template.HTML
+	var parameters []Parameter
+	var throws []Exception
+	paramDesc := map[string]string{}
+	for _, para := range member.DetailedDesc.Paragraphs {
+		for _, sect := range para.SimpleSections {
+			if sect.Kind == "return" {
+				returnDesc = getText(sect.RawXML)
+			}
+		}
+		for _, param := range para.Parameters {
+			for _, item := range param.ParameterItems {
+				for _, name := range item.Names {
+					switch param.Kind {
+					case "param":
+						paramDesc[getPlainText(name.Name.RawXML)] = item.Description
+					case "exception":
+						throws = append(throws, Exception{
+							Type:        getText(name.Name.RawXML),
+							Description: strings.TrimSpace(item.Description),
+						})
+					}
+				}
+			}
+		}
+	}
+	for _, param := range member.Parameters {
+		name := param.Name
+		parameters = append(parameters, Parameter{
+			Name:        name,
+			Type:        getText(param.Type.RawXML),
+			Description: strings.TrimSpace(paramDesc[name]),
+			Default:     plainText(param.Default.RawXML),
+		})
+	}
+	ret := genDoxyMethodReturn(member, returnDesc)
+	access := ""
+	if sectionKind == "public-static-func" {
+		access = "static"
+	}
+	virtual := ""
+	if member.Virt != "non-virtual" {
+		virtual = member.Virt
+	}
+	method := Method{
+		Name:        member.Name,
+		Description: getPlainText(member.Description.RawXML),
+		Details:     member.DetailedDesc.details(member.Description),
+		Returns:     ret,
+		Parameters:  parameters,
+		Throws:      throws,
+		Access:      access,
+		Virtual:     virtual,
+		Examples:    member.DetailedDesc.examples(),
+		File:        member.Location.File,
+		Line:        member.Location.Line,
+	}
+	method.Deprecated, method.Since, method.Stability = member.DetailedDesc.annotations()
+	return method
+}
+
+// templateParams returns the names of the template parameters (e.g. <T>)
+func templateParams(params []Param) string {
+	if params == nil {
+		return ""
+	}
+	var names []string
+	for _, param := range params {
+		name := param.Name
+		if name == "" {
+			// The parameters without the declaration names (e.g. typename T)
+			fields := strings.Fields(plainText(param.Type.RawXML))
+			if len(fields) > 0 {
+				name = fields[len(fields)-1]
+			}
+		}
+		names = append(names, name)
+	}
+	return "<" + strings.Join(names, ", ") + ">"
+}
+
+func genDoxyProperty(member MemberDef, access string) Property {
+	prop := Property{
+		Name:        member.Name,
+		Description: getPlainText(member.Description.RawXML),
+		Details:     member.DetailedDesc.details(member.Description),
+		Type:        getText(member.Type.RawXML),
+		Access:      access,
+		File:        member.Location.File,
+		Line:        member.Location.Line,
+	}
+	prop.Deprecated, prop.Since, prop.Stability = member.DetailedDesc.annotations()
+	return prop
+}
+
+func genDoxyClass(def CompoundDef) Class {
+	cls := Class{
+		Name:        def.Name + templateParams(def.TemplateParams),
+		Description: getPlainText(def.Description.RawXML),
+		Details:     def.DetailedDesc.details(def.Description),
+		Ref:         def.Ref,
+		Examples:    def.DetailedDesc.examples(),
+		File:        def.Location.File,
+		Line:        def.Location.Line,
+	}
+	cls.Deprecated, cls.Since, cls.Stability = def.DetailedDesc.annotations()
+	if def.Kind != "class" {
+		cls.Kind = def.Kind
+	}
+	for _, base := range def.Bases {
+		// The documented interfaces have the refs of the interface compounds
+		if strings.HasPrefix(base.Ref, "interface") {
+			cls.Implements = append(cls.Implements, base.Name)
+		} else {
+			cls.Extends = append(cls.Extends, base.Name)
+		}
+	}
+	for _, section := range def.Sections {
+		sectionKind := section.Kind
+		if sectionKind == "public-attrib" || sectionKind == "public-static-attrib" {
+			access := ""
+			if sectionKind == "public-static-attrib" {
+				access = "static"
+			}
+			for _, member := range section.Members {
+				cls.Properties = append(cls.Properties, genDoxyProperty(member, access))
+			}
+		}
+		if sectionKind == "public-static-func" || sectionKind == "public-func" {
+			for _, member := range section.Members {
+				if strings.HasPrefix(member.Name, "~") {
+					// The destructors
+					continue
+				}
+				method := genDoxyMethod(member, sectionKind)
+				if method.Returns.Type == "" {
+					cls.Constructors = append(cls.Constructors, method)
+				} else {
+					cls.Methods = append(cls.Methods, method)
+				}
+			}
+		}
+	}
+	return cls
+}
+
+// genDoxyEnum converts the enum with its values
+func genDoxyEnum(scope string, member MemberDef) Class {
+	name := member.Name
+	if scope != "" {
+		name = scope + "::" + name
+	}
+	cls := Class{
+		Name:        name,
+		Kind:        "enum",
+		Description: getPlainText(member.Description.RawXML),
+		Details:     member.DetailedDesc.details(member.Description),
+		Ref:         member.ID,
+		File:        member.Location.File,
+		Line:        member.Location.Line,
+	}
+	cls.Deprecated, cls.Since, cls.Stability = member.DetailedDesc.annotations()
+	for _, value := range member.EnumValues {
+		cls.Values = append(cls.Values, EnumValue{
+			Name:        value.Name,
+			Description: getPlainText(value.Description.RawXML),
+			Value:       strings.TrimSpace(strings.TrimPrefix(strings.TrimSpace(value.Initializer), "=")),
+		})
+	}
+	return cls
+}
+
+// compounds parses the combined Doxygen XML
+func (d doxygen) compounds(xmlContent []byte) ([]CompoundDef, error) {
+	type Result struct {
+		XMLName xml.Name      `xml:"doxygen"`
+		Defs    []CompoundDef `xml:"compounddef"`
+	}
+
+	if xmlContent == nil {
+		return nil, nil
+	}
+	var v Result
+	if err := xml.Unmarshal(xmlContent, &v); err != nil {
+		return nil, withContext(err, PhaseParse, "")
+	}
+	return v.Defs, nil
+}
+
+// doxyScope is the scope of the compound members (the files are global)
+func doxyScope(def CompoundDef) string {
+	if def.Kind == "file" {
+		return ""
+	}
+	return def.Name
+}
+
+// GenClasses converts the classes, interfaces, protocols, structs and
+// unions, and the enums of any scope
+func (d doxygen) GenClasses(xmlContent []byte) ([]Class, error) {
+	defs, err := d.compounds(xmlContent)
+	if err != nil {
+		return nil, err
+	}
+
+	var classes []Class
+	seen := map[string]bool{}
+	for _, def := range defs {
+		switch def.Kind {
+		case "class", "interface", "protocol", "struct", "union":
+			classes = append(classes, genDoxyClass(def))
+		}
+		for _, section := range def.Sections {
+			if section.Kind != "enum" && section.Kind != "public-type" {
+				continue
+			}
+			for _, member := range section.Members {
+				if member.Kind == "enum" && !seen[member.ID] {
+					seen[member.ID] = true
+					classes = append(classes, genDoxyEnum(doxyScope(def), member))
+				}
+			}
+		}
+	}
+	rootSources(classes, nil, d.root)
+	return classes, nil
+}
+
+// GenNamespaces converts the free functions, constants and variables of the
+// namespaces and the files (the global namespace)
+func (d doxygen) GenNamespaces(xmlContent []byte) ([]Namespace, error) {
+	defs, err := d.compounds(xmlContent)
+	if err != nil {
+		return nil, err
+	}
+
+	var namespaces []Namespace
+	index := map[string]int{}
+	seen := map[string]bool{}
+	for _, def := range defs {
+		if def.Kind != "namespace" && def.Kind != "file" {
+			continue
+		}
+		var ns Namespace
+		for _, section := range def.Sections {
+			for _, member := range section.Members {
+				if seen[member.ID] {
+					continue
+				}
+				seen[member.ID] = true
+				switch {
+				case section.Kind == "func":
+					ns.Functions = append(ns.Functions, genDoxyMethod(member, section.Kind))
+				case section.Kind == "var" && doxyConstant(member.Type.RawXML):
+					ns.Constants = append(ns.Constants, genDoxyProperty(member, ""))
+				case section.Kind == "var":
+					ns.Variables = append(ns.Variables, genDoxyProperty(member, ""))
+				}
+			}
+		}
+		if ns.Functions == nil && ns.Constants == nil && ns.Variables == nil {
+			continue
+		}
+		ns.Name = doxyScope(def)
+		if ns.Name == "" {
+			ns.Name = "Global"
+		} else {
+			ns.Description = getPlainText(def.Description.RawXML)
+			ns.Details = def.DetailedDesc.details(def.Description)
+		}
+		if i, ok := index[ns.Name]; ok {
+			namespaces[i].Functions = append(namespaces[i].Functions, ns.Functions...)
+			namespaces[i].Constants = append(namespaces[i].Constants, ns.Constants...)
+			namespaces[i].Variables = append(namespaces[i].Variables, ns.Variables...)
+			continue
+		}
+		index[ns.Name] = len(namespaces)
+		namespaces = append(namespaces, ns)
+	}
+	rootSources(nil, namespaces, d.root)
+	return namespaces, nil
+}
+
+func (d doxygen) GenIntermediate(srcDir string) ([]byte, error) {
+	// Doxygen matches the patterns with the absolute paths
+	var excludes []string
+	for _, pattern := range d.excludes {
+		pattern = strings.TrimSuffix(pattern, "/")
+		excludes = append(excludes, "*/"+pattern, "*/"+pattern+"/*")
+	}
+	doxyfile, err := renderTemplate(d.doxyfile, struct {
+		Src     string
+		Exclude string
+		C       bool
+	}{
+		srcDir,
+		strings.Join(excludes, " "),
+		d.c,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	docsDir := "build/docs"
+	if err = createDir(docsDir); err != nil {
+		return nil, err
+	}
+
+	cmd := newCmd("doxygen", "-")
+	cmd.Stdin = bytes.NewReader(doxyfile)
+	if err = cmd.Run(); err != nil {
+		return nil, err
+	}
+
+	return ReadDoxygenXML(path.Join(docsDir, "xml"))
+}
+
+// ReadDoxygenXML merges the compound files listed in the index.xml of the
+// Doxygen XML output dir into the single document
+func ReadDoxygenXML(dir string) ([]byte, error) {
+	type Compound struct {
+		Ref string `xml:"refid,attr"`
+	}
+	type Index struct {
+		XMLName   xml.Name   `xml:"doxygenindex"`
+		Compounds []Compound `xml:"compound"`
+	}
+	type Result struct {
+		XMLName xml.Name `xml:"doxygen"`
+		Defs    string   `xml:",innerxml"`
+	}
+
+	indexFile := filepath.Join(dir, "index.xml")
+	// #nosec
+	content, err := os.ReadFile(indexFile)
+	if err != nil {
+		return nil, withContext(err, PhaseSource, indexFile)
+	}
+	var index Index
+	if err = xml.Unmarshal(content, &index); err != nil {
+		return nil, withContext(err, PhaseParse, indexFile)
+	}
+
+	var defs strings.Builder
+	for _, compound := range index.Compounds {
+		file := filepath.Join(dir, compound.Ref+".xml")
+		// #nosec
+		content, err = os.ReadFile(file)
+		if err != nil {
+			return nil, withContext(err, PhaseSource, file)
+		}
+		var def Result
+		if err = xml.Unmarshal(content, &def); err != nil {
+			return nil, withContext(err, PhaseParse, file)
+		}
+		defs.WriteString(def.Defs)
+	}
+	return xml.Marshal(Result{Defs: defs.String()})
+}
+
+func (d doxygen) CombineIntermediate(a []byte, b []byte) ([]byte, error) {
+	type Result struct {
+		XMLName xml.Name `xml:"doxygen"`
+		Defs    string   `xml:",innerxml"`
+	}
+
+	var aContent Result
+	if err := xml.Unmarshal(a, &aContent); err != nil {
+		return nil, err
+	}
+
+	var bContent Result
+	if err := xml.Unmarshal(b, &bContent); err != nil {
+		return nil, err
+	}
+
+	aContent.Defs = aContent.Defs + bContent.Defs
+	return xml.Marshal(aContent)
+}
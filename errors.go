@@ -0,0 +1,93 @@
+package adx
+
+import (
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// Phase is the stage of the documentation build
+type Phase string
+
+// The phases of the documentation build
+const (
+	// PhaseConfig is reading the project, custom languages and template variables
+	PhaseConfig Phase = "config"
+	// PhaseSource is reading the sources and running the external tools
+	PhaseSource Phase = "source"
+	// PhaseParse is parsing the intermediate content of the generators
+	PhaseParse Phase = "parse"
+	// PhaseInput is reading the adx XML and JSON documents
+	PhaseInput Phase = "input"
+	// PhaseRender is rendering and writing the outputs
+	PhaseRender Phase = "render"
+)
+
+// Error is the failure of the documentation build with its location
+// (the file and line are optional)
+type Error struct {
+	Phase Phase
+	File  string
+	Line  int
+	Err   error
+}
+
+func (e *Error) Error() string {
+	var location string
+	switch {
+	case e.File != "" && e.Line > 0:
+		location = fmt.Sprintf("%s:%d: ", e.File, e.Line)
+	case e.File != "":
+		location = e.File + ": "
+	case e.Line > 0:
+		location = fmt.Sprintf("line %d: ", e.Line)
+	}
+	return fmt.Sprintf("[%s] %s%v", e.Phase, location, e.Err)
+}
+
+func (e *Error) Unwrap() error {
+	return e.Err
+}
+
+// withContext adds the phase and the file to the error, keeping the details
+// of the already structured errors (the copy of the structured error keeps
+// the messages of the errors wrapping it)
+func withContext(err error, phase Phase, file string) error {
+	if err == nil {
+		return nil
+	}
+	var inner *Error
+	if !errors.As(err, &inner) {
+		e := &Error{Phase: phase, File: file, Err: err}
+		var syntaxErr *xml.SyntaxError
+		if errors.As(err, &syntaxErr) {
+			e.Line = syntaxErr.Line
+		}
+		return e
+	}
+	e := *inner
+	if e.File == "" {
+		e.File = file
+	}
+	if err != error(inner) {
+		// The location is in the copy, so it's dropped from the message
+		msg := strings.Replace(err.Error(), inner.Error(), inner.Err.Error(), 1)
+		e.Err = wrappedError{msg, err}
+	}
+	return &e
+}
+
+// wrappedError is the error with the message replaced (the chain is kept)
+type wrappedError struct {
+	msg string
+	err error
+}
+
+func (w wrappedError) Error() string {
+	return w.msg
+}
+
+func (w wrappedError) Unwrap() error {
+	return w.err
+}
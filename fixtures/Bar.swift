@@ -7,6 +7,7 @@ public class Bar {
      * Static Property: STATIC_PROP
      *
      * Static property.
+     * - Deprecated: Use defaultValue.
      */
     public static let STATIC_PROP = "Static property"
 
@@ -16,6 +17,7 @@ public class Bar {
      *
      * - Parameter value: The value.
      * - Returns: A Bar instance.
+     * - Since: 1.2
      */
     public static func staticMethod(_ value: String) -> Bar {
         return Bar()
@@ -27,8 +29,15 @@ public class Bar {
      *
      * - Parameter value: The value.
      * - Returns: The string.
+     * - Throws: BarError If the value is empty.
+     *
+     * ```swift
+     * if let text = try? Bar().instanceMethod("value") {
+     *     print(text)
+     * }
+     * ```
      */
-    public func instanceMethod(_ value: String) -> String {
+    public func instanceMethod(_ value: String) throws -> String {
         return value
     }
 
@@ -39,3 +48,51 @@ public class Bar {
     public init() {
     }
 }
+
+/**
+ * Protocol: Drawable
+ * The drawable type.
+ * - Stability: experimental
+ */
+public protocol Drawable {
+    /**
+     * Method: draw
+     * Draws the type.
+     *
+     * Uses the `context` of the **current** view:
+     * - the *bounds* of the [Bar](#GlobalBar),
+     * - the [graphics](https://developer.apple.com/documentation/coregraphics) state.
+     */
+    func draw()
+}
+
+/**
+ * Enum: Direction
+ * The directions.
+ *
+ * Value: north = "N"
+ * The north direction.
+ *
+ * Value: south
+ */
+public enum Direction: String {
+    case north = "N"
+    case south
+}
+
+/**
+ * Function: makeBar
+ * Creates the bar.
+ *
+ * - Parameter value: The value.
+ * - Returns: The new bar.
+ */
+public func makeBar(_ value: String) -> Bar {
+    return Bar()
+}
+
+/**
+ * Constant: defaultValue
+ * The default value.
+ */
+public let defaultValue = "bar"
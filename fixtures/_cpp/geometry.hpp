@@ -0,0 +1,87 @@
+#pragma once
+
+/** The plane geometry. */
+namespace geo {
+
+/** The point on the plane. @since 1.1 */
+struct Point {
+    /** The abscissa. */
+    double x;
+    /** The ordinate. */
+    double y;
+};
+
+/** The colors of the shapes. */
+enum class Color {
+    Red = 1,   /**< The red color. */
+    Green = 2  /**< The green color. */
+};
+
+/** The shape on the plane. */
+class Shape {
+public:
+    /** Computes the area. */
+    virtual double area() const = 0;
+    /** Returns the name of the shape. */
+    virtual const char *name() const;
+};
+
+/** The generic container. */
+template <typename T>
+class Box : public Shape {
+public:
+    /** Creates the empty box. */
+    Box();
+    /**
+     * Creates the box.
+     * @param capacity The maximum number of items.
+     * @throws std::invalid_argument If the capacity is negative.
+     */
+    explicit Box(int capacity);
+    virtual ~Box();
+    /**
+     * Adds the item.
+     * @param item The item to add.
+     * @return The index of the item.
+     * @code{.cpp}
+     * Box<int> box;
+     * int index = box.add(42); // 0
+     * @endcode
+     */
+    int add(const T &item);
+    /**
+     * Adds the point several times.
+     * @param point The point to add.
+     * @param count The number of copies.
+     * @return The index of the last copy.
+     */
+    int add(const Point &point, int count = 1);
+    /** Computes the area. */
+    virtual double area() const = 0;
+    /** Counts the boxes. @deprecated Use the size of the box. */
+    static int count();
+    /** The default capacity. */
+    static const int CAPACITY = 8;
+};
+
+/**
+ * Computes the distance.
+ *
+ * Uses `std::hypot` of the *coordinate* differences:
+ * - the horizontal one,
+ * - the vertical one.
+ *
+ * See Point and <a href="https://en.wikipedia.org/wiki/Euclidean_distance">the definition</a>.
+ * @param a The first point.
+ * @param b The second point.
+ * @return The Euclidean distance.
+ */
+double distance(const Point &a, const Point &b);
+
+/** The tolerance of the comparisons. */
+const double EPSILON = 1e-9;
+
+/** The number of the created shapes. */
+extern int shapeCount;
+
+}
@@ -0,0 +1,89 @@
+// Package geometry provides the basic shapes.
+package geometry
+
+import "errors"
+
+// Epsilon is the precision of the calculations.
+const Epsilon = 1e-9
+
+// The measurement systems
+const (
+	// Metric is the metric system.
+	Metric   = "metric"
+	Imperial = "imperial" // Imperial is the imperial system.
+	internal = "internal"
+)
+
+// DefaultSystem is the measurement system of the new shapes.
+var DefaultSystem = Metric
+
+// Kind is the shape kind.
+type Kind int
+
+// The shape kinds
+const (
+	KindCircle Kind = iota
+	KindRect
+)
+
+// Shape is the closed figure.
+type Shape interface {
+	// Area calculates the area of the shape.
+	Area() float64
+}
+
+// Point is the location on the plane.
+type Point struct {
+	// X is the horizontal coordinate.
+	X   float64
+	Y   float64 // Y is the vertical coordinate.
+	tag string
+}
+
+// Rect is the axis-aligned rectangle.
+type Rect struct {
+	Point
+	// Width and Height are the rectangle sizes.
+	Width, Height float64
+}
+
+// NewRect creates the rectangle at the origin.
+func NewRect(width, height float64) *Rect {
+	return &Rect{Width: width, Height: height}
+}
+
+// Area calculates the area of the rectangle.
+func (r *Rect) Area() float64 {
+	return r.Width * r.Height
+}
+
+// Contains checks if the point is inside the rectangle.
+func (r Rect) Contains(p Point) bool {
+	return p.X >= r.X && p.X <= r.X+r.Width && p.Y >= r.Y && p.Y <= r.Y+r.Height
+}
+
+// Scale returns the scaled rectangle, the factor should be positive.
+func (r *Rect) Scale(factor float64) (*Rect, error) {
+	if factor <= 0 {
+		return nil, errors.New("non-positive factor")
+	}
+	return NewRect(r.Width*factor, r.Height*factor), nil
+}
+
+func (r *Rect) normalize() {}
+
+// Sum sums the areas of the shapes.
+func Sum(shapes ...Shape) float64 {
+	var sum float64
+	for _, shape := range shapes {
+		sum += shape.Area()
+	}
+	return sum
+}
+
+// Total sums the areas of the shapes.
+//
+// Deprecated: Use Sum instead.
+func Total(shapes ...Shape) float64 {
+	return Sum(shapes...)
+}
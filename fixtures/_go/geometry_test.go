@@ -0,0 +1,5 @@
+package geometry_test
+
+import "testing"
+
+func TestSum(t *testing.T) {}
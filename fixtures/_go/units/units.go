@@ -0,0 +1,10 @@
+// Package units converts the measurement units.
+package units
+
+// FootMeters is the length of the foot in meters.
+const FootMeters = 0.3048
+
+// ToMeters converts the feet to meters.
+func ToMeters(feet float64) float64 {
+	return feet * FootMeters
+}
@@ -0,0 +1,100 @@
+/**
+ * The geometry helpers.
+ * @namespace geo
+ */
+const geo = {};
+
+/**
+ * The rectangle.
+ * @memberof geo
+ * @implements {Shape}
+ * @fires geo.Rect#resize
+ * @experimental
+ */
+geo.Rect = class {
+  /**
+   * Creates the rectangle.
+   * @param {number} width - The width.
+   * @param {number} [height=1] - The height.
+   */
+  constructor(width, height = 1) {
+    /** The width of the rectangle. @type {number} */
+    this.width = width;
+    this.height = height;
+  }
+
+  /**
+   * Computes the area.
+   * @returns {number} The area.
+   * @since 1.2
+   */
+  area() {
+    return this.width * this.height;
+  }
+
+  /**
+   * Scales the rectangle.
+   * @param {?geo.Rect} other - The rectangle to match.
+   * @returns {geo.Rect} The scaled rectangle.
+   * @deprecated Use the constructor.
+   */
+  static scale(other) {
+    return new geo.Rect(other.width, other.height);
+  }
+
+  /** @private */
+  _resize() {}
+};
+
+/**
+ * The colors.
+ * @readonly
+ * @enum {string}
+ */
+geo.Color = {
+  /** The red color. */
+  RED: 'red',
+  /** The green color. */
+  GREEN: 'green'
+};
+
+/**
+ * The drawable shape.
+ * @interface
+ */
+function Shape() {}
+
+/**
+ * Draws the shape.
+ * @param {CanvasRenderingContext2D} ctx - The canvas context.
+ */
+Shape.prototype.draw = function(ctx) {};
+
+/**
+ * Computes the distance between the points.
+ *
+ * Uses `Math.hypot` of the **coordinate** differences:
+ * - the horizontal one,
+ * - the vertical one.
+ * @param {Array.<number>} a - The first point.
+ * @param {Array.<number>} b - The second point.
+ * @returns {number} The distance.
+ * @throws {RangeError} If the points have different dimensions.
+ * @example <caption>Two points</caption>
+ * distance([0, 0], [3, 4]); // 5
+ */
+function distance(a, b) {
+  return Math.hypot(a[0] - b[0], a[1] - b[1]);
+}
+
+/**
+ * The comparison precision.
+ * @constant {number}
+ */
+const EPSILON = 1e-9;
+
+/**
+ * The number of the created shapes.
+ * @type {number}
+ */
+let shapeCount = 0;
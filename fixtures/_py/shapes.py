@@ -0,0 +1,168 @@
+"""Shapes of the plane geometry."""
+
+from abc import ABC, abstractmethod
+from enum import Enum
+from typing import ClassVar, List, Optional, Protocol
+
+# The tolerance of the comparisons
+EPSILON: float = 1e-9
+"""The tolerance of the float comparisons."""
+
+default_scale: float = 1.0
+"""The scale of the new shapes."""
+
+_cache = {}
+
+
+class Shape(ABC):
+    """The closed figure."""
+
+    @abstractmethod
+    def area(self) -> float:
+        """Computes the area.
+
+        Returns:
+            float: The area of the shape.
+        """
+
+
+class Drawable(Protocol):
+    """The shape drawn on the canvas."""
+
+    def draw(self) -> None:
+        """Draws the shape."""
+
+
+class Color(Enum):
+    """The colors of the shapes."""
+
+    RED = "red"
+    """The red color."""
+    GREEN = "green"
+
+
+class Point:
+    """The point on the plane.
+
+    Args:
+        x (float): The abscissa.
+        y (float): The ordinate.
+    """
+
+    ORIGIN: ClassVar["Point"]
+    x: float
+    """The abscissa."""
+
+    def __init__(self, x: float = 0.0, y: float = 0.0):
+        self.x = x
+        self.y = y
+
+    def distance(self, other: "Point") -> float:
+        """Computes the distance to the other point.
+
+        Args:
+            other: The other point.
+
+        Returns:
+            The Euclidean distance.
+
+        Examples:
+            >>> Point(0, 0).distance(Point(3, 4))
+            5.0
+        """
+        return ((self.x - other.x) ** 2 + (self.y - other.y) ** 2) ** 0.5
+
+    def _squared(self):
+        return self.x ** 2 + self.y ** 2
+
+
+class Rect(Shape):
+    """The axis-aligned rectangle."""
+
+    def __init__(self, corner: Point, width: float,
+                 height: float) -> None:
+        """Creates the rectangle.
+
+        Parameters
+        ----------
+        corner : Point
+            The bottom-left corner.
+        width, height : float
+            The size of the rectangle.
+
+        Raises
+        ------
+        ValueError
+            If the size is negative.
+        """
+        self.corner = corner
+        self.width = width
+        self.height = height
+
+    @property
+    def size(self) -> "tuple[float, float]":
+        """The width and the height."""
+        return self.width, self.height
+
+    @size.setter
+    def size(self, value):
+        self.width, self.height = value
+
+    def area(self) -> float:
+        """Computes the area.
+
+        Returns
+        -------
+        float
+            The product of the sides.
+        """
+        return self.width * self.height
+
+    def contains(self, point: Point, strict: bool = False) -> bool:
+        """Checks if the point is inside.
+
+        :param point: The point to check.
+        :param strict: Excludes the border.
+        :returns: True if the point is inside.
+        """
+        return True
+
+    @staticmethod
+    def square(corner: Point, side: float) -> "Rect":
+        """Creates the square."""
+        return Rect(corner, side, side)
+
+    @classmethod
+    def unit(cls) -> "Rect":
+        """Creates the unit square at the origin."""
+        return cls(Point(), 1, 1)
+
+    class Builder:
+        """Builds the rectangles step by step."""
+
+        def build(self) -> "Rect":
+            """Returns the rectangle."""
+
+
+def total_area(shapes: List[Shape], *, scale: Optional[float] = None) -> float:
+    """Sums the areas of the shapes.
+
+    Args:
+        shapes: The shapes to sum.
+        scale (float, optional): The scale factor
+            applied to each area.
+
+    Returns:
+        float: The total area.
+
+    Raises:
+        ValueError: If the scale is negative.
+    """
+    class _Helper:
+        def run(self):
+            pass
+    return sum(shape.area() for shape in shapes)
+
+
+def _private():
+    pass
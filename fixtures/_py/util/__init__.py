@@ -0,0 +1,16 @@
+"""The helper functions."""
+
+VERSION = '1.0'
+
+
+def clamp(value, low, high):
+    """Clamps the value.
+
+    :param value: The value to clamp.
+    :type value: float
+    :param float low: The lower bound.
+    :param float high: The upper bound.
+    :returns: The value within the bounds.
+    :rtype: float
+    """
+    return max(low, min(value, high))
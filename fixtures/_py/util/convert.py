@@ -0,0 +1,16 @@
+def to_meters(feet, *args, **kwargs):
+    """Converts the feet to the meters.
+
+    Parameters
+    ----------
+    feet : float
+        The length in feet.
+    *args
+        The ignored arguments.
+
+    Returns
+    -------
+    meters : float
+        The length in meters.
+    """
+    return feet * 0.3048
@@ -0,0 +1,20 @@
+/** The global geometry helpers. */
+declare namespace Geometry.Units {
+    /** The length units. */
+    const enum Unit { Meter, Foot }
+
+    /** The unit of the new lengths. */
+    let defaultUnit: Unit;
+
+    /**
+     * Converts the length.
+     * @param value The length.
+     * @param unit The unit of the length.
+     * @throws {RangeError} If the length is negative.
+     * @example
+     * ```ts
+     * const meters = toMeters(12, Unit.Foot);
+     * ```
+     */
+    function toMeters(value: number, unit: Unit): number;
+}
@@ -0,0 +1,76 @@
+import { EventEmitter } from "events";
+
+/** The closed figure. */
+export interface Shape {
+    /** The name of the shape. */
+    readonly name: string
+    /**
+     * Computes the area.
+     * @returns The area of the shape.
+     */
+    area(): number;
+    /** Optional hook. */
+    describe?(prefix?: string): string;
+    [key: string]: unknown;
+}
+
+/**
+ * The generic container.
+ */
+export declare class Box<T extends Shape = Shape> extends EventEmitter
+    implements Iterable<T> {
+    /** The default capacity. */
+    static readonly CAPACITY: number;
+    protected items: T[];
+    private secret;
+    #hidden: number;
+    /**
+     * Creates the box.
+     * @param capacity - The maximum number of items.
+     */
+    constructor(capacity?: number);
+    /**
+     * Adds the items.
+     * @param items - The items to add.
+     * @returns The box itself.
+     */
+    add(...items: T[]): this;
+    /**
+     * Finds the item.
+     * @param predicate The condition.
+     * @param [from=0] The start index.
+     */
+    find(predicate: (item: T, index: number) => boolean, from?: number): T | undefined;
+    /** The number of items. */
+    get size(): number;
+    protected static merge<U extends Shape>(a: Box<U>, b: Box<U>): Box<U>;
+    private check(): void;
+    [Symbol.iterator](): Iterator<T>;
+}
+
+/** The colors of the shapes. */
+export declare enum Color {
+    /** The red color. */
+    Red = "red",
+    Green = "green"
+}
+
+/**
+ * Sums the areas.
+ * @param shapes The shapes.
+ * @param scale The scale factor.
+ */
+export declare function totalArea(shapes: Shape[], scale?: number): number;
+
+/** The unit square. */
+export declare const UNIT: Box<Shape>;
+
+export type Listener = (shape: Shape) => void;
+
+/**
+ * Computes the total area.
+ * @deprecated Use the area property.
+ * @since 1.2
+ * @experimental
+ */
+export declare function total(shapes: Shape[]): number;
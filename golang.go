@@ -0,0 +1,394 @@
+package adx
+
+import (
+	"encoding/json"
+	"fmt"
+	"go/ast"
+	"go/doc"
+	"go/parser"
+	"go/scanner"
+	"go/token"
+	"go/types"
+	"html"
+	"html/template"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// golang parses the Go packages with go/doc; the intermediate content is
+// the adx JSON document
+type golang struct {
+	adxContent
+	excludes []string
+	onError  func(error)
+	root     string
+}
+
+func (g golang) SetConf(conf string) {}
+
+func (g *golang) SetExcludes(patterns []string) {
+	g.excludes = patterns
+}
+
+func (g *golang) SetErrorHandler(handler func(error)) {
+	g.onError = handler
+}
+
+func (g *golang) SetSourceRoot(dir string) {
+	g.root = dir
+}
+
+// isGoIgnored checks the dirs ignored by the go tool (testdata, vendor
+// and the ones starting with . or _)
+func isGoIgnored(rel string) bool {
+	for _, elem := range strings.Split(filepath.ToSlash(filepath.Dir(rel)), "/") {
+		if elem == "testdata" || elem == "vendor" ||
+			(elem != "." && (strings.HasPrefix(elem, ".") || strings.HasPrefix(elem, "_"))) {
+			return true
+		}
+	}
+	return false
+}
+
+func (g golang) GenIntermediate(srcDir string) ([]byte, error) {
+	fset := token.NewFileSet()
+	dirs := map[string][]*ast.File{}
+	err := walkSources(srcDir, g.excludes, func(path string, info os.FileInfo) error {
+		rel, err := filepath.Rel(srcDir, path)
+		if err != nil {
+			return err
+		}
+		if filepath.Ext(path) != ".go" || isGoIgnored(rel) {
+			return nil
+		}
+		file, err := parser.ParseFile(fset, path, nil, parser.ParseComments)
+		if err != nil {
+			err = goError(err, path)
+			if g.onError == nil {
+				return err
+			}
+			g.onError(err)
+			return nil
+		}
+		dir := filepath.Dir(rel)
+		dirs[dir] = append(dirs[dir], file)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var dirNames []string
+	for dir := range dirs {
+		dirNames = append(dirNames, dir)
+	}
+	sort.Strings(dirNames)
+	var result AdxResult
+	for _, dir := range dirNames {
+		files := dirs[dir]
+		// The external test packages only have the examples
+		var pkgFiles []*ast.File
+		for _, file := range files {
+			if !strings.HasSuffix(file.Name.Name, "_test") {
+				pkgFiles = append(pkgFiles, file)
+			}
+		}
+		if pkgFiles == nil {
+			continue
+		}
+		pkg, err := doc.NewFromFiles(fset, pkgFiles, filepath.ToSlash(dir))
+		if err != nil {
+			return nil, &Error{Phase: PhaseParse, File: filepath.Join(srcDir, dir), Err: err}
+		}
+		ns := pkg.Name
+		if dir != "." {
+			ns = strings.ReplaceAll(filepath.ToSlash(dir), "/", "::")
+		}
+		classes, namespace := genGoPackage(goPackage{fset: fset, root: g.root}, pkg, ns)
+		result.Classes = append(result.Classes, classes...)
+		result.Namespaces = append(result.Namespaces, namespace)
+	}
+	return json.Marshal(result)
+}
+
+// goError adds the position of the syntax error
+func goError(err error, path string) error {
+	if list, ok := err.(scanner.ErrorList); ok && len(list) > 0 {
+		return &Error{Phase: PhaseParse, File: path, Line: list[0].Pos.Line, Err: fmt.Errorf("%s", list[0].Msg)}
+	}
+	return withContext(err, PhaseSource, path)
+}
+
+var goIdentRe = regexp.MustCompile(`\w+(\.\w+)*`)
+var nonWordRe = regexp.MustCompile(`\W`)
+
+// goPackage converts the declarations linking the types of the package
+type goPackage struct {
+	fset *token.FileSet
+	root string
+	refs typeLinker
+}
+
+// position is the file and the line of the declaration
+func (p goPackage) position(pos token.Pos) (string, int) {
+	position := p.fset.Position(pos)
+	return sourcePath(p.root, position.Filename), position.Line
+}
+
+func goRef(ns string, name string) string {
+	return nonWordRe.ReplaceAllString(ns, "_") + "_" + name
+}
+
+// typeLinker links the identifiers of the escaped types to the class anchors
+type typeLinker map[string]string
+
+func (l typeLinker) link(t *template.HTML) {
+	// #nosec
+	*t = template.HTML(goIdentRe.ReplaceAllStringFunc(string(*t), func(ident string) string {
+		if ref, ok := l[ident]; ok {
+			return fmt.Sprintf("<a href=\"#%s\">%s</a>", ref, ident)
+		}
+		return ident
+	}))
+}
+
+func (l typeLinker) methods(methods []Method) {
+	for i := range methods {
+		for j := range methods[i].Parameters {
+			l.link(&methods[i].Parameters[j].Type)
+		}
+		l.link(&methods[i].Returns.Type)
+		for j := range methods[i].Throws {
+			l.link(&methods[i].Throws[j].Type)
+		}
+	}
+}
+
+func (l typeLinker) properties(props []Property) {
+	for i := range props {
+		l.link(&props[i].Type)
+	}
+}
+
+func (p goPackage) typeHTML(expr ast.Expr) template.HTML {
+	if expr == nil {
+		return ""
+	}
+	// #nosec
+	escaped := template.HTML(html.EscapeString(types.ExprString(expr)))
+	p.refs.link(&escaped)
+	return escaped
+}
+
+// goDoc splits the doc comment into the description and the deprecation
+// note, the paragraph starting with "Deprecated:" (true if it has no text)
+func goDoc(text string) (description string, deprecated string) {
+	var paragraphs []string
+	for _, paragraph := range strings.Split(strings.TrimSpace(text), "\n\n") {
+		if note, ok := strings.CutPrefix(paragraph, "Deprecated:"); ok {
+			deprecated = strings.Join(strings.Fields(note), " ")
+			if deprecated == "" {
+				deprecated = "true"
+			}
+			continue
+		}
+		paragraphs = append(paragraphs, paragraph)
+	}
+	return strings.TrimSpace(strings.Join(paragraphs, "\n\n")), deprecated
+}
+
+func (p goPackage) parameters(fields *ast.FieldList) []Parameter {
+	var params []Parameter
+	if fields == nil {
+		return nil
+	}
+	for _, field := range fields.List {
+		paramType := p.typeHTML(field.Type)
+		if field.Names == nil {
+			params = append(params, Parameter{Type: paramType})
+		}
+		for _, name := range field.Names {
+			params = append(params, Parameter{Name: name.Name, Type: paramType})
+		}
+	}
+	return params
+}
+
+func (p goPackage) returns(results *ast.FieldList) Returns {
+	if results == nil {
+		return Returns{}
+	}
+	var resultTypes []string
+	for _, field := range results.List {
+		count := len(field.Names)
+		if count == 0 {
+			count = 1
+		}
+		for i := 0; i < count; i++ {
+			resultTypes = append(resultTypes, string(p.typeHTML(field.Type)))
+		}
+	}
+	returnType := strings.Join(resultTypes, ", ")
+	if len(resultTypes) > 1 {
+		returnType = "(" + returnType + ")"
+	}
+	// #nosec
+	return Returns{Type: template.HTML(returnType)}
+}
+
+func (p goPackage) function(name string, text string, funcType *ast.FuncType) Method {
+	description, deprecated := goDoc(text)
+	method := Method{
+		Name:        name,
+		Description: description,
+		Deprecated:  deprecated,
+		Parameters:  p.parameters(funcType.Params),
+		Returns:     p.returns(funcType.Results),
+	}
+	method.File, method.Line = p.position(funcType.Pos())
+	return method
+}
+
+// values converts the constants (or variables) declarations, the ones
+// without the type (e.g. iota) have the default type
+func (p goPackage) values(values []*doc.Value, access string, defaultType template.HTML) []Property {
+	var props []Property
+	for _, value := range values {
+		for _, spec := range value.Decl.Specs {
+			valueSpec := spec.(*ast.ValueSpec)
+			text := valueSpec.Doc.Text()
+			if strings.TrimSpace(text) == "" {
+				text = valueSpec.Comment.Text()
+			}
+			if strings.TrimSpace(text) == "" {
+				text = value.Doc
+			}
+			description, deprecated := goDoc(text)
+			for _, name := range valueSpec.Names {
+				if !name.IsExported() {
+					continue
+				}
+				valueType := p.typeHTML(valueSpec.Type)
+				if valueType == "" {
+					valueType = defaultType
+				}
+				prop := Property{
+					Name:        name.Name,
+					Description: description,
+					Deprecated:  deprecated,
+					Access:      access,
+					Type:        valueType,
+				}
+				prop.File, prop.Line = p.position(name.Pos())
+				props = append(props, prop)
+			}
+		}
+	}
+	return props
+}
+
+func (p goPackage) fields(fields *ast.FieldList) []Property {
+	var props []Property
+	for _, field := range fields.List {
+		text := field.Doc.Text()
+		if strings.TrimSpace(text) == "" {
+			text = field.Comment.Text()
+		}
+		description, deprecated := goDoc(text)
+		names := field.Names
+		if names == nil {
+			// The embedded type
+			name := types.ExprString(field.Type)
+			name = name[strings.LastIndex(name, ".")+1:]
+			ident := ast.NewIdent(strings.TrimPrefix(name, "*"))
+			ident.NamePos = field.Type.Pos()
+			names = []*ast.Ident{ident}
+		}
+		for _, name := range names {
+			if name.IsExported() {
+				prop := Property{
+					Name:        name.Name,
+					Description: description,
+					Deprecated:  deprecated,
+					Type:        p.typeHTML(field.Type),
+				}
+				prop.File, prop.Line = p.position(name.Pos())
+				props = append(props, prop)
+			}
+		}
+	}
+	return props
+}
+
+func (p goPackage) class(ns string, t *doc.Type) Class {
+	description, deprecated := goDoc(t.Doc)
+	cls := Class{
+		Name:        ns + "::" + t.Name,
+		Description: description,
+		Deprecated:  deprecated,
+		Ref:         p.refs[t.Name],
+	}
+	for _, spec := range t.Decl.Specs {
+		typeSpec, ok := spec.(*ast.TypeSpec)
+		if !ok || typeSpec.Name.Name != t.Name {
+			continue
+		}
+		cls.File, cls.Line = p.position(typeSpec.Pos())
+		switch typ := typeSpec.Type.(type) {
+		case *ast.StructType:
+			cls.Kind = "struct"
+			cls.Properties = p.fields(typ.Fields)
+		case *ast.InterfaceType:
+			cls.Kind = "interface"
+			for _, field := range typ.Methods.List {
+				funcType, ok := field.Type.(*ast.FuncType)
+				if !ok {
+					continue
+				}
+				for _, name := range field.Names {
+					if name.IsExported() {
+						method := p.function(name.Name, field.Doc.Text(), funcType)
+						method.Virtual = "virtual"
+						cls.Methods = append(cls.Methods, method)
+					}
+				}
+			}
+		}
+	}
+	clsType := p.typeHTML(ast.NewIdent(t.Name))
+	cls.Properties = append(cls.Properties, p.values(t.Consts, "static", clsType)...)
+	cls.Properties = append(cls.Properties, p.values(t.Vars, "static", clsType)...)
+	for _, fn := range t.Funcs {
+		cls.Constructors = append(cls.Constructors, p.function(fn.Name, fn.Doc, fn.Decl.Type))
+	}
+	for _, fn := range t.Methods {
+		cls.Methods = append(cls.Methods, p.function(fn.Name, fn.Doc, fn.Decl.Type))
+	}
+	return cls
+}
+
+// genGoPackage converts the package types into the classes, and the
+// package-level functions, constants and variables into the namespace
+func genGoPackage(p goPackage, pkg *doc.Package, ns string) ([]Class, Namespace) {
+	p.refs = typeLinker{}
+	for _, t := range pkg.Types {
+		p.refs[t.Name] = goRef(ns, t.Name)
+	}
+	var classes []Class
+	for _, t := range pkg.Types {
+		classes = append(classes, p.class(ns, t))
+	}
+	namespace := Namespace{
+		Name:        ns,
+		Description: strings.TrimSpace(pkg.Doc),
+		Constants:   p.values(pkg.Consts, "", ""),
+		Variables:   p.values(pkg.Vars, "", ""),
+	}
+	for _, fn := range pkg.Funcs {
+		namespace.Functions = append(namespace.Functions, p.function(fn.Name, fn.Doc, fn.Decl.Type))
+	}
+	return classes, namespace
+}
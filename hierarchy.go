@@ -0,0 +1,209 @@
+package adx
+
+import (
+	"fmt"
+	"html"
+	"html/template"
+	"strings"
+)
+
+// ClassRef is the class of the hierarchy (the ref is empty for the classes
+// outside of the documentation, e.g. the standard library ones)
+type ClassRef struct {
+	Name string
+	Ref  string
+}
+
+// InheritedMembers are the names of the members inherited from the base class
+type InheritedMembers struct {
+	From       ClassRef
+	Properties []string
+	Methods    []string
+}
+
+// ClassNode is the class of the inheritance tree with its derived classes
+type ClassNode struct {
+	Class    ClassRef
+	Children []ClassNode
+}
+
+// classLink is the link to the documented class or its escaped name
+func classLink(cls ClassRef) template.HTML {
+	name := html.EscapeString(cls.Name)
+	if cls.Ref == "" {
+		// #nosec
+		return template.HTML(name)
+	}
+	// #nosec
+	return template.HTML(fmt.Sprintf("<a href=\"#%s\">%s</a>", cls.Ref, name))
+}
+
+// hierarchyKey is the name without the type arguments and with the ::
+// separators (e.g. geo.Box<T> is geo::Box)
+func hierarchyKey(name string) string {
+	if i := strings.Index(name, "<"); i >= 0 {
+		name = name[:i]
+	}
+	if i := strings.Index(name, "["); i >= 0 {
+		name = name[:i]
+	}
+	return strings.ReplaceAll(strings.TrimSpace(name), ".", "::")
+}
+
+// classIndex finds the classes by their qualified or short names
+type classIndex struct {
+	refs    map[string]ClassRef
+	classes map[string]*Class
+}
+
+func newClassIndex(namespaces map[string][]Class) classIndex {
+	idx := classIndex{refs: map[string]ClassRef{}, classes: map[string]*Class{}}
+	for _, ns := range sortedNamespaces(namespaces) {
+		classes := namespaces[ns]
+		for i := range classes {
+			cls := &classes[i]
+			ref := ClassRef{Name: cls.Name, Ref: cls.Ref}
+			idx.classes[cls.Ref] = cls
+			short := hierarchyKey(cls.Name)
+			full := short
+			if ns != "Global" {
+				full = hierarchyKey(ns) + "::" + short
+			}
+			idx.refs[full] = ref
+			if _, ok := idx.refs[short]; !ok {
+				idx.refs[short] = ref
+			}
+		}
+	}
+	return idx
+}
+
+// resolve finds the class by its name in the scope of the namespace
+func (idx classIndex) resolve(ns string, name string) ClassRef {
+	key := hierarchyKey(name)
+	if ns != "Global" {
+		if ref, ok := idx.refs[hierarchyKey(ns)+"::"+key]; ok {
+			return ref
+		}
+	}
+	if ref, ok := idx.refs[key]; ok {
+		return ref
+	}
+	if i := strings.LastIndex(key, "::"); i >= 0 {
+		if ref, ok := idx.refs[key[i+2:]]; ok {
+			return ref
+		}
+	}
+	return ClassRef{Name: name}
+}
+
+// linkHierarchy resolves the base classes and the interfaces, and adds
+// the derived classes to the documented ones
+func linkHierarchy(namespaces map[string][]Class) {
+	idx := newClassIndex(namespaces)
+	for _, ns := range sortedNamespaces(namespaces) {
+		classes := namespaces[ns]
+		for i := range classes {
+			cls := &classes[i]
+			cls.Bases, cls.Interfaces, cls.Derived, cls.Inherited = nil, nil, nil, nil
+			for _, name := range cls.Extends {
+				cls.Bases = append(cls.Bases, idx.resolve(ns, name))
+			}
+			for _, name := range cls.Implements {
+				cls.Interfaces = append(cls.Interfaces, idx.resolve(ns, name))
+			}
+		}
+	}
+	for _, ns := range sortedNamespaces(namespaces) {
+		for _, cls := range namespaces[ns] {
+			for _, base := range append(append([]ClassRef(nil), cls.Bases...), cls.Interfaces...) {
+				if parent, ok := idx.classes[base.Ref]; ok && base.Ref != cls.Ref {
+					parent.Derived = append(parent.Derived, ClassRef{Name: cls.Name, Ref: cls.Ref})
+				}
+			}
+		}
+	}
+}
+
+// InheritMembers adds the members of the documented base classes and
+// interfaces (including the indirect ones) not overridden in the classes
+func InheritMembers(namespaces map[string][]Class) {
+	idx := newClassIndex(namespaces)
+	for _, classes := range namespaces {
+		for i := range classes {
+			cls := &classes[i]
+			cls.Inherited = nil
+			seen := map[string]bool{}
+			for _, method := range cls.Methods {
+				seen[method.Name] = true
+			}
+			for _, prop := range cls.Properties {
+				seen[prop.Name] = true
+			}
+			visited := map[string]bool{cls.Ref: true}
+			queue := append(append([]ClassRef(nil), cls.Bases...), cls.Interfaces...)
+			for len(queue) > 0 {
+				base, ok := idx.classes[queue[0].Ref]
+				queue = queue[1:]
+				if !ok || visited[base.Ref] {
+					continue
+				}
+				visited[base.Ref] = true
+				inherited := InheritedMembers{From: ClassRef{Name: base.Name, Ref: base.Ref}}
+				for _, prop := range base.Properties {
+					if !seen[prop.Name] {
+						seen[prop.Name] = true
+						inherited.Properties = append(inherited.Properties, prop.Name)
+					}
+				}
+				for _, method := range base.Methods {
+					if !seen[method.Name] {
+						seen[method.Name] = true
+						inherited.Methods = append(inherited.Methods, method.Name)
+					}
+				}
+				if inherited.Properties != nil || inherited.Methods != nil {
+					cls.Inherited = append(cls.Inherited, inherited)
+				}
+				queue = append(append(queue, base.Bases...), base.Interfaces...)
+			}
+		}
+	}
+}
+
+// ClassHierarchy is the inheritance tree of the documented classes: the
+// roots are the classes without the documented bases having the derived ones
+func ClassHierarchy(namespaces map[string][]Class) []ClassNode {
+	idx := newClassIndex(namespaces)
+	var build func(cls *Class, visited map[string]bool) ClassNode
+	build = func(cls *Class, visited map[string]bool) ClassNode {
+		node := ClassNode{Class: ClassRef{Name: cls.Name, Ref: cls.Ref}}
+		visited[cls.Ref] = true
+		for _, derived := range cls.Derived {
+			if child, ok := idx.classes[derived.Ref]; ok && !visited[derived.Ref] {
+				node.Children = append(node.Children, build(child, visited))
+			}
+		}
+		delete(visited, cls.Ref)
+		return node
+	}
+	var roots []ClassNode
+	for _, ns := range sortedNamespaces(namespaces) {
+		for _, cls := range namespaces[ns] {
+			if cls.Derived == nil {
+				continue
+			}
+			isRoot := true
+			for _, base := range append(append([]ClassRef(nil), cls.Bases...), cls.Interfaces...) {
+				if _, ok := idx.classes[base.Ref]; ok {
+					isRoot = false
+				}
+			}
+			if isRoot {
+				cls := cls
+				roots = append(roots, build(&cls, map[string]bool{}))
+			}
+		}
+	}
+	return roots
+}
@@ -0,0 +1,174 @@
+package adx
+
+import (
+	"html/template"
+	"strings"
+	"unicode"
+)
+
+// syntax is the lexical rules of the language used for the highlighting
+type syntax struct {
+	keywords     map[string]bool
+	lineComment  string
+	blockComment [2]string
+	// The string delimiters (the triple quotes are the multiline strings)
+	quotes string
+}
+
+func newSyntax(keywords string, lineComment string, blockComment [2]string, quotes string) *syntax {
+	set := map[string]bool{}
+	for _, keyword := range strings.Fields(keywords) {
+		set[keyword] = true
+	}
+	return &syntax{set, lineComment, blockComment, quotes}
+}
+
+var cComment = [2]string{"/*", "*/"}
+
+var cKeywords = "auto break case char const continue default do double else enum extern " +
+	"float for goto if inline int long register return short signed sizeof static " +
+	"struct switch typedef union unsigned void volatile while true false NULL"
+
+var jsKeywords = "async await break case catch class const continue debugger default " +
+	"delete do else export extends false finally for function if import in " +
+	"instanceof let new null of return static super switch this throw true try " +
+	"typeof undefined var void while yield"
+
+var syntaxes = map[string]*syntax{
+	"c": newSyntax(cKeywords, "//", cComment, `"'`),
+	"cpp": newSyntax(cKeywords+" bool catch class constexpr delete explicit friend "+
+		"mutable namespace new noexcept nullptr operator override private protected "+
+		"public template this throw try typename using virtual", "//", cComment, `"'`),
+	"go": newSyntax("break case chan const continue default defer else fallthrough "+
+		"for func go goto if import interface map package range return select struct "+
+		"switch type var nil true false", "//", cComment, "\"'`"),
+	"java": newSyntax("abstract boolean break byte case catch char class const continue "+
+		"default do double else enum extends final finally float for if implements "+
+		"import instanceof int interface long native new null package private "+
+		"protected public return short static super switch synchronized this throw "+
+		"throws try void volatile while true false", "//", cComment, `"'`),
+	"javascript": newSyntax(jsKeywords, "//", cComment, "\"'`"),
+	"typescript": newSyntax(jsKeywords+" abstract any as boolean declare enum "+
+		"implements interface keyof namespace never number private protected public "+
+		"readonly string type unknown", "//", cComment, "\"'`"),
+	"kotlin": newSyntax("as break class continue do else false for fun if in "+
+		"interface is null object package return super this throw true try typealias "+
+		"val var when while override open private protected public internal data",
+		"//", cComment, `"'`),
+	"swift": newSyntax("associatedtype break case catch class continue default defer "+
+		"deinit do else enum extension false fileprivate for func guard if import in "+
+		"init inout internal let nil open operator private protocol public repeat "+
+		"rethrows return self static struct subscript super switch throw throws true "+
+		"try typealias var where while", "//", cComment, `"`),
+	"python": newSyntax("False None True and as assert async await break class "+
+		"continue def del elif else except finally for from global if import in is "+
+		"lambda nonlocal not or pass raise return try while with yield", "#",
+		[2]string{}, `"'`),
+}
+
+// syntaxNames has the aliases of the languages (e.g. the file extensions)
+var syntaxNames = map[string]string{
+	"h": "cpp", "hpp": "cpp", "cc": "cpp", "cxx": "cpp", "c++": "cpp",
+	"js": "javascript", "mjs": "javascript", "ts": "typescript",
+	"golang": "go", "kt": "kotlin", "py": "python",
+}
+
+func findSyntax(language string) *syntax {
+	language = strings.ToLower(strings.TrimPrefix(language, "."))
+	if name, ok := syntaxNames[language]; ok {
+		language = name
+	}
+	return syntaxes[language]
+}
+
+// highlight converts the code into HTML with the keywords, strings, comments
+// and numbers in the adx-keyword, adx-string, adx-comment and adx-number
+// spans; the code of the unknown languages is only escaped
+func highlight(code string, language string) template.HTML {
+	rules := findSyntax(language)
+	if rules == nil {
+		return template.HTML(template.HTMLEscapeString(code))
+	}
+	var sb strings.Builder
+	span := func(class string, text string) {
+		sb.WriteString(`<span class="adx-` + class + `">`)
+		sb.WriteString(template.HTMLEscapeString(text))
+		sb.WriteString("</span>")
+	}
+	isWord := func(r byte) bool {
+		return r == '_' || r == '$' || r >= 0x80 || unicode.IsLetter(rune(r)) || unicode.IsDigit(rune(r))
+	}
+	for i := 0; i < len(code); {
+		rest := code[i:]
+		switch {
+		case rules.blockComment[0] != "" && strings.HasPrefix(rest, rules.blockComment[0]):
+			end := strings.Index(rest[len(rules.blockComment[0]):], rules.blockComment[1])
+			n := len(rest)
+			if end >= 0 {
+				n = len(rules.blockComment[0]) + end + len(rules.blockComment[1])
+			}
+			span("comment", rest[:n])
+			i += n
+		case rules.lineComment != "" && strings.HasPrefix(rest, rules.lineComment):
+			n := strings.IndexByte(rest, '\n')
+			if n < 0 {
+				n = len(rest)
+			}
+			span("comment", rest[:n])
+			i += n
+		case strings.IndexByte(rules.quotes, rest[0]) >= 0:
+			n := stringLength(rest)
+			span("string", rest[:n])
+			i += n
+		case rest[0] >= '0' && rest[0] <= '9':
+			n := 1
+			for n < len(rest) && (isWord(rest[n]) || rest[n] == '.') {
+				n++
+			}
+			span("number", rest[:n])
+			i += n
+		case isWord(rest[0]):
+			n := 1
+			for n < len(rest) && isWord(rest[n]) {
+				n++
+			}
+			if rules.keywords[rest[:n]] {
+				span("keyword", rest[:n])
+			} else {
+				sb.WriteString(template.HTMLEscapeString(rest[:n]))
+			}
+			i += n
+		default:
+			sb.WriteString(template.HTMLEscapeString(rest[:1]))
+			i++
+		}
+	}
+	// #nosec
+	return template.HTML(sb.String())
+}
+
+// stringLength is the length of the string literal (with the quotes) at the
+// start of the code; the unterminated strings end with the line
+func stringLength(code string) int {
+	quote := code[:1]
+	if triple := strings.Repeat(quote, 3); strings.HasPrefix(code, triple) {
+		end := strings.Index(code[3:], triple)
+		if end < 0 {
+			return len(code)
+		}
+		return end + 6
+	}
+	for n := 1; n < len(code); n++ {
+		switch code[n] {
+		case '\\':
+			n++
+		case quote[0]:
+			return n + 1
+		case '\n':
+			if quote != "`" {
+				return n
+			}
+		}
+	}
+	return len(code)
+}
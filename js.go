@@ -1,69 +1,392 @@
-package main
+package adx
 
 import (
-	"encoding/xml"
-	"log"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
 )
 
+// js runs JSDoc with the doclets output (jsdoc -X) and converts the doclets;
+// the intermediate content is the adx JSON document
 type js struct {
-	conf string
+	adxContent
+	conf     string
+	excludes []string
+	root     string
 }
 
-func (j *js) setConf(conf string) {
+func (j *js) SetConf(conf string) {
 	j.conf = conf
 }
 
-func (j js) genClasses(xmlContent []byte) []Class {
-	type Result struct {
-		XMLName xml.Name `xml:"jsdoc"`
-		Classes []Class  `xml:"classes"`
-	}
+func (j *js) SetExcludes(patterns []string) {
+	j.excludes = patterns
+}
 
-	if xmlContent != nil {
-		var v Result
-		if err := xml.Unmarshal(xmlContent, &v); err != nil {
-			log.Fatal(err)
-		}
-		return v.Classes
-	}
+// The external tools process the sources as a whole, so there are no
+// recoverable errors
+func (j js) SetErrorHandler(handler func(error)) {}
+
+func (j *js) SetSourceRoot(dir string) {
+	j.root = dir
+}
+
+// GenClasses converts the classes with the doclet paths relative to the source root
+func (j js) GenClasses(content []byte) ([]Class, error) {
+	classes, err := j.adxContent.GenClasses(content)
+	rootSources(classes, nil, j.root)
+	return classes, err
+}
 
-	return nil
+// GenNamespaces converts the namespaces with the doclet paths relative to
+// the source root
+func (j js) GenNamespaces(content []byte) ([]Namespace, error) {
+	namespaces, err := j.adxContent.GenNamespaces(content)
+	rootSources(nil, namespaces, j.root)
+	return namespaces, err
 }
 
-func (j js) genIntermediate(srcDir string) []byte {
-	args := []string{"-t", "templates/haruki", srcDir, "-d", "console",
-		"-q", "format=xml"}
+func (j js) GenIntermediate(srcDir string) ([]byte, error) {
+	args := []string{"-X"}
 	if j.conf != "" {
 		args = append(args, "-c", j.conf)
 	}
-	cmd := newCmd("jsdoc", args...)
-	out, err := cmd.Output()
+	if j.excludes == nil {
+		args = append(args, srcDir)
+	} else {
+		// JSDoc excludes are only in its configuration, so the files are listed explicitly
+		err := walkSources(srcDir, j.excludes, func(path string, info os.FileInfo) error {
+			if filepath.Ext(path) == ".js" {
+				args = append(args, path)
+			}
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+	content, err := newCmd("jsdoc", args...).Output()
 	if err != nil {
-		log.Fatal(err)
+		return nil, err
 	}
-	return out
+	return genJSDoc(content)
 }
 
-func (j js) combineIntermediate(a []byte, b []byte) []byte {
-	type Result struct {
-		XMLName xml.Name `xml:"jsdoc"`
-		Classes string   `xml:",innerxml"`
+// ReadJSDocJSON converts the doclets file produced by jsdoc -X into the
+// intermediate content of the js language
+func ReadJSDocJSON(file string) ([]byte, error) {
+	// #nosec
+	content, err := os.ReadFile(file)
+	if err != nil {
+		return nil, withContext(err, PhaseSource, file)
 	}
-	var aContent Result
-	if err := xml.Unmarshal(a, &aContent); err != nil {
-		log.Fatal(err)
+	result, err := genJSDoc(content)
+	if err != nil {
+		return nil, withContext(err, PhaseParse, file)
 	}
+	return result, nil
+}
+
+// jsDoclet is the JSDoc doclet (the documented symbol)
+type jsDoclet struct {
+	Kind         string          `json:"kind"`
+	Name         string          `json:"name"`
+	Longname     string          `json:"longname"`
+	Memberof     string          `json:"memberof"`
+	Scope        string          `json:"scope"`
+	Access       string          `json:"access"`
+	Description  string          `json:"description"`
+	Classdesc    string          `json:"classdesc"`
+	Virtual      bool            `json:"virtual"`
+	IsEnum       bool            `json:"isEnum"`
+	DefaultValue interface{}     `json:"defaultvalue"`
+	Undocumented bool            `json:"undocumented"`
+	Ignore       bool            `json:"ignore"`
+	Type         *jsDocletType   `json:"type"`
+	Params       []jsDocletParam `json:"params"`
+	Returns      []jsDocletParam `json:"returns"`
+	Exceptions   []jsDocletParam `json:"exceptions"`
+	Fires        []string        `json:"fires"`
+	Augments     []string        `json:"augments"`
+	Implements   []string        `json:"implements"`
+	Deprecated   interface{}     `json:"deprecated"`
+	Since        string          `json:"since"`
+	Tags         []jsDocletTag   `json:"tags"`
+	Examples     []string        `json:"examples"`
+	Meta         jsDocletMeta    `json:"meta"`
+}
+
+// jsDocletMeta is the location of the doclet (the directory and the file name)
+type jsDocletMeta struct {
+	Path     string `json:"path"`
+	Filename string `json:"filename"`
+	Lineno   int    `json:"lineno"`
+}
+
+// jsDocletTag is the unknown tag (kept with the allowUnknownTags option)
+type jsDocletTag struct {
+	Title string `json:"title"`
+}
 
-	var bContent Result
-	if err := xml.Unmarshal(b, &bContent); err != nil {
-		log.Fatal(err)
+type jsDocletType struct {
+	Names []string `json:"names"`
+}
+
+// jsDocletParam is the parameter, the return value or the exception of the doclet
+type jsDocletParam struct {
+	Name         string        `json:"name"`
+	Type         *jsDocletType `json:"type"`
+	Description  string        `json:"description"`
+	Optional     bool          `json:"optional"`
+	Nullable     bool          `json:"nullable"`
+	DefaultValue interface{}   `json:"defaultvalue"`
+}
+
+var jsLongnameRe = regexp.MustCompile(`[.~#/]`)
+
+// jsName converts the doclet longname into the adx name (e.g. module:geo~Box
+// is geo::Box)
+func jsName(longname string) string {
+	return jsLongnameRe.ReplaceAllString(strings.TrimPrefix(longname, "module:"), "::")
+}
+
+func (t *jsDocletType) String() string {
+	if t == nil {
+		return ""
 	}
+	return strings.Join(t.Names, " | ")
+}
 
-	aContent.Classes = aContent.Classes + bContent.Classes
-	output, err := xml.Marshal(aContent)
-	if err != nil {
-		log.Fatal(err)
+func (d jsDoclet) access() string {
+	access := ""
+	if d.Access == "protected" {
+		access = "protected"
 	}
+	if d.Scope == "static" {
+		access = strings.TrimSpace(access + " static")
+	}
+	return access
+}
 
-	return output
+// annotations returns the deprecation note (true if there is none), the since
+// version and the stability (the @experimental or @stable tags)
+func (d jsDoclet) annotations() (string, string, string) {
+	var deprecated, stability string
+	switch value := d.Deprecated.(type) {
+	case bool:
+		if value {
+			deprecated = "true"
+		}
+	case string:
+		deprecated = strings.TrimSpace(value)
+	}
+	for _, tag := range d.Tags {
+		if tag.Title == "experimental" || tag.Title == "stable" {
+			stability = tag.Title
+		}
+	}
+	return deprecated, strings.TrimSpace(d.Since), stability
+}
+
+// source returns the file and the line of the doclet
+func (d jsDoclet) source() (string, int) {
+	if d.Meta.Filename == "" {
+		return "", 0
+	}
+	return filepath.Join(d.Meta.Path, d.Meta.Filename), d.Meta.Lineno
+}
+
+func (d jsDoclet) examples() []Example {
+	var examples []Example
+	for _, example := range d.Examples {
+		examples = append(examples, jsExample(example))
+	}
+	return examples
+}
+
+func (d jsDoclet) method(name string) Method {
+	method := Method{
+		Name:   name,
+		Access: d.access(),
+	}
+	method.Description, method.Details = markupDescription(d.Description)
+	if d.Virtual {
+		method.Virtual = "virtual"
+	}
+	method.Deprecated, method.Since, method.Stability = d.annotations()
+	method.Examples = d.examples()
+	method.File, method.Line = d.source()
+	for _, param := range d.Params {
+		if strings.Contains(param.Name, ".") {
+			// The properties of the parameters (e.g. options.name)
+			continue
+		}
+		p := Parameter{
+			Name:        param.Name,
+			Type:        escapedHTML(param.Type.String()),
+			Description: strings.TrimSpace(param.Description),
+		}
+		if param.DefaultValue != nil {
+			p.Default = fmt.Sprint(param.DefaultValue)
+		}
+		if param.Optional {
+			p.Optional = "true"
+		}
+		if param.Nullable {
+			p.Nullable = "true"
+		}
+		method.Parameters = append(method.Parameters, p)
+	}
+	if len(d.Returns) > 0 {
+		method.Returns = Returns{
+			Type:        escapedHTML(d.Returns[0].Type.String()),
+			Description: escapedHTML(strings.TrimSpace(d.Returns[0].Description)),
+		}
+	}
+	for _, exception := range d.Exceptions {
+		method.Throws = append(method.Throws, Exception{
+			Type:        escapedHTML(exception.Type.String()),
+			Description: strings.TrimSpace(exception.Description),
+		})
+	}
+	return method
+}
+
+func (d jsDoclet) property() Property {
+	prop := Property{
+		Name:   d.Name,
+		Access: d.access(),
+		Type:   escapedHTML(d.Type.String()),
+	}
+	prop.Description, prop.Details = markupDescription(d.Description)
+	prop.Deprecated, prop.Since, prop.Stability = d.annotations()
+	prop.File, prop.Line = d.source()
+	return prop
+}
+
+// isClass reports the doclets converted into the classes (including the
+// interfaces and the enums)
+func (d jsDoclet) isClass() bool {
+	return d.Kind == "class" || d.Kind == "interface" || (d.IsEnum && d.Kind != "function")
+}
+
+// genJSDoc converts the doclets: the classes, interfaces and enums are
+// the classes, the namespaces and modules have the global functions and
+// constants
+func genJSDoc(content []byte) ([]byte, error) {
+	var doclets []jsDoclet
+	if err := json.Unmarshal(content, &doclets); err != nil {
+		return nil, err
+	}
+
+	var result AdxResult
+	namespaces := &namespaceIndex{result: &result}
+	classes := map[string]int{}
+	isNamespace := map[string]bool{}
+	var members []jsDoclet
+	for _, d := range doclets {
+		if d.Undocumented || d.Ignore || d.Access == "private" || d.Kind == "package" {
+			continue
+		}
+		switch {
+		case d.isClass():
+			name := jsName(d.Longname)
+			// The ES2015 classes have the class and the constructor descriptions
+			description, ctorDescription := d.Classdesc, d.Description
+			if description == "" {
+				description, ctorDescription = d.Description, ""
+			}
+			cls := Class{
+				Name:       name,
+				Ref:        tsRef("", name),
+				Fires:      strings.Join(d.Fires, ", "),
+				Extends:    d.Augments,
+				Implements: d.Implements,
+				Examples:   d.examples(),
+			}
+			cls.Description, cls.Details = markupDescription(description)
+			cls.Deprecated, cls.Since, cls.Stability = d.annotations()
+			cls.File, cls.Line = d.source()
+			switch {
+			case d.Kind == "interface":
+				cls.Kind = "interface"
+			case d.IsEnum:
+				cls.Kind = "enum"
+			}
+			if d.Kind == "class" && (d.Params != nil || ctorDescription != "") {
+				ctor := d.method(d.Name)
+				ctor.Description, ctor.Details = markupDescription(ctorDescription)
+				ctor.Access = ""
+				// The annotations and the examples of the class doclet are the
+				// ones of the class
+				ctor.Deprecated, ctor.Since, ctor.Stability = "", "", ""
+				ctor.Examples = nil
+				cls.Constructors = append(cls.Constructors, ctor)
+			}
+			classes[d.Longname] = len(result.Classes)
+			result.Classes = append(result.Classes, cls)
+		case d.Kind == "namespace" || d.Kind == "module":
+			isNamespace[d.Longname] = true
+			ns := namespaces.get(jsName(d.Longname))
+			ns.Description, ns.Details = markupDescription(d.Description)
+		default:
+			members = append(members, d)
+		}
+	}
+
+	for _, d := range members {
+		if i, ok := classes[d.Memberof]; ok {
+			cls := &result.Classes[i]
+			switch d.Kind {
+			case "function":
+				method := d.method(d.Name)
+				if cls.Kind == "interface" {
+					method.Virtual = "virtual"
+				}
+				cls.Methods = append(cls.Methods, method)
+			case "member", "constant":
+				if cls.Kind != "enum" {
+					cls.Properties = append(cls.Properties, d.property())
+					continue
+				}
+				value := EnumValue{
+					Name:        d.Name,
+					Description: strings.TrimSpace(d.Description),
+				}
+				if d.DefaultValue != nil {
+					value.Value = fmt.Sprint(d.DefaultValue)
+				}
+				cls.Values = append(cls.Values, value)
+			}
+			continue
+		}
+		if d.Memberof != "" && !isNamespace[d.Memberof] {
+			continue
+		}
+		switch d.Kind {
+		case "function":
+			ns := namespaces.get(jsName(d.Memberof))
+			ns.Functions = append(ns.Functions, d.method(d.Name))
+		case "constant":
+			ns := namespaces.get(jsName(d.Memberof))
+			ns.Constants = append(ns.Constants, d.property())
+		case "member":
+			ns := namespaces.get(jsName(d.Memberof))
+			ns.Variables = append(ns.Variables, d.property())
+		}
+	}
+
+	// The namespaces without the members are only the scopes of the classes
+	var nonEmpty []Namespace
+	for _, ns := range result.Namespaces {
+		if ns.Functions != nil || ns.Constants != nil || ns.Variables != nil {
+			nonEmpty = append(nonEmpty, ns)
+		}
+	}
+	result.Namespaces = nonEmpty
+	linkTSTypes(&result)
+	return json.Marshal(result)
 }
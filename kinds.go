@@ -0,0 +1,66 @@
+package adx
+
+import (
+	"sort"
+	"strings"
+)
+
+// kindOrder is the order of the kind groups (the other kinds, e.g. union,
+// follow them)
+var kindOrder = []string{"class", "interface", "protocol", "trait", "struct", "enum"}
+
+// KindName is the kind of the class (class if not set)
+func (c Class) KindName() string {
+	if c.Kind == "" {
+		return "class"
+	}
+	return c.Kind
+}
+
+// KindLabel is the capitalized kind for the headings (e.g. Interface)
+func (c Class) KindLabel() string {
+	kind := c.KindName()
+	return strings.ToUpper(kind[:1]) + kind[1:]
+}
+
+// KindGroup has the classes of the same kind
+type KindGroup struct {
+	Title   string
+	Classes []Class
+}
+
+// groupByKind splits the classes by their kinds keeping their order
+func groupByKind(classes []Class) []KindGroup {
+	rank := func(kind string) int {
+		for i, k := range kindOrder {
+			if k == kind {
+				return i
+			}
+		}
+		return len(kindOrder)
+	}
+	var kinds []string
+	byKind := map[string][]Class{}
+	for _, cls := range classes {
+		kind := cls.KindName()
+		if byKind[kind] == nil {
+			kinds = append(kinds, kind)
+		}
+		byKind[kind] = append(byKind[kind], cls)
+	}
+	sort.SliceStable(kinds, func(i, j int) bool {
+		if rank(kinds[i]) != rank(kinds[j]) {
+			return rank(kinds[i]) < rank(kinds[j])
+		}
+		return kinds[i] < kinds[j]
+	})
+	var groups []KindGroup
+	for _, kind := range kinds {
+		title := byKind[kind][0].KindLabel() + "s"
+		if kind == "class" {
+			title = "Classes"
+		}
+		groups = append(groups, KindGroup{title, byKind[kind]})
+	}
+	return groups
+}
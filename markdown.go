@@ -0,0 +1,412 @@
+package adx
+
+import (
+	"bytes"
+	"fmt"
+	"html"
+	"path/filepath"
+	"regexp"
+	"strings"
+)
+
+var anchorRe = regexp.MustCompile("<a href=\"#(\\w+)\">([^<]*)</a>")
+
+// markdown writes the classes as GitHub-flavoured Markdown; the page function
+// gives the file of the class ("" for the single-file output)
+type markdown struct {
+	buf   bytes.Buffer
+	pages map[string]string
+}
+
+func newMarkdown(namespaces map[string][]Class, page func(Class) string) *markdown {
+	md := &markdown{pages: map[string]string{}}
+	for _, classes := range namespaces {
+		for _, cls := range classes {
+			md.pages[cls.Ref] = page(cls)
+		}
+	}
+	return md
+}
+
+// text converts the HTML fragment of the model, keeping the class links
+func (md *markdown) text(raw string) string {
+	linked := anchorRe.ReplaceAllStringFunc(raw, func(link string) string {
+		m := anchorRe.FindStringSubmatch(link)
+		page, ok := md.pages[m[1]]
+		if !ok {
+			return m[2]
+		}
+		return fmt.Sprintf("[%s](%s#%s)", m[2], page, m[1])
+	})
+	return strings.TrimSpace(html.UnescapeString(tagRe.ReplaceAllString(linked, "")))
+}
+
+func (md *markdown) cell(raw string) string {
+	text := strings.ReplaceAll(md.text(raw), "|", "\\|")
+	return strings.ReplaceAll(text, "\n", "<br>")
+}
+
+func (md *markdown) line(format string, args ...interface{}) {
+	fmt.Fprintf(&md.buf, format+"\n", args...)
+}
+
+func (md *markdown) paragraph(raw string) {
+	if text := md.text(raw); text != "" {
+		md.line("%s\n", text)
+	}
+}
+
+// description writes the rich description (if any) or the plain one
+func (md *markdown) description(description string, details []Block) {
+	if details == nil {
+		md.paragraph(description)
+		return
+	}
+	md.line("%s\n", strings.Join(md.rich(details), "\n"))
+}
+
+// rich converts the blocks into the lines (the blocks are separated by the
+// blank lines, and the list items are indented with their markers)
+func (md *markdown) rich(blocks []Block) []string {
+	var lines []string
+	for i, block := range blocks {
+		if i > 0 {
+			lines = append(lines, "")
+		}
+		switch block.Kind {
+		case "list", "ordered-list":
+			for j, item := range block.Blocks {
+				marker := "- "
+				if block.Kind == "ordered-list" {
+					marker = fmt.Sprintf("%d. ", j+1)
+				}
+				for k, line := range md.rich(item.Blocks) {
+					switch {
+					case k == 0:
+						line = marker + line
+					case line != "":
+						line = strings.Repeat(" ", len(marker)) + line
+					}
+					lines = append(lines, line)
+				}
+			}
+		case "item":
+			lines = append(lines, md.rich(block.Blocks)...)
+		case "code":
+			fence := "```"
+			for strings.Contains(block.Code, fence) {
+				fence += "`"
+			}
+			lines = append(lines, fence+strings.ToLower(block.Language))
+			lines = append(lines, strings.Split(block.Code, "\n")...)
+			lines = append(lines, fence)
+		default:
+			lines = append(lines, strings.Split(md.spans(block.Spans), "\n")...)
+		}
+	}
+	return lines
+}
+
+// spans converts the inline markup (the unknown classes are not linked)
+func (md *markdown) spans(spans []Span) string {
+	var sb strings.Builder
+	for _, span := range spans {
+		switch span.Kind {
+		case "code":
+			ticks := "`"
+			for strings.Contains(span.Text, ticks) {
+				ticks += "`"
+			}
+			sb.WriteString(ticks + span.Text + ticks)
+		case "emphasis":
+			sb.WriteString("*" + span.Text + "*")
+		case "strong":
+			sb.WriteString("**" + span.Text + "**")
+		case "link":
+			url := span.URL
+			if strings.HasPrefix(url, "#") {
+				page, ok := md.pages[url[1:]]
+				if !ok {
+					sb.WriteString(span.Text)
+					continue
+				}
+				url = page + url
+			} else if !safeURL(url) {
+				sb.WriteString(span.Text)
+				continue
+			}
+			fmt.Fprintf(&sb, "[%s](%s)", span.Text, url)
+		default:
+			sb.WriteString(span.Text)
+		}
+	}
+	return sb.String()
+}
+
+func (md *markdown) frontMatter(fields ...string) {
+	md.line("---")
+	for i := 0; i+1 < len(fields); i += 2 {
+		md.line("%s: %q", fields[i], fields[i+1])
+	}
+	md.line("---\n")
+}
+
+func (md *markdown) table(headers []string, rows [][]string) {
+	md.line("| %s |", strings.Join(headers, " | "))
+	md.line("|%s", strings.Repeat(" --- |", len(headers)))
+	for _, row := range rows {
+		cells := make([]string, len(row))
+		for i, cell := range row {
+			cells[i] = md.cell(cell)
+		}
+		md.line("| %s |", strings.Join(cells, " | "))
+	}
+	md.line("")
+}
+
+func (md *markdown) parameters(level string, params []Parameter) {
+	if params == nil {
+		return
+	}
+	md.line("%s Parameters\n", level)
+	var rows [][]string
+	for _, param := range params {
+		rows = append(rows, []string{param.Label(), string(param.Type), param.Description})
+	}
+	md.table([]string{"Name", "Type", "Description"}, rows)
+}
+
+// class writes the class with the headings starting at the given level
+func (md *markdown) class(cls Class, level int) {
+	h := strings.Repeat("#", level)
+	md.line("<a id=\"%s\"></a>\n", cls.Ref)
+	md.line("%s %s %s\n", h, cls.KindLabel(), cls.Name)
+	md.paragraph(annotationText(cls.Deprecated, cls.Since, cls.Stability))
+	md.source(cls.SourceURL)
+	md.line("Namespace: %s\n", cls.Namespace)
+	if cls.Language != "" {
+		md.line("Language: %s\n", cls.Language)
+	}
+	md.description(cls.Description, cls.Details)
+	md.classRefs("Extends", cls.Bases)
+	md.classRefs("Implements", cls.Interfaces)
+	md.classRefs("Derived classes", cls.Derived)
+	md.examples(h+"#", cls.Examples)
+
+	md.properties(h+"#", "Properties", cls.Properties)
+
+	if cls.Values != nil {
+		md.line("%s# Values\n", h)
+		var rows [][]string
+		for _, value := range cls.Values {
+			rows = append(rows, []string{value.Name, value.Value, value.Description})
+		}
+		md.table([]string{"Name", "Value", "Description"}, rows)
+	}
+
+	for _, ctor := range cls.Constructors {
+		md.line("<a id=\"%s\"></a>\n", ctor.Anchor)
+		md.line("%s# Constructor %s(%s)\n", h, ctor.Name, paramNames(ctor.Parameters))
+		md.paragraph(annotationText(ctor.Deprecated, ctor.Since, ctor.Stability))
+		md.source(ctor.SourceURL)
+		md.description(ctor.Description, ctor.Details)
+		md.parameters(h+"##", ctor.Parameters)
+		md.throws(h+"##", ctor.Throws)
+		md.examples(h+"##", ctor.Examples)
+	}
+
+	for _, method := range cls.Methods {
+		md.method(h+"#", "Method", method)
+	}
+
+	for _, inherited := range cls.Inherited {
+		md.line("%s# Inherited from %s\n", h, md.text(string(classLink(inherited.From))))
+		if inherited.Properties != nil {
+			md.line("Properties: %s\n", strings.Join(inherited.Properties, ", "))
+		}
+		if inherited.Methods != nil {
+			md.line("Methods: %s\n", strings.Join(inherited.Methods, ", "))
+		}
+	}
+}
+
+// method writes the method (or the function) with the heading of the given level
+func (md *markdown) method(h string, label string, method Method) {
+	returnType := ""
+	if method.Returns.Type != "" {
+		returnType = " " + md.text(string(method.Returns.Type))
+	}
+	md.line("<a id=\"%s\"></a>\n", method.Anchor)
+	md.line("%s %s%s %s(%s)\n", h, label, returnType, method.Name,
+		paramNames(method.Parameters))
+	md.paragraph(annotationText(method.Deprecated, method.Since, method.Stability))
+	md.source(method.SourceURL)
+	md.description(method.Description, method.Details)
+	md.parameters(h+"#", method.Parameters)
+	if !method.Returns.Skip {
+		md.line("%s# Returns\n", h)
+		md.table([]string{"Type", "Description"}, [][]string{{
+			string(method.Returns.Type), string(method.Returns.Description),
+		}})
+	}
+	md.throws(h+"#", method.Throws)
+	md.examples(h+"#", method.Examples)
+}
+
+// throws writes the table of the exceptions
+func (md *markdown) throws(level string, throws []Exception) {
+	if throws == nil {
+		return
+	}
+	md.line("%s Throws\n", level)
+	var rows [][]string
+	for _, exception := range throws {
+		rows = append(rows, []string{string(exception.Type), exception.Description})
+	}
+	md.table([]string{"Type", "Description"}, rows)
+}
+
+// examples writes the code blocks (the fences are longer than the backtick
+// runs of the code)
+func (md *markdown) examples(level string, examples []Example) {
+	if examples == nil {
+		return
+	}
+	md.line("%s Examples\n", level)
+	for _, example := range examples {
+		if example.Title != "" {
+			md.line("%s\n", example.Title)
+		}
+		fence := "```"
+		for strings.Contains(example.Code, fence) {
+			fence += "`"
+		}
+		md.line("%s%s\n%s\n%s\n", fence, strings.ToLower(example.Language), example.Code, fence)
+	}
+}
+
+// properties writes the titled table of the properties (or the constants)
+func (md *markdown) properties(h string, title string, props []Property) {
+	if props == nil {
+		return
+	}
+	md.line("%s %s\n", h, title)
+	var rows [][]string
+	for _, prop := range props {
+		description := prop.Description
+		if prop.Details != nil {
+			// The cells have the escaped HTML text
+			description = html.EscapeString(strings.Join(md.rich(prop.Details), "\n"))
+		}
+		description = strings.TrimSpace(description + " " +
+			annotationText(prop.Deprecated, prop.Since, prop.Stability))
+		name := prop.Name
+		if prop.SourceURL != "" {
+			name += fmt.Sprintf(" [source](%s)", prop.SourceURL)
+		}
+		rows = append(rows, []string{name, string(prop.Type), description})
+	}
+	md.table([]string{"Name", "Type", "Description"}, rows)
+}
+
+// source writes the view source link (if any)
+func (md *markdown) source(url string) {
+	if url != "" {
+		md.line("[source](%s)\n", url)
+	}
+}
+
+// namespace writes the namespace members with the headings starting at the given level
+func (md *markdown) namespace(ns Namespace, level int) {
+	if ns.Anchor == "" {
+		return
+	}
+	h := strings.Repeat("#", level)
+	md.line("<a id=\"%s\"></a>\n", ns.Anchor)
+	md.description(ns.Description, ns.Details)
+	if ns.Language != "" {
+		md.line("Language: %s\n", ns.Language)
+	}
+	md.properties(h, "Constants", ns.Constants)
+	md.properties(h, "Variables", ns.Variables)
+	for _, function := range ns.Functions {
+		md.method(h, "Function", function)
+	}
+}
+
+// classRefs writes the labeled list of the linked classes
+func (md *markdown) classRefs(label string, refs []ClassRef) {
+	if refs == nil {
+		return
+	}
+	var links []string
+	for _, ref := range refs {
+		links = append(links, md.text(string(classLink(ref))))
+	}
+	md.line("%s: %s\n", label, strings.Join(links, ", "))
+}
+
+func (md *markdown) classList(classes []Class) {
+	if classes == nil {
+		return
+	}
+	var rows [][]string
+	for _, cls := range classes {
+		link := fmt.Sprintf("<a href=\"#%s\">%s</a>", cls.Ref, cls.Name)
+		rows = append(rows, []string{link, cls.KindName(), cls.Description})
+	}
+	md.table([]string{"Name", "Kind", "Description"}, rows)
+}
+
+// RenderMarkdown writes all the namespaces and classes into a single document
+func RenderMarkdown(title string, namespaces map[string][]Class, members map[string]Namespace,
+	frontMatter bool) ([]byte, error) {
+	md := newMarkdown(namespaces, func(cls Class) string { return "" })
+	if frontMatter {
+		md.frontMatter("title", title)
+	}
+	md.line("# %s\n", title)
+	for _, ns := range namespaceNames(namespaces, members) {
+		md.line("## %s namespace\n", ns)
+		md.namespace(members[ns], 3)
+		md.classList(namespaces[ns])
+		for _, cls := range namespaces[ns] {
+			md.class(cls, 3)
+		}
+	}
+	return md.buf.Bytes(), nil
+}
+
+func markdownPage(cls Class) string {
+	return cls.Ref + ".md"
+}
+
+// RenderMarkdownPages writes the index (with the namespace members) and a document
+// per class into the directory
+func RenderMarkdownPages(title string, namespaces map[string][]Class, members map[string]Namespace,
+	frontMatter bool, dir string) error {
+	if err := createDir(dir); err != nil {
+		return err
+	}
+	index := newMarkdown(namespaces, markdownPage)
+	if frontMatter {
+		index.frontMatter("title", title)
+	}
+	index.line("# %s\n", title)
+	for _, ns := range namespaceNames(namespaces, members) {
+		index.line("## %s namespace\n", ns)
+		index.namespace(members[ns], 3)
+		index.classList(namespaces[ns])
+		for _, cls := range namespaces[ns] {
+			md := newMarkdown(namespaces, markdownPage)
+			if frontMatter {
+				md.frontMatter("title", cls.Name, "namespace", ns)
+			}
+			md.class(cls, 1)
+			if err := save(md.buf.Bytes(), filepath.Join(dir, markdownPage(cls))); err != nil {
+				return err
+			}
+		}
+	}
+	return save(index.buf.Bytes(), filepath.Join(dir, "index.md"))
+}
@@ -0,0 +1,27 @@
+package adx
+
+// namespaceIndex collects the namespace-level members in the order of the
+// declarations (the members without a namespace are in Global)
+type namespaceIndex struct {
+	result *AdxResult
+	index  map[string]int
+}
+
+func (n *namespaceIndex) get(ns string) *Namespace {
+	if ns == "" {
+		ns = "Global"
+	}
+	if n.index == nil {
+		n.index = map[string]int{}
+		for i, namespace := range n.result.Namespaces {
+			n.index[namespace.Name] = i
+		}
+	}
+	i, ok := n.index[ns]
+	if !ok {
+		i = len(n.result.Namespaces)
+		n.index[ns] = i
+		n.result.Namespaces = append(n.result.Namespaces, Namespace{Name: ns})
+	}
+	return &n.result.Namespaces[i]
+}
@@ -0,0 +1,875 @@
+package adx
+
+import (
+	"bytes"
+	"compress/zlib"
+	"fmt"
+	"strings"
+)
+
+// A4 page geometry in PDF points
+const (
+	pdfPageWidth    = 595.28
+	pdfPageHeight   = 841.89
+	pdfMargin       = 56.0
+	pdfContentWidth = pdfPageWidth - 2*pdfMargin
+	pdfCellPadding  = 4.0
+)
+
+// pdfFont describes one of the standard Type 1 fonts (no embedding required)
+type pdfFont struct {
+	res    string
+	base   string
+	widths []int
+}
+
+// Glyph widths for the characters 32..126 (from the Adobe AFM files)
+var helveticaWidths = []int{
+	278, 278, 355, 556, 556, 889, 667, 191, 333, 333, 389, 584, 278, 333, 278, 278,
+	556, 556, 556, 556, 556, 556, 556, 556, 556, 556, 278, 278, 584, 584, 584, 556,
+	1015, 667, 667, 722, 722, 667, 611, 778, 722, 278, 500, 667, 556, 833, 722, 778,
+	667, 778, 722, 667, 611, 722, 667, 944, 667, 667, 611, 278, 278, 278, 469, 556,
+	333, 556, 556, 500, 556, 556, 278, 556, 556, 222, 222, 500, 222, 833, 556, 556,
+	556, 556, 333, 500, 278, 556, 500, 722, 500, 500, 500, 334, 260, 334, 584,
+}
+
+var helveticaBoldWidths = []int{
+	278, 333, 474, 556, 556, 889, 722, 238, 333, 333, 389, 584, 278, 333, 278, 278,
+	556, 556, 556, 556, 556, 556, 556, 556, 556, 556, 333, 333, 584, 584, 584, 611,
+	975, 722, 722, 722, 722, 667, 611, 778, 722, 278, 556, 722, 611, 833, 722, 778,
+	667, 778, 722, 667, 611, 722, 667, 944, 667, 667, 611, 333, 278, 333, 584, 556,
+	333, 556, 611, 556, 611, 556, 333, 611, 611, 278, 278, 556, 278, 889, 611, 611,
+	611, 611, 389, 556, 333, 611, 556, 778, 556, 556, 500, 389, 280, 389, 584,
+}
+
+// Courier is monospaced
+var courierWidths = func() []int {
+	widths := make([]int, len(helveticaWidths))
+	for i := range widths {
+		widths[i] = 600
+	}
+	return widths
+}()
+
+var (
+	fontRegular = &pdfFont{"F1", "Helvetica", helveticaWidths}
+	fontBold    = &pdfFont{"F2", "Helvetica-Bold", helveticaBoldWidths}
+	fontCode    = &pdfFont{"F3", "Courier", courierWidths}
+	fontItalic  = &pdfFont{"F4", "Helvetica-Oblique", helveticaWidths}
+	pdfFonts    = []*pdfFont{fontRegular, fontBold, fontCode, fontItalic}
+)
+
+func (f *pdfFont) runeWidth(r rune) float64 {
+	if r >= 32 && int(r-32) < len(f.widths) {
+		return float64(f.widths[r-32])
+	}
+	return 556
+}
+
+func (f *pdfFont) textWidth(s string, size float64) float64 {
+	var w float64
+	for _, r := range s {
+		w += f.runeWidth(r)
+	}
+	return w * size / 1000
+}
+
+// Characters of WinAnsiEncoding that differ from Latin-1
+var winAnsiRunes = map[rune]byte{
+	'€': 0x80, '‚': 0x82, '„': 0x84, '…': 0x85, '†': 0x86, '‡': 0x87,
+	'‘': 0x91, '’': 0x92, '“': 0x93, '”': 0x94, '•': 0x95, '–': 0x96,
+	'—': 0x97, '™': 0x99,
+}
+
+// pdfString encodes the text as a WinAnsi PDF literal string
+func pdfString(s string) string {
+	var buf bytes.Buffer
+	buf.WriteByte('(')
+	for _, r := range s {
+		var b byte
+		if c, ok := winAnsiRunes[r]; ok {
+			b = c
+		} else if r < 256 && (r >= 160 || (r >= 32 && r < 127)) {
+			b = byte(r)
+		} else {
+			b = '?'
+		}
+		if b == '(' || b == ')' || b == '\\' {
+			buf.WriteByte('\\')
+		}
+		buf.WriteByte(b)
+	}
+	buf.WriteByte(')')
+	return buf.String()
+}
+
+// wrapText splits the text into lines fitting the width
+func wrapText(s string, font *pdfFont, size float64, width float64) []string {
+	var lines []string
+	for _, para := range strings.Split(s, "\n") {
+		line := ""
+		for _, word := range strings.Fields(para) {
+			candidate := word
+			if line != "" {
+				candidate = line + " " + word
+			}
+			if font.textWidth(candidate, size) <= width {
+				line = candidate
+				continue
+			}
+			if line != "" {
+				lines = append(lines, line)
+			}
+			// Break the words that are too long to fit the line
+			for runes := []rune(word); font.textWidth(word, size) > width; word = string(runes) {
+				cut := 1
+				for cut < len(runes) && font.textWidth(string(runes[:cut+1]), size) <= width {
+					cut++
+				}
+				lines = append(lines, string(runes[:cut]))
+				runes = runes[cut:]
+			}
+			line = word
+		}
+		lines = append(lines, line)
+	}
+	return lines
+}
+
+// pdfLink is the link to the anchor or to the external URL
+type pdfLink struct {
+	rect   [4]float64
+	anchor string
+	uri    string
+}
+
+type pdfPage struct {
+	content bytes.Buffer
+	links   []pdfLink
+}
+
+type pdfAnchor struct {
+	page int
+	y    float64
+}
+
+// pdfLayout places the text blocks on the pages top to bottom
+type pdfLayout struct {
+	pages   []*pdfPage
+	y       float64
+	anchors map[string]pdfAnchor
+}
+
+func newPdfLayout() *pdfLayout {
+	return &pdfLayout{anchors: map[string]pdfAnchor{}}
+}
+
+func (l *pdfLayout) page() *pdfPage {
+	return l.pages[len(l.pages)-1]
+}
+
+func (l *pdfLayout) newPage() {
+	l.pages = append(l.pages, new(pdfPage))
+	l.y = pdfPageHeight - pdfMargin
+}
+
+// ensure starts a new page if the remaining space is less than the height
+func (l *pdfLayout) ensure(height float64) {
+	if l.pages == nil || l.y-height < pdfMargin {
+		l.newPage()
+	}
+}
+
+func (l *pdfLayout) anchor(name string) {
+	l.anchors[name] = pdfAnchor{len(l.pages) - 1, l.y}
+}
+
+func (l *pdfLayout) space(height float64) {
+	l.y -= height
+}
+
+func (l *pdfLayout) text(x float64, y float64, font *pdfFont, size float64, s string) {
+	if s == "" {
+		return
+	}
+	fmt.Fprintf(&l.page().content, "BT /%s %.1f Tf %.2f %.2f Td %s Tj ET\n",
+		font.res, size, x, y, pdfString(s))
+}
+
+func (l *pdfLayout) fillRect(x float64, y float64, w float64, h float64, gray float64) {
+	fmt.Fprintf(&l.page().content, "%.2f g %.2f %.2f %.2f %.2f re f 0 g\n",
+		gray, x, y, w, h)
+}
+
+func (l *pdfLayout) hline(x float64, y float64, w float64) {
+	fmt.Fprintf(&l.page().content, "0.8 G 0.5 w %.2f %.2f m %.2f %.2f l S 0 G\n",
+		x, y, x+w, y)
+}
+
+// paragraph writes the wrapped text with the given indentation
+func (l *pdfLayout) paragraph(font *pdfFont, size float64, indent float64, s string) {
+	if s == "" {
+		return
+	}
+	leading := size * 1.3
+	for _, line := range wrapText(s, font, size, pdfContentWidth-indent) {
+		l.ensure(leading)
+		l.y -= leading
+		l.text(pdfMargin+indent, l.y+size*0.25, font, size, line)
+	}
+	l.space(size * 0.5)
+}
+
+// code writes the preformatted lines on the gray background (the long lines
+// are broken at the content width)
+func (l *pdfLayout) code(s string) {
+	const size = 8.5
+	const leading = size * 1.3
+	maxChars := int((pdfContentWidth - 2*pdfCellPadding) / fontCode.textWidth(" ", size))
+	var lines []string
+	for _, line := range strings.Split(strings.ReplaceAll(s, "\t", "    "), "\n") {
+		runes := []rune(line)
+		for len(runes) > maxChars {
+			lines = append(lines, string(runes[:maxChars]))
+			runes = runes[maxChars:]
+		}
+		lines = append(lines, string(runes))
+	}
+	for _, line := range lines {
+		l.ensure(leading)
+		l.fillRect(pdfMargin, l.y-leading, pdfContentWidth, leading, 0.95)
+		l.y -= leading
+		l.text(pdfMargin+pdfCellPadding, l.y+size*0.25, fontCode, size, line)
+	}
+	l.space(size)
+}
+
+// pdfWord is the word of the rich text (the gap is the space before it, and
+// the new line words start the line)
+type pdfWord struct {
+	text    string
+	font    *pdfFont
+	url     string
+	gap     bool
+	newLine bool
+}
+
+var pdfSpanFonts = map[string]*pdfFont{
+	"code": fontCode, "emphasis": fontItalic, "strong": fontBold,
+}
+
+// richWords splits the spans into the words (the words of the adjacent spans
+// without the spaces between them are not separated)
+func richWords(spans []Span) []pdfWord {
+	var words []pdfWord
+	gap, newLine := false, false
+	for _, span := range spans {
+		font := pdfSpanFonts[span.Kind]
+		if font == nil {
+			font = fontRegular
+		}
+		url := ""
+		if span.Kind == "link" && safeURL(span.URL) {
+			url = span.URL
+		}
+		start := -1
+		for i, r := range span.Text + " " {
+			if r != ' ' && r != '\t' && r != '\n' {
+				if start < 0 {
+					start = i
+				}
+				continue
+			}
+			if start >= 0 {
+				words = append(words, pdfWord{span.Text[start:i], font, url, gap, newLine})
+				start, gap, newLine = -1, false, false
+			}
+			if i < len(span.Text) {
+				gap = true
+				newLine = newLine || r == '\n'
+			}
+		}
+	}
+	return words
+}
+
+// richParagraph writes the wrapped words of the spans with their fonts and
+// links; the marker (e.g. the bullet of the list item) precedes the first line
+func (l *pdfLayout) richParagraph(spans []Span, indent float64, marker string) {
+	const size = 10
+	const leading = size * 1.3
+	width := pdfContentWidth - indent
+	// The words of the same font and link are written together
+	var run pdfWord
+	var runX, runWidth float64
+	flush := func() {
+		if run.text == "" {
+			return
+		}
+		left := pdfMargin + indent + runX
+		if run.url == "" {
+			l.text(left, l.y+size*0.25, run.font, size, run.text)
+		} else {
+			// The links are blue
+			l.page().content.WriteString("0 0 0.6 rg\n")
+			l.text(left, l.y+size*0.25, run.font, size, run.text)
+			l.page().content.WriteString("0 g\n")
+			link := pdfLink{rect: [4]float64{left, l.y, left + runWidth, l.y + leading}}
+			if strings.HasPrefix(run.url, "#") {
+				link.anchor = run.url[1:]
+			} else {
+				link.uri = run.url
+			}
+			l.page().links = append(l.page().links, link)
+		}
+		run.text = ""
+	}
+	x := width
+	first := true
+	for _, word := range richWords(spans) {
+		for _, text := range wrapText(word.text, word.font, size, width) {
+			w := word.font.textWidth(text, size)
+			// The spaces between the runs are the regular ones
+			merge := run.text != "" && run.font == word.font && run.url == word.url
+			gap, gapWidth := "", 0.0
+			if word.gap {
+				gap, gapWidth = " ", fontRegular.textWidth(" ", size)
+				if merge {
+					gapWidth = word.font.textWidth(" ", size)
+				}
+			}
+			if word.newLine || x+gapWidth+w > width {
+				flush()
+				l.ensure(leading)
+				l.y -= leading
+				x, gap, gapWidth, merge = 0, "", 0, false
+				if first {
+					l.text(pdfMargin+indent-12, l.y+size*0.25, fontRegular, size, marker)
+					first = false
+				}
+			}
+			if !merge {
+				flush()
+				x += gapWidth
+				run, runX, runWidth = word, x, 0
+				run.text, gap, gapWidth = "", "", 0
+			}
+			run.text += gap + text
+			runWidth += gapWidth + w
+			x += gapWidth + w
+			word.newLine, word.gap = false, false
+		}
+	}
+	flush()
+}
+
+// rich writes the blocks of the rich description (the list items are
+// indented after their markers)
+func (l *pdfLayout) rich(blocks []Block, indent float64, marker string) {
+	for i, block := range blocks {
+		switch block.Kind {
+		case "list", "ordered-list":
+			for j, item := range block.Blocks {
+				itemMarker := "•"
+				if block.Kind == "ordered-list" {
+					itemMarker = fmt.Sprintf("%d.", j+1)
+				}
+				l.rich(item.Blocks, indent+14, itemMarker)
+			}
+			if indent == 0 {
+				l.space(3)
+			}
+		case "item":
+			l.rich(block.Blocks, indent, marker)
+		case "code":
+			l.code(block.Code)
+		default:
+			if i > 0 {
+				marker = ""
+			}
+			l.richParagraph(block.Spans, indent, marker)
+			if indent == 0 {
+				l.space(5)
+			} else {
+				l.space(2)
+			}
+		}
+	}
+}
+
+// layoutDescription writes the rich description (if any) or the plain one
+func layoutDescription(l *pdfLayout, description string, details []Block) {
+	if details == nil {
+		l.paragraph(fontRegular, 10, 0, plainText(description))
+		return
+	}
+	l.rich(details, 0, "")
+}
+
+// heading writes the bold title keeping at least a few lines after it
+func (l *pdfLayout) heading(size float64, s string) {
+	l.space(size * 0.6)
+	l.ensure(size*1.3 + 40)
+	l.paragraph(fontBold, size, 0, s)
+}
+
+// table writes the rows with the header repeated on every page
+func (l *pdfLayout) table(headers []string, widths []float64, rows [][]string) {
+	const size = 9.0
+	const leading = size * 1.3
+	drawHeader := func() {
+		l.ensure(leading + 2*pdfCellPadding)
+		height := leading + 2*pdfCellPadding
+		l.fillRect(pdfMargin, l.y-height, pdfContentWidth, height, 0.93)
+		x := pdfMargin
+		for i, header := range headers {
+			l.text(x+pdfCellPadding, l.y-pdfCellPadding-size, fontBold, size, header)
+			x += widths[i] * pdfContentWidth
+		}
+		l.y -= height
+		l.hline(pdfMargin, l.y, pdfContentWidth)
+	}
+
+	l.ensure(2 * (leading + 2*pdfCellPadding))
+	drawHeader()
+	for _, row := range rows {
+		cells := make([][]string, len(row))
+		maxLines := 1
+		for i, cell := range row {
+			width := widths[i]*pdfContentWidth - 2*pdfCellPadding
+			cells[i] = wrapText(cell, fontRegular, size, width)
+			if len(cells[i]) > maxLines {
+				maxLines = len(cells[i])
+			}
+		}
+		for start := 0; start < maxLines; {
+			available := int((l.y - pdfMargin - 2*pdfCellPadding) / leading)
+			if available < 1 {
+				l.newPage()
+				drawHeader()
+				continue
+			}
+			count := maxLines - start
+			if count > available {
+				count = available
+			}
+			x := pdfMargin
+			for i, lines := range cells {
+				for j := start; j < start+count && j < len(lines); j++ {
+					y := l.y - pdfCellPadding - size - float64(j-start)*leading
+					l.text(x+pdfCellPadding, y, fontRegular, size, lines[j])
+				}
+				x += widths[i] * pdfContentWidth
+			}
+			l.y -= float64(count)*leading + 2*pdfCellPadding
+			l.hline(pdfMargin, l.y, pdfContentWidth)
+			start += count
+		}
+	}
+	l.space(size)
+}
+
+// pdfOutline is the bookmark (and the table of contents entry)
+type pdfOutline struct {
+	title    string
+	anchor   string
+	children []*pdfOutline
+}
+
+var parameterColumns = []float64{0.25, 0.25, 0.5}
+
+func parameterRows(params []Parameter) [][]string {
+	var rows [][]string
+	for _, param := range params {
+		rows = append(rows, []string{
+			param.Label(), plainText(string(param.Type)), plainText(param.Description),
+		})
+	}
+	return rows
+}
+
+func paramNames(params []Parameter) string {
+	var names []string
+	for _, param := range params {
+		names = append(names, param.Name)
+	}
+	return strings.Join(names, ", ")
+}
+
+// layoutAnnotations writes the deprecation, the since version and the stability (if any)
+func layoutAnnotations(l *pdfLayout, deprecated string, since string, stability string) {
+	if text := annotationText(deprecated, since, stability); text != "" {
+		l.paragraph(fontRegular, 10, 0, text)
+	}
+}
+
+// layoutExamples writes the titled code blocks
+func layoutExamples(l *pdfLayout, size float64, examples []Example) {
+	if examples == nil {
+		return
+	}
+	l.heading(size, "Examples")
+	for _, example := range examples {
+		l.paragraph(fontRegular, 10, 0, example.Title)
+		l.code(example.Code)
+	}
+}
+
+func layoutMethod(l *pdfLayout, title string, method Method, withReturns bool) {
+	l.heading(12, title)
+	layoutAnnotations(l, method.Deprecated, method.Since, method.Stability)
+	layoutDescription(l, method.Description, method.Details)
+	if method.Parameters != nil {
+		l.heading(10, "Parameters")
+		l.table([]string{"Name", "Type", "Description"}, parameterColumns,
+			parameterRows(method.Parameters))
+	}
+	if withReturns && !method.Returns.Skip {
+		l.heading(10, "Returns")
+		l.table([]string{"Type", "Description"}, []float64{0.3, 0.7}, [][]string{{
+			plainText(string(method.Returns.Type)),
+			plainText(string(method.Returns.Description)),
+		}})
+	}
+	if method.Throws != nil {
+		l.heading(10, "Throws")
+		var rows [][]string
+		for _, exception := range method.Throws {
+			rows = append(rows, []string{
+				plainText(string(exception.Type)), plainText(exception.Description),
+			})
+		}
+		l.table([]string{"Type", "Description"}, []float64{0.3, 0.7}, rows)
+	}
+	layoutExamples(l, 10, method.Examples)
+}
+
+// methodTitle is the heading of the method (or the function) with its return type
+func methodTitle(label string, method Method) string {
+	title := label + " "
+	if returnType := plainText(string(method.Returns.Type)); returnType != "" {
+		title += returnType + " "
+	}
+	return title + fmt.Sprintf("%s(%s)", method.Name, paramNames(method.Parameters))
+}
+
+// layoutProperties writes the titled table of the properties (or the constants)
+func layoutProperties(l *pdfLayout, title string, props []Property) {
+	if props == nil {
+		return
+	}
+	l.heading(14, title)
+	var rows [][]string
+	for _, prop := range props {
+		rows = append(rows, []string{
+			prop.Name, plainText(string(prop.Type)),
+			strings.TrimSpace(plainText(prop.Description) + " " +
+				annotationText(prop.Deprecated, prop.Since, prop.Stability)),
+		})
+	}
+	l.table([]string{"Name", "Type", "Description"}, parameterColumns, rows)
+}
+
+// layoutMembers writes the namespace members after the list of the classes
+func layoutMembers(l *pdfLayout, ns Namespace) {
+	if ns.Anchor == "" {
+		return
+	}
+	l.anchor(ns.Anchor)
+	layoutDescription(l, ns.Description, ns.Details)
+	layoutProperties(l, "Constants", ns.Constants)
+	layoutProperties(l, "Variables", ns.Variables)
+	for _, function := range ns.Functions {
+		layoutMethod(l, methodTitle("Function", function), function, true)
+	}
+}
+
+func layoutClass(l *pdfLayout, ns string, cls Class) {
+	l.newPage()
+	l.anchor(cls.Ref)
+	l.paragraph(fontBold, 18, 0, cls.KindLabel()+" "+cls.Name)
+	layoutAnnotations(l, cls.Deprecated, cls.Since, cls.Stability)
+	l.paragraph(fontRegular, 10, 0, "Namespace: "+ns)
+	if cls.Language != "" {
+		l.paragraph(fontRegular, 10, 0, "Language: "+cls.Language)
+	}
+	layoutDescription(l, cls.Description, cls.Details)
+	for _, refs := range []struct {
+		label string
+		refs  []ClassRef
+	}{{"Extends", cls.Bases}, {"Implements", cls.Interfaces}, {"Derived classes", cls.Derived}} {
+		if refs.refs != nil {
+			var names []string
+			for _, ref := range refs.refs {
+				names = append(names, ref.Name)
+			}
+			l.paragraph(fontRegular, 10, 0, refs.label+": "+strings.Join(names, ", "))
+		}
+	}
+	layoutExamples(l, 14, cls.Examples)
+
+	layoutProperties(l, "Properties", cls.Properties)
+	if cls.Values != nil {
+		l.heading(14, "Values")
+		var rows [][]string
+		for _, value := range cls.Values {
+			rows = append(rows, []string{value.Name, value.Value, plainText(value.Description)})
+		}
+		l.table([]string{"Name", "Value", "Description"}, parameterColumns, rows)
+	}
+	for _, ctor := range cls.Constructors {
+		title := fmt.Sprintf("Constructor %s(%s)", cls.Name, paramNames(ctor.Parameters))
+		layoutMethod(l, title, ctor, false)
+	}
+	for _, method := range cls.Methods {
+		layoutMethod(l, methodTitle("Method", method), method, true)
+	}
+	for _, inherited := range cls.Inherited {
+		l.heading(14, "Inherited from "+inherited.From.Name)
+		if inherited.Properties != nil {
+			l.paragraph(fontRegular, 10, 0, "Properties: "+strings.Join(inherited.Properties, ", "))
+		}
+		if inherited.Methods != nil {
+			l.paragraph(fontRegular, 10, 0, "Methods: "+strings.Join(inherited.Methods, ", "))
+		}
+	}
+}
+
+// layoutNamespaces writes the namespace members and the classes, and returns
+// the document outline
+func layoutNamespaces(l *pdfLayout, namespaces map[string][]Class, members map[string]Namespace) []*pdfOutline {
+	var outline []*pdfOutline
+	for _, ns := range namespaceNames(namespaces, members) {
+		nsOutline := &pdfOutline{title: ns + " namespace", anchor: "ns:" + ns}
+		l.newPage()
+		l.anchor(nsOutline.anchor)
+		l.paragraph(fontBold, 20, 0, nsOutline.title)
+		l.space(10)
+		for _, cls := range namespaces[ns] {
+			l.paragraph(fontBold, 11, 0, cls.KindLabel()+" "+cls.Name)
+			l.paragraph(fontRegular, 10, 12, plainText(cls.Description))
+			nsOutline.children = append(nsOutline.children,
+				&pdfOutline{title: cls.Name, anchor: cls.Ref})
+		}
+		layoutMembers(l, members[ns])
+		for _, cls := range namespaces[ns] {
+			layoutClass(l, ns, cls)
+		}
+		outline = append(outline, nsOutline)
+	}
+	return outline
+}
+
+// layoutTOC writes the table of contents; the page numbers are shifted by the offset
+func layoutTOC(outline []*pdfOutline, anchors map[string]pdfAnchor, offset int) *pdfLayout {
+	l := newPdfLayout()
+	l.newPage()
+	l.paragraph(fontBold, 20, 0, "Contents")
+	l.space(10)
+	var entry func(item *pdfOutline, indent float64, font *pdfFont)
+	entry = func(item *pdfOutline, indent float64, font *pdfFont) {
+		const size = 10.0
+		l.ensure(size * 1.6)
+		l.y -= size * 1.6
+		page := fmt.Sprint(anchors[item.anchor].page + offset + 1)
+		pageX := pdfPageWidth - pdfMargin - fontRegular.textWidth(page, size)
+		l.text(pdfMargin+indent, l.y, font, size, item.title)
+		l.text(pageX, l.y, fontRegular, size, page)
+		l.page().links = append(l.page().links, pdfLink{
+			rect:   [4]float64{pdfMargin, l.y - 3, pdfPageWidth - pdfMargin, l.y + size},
+			anchor: item.anchor,
+		})
+		for _, child := range item.children {
+			entry(child, indent+16, fontRegular)
+		}
+	}
+	for _, item := range outline {
+		entry(item, 0, fontBold)
+	}
+	return l
+}
+
+func layoutTitlePage(title string, namespaces map[string][]Class) *pdfLayout {
+	l := newPdfLayout()
+	l.newPage()
+	l.y = pdfPageHeight * 0.62
+	for _, line := range wrapText(title, fontBold, 28, pdfContentWidth) {
+		x := (pdfPageWidth - fontBold.textWidth(line, 28)) / 2
+		l.text(x, l.y, fontBold, 28, line)
+		l.y -= 36
+	}
+	classes := 0
+	for _, ns := range namespaces {
+		classes += len(ns)
+	}
+	subtitle := fmt.Sprintf("API Reference: %d namespaces, %d classes",
+		len(namespaces), classes)
+	x := (pdfPageWidth - fontRegular.textWidth(subtitle, 12)) / 2
+	l.text(x, l.y-10, fontRegular, 12, subtitle)
+	return l
+}
+
+// pdfWriter serializes the objects and keeps the cross-reference offsets
+type pdfWriter struct {
+	buf     bytes.Buffer
+	offsets []int
+}
+
+func (w *pdfWriter) object(id int, body string) {
+	for len(w.offsets) < id {
+		w.offsets = append(w.offsets, 0)
+	}
+	w.offsets[id-1] = w.buf.Len()
+	fmt.Fprintf(&w.buf, "%d 0 obj\n%s\nendobj\n", id, body)
+}
+
+func (w *pdfWriter) stream(id int, data []byte) error {
+	var compressed bytes.Buffer
+	zw := zlib.NewWriter(&compressed)
+	if _, err := zw.Write(data); err != nil {
+		return err
+	}
+	if err := zw.Close(); err != nil {
+		return err
+	}
+	w.object(id, fmt.Sprintf("<< /Length %d /Filter /FlateDecode >>\nstream\n%s\nendstream",
+		compressed.Len(), compressed.String()))
+	return nil
+}
+
+// writePdf assembles the pages, the link annotations and the bookmarks
+func writePdf(title string, pages []*pdfPage, anchors map[string]pdfAnchor,
+	outline []*pdfOutline) ([]byte, error) {
+	const catalogID, pagesID, firstFontID = 1, 2, 3
+	firstPageID := firstFontID + len(pdfFonts)
+	pageID := func(i int) int { return firstPageID + 2*i }
+	outlinesID := firstPageID + 2*len(pages)
+
+	dest := func(anchor string) string {
+		a := anchors[anchor]
+		return fmt.Sprintf("[%d 0 R /XYZ null %.2f null]", pageID(a.page), a.y+20)
+	}
+
+	w := new(pdfWriter)
+	w.buf.WriteString("%PDF-1.4\n%\xe2\xe3\xcf\xd3\n")
+	w.object(catalogID, fmt.Sprintf(
+		"<< /Type /Catalog /Pages %d 0 R /Outlines %d 0 R /PageMode /UseOutlines >>",
+		pagesID, outlinesID))
+
+	var kids []string
+	for i := range pages {
+		kids = append(kids, fmt.Sprintf("%d 0 R", pageID(i)))
+	}
+	w.object(pagesID, fmt.Sprintf("<< /Type /Pages /Kids [%s] /Count %d >>",
+		strings.Join(kids, " "), len(pages)))
+
+	var fonts []string
+	for i, font := range pdfFonts {
+		w.object(firstFontID+i, fmt.Sprintf(
+			"<< /Type /Font /Subtype /Type1 /BaseFont /%s /Encoding /WinAnsiEncoding >>",
+			font.base))
+		fonts = append(fonts, fmt.Sprintf("/%s %d 0 R", font.res, firstFontID+i))
+	}
+
+	for i, page := range pages {
+		var annots []string
+		for _, link := range page.links {
+			target := "/A << /S /URI /URI " + pdfString(link.uri) + " >>"
+			if link.uri == "" {
+				// The links to the classes missing in the document are skipped
+				if _, ok := anchors[link.anchor]; !ok {
+					continue
+				}
+				target = "/Dest " + dest(link.anchor)
+			}
+			annots = append(annots, fmt.Sprintf(
+				"<< /Type /Annot /Subtype /Link /Border [0 0 0] /Rect [%.2f %.2f %.2f %.2f] %s >>",
+				link.rect[0], link.rect[1], link.rect[2], link.rect[3], target))
+		}
+		content := page.content.Bytes()
+		if i > 0 {
+			number := fmt.Sprint(i + 1)
+			x := (pdfPageWidth - fontRegular.textWidth(number, 9)) / 2
+			content = append(content, fmt.Sprintf("BT /%s 9 Tf %.2f %.2f Td %s Tj ET\n",
+				fontRegular.res, x, pdfMargin/2, pdfString(number))...)
+		}
+		w.object(pageID(i), fmt.Sprintf(
+			"<< /Type /Page /Parent %d 0 R /MediaBox [0 0 %.2f %.2f] /Resources << /Font << %s >> >> /Contents %d 0 R /Annots [%s] >>",
+			pagesID, pdfPageWidth, pdfPageHeight, strings.Join(fonts, " "),
+			pageID(i)+1, strings.Join(annots, " ")))
+		if err := w.stream(pageID(i)+1, content); err != nil {
+			return nil, err
+		}
+	}
+
+	// The outline items are numbered depth-first after the outlines root
+	nextID := outlinesID + 1
+	var writeItems func(items []*pdfOutline, parent int) (int, int, int)
+	writeItems = func(items []*pdfOutline, parent int) (int, int, int) {
+		ids := make([]int, len(items))
+		for i := range items {
+			ids[i] = nextID
+			nextID++
+		}
+		count := len(items)
+		for i, item := range items {
+			body := fmt.Sprintf("<< /Title %s /Parent %d 0 R /Dest %s",
+				pdfString(item.title), parent, dest(item.anchor))
+			if i > 0 {
+				body += fmt.Sprintf(" /Prev %d 0 R", ids[i-1])
+			}
+			if i < len(items)-1 {
+				body += fmt.Sprintf(" /Next %d 0 R", ids[i+1])
+			}
+			if item.children != nil {
+				first, last, _ := writeItems(item.children, ids[i])
+				body += fmt.Sprintf(" /First %d 0 R /Last %d 0 R /Count %d",
+					first, last, -len(item.children))
+			}
+			w.object(ids[i], body+" >>")
+		}
+		if count == 0 {
+			return 0, 0, 0
+		}
+		return ids[0], ids[count-1], count
+	}
+	first, last, count := writeItems(outline, outlinesID)
+	if count > 0 {
+		w.object(outlinesID, fmt.Sprintf("<< /Type /Outlines /First %d 0 R /Last %d 0 R /Count %d >>",
+			first, last, count))
+	} else {
+		w.object(outlinesID, "<< /Type /Outlines /Count 0 >>")
+	}
+	// The document information dictionary follows the outline items
+	infoID := nextID
+	w.object(infoID, fmt.Sprintf("<< /Title %s /Producer (adx) >>", pdfString(title)))
+
+	xref := w.buf.Len()
+	fmt.Fprintf(&w.buf, "xref\n0 %d\n0000000000 65535 f \n", len(w.offsets)+1)
+	for _, offset := range w.offsets {
+		fmt.Fprintf(&w.buf, "%010d 00000 n \n", offset)
+	}
+	fmt.Fprintf(&w.buf, "trailer\n<< /Size %d /Root %d 0 R /Info %d 0 R >>\nstartxref\n%d\n%%%%EOF\n",
+		len(w.offsets)+1, catalogID, infoID, xref)
+	return w.buf.Bytes(), nil
+}
+
+// RenderPDF writes the title page, the table of contents, the namespace members
+// and the classes
+func RenderPDF(title string, namespaces map[string][]Class, members map[string]Namespace) ([]byte, error) {
+	content := newPdfLayout()
+	outline := layoutNamespaces(content, namespaces, members)
+
+	// The TOC page count doesn't depend on the page numbers, so it's laid out twice
+	offset := 1 + len(layoutTOC(outline, content.anchors, 0).pages)
+	toc := layoutTOC(outline, content.anchors, offset)
+
+	anchors := map[string]pdfAnchor{}
+	for name, a := range content.anchors {
+		anchors[name] = pdfAnchor{a.page + offset, a.y}
+	}
+	pages := layoutTitlePage(title, namespaces).pages
+	pages = append(pages, toc.pages...)
+	pages = append(pages, content.pages...)
+	return writePdf(title, pages, anchors, outline)
+}
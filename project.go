@@ -0,0 +1,366 @@
+package adx
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"gopkg.in/yaml.v2"
+)
+
+// ProjectFile is auto-discovered in the working directory by the command line tool
+const ProjectFile = "adx.yaml"
+
+// ProjectInput is the source code of a single language
+type ProjectInput struct {
+	Lang    string
+	Src     []string
+	Exclude []string
+	JSConf  string `yaml:"jsconf"`
+	// DoxygenXML are the existing Doxygen XML output dirs (parsed by the
+	// Doxygen-backed languages along with the sources)
+	DoxygenXML []string `yaml:"doxygen-xml"`
+	// JSDocJSON are the existing doclets files (jsdoc -X) of the js language
+	JSDocJSON []string `yaml:"jsdoc-json"`
+}
+
+// ProjectOutput is the rendered documentation (the format is based on
+// the path extension by default, the paths without one are the html
+// directories); the html and md outputs with the format field are
+// directories unless the path has the format extension
+type ProjectOutput struct {
+	Path        string
+	Format      string
+	FrontMatter bool `yaml:"front-matter"`
+}
+
+// outputFormats are the formats of the outputs, html and md also have the
+// directory outputs (the site and the pages)
+var outputFormats = map[string]bool{"xml": true, "json": true, "html": true, "pdf": true, "md": true}
+
+// format returns the output format and whether the output is a directory
+func (o ProjectOutput) format() (string, bool, error) {
+	ext := strings.ToLower(strings.TrimPrefix(filepath.Ext(o.Path), "."))
+	format := o.Format
+	if format == "" {
+		format = ext
+		if ext == "" {
+			format = "html"
+		} else if !outputFormats[ext] {
+			return "", false, &Error{Phase: PhaseConfig, Err: fmt.Errorf("unknown output extension .%s (use the format field)", ext)}
+		}
+	}
+	if !outputFormats[format] {
+		return "", false, &Error{Phase: PhaseConfig, Err: fmt.Errorf("unknown output format %s", format)}
+	}
+	return format, ext != format && (format == "html" || format == "md"), nil
+}
+
+// UnmarshalYAML allows the outputs to be the plain paths
+func (o *ProjectOutput) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var path string
+	if err := unmarshal(&path); err == nil {
+		o.Path = path
+		return nil
+	}
+	type plain ProjectOutput
+	return unmarshal((*plain)(o))
+}
+
+// Project describes the whole documentation build
+type Project struct {
+	Title     string
+	Template  string
+	Vars      map[string]string
+	Conf      string
+	Languages map[string]Language
+	Inputs    []ProjectInput
+	In        []string
+	Outputs   []ProjectOutput
+	// Inherited lists the members inherited from the documented base
+	// classes in the class documentation
+	Inherited bool `yaml:"inherited"`
+	// HideDeprecated removes the deprecated classes and members from the
+	// rendered outputs (HTML, PDF and Markdown)
+	HideDeprecated bool `yaml:"hide-deprecated"`
+	// SourceURL is the URL pattern of the view source links with the {path}
+	// and {line} placeholders (e.g. https://git.example/{path}#L{line})
+	SourceURL string `yaml:"source-url"`
+	// SourceRoot is the dir the source paths are relative to (the project
+	// file dir by default, the working directory without the project file)
+	SourceRoot string `yaml:"source-root"`
+	// KeepGoing reports the unreadable source files and the invalid adx
+	// inputs to Report (if any) and skips them instead of failing the build
+	KeepGoing bool        `yaml:"keep-going"`
+	Report    func(error) `yaml:"-"`
+}
+
+// LoadProject reads the project file resolving its paths relative to the file
+func LoadProject(file string) (Project, error) {
+	var p Project
+	// #nosec
+	data, err := os.ReadFile(file)
+	if err != nil {
+		return p, withContext(err, PhaseConfig, file)
+	}
+	if err = yaml.UnmarshalStrict(data, &p); err != nil {
+		return p, withContext(err, PhaseConfig, file)
+	}
+
+	dir := filepath.Dir(file)
+	resolve := func(path *string) {
+		if *path != "" && !filepath.IsAbs(*path) {
+			*path = filepath.Join(dir, *path)
+		}
+	}
+	resolve(&p.Template)
+	resolve(&p.Conf)
+	resolve(&p.SourceRoot)
+	if p.SourceRoot == "" {
+		p.SourceRoot = dir
+	}
+	for i := range p.Inputs {
+		for j := range p.Inputs[i].Src {
+			resolve(&p.Inputs[i].Src[j])
+		}
+		resolve(&p.Inputs[i].JSConf)
+		for j := range p.Inputs[i].DoxygenXML {
+			resolve(&p.Inputs[i].DoxygenXML[j])
+		}
+		for j := range p.Inputs[i].JSDocJSON {
+			resolve(&p.Inputs[i].JSDocJSON[j])
+		}
+	}
+	for i := range p.In {
+		resolve(&p.In[i])
+	}
+	for i := range p.Outputs {
+		resolve(&p.Outputs[i].Path)
+		if _, _, err = p.Outputs[i].format(); err != nil {
+			return p, withContext(err, PhaseConfig, file)
+		}
+	}
+	return p, nil
+}
+
+// CustomLanguages merges the custom languages from the configuration file and the project
+func (p Project) CustomLanguages() (map[string]Language, error) {
+	languages := map[string]Language{}
+	if p.Conf != "" {
+		conf, err := LoadLanguages(p.Conf)
+		if err != nil {
+			return nil, err
+		}
+		for name, language := range conf {
+			languages[name] = language
+		}
+	}
+	for name, language := range p.Languages {
+		languages[name] = language
+	}
+	return languages, nil
+}
+
+// isExcluded matches the path relative to the source dir with the patterns:
+// the patterns with a slash are matched with the whole path, the others
+// with any of its elements
+func isExcluded(rel string, patterns []string) bool {
+	rel = filepath.ToSlash(rel)
+	for _, pattern := range patterns {
+		if strings.Contains(pattern, "/") {
+			pattern = strings.TrimSuffix(pattern, "/")
+			if ok, _ := filepath.Match(pattern, rel); ok {
+				return true
+			}
+			continue
+		}
+		for _, elem := range strings.Split(rel, "/") {
+			if ok, _ := filepath.Match(pattern, elem); ok {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// walkSources calls the function for the source files not excluded by the patterns
+func walkSources(srcDir string, exclude []string, fn func(path string, info os.FileInfo) error) error {
+	return filepath.Walk(srcDir, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		rel, err := filepath.Rel(srcDir, path)
+		if err != nil {
+			return err
+		}
+		if rel != "." && isExcluded(rel, exclude) {
+			if info.IsDir() {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if info.IsDir() {
+			return nil
+		}
+		return fn(path, info)
+	})
+}
+
+// inputContent generates the intermediate content of the input sources and
+// merges the existing Doxygen XML and JSDoc JSON outputs
+func inputContent(input ProjectInput, gen Generator) ([]byte, error) {
+	content, err := IntermediateContent(input.Src, gen)
+	if err != nil {
+		return nil, err
+	}
+	merge := func(paths []string, read func(string) ([]byte, error), tool string, ok bool) error {
+		if paths != nil && !ok {
+			return &Error{Phase: PhaseConfig, Err: fmt.Errorf("the %s language doesn't read the %s output", input.Lang, tool)}
+		}
+		for _, path := range paths {
+			pathContent, err := read(path)
+			if err != nil {
+				return err
+			}
+			if content == nil {
+				content = pathContent
+				continue
+			}
+			if content, err = gen.CombineIntermediate(content, pathContent); err != nil {
+				return withContext(err, PhaseParse, path)
+			}
+		}
+		return nil
+	}
+	_, isDoxygen := gen.(*doxygen)
+	if err = merge(input.DoxygenXML, ReadDoxygenXML, "Doxygen XML", isDoxygen); err != nil {
+		return nil, err
+	}
+	_, isJS := gen.(*js)
+	if err = merge(input.JSDocJSON, ReadJSDocJSON, "JSDoc JSON", isJS); err != nil {
+		return nil, err
+	}
+	return content, nil
+}
+
+// Parse generates the documents for all the inputs and merges the adx files
+func (p Project) Parse() (AdxResult, error) {
+	var doc AdxResult
+	languages, err := p.CustomLanguages()
+	if err != nil {
+		return doc, err
+	}
+	report := func(err error) {
+		if p.Report != nil {
+			p.Report(err)
+		}
+	}
+	for _, input := range p.Inputs {
+		gen, err := LookupGenerator(languages, input.Lang)
+		if err != nil {
+			return doc, err
+		}
+		gen.SetConf(input.JSConf)
+		gen.SetExcludes(input.Exclude)
+		gen.SetSourceRoot(p.SourceRoot)
+		if p.KeepGoing {
+			gen.SetErrorHandler(report)
+		}
+		intermediateContent, err := inputContent(input, gen)
+		if err != nil {
+			return doc, err
+		}
+		classes, err := gen.GenClasses(intermediateContent)
+		if err != nil {
+			return doc, withContext(err, PhaseParse, "")
+		}
+		for _, cls := range classes {
+			if cls.Language == "" {
+				cls.Language = input.Lang
+			}
+			doc.Classes = append(doc.Classes, cls)
+		}
+		if nsGen, ok := gen.(NamespaceGenerator); ok {
+			namespaces, err := nsGen.GenNamespaces(intermediateContent)
+			if err != nil {
+				return doc, withContext(err, PhaseParse, "")
+			}
+			for _, ns := range namespaces {
+				if ns.Language == "" {
+					ns.Language = input.Lang
+				}
+				doc.Namespaces = append(doc.Namespaces, ns)
+			}
+		}
+	}
+	for _, inFile := range p.In {
+		v, err := ReadAdx(inFile)
+		if err != nil {
+			if !p.KeepGoing {
+				return doc, err
+			}
+			report(err)
+			continue
+		}
+		doc.Classes = append(doc.Classes, v.Classes...)
+		doc.Namespaces = append(doc.Namespaces, v.Namespaces...)
+	}
+	return doc, nil
+}
+
+// Render writes the output in its format
+func (p Project) Render(doc AdxResult, output ProjectOutput) error {
+	return withContext(p.render(doc, output), PhaseRender, output.Path)
+}
+
+func (p Project) render(doc AdxResult, output ProjectOutput) error {
+	// The interchange outputs keep the deprecated APIs
+	rendered := doc
+	if p.HideDeprecated {
+		rendered = HideDeprecated(doc)
+	}
+	namespaces := Normalize(rendered.Classes)
+	members := NormalizeNamespaces(rendered.Namespaces)
+	if p.Inherited {
+		InheritMembers(namespaces)
+	}
+	if p.SourceURL != "" {
+		LinkSources(namespaces, members, p.SourceURL)
+	}
+	opts := RenderOptions{
+		Title:    p.Title,
+		Template: p.Template,
+		Vars:     p.Vars,
+	}
+	format, dir, err := output.format()
+	if err != nil {
+		return err
+	}
+	if dir {
+		if format == "md" {
+			return RenderMarkdownPages(p.Title, namespaces, members, output.FrontMatter, output.Path)
+		}
+		return RenderSite(opts, namespaces, members, output.Path)
+	}
+	var content []byte
+	switch format {
+	case "xml":
+		content, err = RenderXML(doc)
+	case "json":
+		content, err = RenderJSON(doc)
+	case "html":
+		content, err = RenderHTML(opts, namespaces, members)
+	case "pdf":
+		content, err = RenderPDF(p.Title, namespaces, members)
+	case "md":
+		content, err = RenderMarkdown(p.Title, namespaces, members, output.FrontMatter)
+	}
+	if err != nil {
+		return err
+	}
+	if err = createDir(filepath.Dir(output.Path)); err != nil {
+		return err
+	}
+	return save(content, output.Path)
+}
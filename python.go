@@ -0,0 +1,900 @@
+package adx
+
+import (
+	"encoding/json"
+	"errors"
+	"html/template"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+)
+
+// python parses the Python modules natively (the indentation-based blocks
+// and the Google, NumPy and reST docstrings); the intermediate content is
+// the adx JSON document
+type python struct {
+	adxContent
+	excludes []string
+	onError  func(error)
+	root     string
+}
+
+func (py python) SetConf(conf string) {}
+
+func (py *python) SetExcludes(patterns []string) {
+	py.excludes = patterns
+}
+
+func (py *python) SetErrorHandler(handler func(error)) {
+	py.onError = handler
+}
+
+func (py *python) SetSourceRoot(dir string) {
+	py.root = dir
+}
+
+// pyModuleName converts the module path into the namespace (the packages
+// are named after their dirs), the private modules are skipped
+func pyModuleName(srcDir string, rel string) (string, bool) {
+	rel = filepath.ToSlash(strings.TrimSuffix(rel, ".py"))
+	elems := strings.Split(rel, "/")
+	if elems[len(elems)-1] == "__init__" {
+		elems = elems[:len(elems)-1]
+	}
+	for _, elem := range elems {
+		if strings.HasPrefix(elem, "_") || strings.HasPrefix(elem, ".") {
+			return "", false
+		}
+	}
+	if len(elems) == 0 {
+		abs, err := filepath.Abs(srcDir)
+		if err != nil {
+			return "", false
+		}
+		elems = []string{filepath.Base(abs)}
+	}
+	return strings.Join(elems, "::"), true
+}
+
+func (py python) GenIntermediate(srcDir string) ([]byte, error) {
+	var result AdxResult
+	err := walkSources(srcDir, py.excludes, func(path string, info os.FileInfo) error {
+		rel, err := filepath.Rel(srcDir, path)
+		if err != nil {
+			return err
+		}
+		ns, ok := pyModuleName(srcDir, rel)
+		if filepath.Ext(path) != ".py" || !ok {
+			return nil
+		}
+		// #nosec
+		src, err := os.ReadFile(path)
+		if err == nil {
+			var classes []Class
+			var namespace Namespace
+			classes, namespace, err = genPyModule(string(src), ns)
+			if err == nil {
+				result.Classes = append(result.Classes, classes...)
+				if namespace.Description != "" || namespace.Functions != nil || namespace.Constants != nil ||
+					namespace.Variables != nil {
+					result.Namespaces = append(result.Namespaces, namespace)
+				}
+				setSourceFile(&result, sourcePath(py.root, path))
+				return nil
+			}
+		}
+		err = withContext(err, PhaseSource, path)
+		if py.onError == nil {
+			return err
+		}
+		py.onError(err)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(result)
+}
+
+// pyLine is the logical line of the source: the lines continued with the
+// backslashes or the open brackets and the multi-line strings are joined
+type pyLine struct {
+	indent int
+	text   string
+	line   int
+}
+
+// pyLines splits the source into the logical lines skipping the comments
+// and the blank lines
+func pyLines(src string) ([]pyLine, error) {
+	src = strings.ReplaceAll(src, "\r\n", "\n")
+	var lines []pyLine
+	var buf strings.Builder
+	var quote string
+	depth, lineNo, start, indent := 0, 1, 1, -1
+	emit := func() {
+		if text := strings.TrimSpace(buf.String()); text != "" {
+			lines = append(lines, pyLine{indent: indent, text: text, line: start})
+		}
+		buf.Reset()
+		indent = -1
+	}
+	for i := 0; i < len(src); {
+		c := src[i]
+		if quote != "" {
+			switch {
+			case c == '\\' && i+1 < len(src):
+				if src[i+1] == '\n' {
+					lineNo++
+				}
+				buf.WriteString(src[i : i+2])
+				i += 2
+			case strings.HasPrefix(src[i:], quote):
+				buf.WriteString(quote)
+				i += len(quote)
+				quote = ""
+			case c == '\n' && len(quote) == 1:
+				return nil, &Error{Phase: PhaseParse, Line: lineNo, Err: errors.New("unterminated string literal")}
+			default:
+				if c == '\n' {
+					lineNo++
+				}
+				buf.WriteByte(c)
+				i++
+			}
+			continue
+		}
+		if indent < 0 && depth == 0 {
+			// The indentation of the new logical line
+			n := 0
+			for ; i < len(src) && (src[i] == ' ' || src[i] == '\t'); i++ {
+				if src[i] == '\t' {
+					n += 8 - n%8
+				} else {
+					n++
+				}
+			}
+			if i < len(src) && (src[i] == '\n' || src[i] == '#') {
+				for ; i < len(src) && src[i] != '\n'; i++ {
+				}
+				i++
+				lineNo++
+				continue
+			}
+			indent, start = n, lineNo
+			continue
+		}
+		switch {
+		case c == '#':
+			for ; i < len(src) && src[i] != '\n'; i++ {
+			}
+			continue
+		case c == '"' || c == '\'':
+			quote = string(c)
+			if strings.HasPrefix(src[i:], strings.Repeat(quote, 3)) {
+				quote = strings.Repeat(quote, 3)
+			}
+			buf.WriteString(quote)
+			i += len(quote)
+			continue
+		case c == '\\' && i+1 < len(src) && src[i+1] == '\n':
+			buf.WriteByte(' ')
+			i += 2
+			lineNo++
+			continue
+		case c == '(' || c == '[' || c == '{':
+			depth++
+		case (c == ')' || c == ']' || c == '}') && depth > 0:
+			depth--
+		case c == '\n':
+			lineNo++
+			i++
+			if depth > 0 {
+				buf.WriteByte(' ')
+			} else {
+				emit()
+			}
+			continue
+		}
+		buf.WriteByte(c)
+		i++
+	}
+	if quote != "" {
+		return nil, &Error{Phase: PhaseParse, Line: start, Err: errors.New("unterminated string literal")}
+	}
+	emit()
+	return lines, nil
+}
+
+// pySplit splits the text by the separator outside of the brackets and the
+// strings up to the unmatched closing bracket (its index is returned, or -1)
+func pySplit(text string, sep byte) ([]string, int) {
+	var parts []string
+	var quote byte
+	depth, start := 0, 0
+	for i := 0; i < len(text); i++ {
+		c := text[i]
+		switch {
+		case quote != 0:
+			if c == '\\' {
+				i++
+			} else if c == quote {
+				quote = 0
+			}
+		case c == '"' || c == '\'':
+			quote = c
+		case c == '(' || c == '[' || c == '{':
+			depth++
+		case c == ')' || c == ']' || c == '}':
+			if depth == 0 {
+				return append(parts, text[start:i]), i
+			}
+			depth--
+		case c == sep && depth == 0:
+			parts = append(parts, text[start:i])
+			start = i + 1
+		}
+	}
+	return append(parts, text[start:]), -1
+}
+
+// pyString returns the content of the string literal
+func pyString(text string) (string, bool) {
+	s := strings.TrimLeft(text, "rRuU")
+	if len(text)-len(s) > 1 {
+		return "", false
+	}
+	for _, quote := range []string{`"""`, `'''`, `"`, `'`} {
+		if len(s) >= 2*len(quote) && strings.HasPrefix(s, quote) && strings.HasSuffix(s, quote) {
+			return s[len(quote) : len(s)-len(quote)], true
+		}
+	}
+	return "", false
+}
+
+// pyParam is the parameter of the signature
+type pyParam struct {
+	name, typ, value string
+}
+
+var pyDefRe = regexp.MustCompile(`^(?:async\s+)?def\s+(\w+)\s*\(`)
+var pyClassRe = regexp.MustCompile(`^class\s+(\w+)`)
+var pyAttrRe = regexp.MustCompile(`^([A-Za-z]\w*)\s*(:[^=]+)?(=.*)?$`)
+var pyConstRe = regexp.MustCompile(`^[A-Z][A-Z0-9_]*$`)
+
+// pySignature parses the function definition with the type hints
+func pySignature(text string) (string, []pyParam, string, bool) {
+	m := pyDefRe.FindStringSubmatchIndex(text)
+	if m == nil {
+		return "", nil, "", false
+	}
+	rest := text[m[1]:]
+	parts, end := pySplit(rest, ',')
+	if end < 0 {
+		return "", nil, "", false
+	}
+	var params []pyParam
+	for _, part := range parts {
+		values, _ := pySplit(part, '=')
+		hints, _ := pySplit(values[0], ':')
+		param := pyParam{name: strings.TrimSpace(hints[0])}
+		if param.name == "" || param.name == "/" || param.name == "*" {
+			continue
+		}
+		if len(hints) > 1 {
+			param.typ = strings.TrimSpace(strings.Join(hints[1:], ":"))
+		}
+		if len(values) > 1 {
+			param.value = strings.TrimSpace(strings.Join(values[1:], "="))
+		}
+		params = append(params, param)
+	}
+	var returnType string
+	if after := strings.TrimSpace(rest[end+1:]); strings.HasPrefix(after, "->") {
+		hints, _ := pySplit(after[2:], ':')
+		returnType = strings.TrimSpace(hints[0])
+	}
+	return text[m[2]:m[3]], params, returnType, true
+}
+
+// pyBases returns the base classes of the class definition (the keyword
+// arguments, e.g. metaclass=ABCMeta, and object are skipped)
+func pyBases(text string) []string {
+	text = strings.TrimSpace(text)
+	if !strings.HasPrefix(text, "(") {
+		return nil
+	}
+	parts, _ := pySplit(text[1:], ',')
+	var bases []string
+	for _, part := range parts {
+		base := strings.TrimSpace(part)
+		if base != "" && base != "object" && !strings.Contains(base, "=") {
+			bases = append(bases, base)
+		}
+	}
+	return bases
+}
+
+// pyKinds are the kinds of the classes with the special bases
+var pyKinds = map[string]string{
+	"Enum": "enum", "IntEnum": "enum", "StrEnum": "enum", "Flag": "enum", "IntFlag": "enum",
+	"Protocol": "protocol",
+}
+
+// pyKind returns the kind of the class by its bases (e.g. enum.Enum or
+// Protocol[T])
+func pyKind(bases []string) string {
+	for _, base := range bases {
+		name := hierarchyKey(base)
+		if kind, ok := pyKinds[name[strings.LastIndex(name, ":")+1:]]; ok {
+			return kind
+		}
+	}
+	return ""
+}
+
+// pyDecorator returns the last component of the decorator name
+func pyDecorator(text string) string {
+	name := strings.TrimSpace(strings.TrimPrefix(text, "@"))
+	if i := strings.Index(name, "("); i >= 0 {
+		name = name[:i]
+	}
+	return name[strings.LastIndex(name, ".")+1:]
+}
+
+var pyKeywords = map[string]bool{
+	"and": true, "assert": true, "async": true, "await": true, "break": true,
+	"continue": true, "del": true, "elif": true, "else": true, "except": true,
+	"finally": true, "for": true, "from": true, "global": true, "if": true,
+	"import": true, "nonlocal": true, "pass": true, "raise": true,
+	"return": true, "try": true, "while": true, "with": true, "yield": true,
+}
+
+// pyScope is the block of the class or the function (the function bodies
+// and the private classes are ignored)
+type pyScope struct {
+	indent int
+	cls    *Class
+	name   string
+}
+
+// pyModule converts the module declarations linking the types of the module
+type pyModule struct {
+	ns        string
+	refs      typeLinker
+	classes   []*Class
+	classDocs map[*Class]pyDoc
+	namespace Namespace
+}
+
+// pyHint converts the type hint (the forward references are unquoted)
+func pyHint(text string) template.HTML {
+	return escapedHTML(strings.NewReplacer(`"`, "", "'", "").Replace(text))
+}
+
+// function converts the signature declared at the line, the self (or cls)
+// parameter of the methods is skipped
+func (m *pyModule) function(name string, params []pyParam, returnType string, isMethod bool, line int) Method {
+	method := Method{Name: name, Line: line}
+	for i, param := range params {
+		if isMethod && i == 0 {
+			continue
+		}
+		method.Parameters = append(method.Parameters, Parameter{
+			Name:    param.name,
+			Type:    pyHint(param.typ),
+			Default: param.value,
+		})
+	}
+	if returnType != "None" {
+		method.Returns.Type = pyHint(returnType)
+	}
+	return method
+}
+
+// apply fills the method descriptions and the missing types from the
+// docstring (the examples are the ones of the description)
+func (d pyDoc) apply(method *Method, withDescription bool) {
+	if withDescription {
+		method.Description = d.description
+		method.Examples = d.examples
+	}
+	for i := range method.Parameters {
+		param := &method.Parameters[i]
+		paramDoc, ok := d.params[strings.TrimLeft(param.Name, "*")]
+		if !ok {
+			continue
+		}
+		if param.Description == "" {
+			param.Description = paramDoc.description
+		}
+		if param.Type == "" {
+			param.Type = escapedHTML(paramDoc.typ)
+		}
+	}
+	if method.Returns.Type == "" {
+		method.Returns.Type = escapedHTML(d.returns.typ)
+	}
+	if method.Returns.Description == "" {
+		method.Returns.Description = escapedHTML(d.returns.description)
+	}
+	if method.Throws != nil {
+		return
+	}
+	for _, raises := range d.raises {
+		method.Throws = append(method.Throws, Exception{
+			Type:        escapedHTML(raises.typ),
+			Description: raises.description,
+		})
+	}
+}
+
+// genPyModule converts the classes of the module, and the module-level
+// functions and constants into the namespace
+func genPyModule(src string, ns string) ([]Class, Namespace, error) {
+	lines, err := pyLines(src)
+	if err != nil {
+		return nil, Namespace{}, err
+	}
+	m := pyModule{
+		ns:        ns,
+		refs:      typeLinker{},
+		classDocs: map[*Class]pyDoc{},
+		namespace: Namespace{Name: ns},
+	}
+	var stack []pyScope
+	var decorators []string
+	// The docstring (the string literal following the declaration)
+	var pending func(pyDoc)
+	pendingIndent := 0
+	for i, line := range lines {
+		if pending != nil {
+			apply := pending
+			pending = nil
+			if doc, ok := pyString(line.text); ok && line.indent >= pendingIndent {
+				apply(parsePyDoc(doc))
+				continue
+			}
+		}
+		if i == 0 && line.indent == 0 {
+			if doc, ok := pyString(line.text); ok {
+				m.namespace.Description = parsePyDoc(doc).description
+				continue
+			}
+		}
+		for len(stack) > 0 && stack[len(stack)-1].indent >= line.indent {
+			stack = stack[:len(stack)-1]
+		}
+		var parent *pyScope
+		if len(stack) > 0 {
+			parent = &stack[len(stack)-1]
+			if parent.cls == nil {
+				continue
+			}
+		}
+		text := line.text
+		if strings.HasPrefix(text, "@") {
+			decorators = append(decorators, pyDecorator(text))
+			continue
+		}
+		decorated := map[string]bool{}
+		for _, decorator := range decorators {
+			decorated[decorator] = true
+		}
+		decorators = nil
+
+		if match := pyClassRe.FindStringSubmatch(text); match != nil {
+			scope := pyScope{indent: line.indent, name: match[1]}
+			if parent != nil {
+				scope.name = parent.name + "." + match[1]
+			}
+			if !strings.HasPrefix(match[1], "_") {
+				cls := &Class{
+					Name: ns + "::" + scope.name,
+					Ref:  goRef(ns, nonWordRe.ReplaceAllString(scope.name, "_")),
+					Line: line.line,
+				}
+				cls.Extends = pyBases(text[len(match[0]):])
+				cls.Kind = pyKind(cls.Extends)
+				m.refs[scope.name] = cls.Ref
+				m.classes = append(m.classes, cls)
+				scope.cls = cls
+				pending = func(doc pyDoc) {
+					cls.Description = doc.description
+					cls.Examples = doc.examples
+					m.classDocs[cls] = doc
+				}
+				pendingIndent = line.indent + 1
+			}
+			stack = append(stack, scope)
+			continue
+		}
+		if name, params, returnType, ok := pySignature(text); ok {
+			stack = append(stack, pyScope{indent: line.indent})
+			if strings.HasPrefix(name, "_") && name != "__init__" {
+				continue
+			}
+			pendingIndent = line.indent + 1
+			if parent == nil {
+				if name == "__init__" {
+					continue
+				}
+				functions := &m.namespace.Functions
+				*functions = append(*functions, m.function(name, params, returnType, false, line.line))
+				index := len(*functions) - 1
+				pending = func(doc pyDoc) {
+					doc.apply(&(*functions)[index], true)
+				}
+				continue
+			}
+			cls := parent.cls
+			switch {
+			case decorated["setter"] || decorated["deleter"]:
+			case decorated["property"] || decorated["cached_property"]:
+				if returnType == "None" {
+					returnType = ""
+				}
+				cls.Properties = append(cls.Properties, Property{Name: name, Type: pyHint(returnType), Line: line.line})
+				index := len(cls.Properties) - 1
+				pending = func(doc pyDoc) {
+					prop := &cls.Properties[index]
+					prop.Description = doc.description
+					if prop.Type == "" {
+						prop.Type = escapedHTML(doc.returns.typ)
+					}
+				}
+			case name == "__init__":
+				cls.Constructors = append(cls.Constructors, m.function("", params, "", true, line.line))
+				index := len(cls.Constructors) - 1
+				pending = func(doc pyDoc) {
+					doc.apply(&cls.Constructors[index], true)
+				}
+			default:
+				method := m.function(name, params, returnType, !decorated["staticmethod"], line.line)
+				if decorated["staticmethod"] || decorated["classmethod"] {
+					method.Access = "static"
+				}
+				if decorated["abstractmethod"] {
+					method.Virtual = "virtual"
+				}
+				cls.Methods = append(cls.Methods, method)
+				index := len(cls.Methods) - 1
+				pending = func(doc pyDoc) {
+					doc.apply(&cls.Methods[index], true)
+				}
+			}
+			continue
+		}
+		// The attributes: the annotated or upper-case class attributes,
+		// the upper-case module constants and the annotated module variables
+		match := pyAttrRe.FindStringSubmatch(text)
+		if match == nil || pyKeywords[match[1]] || (match[2] == "" && match[3] == "") ||
+			strings.HasPrefix(match[3], "==") {
+			continue
+		}
+		attrType := strings.TrimSpace(strings.TrimPrefix(match[2], ":"))
+		isConst := pyConstRe.MatchString(match[1])
+		var props *[]Property
+		access := ""
+		if parent == nil {
+			switch {
+			case isConst:
+				props = &m.namespace.Constants
+			case attrType != "":
+				props = &m.namespace.Variables
+			default:
+				continue
+			}
+		} else if cls := parent.cls; cls.Kind == "enum" {
+			// The enum values are the assigned attributes
+			if match[3] == "" || attrType != "" {
+				continue
+			}
+			cls.Values = append(cls.Values, EnumValue{
+				Name:  match[1],
+				Value: strings.TrimSpace(match[3][1:]),
+			})
+			index := len(cls.Values) - 1
+			pending = func(doc pyDoc) {
+				cls.Values[index].Description = doc.description
+			}
+			pendingIndent = line.indent
+			continue
+		} else {
+			if !isConst && attrType == "" {
+				continue
+			}
+			props = &parent.cls.Properties
+			if isConst || strings.HasPrefix(attrType, "ClassVar") {
+				access = "static"
+			}
+		}
+		*props = append(*props, Property{Name: match[1], Access: access, Type: pyHint(attrType), Line: line.line})
+		index := len(*props) - 1
+		pending = func(doc pyDoc) {
+			(*props)[index].Description = doc.description
+		}
+		pendingIndent = line.indent
+	}
+
+	var classes []Class
+	for _, cls := range m.classes {
+		// The constructor parameters may be documented by the class docstring
+		if doc, ok := m.classDocs[cls]; ok {
+			for i := range cls.Constructors {
+				doc.apply(&cls.Constructors[i], false)
+			}
+		}
+		m.refs.methods(cls.Constructors)
+		m.refs.methods(cls.Methods)
+		m.refs.properties(cls.Properties)
+		classes = append(classes, *cls)
+	}
+	m.refs.methods(m.namespace.Functions)
+	m.refs.properties(m.namespace.Constants)
+	m.refs.properties(m.namespace.Variables)
+	return classes, m.namespace, nil
+}
+
+// pyParamDoc is the documented parameter (or the return value)
+type pyParamDoc struct {
+	typ, description string
+}
+
+// pyDoc is the parsed docstring
+type pyDoc struct {
+	description string
+	params      map[string]pyParamDoc
+	returns     pyParamDoc
+	raises      []pyParamDoc
+	examples    []Example
+}
+
+const pySections = `Args|Arguments|Parameters|Params|Keyword Args|Keyword Arguments|Other Parameters|` +
+	`Returns|Return|Yields|Yield|Raises|Attributes|Examples?|Notes?|Warnings?|See Also|References|Todo`
+
+var pyGoogleSectionRe = regexp.MustCompile(`^(` + pySections + `):\s*$`)
+var pyNumpySectionRe = regexp.MustCompile(`^(` + pySections + `)\s*$`)
+var pyUnderlineRe = regexp.MustCompile(`^-{3,}\s*$`)
+var pyFieldRe = regexp.MustCompile(`^:(param|parameter|arg|argument|key|keyword|type|returns?|rtype|raises?|except|exception)\b\s*([^:]*):\s*(.*)$`)
+var pyGoogleParamRe = regexp.MustCompile(`^(\*{0,2}\w+)\s*(?:\(([^)]*)\))?\s*:\s*(.*)$`)
+var pyGoogleReturnsRe = regexp.MustCompile(`^([\w.]+(?:\[.*\])?)\s*:\s*(.*)$`)
+var pyNumpyParamRe = regexp.MustCompile(`^(\*{0,2}\w+(?:\s*,\s*\*{0,2}\w+)*)\s*(?::\s*(.*))?$`)
+
+// pyCleanDoc removes the docstring indentation as inspect.cleandoc does
+func pyCleanDoc(text string) []string {
+	lines := strings.Split(strings.ReplaceAll(text, "\t", "        "), "\n")
+	margin := -1
+	for _, line := range lines[1:] {
+		if trimmed := strings.TrimLeft(line, " "); trimmed != "" {
+			if n := len(line) - len(trimmed); margin < 0 || n < margin {
+				margin = n
+			}
+		}
+	}
+	lines[0] = strings.TrimSpace(lines[0])
+	for i := 1; i < len(lines); i++ {
+		if strings.TrimSpace(lines[i]) == "" {
+			lines[i] = ""
+		} else {
+			lines[i] = strings.TrimRight(lines[i][margin:], " ")
+		}
+	}
+	for len(lines) > 0 && lines[0] == "" {
+		lines = lines[1:]
+	}
+	for len(lines) > 0 && lines[len(lines)-1] == "" {
+		lines = lines[:len(lines)-1]
+	}
+	return lines
+}
+
+func isPyIndented(line string) bool {
+	return strings.HasPrefix(line, " ")
+}
+
+// pyEntry is the item of the docstring section: the head line and the
+// indented lines following it
+type pyEntry struct {
+	head string
+	body []string
+}
+
+func (e pyEntry) text(rest string) string {
+	return strings.Join(strings.Fields(rest+" "+strings.Join(e.body, " ")), " ")
+}
+
+func pyEntries(lines []string) []pyEntry {
+	var entries []pyEntry
+	for _, line := range lines {
+		switch {
+		case line != "" && !isPyIndented(line):
+			entries = append(entries, pyEntry{head: line})
+		case len(entries) > 0:
+			last := &entries[len(entries)-1]
+			last.body = append(last.body, line)
+		}
+	}
+	return entries
+}
+
+// pyDedent removes the common indentation of the section lines
+func pyDedent(lines []string) []string {
+	margin := -1
+	for _, line := range lines {
+		if trimmed := strings.TrimLeft(line, " "); trimmed != "" {
+			if n := len(line) - len(trimmed); margin < 0 || n < margin {
+				margin = n
+			}
+		}
+	}
+	var result []string
+	for _, line := range lines {
+		if line != "" {
+			line = line[margin:]
+		}
+		result = append(result, line)
+	}
+	return result
+}
+
+func (d *pyDoc) param(name string, typ string, description string) {
+	typ = strings.TrimSuffix(strings.TrimSpace(typ), ", optional")
+	name = strings.TrimLeft(name, "*")
+	paramDoc := d.params[name]
+	if typ != "" {
+		paramDoc.typ = typ
+	}
+	if description != "" {
+		paramDoc.description = description
+	}
+	d.params[name] = paramDoc
+}
+
+// example adds the code of the examples section (e.g. the doctest)
+func (d *pyDoc) example(lines []string) {
+	if code := strings.Trim(strings.Join(pyDedent(lines), "\n"), "\n"); code != "" {
+		d.examples = append(d.examples, Example{Language: "python", Code: code})
+	}
+}
+
+func (d *pyDoc) googleSection(name string, lines []string) {
+	if name == "Example" || name == "Examples" {
+		d.example(lines)
+		return
+	}
+	entries := pyEntries(pyDedent(lines))
+	switch name {
+	case "Args", "Arguments", "Parameters", "Params", "Keyword Args", "Keyword Arguments", "Other Parameters":
+		for _, entry := range entries {
+			if match := pyGoogleParamRe.FindStringSubmatch(entry.head); match != nil {
+				d.param(match[1], match[2], entry.text(match[3]))
+			}
+		}
+	case "Returns", "Return", "Yields", "Yield":
+		if len(entries) == 0 {
+			return
+		}
+		entry := pyEntry{head: entries[0].head}
+		for _, other := range entries {
+			if other.head != entry.head {
+				entry.body = append(entry.body, other.head)
+			}
+			entry.body = append(entry.body, other.body...)
+		}
+		if match := pyGoogleReturnsRe.FindStringSubmatch(entry.head); match != nil {
+			d.returns = pyParamDoc{typ: match[1], description: entry.text(match[2])}
+		} else {
+			d.returns = pyParamDoc{description: entry.text(entry.head)}
+		}
+	case "Raises":
+		for _, entry := range entries {
+			if match := pyGoogleReturnsRe.FindStringSubmatch(entry.head); match != nil {
+				d.raises = append(d.raises, pyParamDoc{typ: match[1], description: entry.text(match[2])})
+			}
+		}
+	}
+}
+
+func (d *pyDoc) numpySection(name string, lines []string) {
+	if name == "Examples" {
+		d.example(lines)
+		return
+	}
+	entries := pyEntries(lines)
+	switch name {
+	case "Parameters", "Other Parameters":
+		for _, entry := range entries {
+			match := pyNumpyParamRe.FindStringSubmatch(entry.head)
+			if match == nil {
+				continue
+			}
+			for _, paramName := range strings.Split(match[1], ",") {
+				d.param(strings.TrimSpace(paramName), match[2], entry.text(""))
+			}
+		}
+	case "Returns", "Yields":
+		if len(entries) == 0 {
+			return
+		}
+		typ := entries[0].head
+		if i := strings.Index(typ, ":"); i >= 0 {
+			typ = typ[i+1:]
+		}
+		d.returns = pyParamDoc{typ: strings.TrimSpace(typ), description: entries[0].text("")}
+	case "Raises":
+		for _, entry := range entries {
+			d.raises = append(d.raises, pyParamDoc{typ: strings.TrimSpace(entry.head), description: entry.text("")})
+		}
+	}
+}
+
+func (d *pyDoc) field(kind string, arg string, description string) {
+	words := strings.Fields(arg)
+	switch kind {
+	case "param", "parameter", "arg", "argument", "key", "keyword":
+		if len(words) > 0 {
+			d.param(words[len(words)-1], strings.Join(words[:len(words)-1], " "), description)
+		}
+	case "type":
+		if len(words) > 0 {
+			d.param(words[0], description, "")
+		}
+	case "returns", "return":
+		d.returns.description = description
+	case "rtype":
+		d.returns.typ = description
+	case "raises", "raise", "except", "exception":
+		d.raises = append(d.raises, pyParamDoc{typ: strings.TrimSpace(arg), description: description})
+	}
+}
+
+// parsePyDoc parses the Google-style (the "Args:" sections), NumPy-style
+// (the underlined sections) and reST-style (the ":param x:" fields) docstrings;
+// the text before the first section is the description
+func parsePyDoc(text string) pyDoc {
+	d := pyDoc{params: map[string]pyParamDoc{}}
+	lines := pyCleanDoc(text)
+	var description []string
+	inDescription := true
+	for i := 0; i < len(lines); {
+		line := lines[i]
+		if match := pyGoogleSectionRe.FindStringSubmatch(line); match != nil {
+			end := i + 1
+			for ; end < len(lines) && (lines[end] == "" || isPyIndented(lines[end])); end++ {
+			}
+			d.googleSection(match[1], lines[i+1:end])
+			i, inDescription = end, false
+			continue
+		}
+		if match := pyNumpySectionRe.FindStringSubmatch(line); match != nil &&
+			i+1 < len(lines) && pyUnderlineRe.MatchString(lines[i+1]) {
+			end := i + 2
+			for ; end < len(lines); end++ {
+				if pyNumpySectionRe.MatchString(lines[end]) && end+1 < len(lines) &&
+					pyUnderlineRe.MatchString(lines[end+1]) {
+					break
+				}
+			}
+			d.numpySection(match[1], lines[i+2:end])
+			i, inDescription = end, false
+			continue
+		}
+		if match := pyFieldRe.FindStringSubmatch(line); match != nil {
+			end := i + 1
+			for ; end < len(lines) && isPyIndented(lines[end]); end++ {
+			}
+			entry := pyEntry{body: lines[i+1 : end]}
+			d.field(match[1], match[2], entry.text(match[3]))
+			i, inDescription = end, false
+			continue
+		}
+		if inDescription {
+			description = append(description, line)
+		}
+		i++
+	}
+	d.description = strings.TrimSpace(strings.Join(description, "\n"))
+	return d
+}
@@ -0,0 +1,527 @@
+package adx
+
+import (
+	"fmt"
+	"html"
+	"html/template"
+	"path"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// richNode is the block being built with its child blocks
+type richNode struct {
+	block    Block
+	children []*richNode
+}
+
+func (n *richNode) toBlock() Block {
+	block := n.block
+	for _, child := range n.children {
+		block.Blocks = append(block.Blocks, child.toBlock())
+	}
+	return block
+}
+
+// richBuilder collects the blocks of the rich description: the spans of the
+// current paragraph are added to the innermost open list item (if any)
+type richBuilder struct {
+	root  richNode
+	open  []*richNode
+	spans []Span
+	// The open inline markup (the spans have the innermost one)
+	inline []Span
+}
+
+func (b *richBuilder) container() *richNode {
+	if len(b.open) == 0 {
+		return &b.root
+	}
+	return b.open[len(b.open)-1]
+}
+
+func (b *richBuilder) add(block Block) *richNode {
+	node := &richNode{block: block}
+	parent := b.container()
+	parent.children = append(parent.children, node)
+	return node
+}
+
+// span adds the text to the paragraph merging it with the previous span of
+// the same kind
+func (b *richBuilder) span(kind string, url string, text string) {
+	if text == "" {
+		return
+	}
+	if n := len(b.spans); n > 0 && b.spans[n-1].Kind == kind && b.spans[n-1].URL == url {
+		b.spans[n-1].Text += text
+		return
+	}
+	b.spans = append(b.spans, Span{Kind: kind, Text: text, URL: url})
+}
+
+// text adds the text with the innermost open inline markup
+func (b *richBuilder) text(text string) {
+	var kind, url string
+	if n := len(b.inline); n > 0 {
+		kind, url = b.inline[n-1].Kind, b.inline[n-1].URL
+	}
+	b.span(kind, url, text)
+}
+
+// flush ends the current paragraph (without the surrounding spaces)
+func (b *richBuilder) flush() {
+	spans := b.spans
+	b.spans = nil
+	for len(spans) > 0 {
+		spans[0].Text = strings.TrimLeft(spans[0].Text, " \t\n")
+		if spans[0].Text != "" {
+			break
+		}
+		spans = spans[1:]
+	}
+	for len(spans) > 0 {
+		last := &spans[len(spans)-1]
+		last.Text = strings.TrimRight(last.Text, " \t\n")
+		if last.Text != "" {
+			break
+		}
+		spans = spans[:len(spans)-1]
+	}
+	if len(spans) > 0 {
+		b.add(Block{Spans: spans})
+	}
+}
+
+func isList(kind string) bool {
+	return kind == "list" || kind == "ordered-list"
+}
+
+// start opens the list or the list item (the previous item is closed, and
+// the items outside of the lists are the plain paragraphs)
+func (b *richBuilder) start(kind string) {
+	b.flush()
+	if kind == "item" {
+		if b.container().block.Kind == "item" {
+			b.open = b.open[:len(b.open)-1]
+		}
+		if !isList(b.container().block.Kind) {
+			return
+		}
+	}
+	b.open = append(b.open, b.add(Block{Kind: kind}))
+}
+
+// end closes the innermost list (of any kind) or list item with the ones
+// left open in it
+func (b *richBuilder) end(kind string) {
+	b.flush()
+	for i := len(b.open) - 1; i >= 0; i-- {
+		if open := b.open[i].block.Kind; open == kind || (isList(kind) && isList(open)) {
+			b.open = b.open[:i]
+			return
+		}
+	}
+}
+
+func (b *richBuilder) blocks() []Block {
+	b.flush()
+	var blocks []Block
+	for _, child := range b.root.children {
+		blocks = append(blocks, child.toBlock())
+	}
+	return blocks
+}
+
+// markupRoles are the roles of the HTML (JSDoc) and Doxygen XML tags
+var markupRoles = map[string]string{
+	"p": "paragraph", "para": "paragraph", "div": "paragraph",
+	"ul": "list", "itemizedlist": "list",
+	"ol": "ordered-list", "orderedlist": "ordered-list",
+	"li": "item", "listitem": "item",
+	"pre": "code-block", "programlisting": "code-block", "verbatim": "code-block",
+	"code": "code", "tt": "code", "computeroutput": "code",
+	"em": "emphasis", "i": "emphasis", "emphasis": "emphasis",
+	"strong": "strong", "b": "strong", "bold": "strong",
+	"a": "link", "ulink": "link", "ref": "link",
+	"br": "break", "linebreak": "break",
+}
+
+var markupTagRe = regexp.MustCompile(`<!--[\s\S]*?-->|<(/?)([A-Za-z][\w:-]*)((?:[^>"']|"[^"]*"|'[^']*')*)>`)
+var markupAttrRe = regexp.MustCompile(`([\w:-]+)\s*=\s*(?:"([^"]*)"|'([^']*)')`)
+var markupLanguageRe = regexp.MustCompile(`\b(?:lang|language)-([\w+#-]+)`)
+var blankLineRe = regexp.MustCompile(`\n[ \t]*\n`)
+var whitespaceRe = regexp.MustCompile(`\s+`)
+
+func markupAttrs(text string) map[string]string {
+	attrs := map[string]string{}
+	for _, m := range markupAttrRe.FindAllStringSubmatch(text, -1) {
+		attrs[m[1]] = html.UnescapeString(m[2] + m[3])
+	}
+	return attrs
+}
+
+// markupURL is the link of the tag (the Doxygen references are the anchors)
+func markupURL(name string, attrs map[string]string) string {
+	switch name {
+	case "ref":
+		return "#" + attrs["refid"]
+	case "ulink":
+		return attrs["url"]
+	}
+	return attrs["href"]
+}
+
+// parseMarkup converts the HTML or the Doxygen XML fragment into the blocks;
+// the skipped elements (with their content) are reported by the skip function,
+// and the text separated by the blank lines is split into the paragraphs
+func parseMarkup(raw string, skip func(name string, attrs map[string]string) bool) []Block {
+	var b richBuilder
+	// The skipped element (and its nesting level), and the code block
+	var skipped string
+	depth := 0
+	var code *Block
+	codeTag := ""
+	addText := func(text string) {
+		if skipped != "" {
+			return
+		}
+		text = html.UnescapeString(text)
+		if code != nil {
+			code.Code += text
+			return
+		}
+		for i, para := range blankLineRe.Split(text, -1) {
+			if i > 0 {
+				b.flush()
+			}
+			b.text(whitespaceRe.ReplaceAllString(para, " "))
+		}
+	}
+	last := 0
+	for _, m := range markupTagRe.FindAllStringSubmatchIndex(raw, -1) {
+		addText(raw[last:m[0]])
+		last = m[1]
+		if m[2] < 0 {
+			// The comments
+			continue
+		}
+		closing := raw[m[2]:m[3]] == "/"
+		name := strings.ToLower(raw[m[4]:m[5]])
+		rest := raw[m[6]:m[7]]
+		selfClosing := strings.HasSuffix(rest, "/")
+		if skipped != "" {
+			if name == skipped && !selfClosing {
+				if closing {
+					depth--
+				} else {
+					depth++
+				}
+				if depth == 0 {
+					skipped = ""
+				}
+			}
+			continue
+		}
+		attrs := markupAttrs(rest)
+		if !closing && skip != nil && skip(name, attrs) {
+			if !selfClosing {
+				skipped, depth = name, 1
+			}
+			continue
+		}
+		if code != nil {
+			switch {
+			case closing && name == codeTag:
+				code.Code = strings.Trim(code.Code, "\n")
+				if code.Code != "" {
+					b.add(*code)
+				}
+				code = nil
+			case closing && name == "codeline":
+				code.Code += "\n"
+			case name == "sp":
+				code.Code += " "
+			case !closing && code.Language == "":
+				code.Language = markupLanguage(attrs)
+			}
+			continue
+		}
+		switch role := markupRoles[name]; {
+		case role == "paragraph":
+			b.flush()
+		case isList(role) || role == "item":
+			if closing {
+				b.end(role)
+			} else if !selfClosing {
+				b.start(role)
+			}
+		case role == "code-block" && !closing && !selfClosing:
+			b.flush()
+			code = &Block{Kind: "code", Language: markupLanguage(attrs)}
+			codeTag = name
+		case role == "break":
+			b.text("\n")
+		case role != "" && closing:
+			for i := len(b.inline) - 1; i >= 0; i-- {
+				if b.inline[i].Text == name {
+					b.inline = b.inline[:i]
+					break
+				}
+			}
+		case role != "" && !selfClosing:
+			// The tag name closes the inline markup
+			b.inline = append(b.inline, Span{Kind: role, Text: name, URL: markupURL(name, attrs)})
+		}
+	}
+	addText(raw[last:])
+	if code != nil {
+		code.Code = strings.Trim(code.Code, "\n")
+		b.add(*code)
+	}
+	return b.blocks()
+}
+
+// markupLanguage is the language of the code block: the class (e.g.
+// lang-js or language-js) or the extension of the Doxygen file name
+func markupLanguage(attrs map[string]string) string {
+	if m := markupLanguageRe.FindStringSubmatch(attrs["class"]); m != nil {
+		return m[1]
+	}
+	return strings.TrimPrefix(path.Ext(attrs["filename"]), ".")
+}
+
+var mdListRe = regexp.MustCompile(`^([-*+]|\d+[.)])\s+(.*)$`)
+
+// The inline code, strong text, emphasis and links (the markers are not
+// followed or preceded by the spaces)
+var mdInlineRe = regexp.MustCompile("`([^`]+)`" +
+	`|\*\*([^*\s](?:[^*]*[^*\s])?)\*\*` +
+	`|__([^_\s](?:[^_]*[^_\s])?)__` +
+	`|\*([^*\s](?:[^*]*[^*\s])?)\*` +
+	`|\b_([^_\s](?:[^_]*[^_\s])?)_\b` +
+	`|\[([^\]]+)\]\(([^)\s]+)\)`)
+
+// inlineMarkdown adds the spans of the Markdown text
+func (b *richBuilder) inlineMarkdown(text string) {
+	last := 0
+	for _, m := range mdInlineRe.FindAllStringSubmatchIndex(text, -1) {
+		b.span("", "", text[last:m[0]])
+		last = m[1]
+		group := func(i int) string {
+			return text[m[2*i]:m[2*i+1]]
+		}
+		switch {
+		case m[2] >= 0:
+			b.span("code", "", group(1))
+		case m[4] >= 0:
+			b.span("strong", "", group(2))
+		case m[6] >= 0:
+			b.span("strong", "", group(3))
+		case m[8] >= 0:
+			b.span("emphasis", "", group(4))
+		case m[10] >= 0:
+			b.span("emphasis", "", group(5))
+		default:
+			b.span("link", group(7), group(6))
+		}
+	}
+	b.span("", "", text[last:])
+}
+
+// parseMarkdown converts the Markdown text: the paragraphs, the lists (the
+// items are not nested), the fenced code blocks, and the inline code,
+// emphasis, strong text and links
+func parseMarkdown(text string) []Block {
+	var b richBuilder
+	lines := strings.Split(text, "\n")
+	closeLists := func() {
+		b.flush()
+		b.open = nil
+	}
+	blank := false
+	for i := 0; i < len(lines); i++ {
+		line := strings.TrimSpace(lines[i])
+		m := mdListRe.FindStringSubmatch(line)
+		switch {
+		case strings.HasPrefix(line, codeFence):
+			closeLists()
+			block := Block{Kind: "code", Language: strings.TrimSpace(line[len(codeFence):])}
+			var code []string
+			for i++; i < len(lines) && !strings.HasPrefix(strings.TrimSpace(lines[i]), codeFence); i++ {
+				code = append(code, lines[i])
+			}
+			block.Code = strings.Trim(strings.Join(pyDedent(code), "\n"), "\n")
+			b.add(block)
+		case line == "":
+			b.flush()
+			blank = true
+			continue
+		case m != nil:
+			kind := "list"
+			if m[1][0] >= '0' && m[1][0] <= '9' {
+				kind = "ordered-list"
+			}
+			if b.container().block.Kind == "item" {
+				b.end("item")
+			}
+			if b.container().block.Kind != kind {
+				closeLists()
+				b.start(kind)
+			}
+			b.start("item")
+			b.inlineMarkdown(m[2])
+		default:
+			if blank && b.open != nil {
+				closeLists()
+			}
+			if b.spans != nil {
+				b.span("", "", "\n")
+			}
+			b.inlineMarkdown(line)
+		}
+		blank = false
+	}
+	return b.blocks()
+}
+
+// richDetails returns the blocks unless they are the single plain text
+// paragraph (the description is enough then)
+func richDetails(blocks []Block) []Block {
+	if len(blocks) == 1 && blocks[0].Kind == "" && len(blocks[0].Spans) == 1 && blocks[0].Spans[0].Kind == "" {
+		return nil
+	}
+	return blocks
+}
+
+func spansText(spans []Span) string {
+	var text strings.Builder
+	for _, span := range spans {
+		text.WriteString(span.Text)
+	}
+	return text.String()
+}
+
+// blocksText is the plain text of the blocks: the paragraphs are separated
+// by the blank lines and the list items are on their own lines
+func blocksText(blocks []Block) string {
+	var parts []string
+	for _, block := range blocks {
+		switch {
+		case isList(block.Kind):
+			var items []string
+			for i, item := range block.Blocks {
+				marker := "-"
+				if block.Kind == "ordered-list" {
+					marker = strconv.Itoa(i+1) + "."
+				}
+				items = append(items, marker+" "+blocksText(item.Blocks))
+			}
+			parts = append(parts, strings.Join(items, "\n"))
+		case block.Kind == "item":
+			parts = append(parts, blocksText(block.Blocks))
+		case block.Kind == "code":
+			parts = append(parts, block.Code)
+		default:
+			parts = append(parts, spansText(block.Spans))
+		}
+	}
+	return strings.Join(parts, "\n\n")
+}
+
+// markdownDescription converts the Markdown description into the plain text
+// and the details
+func markdownDescription(description *string, details *[]Block) {
+	blocks := parseMarkdown(*description)
+	*description = blocksText(blocks)
+	*details = richDetails(blocks)
+}
+
+// markupDescription converts the HTML description into the plain text and
+// the details
+func markupDescription(raw string) (string, []Block) {
+	blocks := parseMarkup(raw, nil)
+	return blocksText(blocks), richDetails(blocks)
+}
+
+var urlSchemeRe = regexp.MustCompile(`^[A-Za-z][\w+.-]*:`)
+
+// safeURL allows the relative, anchor, http(s) and mailto links only (the
+// browsers ignore the surrounding spaces and the control characters, e.g.
+// the tabs inside of the scheme)
+func safeURL(url string) bool {
+	url = strings.TrimSpace(strings.Map(func(r rune) rune {
+		if r < ' ' || r == 0x7f {
+			return -1
+		}
+		return r
+	}, url))
+	scheme := strings.ToLower(urlSchemeRe.FindString(url))
+	return scheme == "" || scheme == "http:" || scheme == "https:" || scheme == "mailto:"
+}
+
+// richHTML renders the rich description (the class anchors are resolved by
+// the templates)
+func richHTML(blocks []Block) template.HTML {
+	var sb strings.Builder
+	writeRichHTML(&sb, blocks)
+	// #nosec
+	return template.HTML(sb.String())
+}
+
+func writeRichHTML(sb *strings.Builder, blocks []Block) {
+	for _, block := range blocks {
+		switch block.Kind {
+		case "list", "ordered-list":
+			tag := "ul"
+			if block.Kind == "ordered-list" {
+				tag = "ol"
+			}
+			sb.WriteString("<" + tag + ">")
+			writeRichHTML(sb, block.Blocks)
+			sb.WriteString("</" + tag + ">")
+		case "item":
+			sb.WriteString("<li>")
+			// The single paragraph items are tight
+			if len(block.Blocks) == 1 && block.Blocks[0].Kind == "" {
+				writeSpansHTML(sb, block.Blocks[0].Spans)
+			} else {
+				writeRichHTML(sb, block.Blocks)
+			}
+			sb.WriteString("</li>")
+		case "code":
+			sb.WriteString(`<pre class="adx-code"><code`)
+			if block.Language != "" {
+				fmt.Fprintf(sb, ` class="language-%s"`, template.HTMLEscapeString(strings.ToLower(block.Language)))
+			}
+			sb.WriteString(">" + string(highlight(block.Code, block.Language)) + "</code></pre>")
+		default:
+			sb.WriteString("<p>")
+			writeSpansHTML(sb, block.Spans)
+			sb.WriteString("</p>")
+		}
+	}
+}
+
+func writeSpansHTML(sb *strings.Builder, spans []Span) {
+	for _, span := range spans {
+		text := template.HTMLEscapeString(span.Text)
+		switch span.Kind {
+		case "code":
+			sb.WriteString("<code>" + text + "</code>")
+		case "emphasis":
+			sb.WriteString("<em>" + text + "</em>")
+		case "strong":
+			sb.WriteString("<strong>" + text + "</strong>")
+		case "link":
+			if safeURL(span.URL) {
+				fmt.Fprintf(sb, `<a href="%s">%s</a>`, template.HTMLEscapeString(span.URL), text)
+			} else {
+				sb.WriteString(text)
+			}
+		default:
+			sb.WriteString(text)
+		}
+	}
+}
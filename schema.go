@@ -0,0 +1,401 @@
+package adx
+
+import (
+	"bytes"
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"html/template"
+	"io"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// schemaField is the element (or attribute) of the interchange format
+type schemaField struct {
+	xmlName  string
+	jsonName string
+	xmlAttr  bool
+	multiple bool
+	kind     string
+	typ      *schemaType
+}
+
+// schemaType is the complex type derived from the model struct
+type schemaType struct {
+	name   string
+	fields []*schemaField
+}
+
+var htmlType = reflect.TypeOf(template.HTML(""))
+
+func tagName(tag string, field reflect.StructField) (string, bool) {
+	if tag == "-" {
+		return "", false
+	}
+	name := strings.Split(tag, ",")[0]
+	if name == "" {
+		name = field.Name
+	}
+	return name, true
+}
+
+// buildSchema describes the struct type using its xml and json tags
+func buildSchema(t reflect.Type, types map[string]*schemaType) *schemaType {
+	if st, ok := types[t.Name()]; ok {
+		return st
+	}
+	st := &schemaType{name: t.Name()}
+	types[t.Name()] = st
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if field.Name == "XMLName" {
+			continue
+		}
+		xmlTag := field.Tag.Get("xml")
+		xmlName, inXML := tagName(xmlTag, field)
+		jsonName, inJSON := tagName(field.Tag.Get("json"), field)
+		if !inXML && !inJSON {
+			continue
+		}
+		f := &schemaField{
+			xmlAttr: strings.HasSuffix(xmlTag, ",attr"),
+		}
+		if inXML {
+			f.xmlName = xmlName
+		}
+		if inJSON {
+			f.jsonName = jsonName
+		}
+		ft := field.Type
+		if ft.Kind() == reflect.Slice {
+			f.multiple = true
+			ft = ft.Elem()
+		}
+		switch {
+		case ft.Kind() == reflect.Struct:
+			f.kind = ft.Name()
+			f.typ = buildSchema(ft, types)
+		case ft.Kind() == reflect.Int:
+			f.kind = "integer"
+		case ft.Kind() == reflect.String || ft == htmlType:
+			f.kind = "string"
+		default:
+			panic("unsupported schema type " + ft.String())
+		}
+		st.fields = append(st.fields, f)
+	}
+	return st
+}
+
+func adxSchema() (*schemaType, map[string]*schemaType) {
+	types := map[string]*schemaType{}
+	return buildSchema(reflect.TypeOf(AdxResult{}), types), types
+}
+
+func sortedTypes(types map[string]*schemaType) []*schemaType {
+	var result []*schemaType
+	for _, st := range types {
+		result = append(result, st)
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].name < result[j].name })
+	return result
+}
+
+// RenderXSD writes the XML Schema of the interchange format
+func RenderXSD() []byte {
+	root, types := adxSchema()
+	var buf bytes.Buffer
+	buf.WriteString(xml.Header)
+	buf.WriteString("<xs:schema xmlns:xs=\"http://www.w3.org/2001/XMLSchema\">\n")
+	fmt.Fprintf(&buf, "  <xs:element name=\"adx\" type=\"%s\"/>\n", root.name)
+	for _, st := range sortedTypes(types) {
+		fmt.Fprintf(&buf, "  <xs:complexType name=\"%s\">\n    <xs:sequence>\n", st.name)
+		var attrs []*schemaField
+		for _, f := range st.fields {
+			if f.xmlName == "" {
+				continue
+			}
+			if f.xmlAttr {
+				attrs = append(attrs, f)
+				continue
+			}
+			kind := "xs:" + f.kind
+			if f.typ != nil {
+				kind = f.kind
+			}
+			maxOccurs := "1"
+			if f.multiple {
+				maxOccurs = "unbounded"
+			}
+			fmt.Fprintf(&buf, "      <xs:element name=\"%s\" type=\"%s\" minOccurs=\"0\" maxOccurs=\"%s\"/>\n",
+				f.xmlName, kind, maxOccurs)
+		}
+		buf.WriteString("    </xs:sequence>\n")
+		for _, f := range attrs {
+			fmt.Fprintf(&buf, "    <xs:attribute name=\"%s\" type=\"xs:%s\"/>\n", f.xmlName, f.kind)
+		}
+		buf.WriteString("  </xs:complexType>\n")
+	}
+	buf.WriteString("</xs:schema>\n")
+	return buf.Bytes()
+}
+
+// RenderJSONSchema writes the JSON Schema of the interchange format
+func RenderJSONSchema() []byte {
+	root, types := adxSchema()
+	defs := map[string]interface{}{}
+	for _, st := range types {
+		properties := map[string]interface{}{}
+		for _, f := range st.fields {
+			if f.jsonName == "" {
+				continue
+			}
+			var prop map[string]interface{}
+			if f.typ != nil {
+				prop = map[string]interface{}{"$ref": "#/$defs/" + f.kind}
+			} else {
+				prop = map[string]interface{}{"type": f.kind}
+			}
+			if f.multiple {
+				prop = map[string]interface{}{"type": "array", "items": prop}
+			}
+			properties[f.jsonName] = prop
+		}
+		defs[st.name] = map[string]interface{}{
+			"type":                 "object",
+			"properties":           properties,
+			"additionalProperties": false,
+		}
+	}
+	schema := map[string]interface{}{
+		"$schema":  "https://json-schema.org/draft/2020-12/schema",
+		"$id":      fmt.Sprintf("https://github.com/nuald/adx/schema/v%d/adx.schema.json", FormatVersion),
+		"title":    "adx interchange format",
+		"$ref":     "#/$defs/" + root.name,
+		"required": []string{"version"},
+		"$defs":    defs,
+	}
+	result, err := json.MarshalIndent(schema, "", "  ")
+	if err != nil {
+		panic(err)
+	}
+	return append(result, '\n')
+}
+
+// xmlMigrations rename (or drop, if the new name is empty) the elements
+// of the older documents; the key is the version being migrated from
+var xmlMigrations = map[int]map[string]string{
+	1: {"Skip": "", "Ref": "ref"},
+}
+
+// xmlVersion reads the version attribute of the root element
+func xmlVersion(content []byte) (int, error) {
+	d := xml.NewDecoder(bytes.NewReader(content))
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return 0, fmt.Errorf("no root element: %v", err)
+		}
+		if start, ok := token.(xml.StartElement); ok {
+			if start.Name.Local != "adx" {
+				return 0, lineError(1, "the root element is <%s>, not <adx>", start.Name.Local)
+			}
+			for _, attr := range start.Attr {
+				if attr.Name.Local == "version" {
+					version, err := strconv.Atoi(attr.Value)
+					if err != nil {
+						return 0, fmt.Errorf("invalid version %q", attr.Value)
+					}
+					return version, nil
+				}
+			}
+			// The documents before the versioning
+			return 1, nil
+		}
+	}
+}
+
+// migrateXML rewrites the document from the given version to the current one
+func migrateXML(content []byte, version int) ([]byte, error) {
+	for ; version < FormatVersion; version++ {
+		renames := xmlMigrations[version]
+		d := xml.NewDecoder(bytes.NewReader(content))
+		var buf bytes.Buffer
+		e := xml.NewEncoder(&buf)
+		var stack []xml.Name
+		for {
+			token, err := d.Token()
+			if err == io.EOF {
+				break
+			}
+			if err != nil {
+				return nil, err
+			}
+			switch t := token.(type) {
+			case xml.StartElement:
+				if len(stack) == 0 {
+					t.Attr = []xml.Attr{{Name: xml.Name{Local: "version"},
+						Value: strconv.Itoa(version + 1)}}
+				} else if name, ok := renames[t.Name.Local]; ok {
+					if name == "" {
+						if err = d.Skip(); err != nil {
+							return nil, err
+						}
+						continue
+					}
+					t.Name.Local = name
+				}
+				stack = append(stack, t.Name)
+				token = t
+			case xml.EndElement:
+				t.Name = stack[len(stack)-1]
+				stack = stack[:len(stack)-1]
+				token = t
+			}
+			if err = e.EncodeToken(xml.CopyToken(token)); err != nil {
+				return nil, err
+			}
+		}
+		if err := e.Flush(); err != nil {
+			return nil, err
+		}
+		content = buf.Bytes()
+	}
+	return content, nil
+}
+
+func findField(st *schemaType, name string, isJSON bool) *schemaField {
+	for _, f := range st.fields {
+		if (isJSON && f.jsonName == name) || (!isJSON && !f.xmlAttr && f.xmlName == name) {
+			return f
+		}
+	}
+	return nil
+}
+
+// lineError is the invalid input error at the line of the document
+func lineError(line int, format string, args ...interface{}) error {
+	return &Error{Phase: PhaseInput, Line: line, Err: fmt.Errorf(format, args...)}
+}
+
+// validateXML checks the elements of the document against the schema
+func validateXML(content []byte) error {
+	root, _ := adxSchema()
+	d := xml.NewDecoder(bytes.NewReader(content))
+	type frame struct {
+		name string
+		typ  *schemaType
+	}
+	var stack []frame
+	for {
+		token, err := d.Token()
+		if err == io.EOF {
+			return nil
+		}
+		line, _ := d.InputPos()
+		if err != nil {
+			return lineError(line, "%v", err)
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			name := t.Name.Local
+			if len(stack) == 0 {
+				stack = append(stack, frame{name, root})
+				continue
+			}
+			parent := stack[len(stack)-1]
+			if parent.typ == nil {
+				return lineError(line, "unexpected element <%s> in the text element <%s>",
+					name, parent.name)
+			}
+			f := findField(parent.typ, name, false)
+			if f == nil {
+				return lineError(line, "unknown element <%s> in <%s>", name, parent.name)
+			}
+			stack = append(stack, frame{name, f.typ})
+		case xml.EndElement:
+			stack = stack[:len(stack)-1]
+		case xml.CharData:
+			if len(stack) > 0 && stack[len(stack)-1].typ != nil && len(bytes.TrimSpace(t)) > 0 {
+				return lineError(line, "unexpected text in <%s>", stack[len(stack)-1].name)
+			}
+		}
+	}
+}
+
+// validateJSON checks the fields of the document against the schema
+func validateJSON(content []byte) (int, error) {
+	root, _ := adxSchema()
+	var doc interface{}
+	if err := json.Unmarshal(content, &doc); err != nil {
+		return 0, err
+	}
+	obj, ok := doc.(map[string]interface{})
+	if !ok {
+		return 0, fmt.Errorf("the document is not an object")
+	}
+	version, ok := obj["version"].(float64)
+	if !ok {
+		return 0, fmt.Errorf("no version field")
+	}
+	if int(version) > FormatVersion {
+		return int(version), nil
+	}
+
+	var check func(value interface{}, f *schemaField, path string) error
+	checkObject := func(value interface{}, st *schemaType, path string) error {
+		obj, ok := value.(map[string]interface{})
+		if !ok {
+			return fmt.Errorf("%s: expected an object", path)
+		}
+		var keys []string
+		for key := range obj {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		for _, key := range keys {
+			f := findField(st, key, true)
+			if f == nil {
+				return fmt.Errorf("%s: unknown field %q", path, key)
+			}
+			if err := check(obj[key], f, path+"."+key); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	check = func(value interface{}, f *schemaField, path string) error {
+		if f.multiple {
+			items, ok := value.([]interface{})
+			if !ok {
+				return fmt.Errorf("%s: expected an array", path)
+			}
+			for i, item := range items {
+				single := *f
+				single.multiple = false
+				if err := check(item, &single, fmt.Sprintf("%s[%d]", path, i)); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+		if f.typ != nil {
+			return checkObject(value, f.typ, path)
+		}
+		var valid bool
+		switch f.kind {
+		case "string":
+			_, valid = value.(string)
+		case "integer":
+			_, valid = value.(float64)
+		}
+		if !valid {
+			return fmt.Errorf("%s: expected %s", path, f.kind)
+		}
+		return nil
+	}
+	return int(version), checkObject(doc, root, "$")
+}
@@ -0,0 +1,147 @@
+package adx
+
+import (
+	"encoding/json"
+	"path/filepath"
+)
+
+// searchEntry is the item of the client-side search index
+type searchEntry struct {
+	Name        string `json:"name"`
+	Kind        string `json:"kind"`
+	Context     string `json:"context"`
+	Description string `json:"description"`
+	URL         string `json:"url"`
+	Language    string `json:"language,omitempty"`
+}
+
+// buildSearchIndex lists the classes and their members, and the namespace
+// members; the page functions give the pages of the class and the namespace
+// ("" for the single-page output)
+func buildSearchIndex(namespaces map[string][]Class, members map[string]Namespace,
+	page func(Class) string, nsPage func(string) string) []searchEntry {
+	index := []searchEntry{}
+	for _, ns := range sortedNamespaces(namespaces) {
+		for _, cls := range namespaces[ns] {
+			classURL := page(cls) + "#" + cls.Ref
+			qualified := ns + "." + cls.Name
+			index = append(index, searchEntry{
+				Name:        cls.Name,
+				Kind:        cls.KindName(),
+				Context:     ns,
+				Description: plainText(cls.Description),
+				URL:         classURL,
+				Language:    cls.Language,
+			})
+			for _, prop := range cls.Properties {
+				index = append(index, searchEntry{
+					Name:        prop.Name,
+					Kind:        "property",
+					Context:     qualified,
+					Description: plainText(prop.Description),
+					URL:         page(cls) + "#" + prop.Anchor,
+					Language:    cls.Language,
+				})
+			}
+			for _, value := range cls.Values {
+				index = append(index, searchEntry{
+					Name:        value.Name,
+					Kind:        "value",
+					Context:     qualified,
+					Description: plainText(value.Description),
+					URL:         page(cls) + "#" + value.Anchor,
+					Language:    cls.Language,
+				})
+			}
+			methods := append(append([]Method{}, cls.Constructors...), cls.Methods...)
+			for i, method := range methods {
+				kind := "method"
+				if i < len(cls.Constructors) {
+					kind = "constructor"
+				}
+				methodURL := page(cls) + "#" + method.Anchor
+				index = append(index, searchEntry{
+					Name:        method.Name,
+					Kind:        kind,
+					Context:     qualified,
+					Description: plainText(method.Description),
+					URL:         methodURL,
+					Language:    cls.Language,
+				})
+				for _, param := range method.Parameters {
+					index = append(index, searchEntry{
+						Name:        param.Name,
+						Kind:        "parameter",
+						Context:     qualified + "." + method.Name,
+						Description: plainText(param.Description),
+						URL:         methodURL,
+						Language:    cls.Language,
+					})
+				}
+			}
+		}
+	}
+	for _, name := range namespaceNames(namespaces, members) {
+		ns, ok := members[name]
+		if !ok {
+			continue
+		}
+		for _, list := range []struct {
+			kind  string
+			props []Property
+		}{{"constant", ns.Constants}, {"variable", ns.Variables}} {
+			for _, prop := range list.props {
+				index = append(index, searchEntry{
+					Name:        prop.Name,
+					Kind:        list.kind,
+					Context:     name,
+					Description: plainText(prop.Description),
+					URL:         nsPage(name) + "#" + prop.Anchor,
+					Language:    ns.Language,
+				})
+			}
+		}
+		for _, function := range ns.Functions {
+			functionURL := nsPage(name) + "#" + function.Anchor
+			index = append(index, searchEntry{
+				Name:        function.Name,
+				Kind:        "function",
+				Context:     name,
+				Description: plainText(function.Description),
+				URL:         functionURL,
+				Language:    ns.Language,
+			})
+			for _, param := range function.Parameters {
+				index = append(index, searchEntry{
+					Name:        param.Name,
+					Kind:        "parameter",
+					Context:     name + "." + function.Name,
+					Description: plainText(param.Description),
+					URL:         functionURL,
+					Language:    ns.Language,
+				})
+			}
+		}
+	}
+	return index
+}
+
+// saveSearchIndex writes the index as JSON and as the script loadable from file://
+func saveSearchIndex(opts RenderOptions, index []searchEntry, dir string) error {
+	content, err := json.Marshal(index)
+	if err != nil {
+		return err
+	}
+	if err = save(content, filepath.Join(dir, "search.json")); err != nil {
+		return err
+	}
+	script := append([]byte("var adxSearchIndex = "), content...)
+	if err = save(append(script, ";\n"...), filepath.Join(dir, "search-index.js")); err != nil {
+		return err
+	}
+	searchScript, err := opts.asset("data/search.js")
+	if err != nil {
+		return err
+	}
+	return save(searchScript, filepath.Join(dir, "search.js"))
+}
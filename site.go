@@ -0,0 +1,138 @@
+package adx
+
+import (
+	"fmt"
+	"html/template"
+	"path/filepath"
+	"regexp"
+)
+
+var refLinkRe = regexp.MustCompile("href=\"#(\\w+)\"")
+
+func namespacePage(ns string) string {
+	return "ns-" + ns + ".html"
+}
+
+func classPage(cls Class) string {
+	return cls.Ref + ".html"
+}
+
+// siteFuncs resolves the in-page class anchors to the class pages
+func siteFuncs(namespaces map[string][]Class) template.FuncMap {
+	pages := map[string]string{}
+	for _, classes := range namespaces {
+		for _, cls := range classes {
+			pages[cls.Ref] = classPage(cls)
+		}
+	}
+	return template.FuncMap{
+		"classPage":     classPage,
+		"namespacePage": namespacePage,
+		"resolve": func(raw template.HTML) template.HTML {
+			// #nosec
+			return template.HTML(refLinkRe.ReplaceAllStringFunc(string(raw), func(link string) string {
+				ref := refLinkRe.FindStringSubmatch(link)[1]
+				if page, ok := pages[ref]; ok {
+					return fmt.Sprintf("href=\"%s\"", page)
+				}
+				return link
+			}))
+		},
+	}
+}
+
+func parseSiteTemplate(opts RenderOptions, funcs template.FuncMap, page string) (*template.Template, error) {
+	return parseTemplate(opts, funcs, "data/site/"+page, "data/site/layout.html", "data/partials.html")
+}
+
+// savePage executes the template into the file
+func savePage(t *template.Template, data interface{}, out string) error {
+	content, err := executeTemplate(t, data)
+	if err != nil {
+		return err
+	}
+	return save(content, out)
+}
+
+// RenderSite writes the index, namespace and class pages into the directory
+func RenderSite(opts RenderOptions, namespaces map[string][]Class, members map[string]Namespace, dir string) error {
+	if err := createDir(dir); err != nil {
+		return err
+	}
+	funcs := siteFuncs(namespaces)
+	style, err := opts.asset("data/style.css")
+	if err != nil {
+		return err
+	}
+	if err = save(style, filepath.Join(dir, "style.css")); err != nil {
+		return err
+	}
+	if err = saveSearchIndex(opts, buildSearchIndex(namespaces, members, classPage, namespacePage), dir); err != nil {
+		return err
+	}
+
+	index, err := parseSiteTemplate(opts, funcs, "index.html")
+	if err != nil {
+		return err
+	}
+	err = savePage(index, struct {
+		Title      string
+		Names      []string
+		Namespaces map[string][]Class
+		Members    map[string]Namespace
+		Languages  []string
+		Hierarchy  []ClassNode
+	}{
+		opts.Title,
+		namespaceNames(namespaces, members),
+		namespaces,
+		members,
+		languages(namespaces),
+		ClassHierarchy(namespaces),
+	}, filepath.Join(dir, "index.html"))
+	if err != nil {
+		return err
+	}
+
+	nsTpl, err := parseSiteTemplate(opts, funcs, "namespace.html")
+	if err != nil {
+		return err
+	}
+	classTpl, err := parseSiteTemplate(opts, funcs, "class.html")
+	if err != nil {
+		return err
+	}
+	for _, ns := range namespaceNames(namespaces, members) {
+		classes := namespaces[ns]
+		err = savePage(nsTpl, struct {
+			Title     string
+			Namespace string
+			Members   Namespace
+			Classes   []Class
+			Languages []string
+		}{
+			opts.Title,
+			ns,
+			members[ns],
+			classes,
+			languages(map[string][]Class{ns: classes}),
+		}, filepath.Join(dir, namespacePage(ns)))
+		if err != nil {
+			return err
+		}
+
+		for _, cls := range classes {
+			err = savePage(classTpl, struct {
+				Title string
+				Class Class
+			}{
+				opts.Title,
+				cls,
+			}, filepath.Join(dir, classPage(cls)))
+			if err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
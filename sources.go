@@ -0,0 +1,115 @@
+package adx
+
+import (
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+// sourcePath is the path of the source file (relative to the working
+// directory or absolute) relative to the source root dir, the working
+// directory if empty (the paths outside of it are absolute) with the slashes
+func sourcePath(root string, path string) string {
+	abs, err := filepath.Abs(path)
+	if err != nil {
+		return filepath.ToSlash(path)
+	}
+	path = abs
+	if root, err = filepath.Abs(root); err == nil {
+		rel, err := filepath.Rel(root, abs)
+		if err == nil && rel != ".." && !strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+			path = rel
+		}
+	}
+	return filepath.ToSlash(path)
+}
+
+// sourceFiles calls the function for the files of the classes, members and
+// namespace members
+func sourceFiles(classes []Class, namespaces []Namespace, fn func(file *string)) {
+	methods := func(methods []Method) {
+		for i := range methods {
+			fn(&methods[i].File)
+		}
+	}
+	properties := func(props []Property) {
+		for i := range props {
+			fn(&props[i].File)
+		}
+	}
+	for i := range classes {
+		cls := &classes[i]
+		fn(&cls.File)
+		methods(cls.Constructors)
+		methods(cls.Methods)
+		properties(cls.Properties)
+	}
+	for _, ns := range namespaces {
+		methods(ns.Functions)
+		properties(ns.Constants)
+		properties(ns.Variables)
+	}
+}
+
+// setSourceFile sets the file of the classes, members and namespace members
+// without one (the generators parsing the files one by one only know the lines)
+func setSourceFile(result *AdxResult, file string) {
+	sourceFiles(result.Classes, result.Namespaces, func(f *string) {
+		if *f == "" {
+			*f = file
+		}
+	})
+}
+
+// rootSources makes the files reported by the external tools relative to
+// the source root dir
+func rootSources(classes []Class, namespaces []Namespace, root string) {
+	sourceFiles(classes, namespaces, func(file *string) {
+		if *file != "" {
+			*file = sourcePath(root, *file)
+		}
+	})
+}
+
+// sourceURL formats the URL pattern with the {path} and {line} placeholders;
+// the fragment with the line is dropped if the line is unknown
+func sourceURL(pattern string, file string, line int) string {
+	if pattern == "" || file == "" {
+		return ""
+	}
+	if line == 0 {
+		if i := strings.Index(pattern, "#"); i >= 0 && strings.Contains(pattern[i:], "{line}") {
+			pattern = pattern[:i]
+		}
+	}
+	return strings.NewReplacer("{path}", file, "{line}", strconv.Itoa(line)).Replace(pattern)
+}
+
+// LinkSources sets the source URLs of the normalized classes, members and
+// namespace members using the URL pattern (e.g. https://git.example/{path}#L{line})
+func LinkSources(namespaces map[string][]Class, members map[string]Namespace, pattern string) {
+	methods := func(methods []Method) {
+		for i := range methods {
+			methods[i].SourceURL = sourceURL(pattern, methods[i].File, methods[i].Line)
+		}
+	}
+	properties := func(props []Property) {
+		for i := range props {
+			props[i].SourceURL = sourceURL(pattern, props[i].File, props[i].Line)
+		}
+	}
+	for _, classes := range namespaces {
+		for i := range classes {
+			cls := &classes[i]
+			cls.SourceURL = sourceURL(pattern, cls.File, cls.Line)
+			methods(cls.Constructors)
+			methods(cls.Methods)
+			properties(cls.Properties)
+		}
+	}
+	for _, ns := range members {
+		methods(ns.Functions)
+		properties(ns.Constants)
+		properties(ns.Variables)
+	}
+}
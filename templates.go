@@ -0,0 +1,154 @@
+package adx
+
+import (
+	"bytes"
+	"fmt"
+	"html/template"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+// Version is the adx version (set with -ldflags "-X github.com/nuald/adx.Version=...")
+var Version = "dev"
+
+// RenderOptions customize the rendered documentation
+type RenderOptions struct {
+	Title string
+	// The template file replacing data/default.html, or the directory
+	// with the files overriding the embedded data/ assets
+	Template string
+	// The user variables available in the templates
+	Vars map[string]string
+	// The additional template functions
+	Funcs template.FuncMap
+}
+
+// ParseVars parses the template variables in the name=value format
+func ParseVars(vars []string) (map[string]string, error) {
+	result := map[string]string{}
+	for _, v := range vars {
+		pair := strings.SplitN(v, "=", 2)
+		if len(pair) != 2 {
+			return nil, &Error{
+				Phase: PhaseConfig,
+				Err:   fmt.Errorf("template variable %q should be in the name=value format", v),
+			}
+		}
+		result[pair[0]] = pair[1]
+	}
+	return result, nil
+}
+
+// asset reads the user template overriding the embedded asset if it exists
+func (o RenderOptions) asset(name string) ([]byte, error) {
+	if o.Template != "" {
+		info, err := os.Stat(o.Template)
+		if err != nil {
+			return nil, err
+		}
+		path := o.Template
+		if info.IsDir() {
+			path = filepath.Join(o.Template, strings.TrimPrefix(name, "data/"))
+		} else if name != "data/default.html" {
+			path = ""
+		}
+		if path != "" {
+			// #nosec
+			content, err := os.ReadFile(path)
+			if err == nil {
+				return content, nil
+			}
+			if !os.IsNotExist(err) {
+				return nil, err
+			}
+		}
+	}
+	return Asset(name)
+}
+
+// templateFuncs are available in all the templates
+func (o RenderOptions) templateFuncs() template.FuncMap {
+	buildDate := time.Now()
+	funcs := template.FuncMap{
+		"resolve": func(raw template.HTML) template.HTML {
+			return raw
+		},
+		"var": func(name string) string {
+			return o.Vars[name]
+		},
+		"version": func() string {
+			return Version
+		},
+		"buildDate": func() time.Time {
+			return buildDate
+		},
+		"plain":     plainText,
+		"classLink": classLink,
+		"kinds":     groupByKind,
+		"lower":     strings.ToLower,
+		"upper":     strings.ToUpper,
+		"join":      strings.Join,
+		"highlight": highlight,
+		"rich":      richHTML,
+	}
+	for name, fn := range o.Funcs {
+		funcs[name] = fn
+	}
+	return funcs
+}
+
+// parseTemplate parses the files (the first one is executed, the others
+// define the partials); the funcs override the default ones. The partials
+// from the partials/ subdirectory of the user template directory are
+// parsed last to redefine the embedded ones.
+func parseTemplate(opts RenderOptions, funcs template.FuncMap, tplFiles ...string) (*template.Template, error) {
+	t := template.New(tplFiles[0]).Funcs(opts.templateFuncs()).Funcs(funcs)
+	for i, tplFile := range tplFiles {
+		current := t
+		if i > 0 {
+			current = t.New(tplFile)
+		}
+		content, err := opts.asset(tplFile)
+		if err != nil {
+			return nil, err
+		}
+		if _, err = current.Parse(string(content)); err != nil {
+			return nil, err
+		}
+	}
+	if opts.Template != "" {
+		partials, err := filepath.Glob(filepath.Join(opts.Template, "partials", "*.html"))
+		if err != nil {
+			return nil, err
+		}
+		for _, partial := range partials {
+			// #nosec
+			content, err := os.ReadFile(partial)
+			if err != nil {
+				return nil, err
+			}
+			if _, err = t.New(partial).Parse(string(content)); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return t, nil
+}
+
+func executeTemplate(t *template.Template, data interface{}) ([]byte, error) {
+	var buf bytes.Buffer
+	if err := t.Execute(&buf, data); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func renderTemplate(tplFile string, data interface{}) ([]byte, error) {
+	t, err := parseTemplate(RenderOptions{}, nil, tplFile)
+	if err != nil {
+		return nil, err
+	}
+	return executeTemplate(t, data)
+}
@@ -0,0 +1,1360 @@
+package adx
+
+import (
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+)
+
+// typescript parses the declaration files (.d.ts) natively and reads
+// the TypeDoc JSON output (the .json files); the intermediate content
+// is the adx JSON document
+type typescript struct {
+	adxContent
+	excludes []string
+	onError  func(error)
+	root     string
+}
+
+func (t typescript) SetConf(conf string) {}
+
+func (t *typescript) SetExcludes(patterns []string) {
+	t.excludes = patterns
+}
+
+func (t *typescript) SetErrorHandler(handler func(error)) {
+	t.onError = handler
+}
+
+func (t *typescript) SetSourceRoot(dir string) {
+	t.root = dir
+}
+
+// tsModuleName converts the declaration file path into the namespace
+// (index.d.ts is named after its dir)
+func tsModuleName(srcDir string, rel string) string {
+	if rel == "." {
+		rel = filepath.Base(srcDir)
+	}
+	rel = filepath.ToSlash(strings.TrimSuffix(rel, ".d.ts"))
+	elems := strings.Split(rel, "/")
+	if elems[len(elems)-1] == "index" {
+		elems = elems[:len(elems)-1]
+	}
+	if len(elems) == 0 {
+		abs, err := filepath.Abs(srcDir)
+		if err != nil {
+			return "index"
+		}
+		elems = []string{filepath.Base(abs)}
+	}
+	return strings.Join(elems, "::")
+}
+
+func (t typescript) GenIntermediate(srcDir string) ([]byte, error) {
+	var result AdxResult
+	err := walkSources(srcDir, t.excludes, func(path string, info os.FileInfo) error {
+		rel, err := filepath.Rel(srcDir, path)
+		if err != nil {
+			return err
+		}
+		isDeclaration := strings.HasSuffix(path, ".d.ts")
+		if !isDeclaration && filepath.Ext(path) != ".json" {
+			return nil
+		}
+		// #nosec
+		src, err := os.ReadFile(path)
+		if err == nil {
+			if isDeclaration {
+				err = genTSDeclarations(string(src), tsModuleName(srcDir, rel), &result)
+				setSourceFile(&result, sourcePath(t.root, path))
+			} else {
+				err = genTypeDoc(src, &result)
+			}
+			if err == nil {
+				return nil
+			}
+		}
+		err = withContext(err, PhaseSource, path)
+		if t.onError == nil {
+			return err
+		}
+		t.onError(err)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	linkTSTypes(&result)
+	return json.Marshal(result)
+}
+
+// linkTSTypes links the class names (plain or qualified with the namespace)
+func linkTSTypes(result *AdxResult) {
+	refs := typeLinker{}
+	for _, cls := range result.Classes {
+		name := cls.Name
+		if i := strings.Index(name, "<"); i >= 0 {
+			name = name[:i]
+		}
+		refs[strings.ReplaceAll(name, "::", ".")] = cls.Ref
+		short := name
+		if i := strings.LastIndex(name, "::"); i >= 0 {
+			short = name[i+2:]
+		}
+		if _, ok := refs[short]; !ok {
+			refs[short] = cls.Ref
+		}
+	}
+	for i := range result.Classes {
+		cls := &result.Classes[i]
+		refs.methods(cls.Constructors)
+		refs.methods(cls.Methods)
+		refs.properties(cls.Properties)
+	}
+	for i := range result.Namespaces {
+		refs.methods(result.Namespaces[i].Functions)
+		refs.properties(result.Namespaces[i].Constants)
+		refs.properties(result.Namespaces[i].Variables)
+	}
+}
+
+func tsJoin(ns string, name string) string {
+	if ns == "" {
+		return name
+	}
+	return ns + "::" + name
+}
+
+func tsRef(ns string, name string) string {
+	return nonWordRe.ReplaceAllString(tsJoin(ns, name), "_")
+}
+
+// jsDoc is the parsed JSDoc (or TSDoc) comment
+type jsDoc struct {
+	description string
+	params      map[string]jsParamDoc
+	returns     string
+	throws      []Exception
+	examples    []Example
+	// The deprecation note (true if there is none), the since version and
+	// the stability (the @experimental or @stable tags)
+	deprecated, since, stability string
+}
+
+// annotation sets the annotation of the tag (without the @ prefix)
+func (d *jsDoc) annotation(tag string, text string) {
+	switch tag {
+	case "deprecated":
+		d.deprecated = text
+		if d.deprecated == "" {
+			d.deprecated = "true"
+		}
+	case "since":
+		d.since = text
+	case "experimental", "stable":
+		d.stability = tag
+	}
+}
+
+// annotate sets the missing annotations of the class, method or property
+func (d jsDoc) annotate(fields *annotationFields) {
+	for _, field := range []struct {
+		target *string
+		value  string
+	}{{fields.deprecated, d.deprecated}, {fields.since, d.since}, {fields.stability, d.stability}} {
+		if *field.target == "" {
+			*field.target = field.value
+		}
+	}
+}
+
+// jsParamDoc is the documented parameter (the optional ones are in the
+// brackets with the optional default value, e.g. [name=value])
+type jsParamDoc struct {
+	typ, description, value string
+	optional                bool
+}
+
+var jsDocTagRe = regexp.MustCompile(`^@(\w+)\s*(.*)$`)
+var jsDocParamRe = regexp.MustCompile(`^(?:\{(.*?)\}\s*)?(\[[^\]]*\]|[\w$.]+)\s*(?:-\s*)?(.*)$`)
+
+// jsThrows parses the @throws tag text with the optional type in the braces
+// (e.g. {RangeError} If the value is negative)
+func jsThrows(text string) Exception {
+	var exception Exception
+	if strings.HasPrefix(text, "{") {
+		if i := strings.Index(text, "}"); i >= 0 {
+			exception.Type = escapedHTML(strings.TrimSpace(text[1:i]))
+			text = text[i+1:]
+		}
+	}
+	exception.Description = strings.TrimSpace(text)
+	return exception
+}
+
+var jsCaptionRe = regexp.MustCompile(`^\s*<caption>(.*?)</caption>`)
+var jsFenceRe = regexp.MustCompile("(?s)^\\s*```([\\w+#-]*)\n(.*?)\n\\s*```\\s*$")
+
+// jsExample parses the @example tag text with the optional caption and the
+// optional code fence (TSDoc has the fenced examples)
+func jsExample(text string) Example {
+	var example Example
+	if match := jsCaptionRe.FindStringSubmatch(text); match != nil {
+		example.Title = strings.TrimSpace(match[1])
+		text = text[len(match[0]):]
+	}
+	if match := jsFenceRe.FindStringSubmatch(text); match != nil {
+		example.Language, text = match[1], match[2]
+	}
+	example.Code = strings.TrimRight(strings.Trim(text, "\n"), " \n")
+	return example
+}
+
+// parseJSDoc parses the /** */ comment: the text before the first tag is
+// the description, @param, @returns and @throws document the signature, and
+// @example has the code (the lines are kept)
+func parseJSDoc(comment string) jsDoc {
+	d := jsDoc{params: map[string]jsParamDoc{}}
+	text := strings.TrimSuffix(strings.TrimPrefix(comment, "/**"), "*/")
+	var description []string
+	var tags [][2]string
+	for _, line := range strings.Split(text, "\n") {
+		line = strings.TrimPrefix(strings.TrimSpace(line), "*")
+		line = strings.TrimRight(strings.TrimPrefix(line, " "), " ")
+		if match := jsDocTagRe.FindStringSubmatch(strings.TrimSpace(line)); match != nil {
+			tags = append(tags, [2]string{match[1], match[2]})
+		} else if len(tags) > 0 {
+			tags[len(tags)-1][1] += "\n" + line
+		} else {
+			description = append(description, line)
+		}
+	}
+	d.description = strings.TrimSpace(strings.Join(description, "\n"))
+	for _, tag := range tags {
+		if tag[0] == "example" {
+			d.examples = append(d.examples, jsExample(tag[1]))
+			continue
+		}
+		text := strings.Join(strings.Fields(tag[1]), " ")
+		switch tag[0] {
+		case "param", "arg", "argument":
+			match := jsDocParamRe.FindStringSubmatch(text)
+			if match == nil {
+				continue
+			}
+			param := jsParamDoc{typ: match[1], description: match[3]}
+			name := match[2]
+			if strings.HasPrefix(name, "[") {
+				param.optional = true
+				name = strings.Trim(name, "[]")
+				if i := strings.Index(name, "="); i >= 0 {
+					name, param.value = name[:i], name[i+1:]
+				}
+			}
+			d.params[name] = param
+		case "returns", "return":
+			if strings.HasPrefix(text, "{") {
+				if i := strings.Index(text, "}"); i >= 0 {
+					text = strings.TrimSpace(text[i+1:])
+				}
+			}
+			d.returns = text
+		case "throws", "exception":
+			d.throws = append(d.throws, jsThrows(text))
+		default:
+			d.annotation(tag[0], text)
+		}
+	}
+	return d
+}
+
+// apply fills the parameters and the return value descriptions
+func (d jsDoc) apply(method *Method) {
+	if method.Description == "" {
+		method.Description = d.description
+	}
+	for i := range method.Parameters {
+		param := &method.Parameters[i]
+		paramDoc, ok := d.params[strings.TrimPrefix(param.Name, "...")]
+		if !ok {
+			continue
+		}
+		if param.Description == "" {
+			param.Description = paramDoc.description
+		}
+		if param.Type == "" {
+			param.Type = escapedHTML(paramDoc.typ)
+		}
+		if param.Default == "" {
+			param.Default = paramDoc.value
+		}
+		if paramDoc.optional {
+			param.Optional = "true"
+		}
+	}
+	if method.Returns.Description == "" {
+		method.Returns.Description = escapedHTML(d.returns)
+	}
+	if method.Throws == nil {
+		method.Throws = d.throws
+	}
+	d.annotate(methodAnnotations(method))
+	if method.Examples == nil {
+		method.Examples = d.examples
+	}
+}
+
+// tsToken is the token of the declaration file with the JSDoc comment preceding it
+type tsToken struct {
+	text       string
+	start, end int
+	line       int
+	doc        string
+}
+
+func isTSIdent(c byte) bool {
+	return c == '_' || c == '$' || c == '#' || c >= 0x80 ||
+		('a' <= c && c <= 'z') || ('A' <= c && c <= 'Z') || ('0' <= c && c <= '9')
+}
+
+func tsTokens(src string) ([]tsToken, error) {
+	var tokens []tsToken
+	line := 1
+	doc := ""
+	for i := 0; i < len(src); {
+		c := src[i]
+		start, startLine := i, line
+		switch {
+		case c == '\n':
+			line++
+			i++
+			continue
+		case c == ' ' || c == '\t' || c == '\r' || c == '\f' || c == '\v':
+			i++
+			continue
+		case strings.HasPrefix(src[i:], "//"):
+			for ; i < len(src) && src[i] != '\n'; i++ {
+			}
+			continue
+		case strings.HasPrefix(src[i:], "/*"):
+			end := strings.Index(src[i+2:], "*/")
+			if end < 0 {
+				return nil, &Error{Phase: PhaseParse, Line: line, Err: errors.New("unterminated comment")}
+			}
+			comment := src[i : i+end+4]
+			if strings.HasPrefix(comment, "/**") && comment != "/**/" {
+				doc = comment
+			}
+			line += strings.Count(comment, "\n")
+			i += len(comment)
+			continue
+		case c == '"' || c == '\'' || c == '`':
+			for i++; i < len(src) && src[i] != c; i++ {
+				if src[i] == '\\' {
+					i++
+				} else if src[i] == '\n' && c != '`' {
+					break
+				}
+			}
+			if i >= len(src) || src[i] != c {
+				return nil, &Error{Phase: PhaseParse, Line: startLine, Err: errors.New("unterminated string literal")}
+			}
+			i++
+			line += strings.Count(src[start:i], "\n")
+		case isTSIdent(c):
+			for i++; i < len(src) && isTSIdent(src[i]); i++ {
+			}
+			// The numbers with the fractions
+			if '0' <= c && c <= '9' && i+1 < len(src) && src[i] == '.' && '0' <= src[i+1] && src[i+1] <= '9' {
+				for i++; i < len(src) && isTSIdent(src[i]); i++ {
+				}
+			}
+		case strings.HasPrefix(src[i:], "=>"):
+			i += 2
+		case strings.HasPrefix(src[i:], "..."):
+			i += 3
+		default:
+			i++
+		}
+		tokens = append(tokens, tsToken{text: src[start:i], start: start, end: i, line: startLine, doc: doc})
+		doc = ""
+	}
+	return tokens, nil
+}
+
+// tsParser parses the declarations of the classes, interfaces, enums,
+// functions and constants (the function bodies aren't expected)
+type tsParser struct {
+	src        string
+	tokens     []tsToken
+	pos        int
+	result     *AdxResult
+	namespaces namespaceIndex
+}
+
+func (p *tsParser) peek(offset int) tsToken {
+	if p.pos+offset < len(p.tokens) {
+		return p.tokens[p.pos+offset]
+	}
+	return tsToken{}
+}
+
+func (p *tsParser) next() tsToken {
+	tok := p.peek(0)
+	if p.pos < len(p.tokens) {
+		p.pos++
+	}
+	return tok
+}
+
+func (p *tsParser) accept(text string) bool {
+	if p.pos < len(p.tokens) && p.tokens[p.pos].text == text {
+		p.pos++
+		return true
+	}
+	return false
+}
+
+func (p *tsParser) eof() bool {
+	return p.pos >= len(p.tokens)
+}
+
+// The tokens continuing the expression on the next line
+var tsContinuing = map[string]bool{
+	"|": true, "&": true, "=>": true, ",": true, ":": true, "?": true, "=": true,
+	".": true, "extends": true, "implements": true, "keyof": true, "typeof": true, "readonly": true,
+}
+
+// collect returns the source text up to one of the stop tokens outside of
+// the brackets (or up to the line break ending the statement)
+func (p *tsParser) collect(stops ...string) string {
+	start, end, depth := -1, -1, 0
+	for !p.eof() {
+		tok := p.peek(0)
+		if depth == 0 {
+			stop := false
+			for _, text := range stops {
+				stop = stop || tok.text == text
+			}
+			if start >= 0 && tok.line > p.tokens[p.pos-1].line &&
+				!tsContinuing[p.tokens[p.pos-1].text] && !tsContinuing[tok.text] {
+				stop = true
+			}
+			if stop {
+				break
+			}
+		}
+		switch tok.text {
+		case "(", "[", "{", "<":
+			depth++
+		case ")", "]", "}", ">":
+			if depth == 0 {
+				return p.text(start, end)
+			}
+			depth--
+		}
+		if start < 0 {
+			start = tok.start
+		}
+		end = tok.end
+		p.pos++
+	}
+	return p.text(start, end)
+}
+
+func (p *tsParser) text(start int, end int) string {
+	if start < 0 {
+		return ""
+	}
+	return strings.Join(strings.Fields(p.src[start:end]), " ")
+}
+
+// typeParams returns the names of the generic type parameters (e.g. <T, U>
+// for <T extends Shape, U = T>)
+func (p *tsParser) typeParams() string {
+	if !p.accept("<") {
+		return ""
+	}
+	var names []string
+	expectName := true
+	for depth := 1; depth > 0 && !p.eof(); {
+		tok := p.next()
+		switch tok.text {
+		case "<", "(", "[", "{":
+			depth++
+		case ">", ")", "]", "}":
+			depth--
+		case ",":
+			expectName = depth == 1
+			continue
+		default:
+			if expectName && depth == 1 && tok.text != "const" && tok.text != "in" && tok.text != "out" {
+				names = append(names, tok.text)
+				expectName = false
+			}
+		}
+	}
+	return "<" + strings.Join(names, ", ") + ">"
+}
+
+func tsName(tok tsToken) string {
+	return strings.Trim(tok.text, "\"'`")
+}
+
+var tsStatementModifiers = map[string]bool{
+	"export": true, "declare": true, "default": true, "abstract": true, "async": true,
+}
+
+var tsMemberModifiers = map[string]bool{
+	"public": true, "private": true, "protected": true, "static": true, "readonly": true,
+	"abstract": true, "declare": true, "override": true, "async": true, "accessor": true,
+	"get": true, "set": true,
+}
+
+// The tokens following the member name (so the modifier is the name)
+var tsMemberNameEnd = map[string]bool{
+	"(": true, "<": true, ":": true, "?": true, "!": true, ";": true, ",": true, "}": true, "=": true,
+}
+
+func (p *tsParser) statements(ns string) {
+	for !p.eof() && p.peek(0).text != "}" {
+		p.statement(ns)
+	}
+}
+
+func (p *tsParser) statement(ns string) {
+	doc := parseJSDoc(p.peek(0).doc)
+	for tsStatementModifiers[p.peek(0).text] && !tsMemberNameEnd[p.peek(1).text] {
+		p.pos++
+	}
+	switch tok := p.next(); tok.text {
+	case "namespace", "module", "global":
+		name := ""
+		if tok.text != "global" {
+			name = strings.ReplaceAll(tsName(p.next()), "/", "::")
+			for p.accept(".") {
+				name += "::" + p.next().text
+			}
+		}
+		// The global augmentations are in the root scope
+		scope := ""
+		if tok.text != "global" {
+			scope = tsJoin(ns, name)
+		}
+		if doc.description != "" {
+			p.namespaces.get(scope).Description = doc.description
+		}
+		if p.accept("{") {
+			p.statements(scope)
+			p.accept("}")
+		}
+	case "interface", "class":
+		p.class(ns, doc, tok.text == "interface")
+	case "enum":
+		p.enum(ns, doc)
+	case "const", "let", "var":
+		if tok.text == "const" && p.accept("enum") {
+			p.enum(ns, doc)
+			return
+		}
+		for !p.eof() {
+			nameTok := p.next()
+			var varType string
+			if p.accept(":") {
+				varType = p.collect(";", ",", "=")
+			}
+			if p.accept("=") {
+				p.collect(";", ",")
+			}
+			namespace := p.namespaces.get(ns)
+			prop := Property{
+				Name:        nameTok.text,
+				Description: doc.description,
+				Type:        escapedHTML(varType),
+				Line:        nameTok.line,
+			}
+			doc.annotate(propertyAnnotations(&prop))
+			if tok.text == "const" {
+				namespace.Constants = append(namespace.Constants, prop)
+			} else {
+				namespace.Variables = append(namespace.Variables, prop)
+			}
+			if !p.accept(",") {
+				break
+			}
+		}
+	case "function":
+		nameTok := p.next()
+		method := p.signature(nameTok.text, doc)
+		method.Line = nameTok.line
+		namespace := p.namespaces.get(ns)
+		namespace.Functions = append(namespace.Functions, method)
+	case "{":
+		// The export lists
+		p.pos--
+		p.collect(";")
+	case ";":
+	default:
+		// The imports, type aliases and variables
+		p.pos--
+		if p.collect(";") == "" && !p.eof() && p.peek(0).text != ";" {
+			// The unbalanced closing brackets
+			p.pos++
+		}
+	}
+	p.accept(";")
+}
+
+func (p *tsParser) class(ns string, doc jsDoc, isInterface bool) {
+	nameTok := p.next()
+	name := nameTok.text
+	typeParams := p.typeParams()
+	extends, implements := tsHeritage(p.collect("{"))
+	if !p.accept("{") {
+		return
+	}
+	cls := Class{
+		Name:        tsJoin(ns, name+typeParams),
+		Description: doc.description,
+		Ref:         tsRef(ns, name),
+		Extends:     extends,
+		Implements:  implements,
+		Examples:    doc.examples,
+		Line:        nameTok.line,
+	}
+	if isInterface {
+		cls.Kind = "interface"
+	}
+	doc.annotate(classAnnotations(&cls))
+	p.members(&cls, isInterface)
+	p.accept("}")
+	p.result.Classes = append(p.result.Classes, cls)
+}
+
+// tsHeritage splits the heritage clauses (e.g. extends A<T, U> implements B, C)
+func tsHeritage(text string) ([]string, []string) {
+	var extends, implements []string
+	var current *[]string
+	depth, start := 0, 0
+	add := func(end int) {
+		if name := strings.TrimSpace(text[start:end]); name != "" && current != nil {
+			*current = append(*current, name)
+		}
+		start = end + 1
+	}
+	for i := 0; i < len(text); i++ {
+		switch c := text[i]; {
+		case c == '<' || c == '(' || c == '[' || c == '{':
+			depth++
+		case c == '>' || c == ')' || c == ']' || c == '}':
+			depth--
+		case c == ',' && depth == 0:
+			add(i)
+		case depth == 0 && (i == 0 || text[i-1] == ' '):
+			for _, clause := range []string{"extends", "implements"} {
+				end := i + len(clause)
+				if strings.HasPrefix(text[i:], clause) && (end == len(text) || text[end] == ' ') {
+					add(i)
+					current = &extends
+					if clause == "implements" {
+						current = &implements
+					}
+					start = end
+					i = end - 1
+				}
+			}
+		}
+	}
+	add(len(text))
+	return extends, implements
+}
+
+func (p *tsParser) enum(ns string, doc jsDoc) {
+	nameTok := p.next()
+	name := nameTok.text
+	cls := Class{
+		Name:        tsJoin(ns, name),
+		Kind:        "enum",
+		Description: doc.description,
+		Ref:         tsRef(ns, name),
+		Line:        nameTok.line,
+	}
+	doc.annotate(classAnnotations(&cls))
+	p.accept("{")
+	for !p.eof() && !p.accept("}") {
+		memberDoc := parseJSDoc(p.peek(0).doc)
+		value := EnumValue{
+			Name:        tsName(p.next()),
+			Description: memberDoc.description,
+		}
+		if p.accept("=") {
+			value.Value = p.collect(",", "}")
+		}
+		p.accept(",")
+		cls.Values = append(cls.Values, value)
+	}
+	p.result.Classes = append(p.result.Classes, cls)
+}
+
+// tsAccess converts the modifiers into the access (the private members are skipped)
+func tsAccess(modifiers map[string]bool) string {
+	var access []string
+	if modifiers["protected"] {
+		access = append(access, "protected")
+	}
+	if modifiers["static"] {
+		access = append(access, "static")
+	}
+	return strings.Join(access, " ")
+}
+
+func (p *tsParser) members(cls *Class, isInterface bool) {
+	for !p.eof() && p.peek(0).text != "}" {
+		doc := parseJSDoc(p.peek(0).doc)
+		if p.accept(";") || p.accept(",") {
+			continue
+		}
+		modifiers := map[string]bool{}
+		for tsMemberModifiers[p.peek(0).text] && !tsMemberNameEnd[p.peek(1).text] {
+			modifiers[p.next().text] = true
+		}
+		switch tok := p.peek(0); {
+		case tok.text == "[" || tok.text == "(" || tok.text == "<" ||
+			(tok.text == "new" && (p.peek(1).text == "(" || p.peek(1).text == "<")):
+			// The index, call and construct signatures
+			p.collect(";", ",")
+			continue
+		}
+		nameTok := p.next()
+		name := tsName(nameTok)
+		p.accept("?")
+		p.accept("!")
+		private := modifiers["private"] || strings.HasPrefix(name, "#")
+		access := tsAccess(modifiers)
+		if p.peek(0).text == "(" || p.peek(0).text == "<" {
+			method := p.signature(name, doc)
+			if private {
+				continue
+			}
+			method.Access = access
+			method.Line = nameTok.line
+			switch {
+			case modifiers["get"]:
+				prop := Property{
+					Name:        name,
+					Description: method.Description,
+					Access:      access,
+					Type:        method.Returns.Type,
+					Line:        nameTok.line,
+				}
+				doc.annotate(propertyAnnotations(&prop))
+				cls.Properties = append(cls.Properties, prop)
+			case modifiers["set"]:
+				found := false
+				for _, prop := range cls.Properties {
+					found = found || prop.Name == name
+				}
+				if !found && len(method.Parameters) > 0 {
+					prop := Property{
+						Name:        name,
+						Description: method.Description,
+						Access:      access,
+						Type:        method.Parameters[0].Type,
+						Line:        nameTok.line,
+					}
+					doc.annotate(propertyAnnotations(&prop))
+					cls.Properties = append(cls.Properties, prop)
+				}
+			case name == "constructor":
+				method.Name = ""
+				method.Returns = Returns{}
+				cls.Constructors = append(cls.Constructors, method)
+			default:
+				if isInterface || modifiers["abstract"] {
+					method.Virtual = "virtual"
+				}
+				cls.Methods = append(cls.Methods, method)
+			}
+			continue
+		}
+		var propType string
+		if p.accept(":") {
+			propType = p.collect(";", ",", "=")
+		}
+		if p.accept("=") {
+			p.collect(";", ",")
+		}
+		if !private {
+			prop := Property{
+				Name:        name,
+				Description: doc.description,
+				Access:      access,
+				Type:        escapedHTML(propType),
+				Line:        nameTok.line,
+			}
+			doc.annotate(propertyAnnotations(&prop))
+			cls.Properties = append(cls.Properties, prop)
+		}
+	}
+}
+
+// signature parses the parameters and the return type of the function
+func (p *tsParser) signature(name string, doc jsDoc) Method {
+	p.typeParams()
+	method := Method{Name: name}
+	p.accept("(")
+	for !p.eof() && !p.accept(")") {
+		rest := p.accept("...")
+		var paramName string
+		if text := p.peek(0).text; text == "{" || text == "[" {
+			// The destructured parameter
+			paramName = p.collect("?", ":", ",", "=")
+		} else {
+			paramName = p.next().text
+		}
+		param := Parameter{Name: paramName}
+		if rest {
+			param.Name = "..." + paramName
+		}
+		if p.accept("?") {
+			param.Optional = "true"
+		}
+		if p.accept(":") {
+			param.Type = escapedHTML(p.collect(",", "="))
+		}
+		if p.accept("=") {
+			param.Default = p.collect(",")
+			param.Optional = "true"
+		}
+		p.accept(",")
+		if paramName != "this" {
+			method.Parameters = append(method.Parameters, param)
+		}
+	}
+	if p.accept(":") {
+		method.Returns.Type = escapedHTML(p.collect(";", ",", "}"))
+	}
+	doc.apply(&method)
+	return method
+}
+
+// isTSModule checks the top-level imports and exports (otherwise the
+// declarations are global)
+func isTSModule(tokens []tsToken) bool {
+	depth := 0
+	for _, tok := range tokens {
+		switch tok.text {
+		case "{":
+			depth++
+		case "}":
+			depth--
+		case "import", "export":
+			if depth == 0 {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// genTSDeclarations parses the declaration file: the module declarations
+// are in the namespace of the module, the global ones are not
+func genTSDeclarations(src string, module string, result *AdxResult) error {
+	tokens, err := tsTokens(strings.TrimPrefix(src, "\ufeff"))
+	if err != nil {
+		return err
+	}
+	p := tsParser{
+		src:        strings.TrimPrefix(src, "\ufeff"),
+		tokens:     tokens,
+		result:     result,
+		namespaces: namespaceIndex{result: result},
+	}
+	ns := ""
+	if isTSModule(tokens) {
+		ns = module
+	}
+	for !p.eof() {
+		p.statements(ns)
+		// The unbalanced braces
+		p.accept("}")
+	}
+	return nil
+}
+
+// typeDocReflection is the declaration of the TypeDoc JSON output (the
+// project, modules, classes, members, signatures and parameters)
+type typeDocReflection struct {
+	Name       string `json:"name"`
+	Kind       int    `json:"kind"`
+	KindString string `json:"kindString"`
+	Flags      struct {
+		IsOptional  bool `json:"isOptional"`
+		IsPrivate   bool `json:"isPrivate"`
+		IsProtected bool `json:"isProtected"`
+		IsStatic    bool `json:"isStatic"`
+		IsAbstract  bool `json:"isAbstract"`
+		IsRest      bool `json:"isRest"`
+		IsConst     bool `json:"isConst"`
+	} `json:"flags"`
+	Comment        *typeDocComment     `json:"comment"`
+	Children       []typeDocReflection `json:"children"`
+	Signatures     []typeDocReflection `json:"signatures"`
+	Parameters     []typeDocReflection `json:"parameters"`
+	Type           *typeDocType        `json:"type"`
+	DefaultValue   string              `json:"defaultValue"`
+	TypeParameters []typeDocReflection `json:"typeParameters"`
+	// The older versions have the singular name
+	TypeParameter []typeDocReflection `json:"typeParameter"`
+	GetSignature  json.RawMessage     `json:"getSignature"`
+	InheritedFrom json.RawMessage     `json:"inheritedFrom"`
+	// The heritage clauses of the classes and interfaces
+	ExtendedTypes    []typeDocType `json:"extendedTypes"`
+	ImplementedTypes []typeDocType `json:"implementedTypes"`
+	// The declaration locations (relative to the base path of the project)
+	Sources []struct {
+		FileName string `json:"fileName"`
+		Line     int    `json:"line"`
+	} `json:"sources"`
+}
+
+// typeDocComment has the summary and the block tags (the older versions
+// have the short text, text and returns fields)
+type typeDocComment struct {
+	Summary   []typeDocPart `json:"summary"`
+	BlockTags []struct {
+		Tag     string        `json:"tag"`
+		Content []typeDocPart `json:"content"`
+	} `json:"blockTags"`
+	ModifierTags []string `json:"modifierTags"`
+	ShortText    string   `json:"shortText"`
+	Text         string   `json:"text"`
+	Returns      string   `json:"returns"`
+	// The tags of the older versions
+	Tags []struct {
+		Tag  string `json:"tag"`
+		Text string `json:"text"`
+	} `json:"tags"`
+}
+
+type typeDocPart struct {
+	Text string `json:"text"`
+}
+
+// typeDocType is the type expression
+type typeDocType struct {
+	Type          string             `json:"type"`
+	Name          string             `json:"name"`
+	Value         interface{}        `json:"value"`
+	Operator      string             `json:"operator"`
+	TypeArguments []typeDocType      `json:"typeArguments"`
+	ElementType   *typeDocType       `json:"elementType"`
+	Types         []typeDocType      `json:"types"`
+	Elements      []typeDocType      `json:"elements"`
+	Declaration   *typeDocReflection `json:"declaration"`
+	ObjectType    *typeDocType       `json:"objectType"`
+	IndexType     *typeDocType       `json:"indexType"`
+	QueryType     *typeDocType       `json:"queryType"`
+	Target        json.RawMessage    `json:"target"`
+}
+
+// The reflection kinds of TypeDoc (the kind strings of the older versions
+// are preferred as their numbers differ)
+const (
+	typeDocProject     = 1
+	typeDocModule      = 2
+	typeDocNamespace   = 4
+	typeDocEnum        = 8
+	typeDocVariable    = 32
+	typeDocFunction    = 64
+	typeDocClass       = 128
+	typeDocInterface   = 256
+	typeDocConstructor = 512
+	typeDocProperty    = 1024
+	typeDocMethod      = 2048
+	typeDocAccessor    = 262144
+)
+
+var typeDocKindStrings = map[string]int{
+	"Project": typeDocProject, "External module": typeDocModule, "Module": typeDocModule,
+	"Namespace": typeDocNamespace, "Enumeration": typeDocEnum, "Variable": typeDocVariable,
+	"Function": typeDocFunction, "Class": typeDocClass, "Interface": typeDocInterface,
+	"Constructor": typeDocConstructor, "Property": typeDocProperty, "Method": typeDocMethod,
+	"Accessor": typeDocAccessor,
+}
+
+func (r typeDocReflection) kind() int {
+	if kind, ok := typeDocKindStrings[r.KindString]; ok {
+		return kind
+	}
+	return r.Kind
+}
+
+func (c *typeDocComment) description() string {
+	if c == nil {
+		return ""
+	}
+	if c.Summary == nil {
+		return strings.TrimSpace(strings.TrimSpace(c.ShortText) + "\n\n" + strings.TrimSpace(c.Text))
+	}
+	return typeDocText(c.Summary)
+}
+
+func (c *typeDocComment) returns() string {
+	if c == nil {
+		return ""
+	}
+	for _, tag := range c.BlockTags {
+		if tag.Tag == "@returns" || tag.Tag == "@return" {
+			return typeDocText(tag.Content)
+		}
+	}
+	return strings.TrimSpace(c.Returns)
+}
+
+func (c *typeDocComment) throws() []Exception {
+	if c == nil {
+		return nil
+	}
+	var throws []Exception
+	for _, tag := range c.BlockTags {
+		if tag.Tag == "@throws" {
+			throws = append(throws, jsThrows(typeDocText(tag.Content)))
+		}
+	}
+	return throws
+}
+
+func (c *typeDocComment) examples() []Example {
+	if c == nil {
+		return nil
+	}
+	var examples []Example
+	for _, tag := range c.BlockTags {
+		if tag.Tag == "@example" {
+			examples = append(examples, jsExample(typeDocText(tag.Content)))
+		}
+	}
+	return examples
+}
+
+// annotate sets the missing annotations of the class, method or property
+// (the @deprecated and @since block tags, the @experimental and @stable
+// modifier tags)
+func (c *typeDocComment) annotate(fields *annotationFields) {
+	if c == nil {
+		return
+	}
+	var d jsDoc
+	for _, tag := range c.BlockTags {
+		d.annotation(strings.TrimPrefix(tag.Tag, "@"), typeDocText(tag.Content))
+	}
+	for _, tag := range c.ModifierTags {
+		d.annotation(strings.TrimPrefix(tag, "@"), "")
+	}
+	for _, tag := range c.Tags {
+		d.annotation(tag.Tag, strings.TrimSpace(tag.Text))
+	}
+	d.annotate(fields)
+}
+
+func typeDocText(parts []typeDocPart) string {
+	var text strings.Builder
+	for _, part := range parts {
+		text.WriteString(part.Text)
+	}
+	return strings.TrimSpace(text.String())
+}
+
+// String formats the type as the TypeScript expression
+func (t *typeDocType) String() string {
+	if t == nil {
+		return ""
+	}
+	join := func(types []typeDocType, sep string) string {
+		var texts []string
+		for i := range types {
+			texts = append(texts, types[i].String())
+		}
+		return strings.Join(texts, sep)
+	}
+	switch t.Type {
+	case "array":
+		elem := t.ElementType.String()
+		if t.ElementType != nil && (t.ElementType.Type == "union" || t.ElementType.Type == "intersection") {
+			elem = "(" + elem + ")"
+		}
+		return elem + "[]"
+	case "union":
+		return join(t.Types, " | ")
+	case "intersection":
+		return join(t.Types, " & ")
+	case "tuple":
+		return "[" + join(t.Elements, ", ") + "]"
+	case "optional":
+		return t.ElementType.String() + "?"
+	case "rest":
+		return "..." + t.ElementType.String()
+	case "literal":
+		if t.Value == nil {
+			return "null"
+		}
+		value, _ := json.Marshal(t.Value)
+		return string(value)
+	case "typeOperator":
+		var target typeDocType
+		_ = json.Unmarshal(t.Target, &target)
+		return t.Operator + " " + target.String()
+	case "indexedAccess":
+		return t.ObjectType.String() + "[" + t.IndexType.String() + "]"
+	case "query":
+		return "typeof " + t.QueryType.String()
+	case "reflection":
+		return t.Declaration.literal()
+	}
+	name := t.Name
+	if t.TypeArguments != nil {
+		name += "<" + join(t.TypeArguments, ", ") + ">"
+	}
+	return name
+}
+
+// literal formats the type literal (the function or the object type)
+func (r *typeDocReflection) literal() string {
+	if r == nil {
+		return "object"
+	}
+	if len(r.Signatures) > 0 {
+		sig := r.Signatures[0]
+		var params []string
+		for _, param := range sig.Parameters {
+			params = append(params, param.Name+": "+param.Type.String())
+		}
+		return "(" + strings.Join(params, ", ") + ") => " + sig.Type.String()
+	}
+	var members []string
+	for _, child := range r.Children {
+		members = append(members, child.Name+": "+child.Type.String())
+	}
+	return "{ " + strings.Join(members, "; ") + " }"
+}
+
+func (r typeDocReflection) typeParams() string {
+	params := r.TypeParameters
+	if params == nil {
+		params = r.TypeParameter
+	}
+	if params == nil {
+		return ""
+	}
+	var names []string
+	for _, param := range params {
+		names = append(names, param.Name)
+	}
+	return "<" + strings.Join(names, ", ") + ">"
+}
+
+// source returns the file and the line of the first declaration
+func (r typeDocReflection) source() (string, int) {
+	if len(r.Sources) == 0 {
+		return "", 0
+	}
+	return filepath.ToSlash(r.Sources[0].FileName), r.Sources[0].Line
+}
+
+func (r typeDocReflection) access() string {
+	var access []string
+	if r.Flags.IsProtected {
+		access = append(access, "protected")
+	}
+	if r.Flags.IsStatic {
+		access = append(access, "static")
+	}
+	return strings.Join(access, " ")
+}
+
+// method converts the signature (its comment has the description)
+func (r typeDocReflection) method(name string, sig typeDocReflection) Method {
+	comment := sig.Comment
+	if comment == nil {
+		comment = r.Comment
+	}
+	method := Method{
+		Name:        name,
+		Description: comment.description(),
+		Access:      r.access(),
+		Returns: Returns{
+			Type:        escapedHTML(sig.Type.String()),
+			Description: escapedHTML(comment.returns()),
+		},
+		Throws:   comment.throws(),
+		Examples: comment.examples(),
+	}
+	comment.annotate(methodAnnotations(&method))
+	r.Comment.annotate(methodAnnotations(&method))
+	method.File, method.Line = sig.source()
+	if method.File == "" {
+		method.File, method.Line = r.source()
+	}
+	for _, param := range sig.Parameters {
+		parameter := Parameter{
+			Name:        param.Name,
+			Type:        escapedHTML(param.Type.String()),
+			Description: param.Comment.description(),
+			Default:     param.DefaultValue,
+		}
+		if param.Flags.IsRest {
+			parameter.Name = "..." + param.Name
+		}
+		if param.Flags.IsOptional || param.DefaultValue != "" {
+			parameter.Optional = "true"
+		}
+		method.Parameters = append(method.Parameters, parameter)
+	}
+	return method
+}
+
+func (r typeDocReflection) class(ns string) Class {
+	cls := Class{
+		Name:        tsJoin(ns, r.Name+r.typeParams()),
+		Description: r.Comment.description(),
+		Ref:         tsRef(ns, r.Name),
+		Examples:    r.Comment.examples(),
+	}
+	r.Comment.annotate(classAnnotations(&cls))
+	cls.File, cls.Line = r.source()
+	for _, base := range r.ExtendedTypes {
+		cls.Extends = append(cls.Extends, base.String())
+	}
+	for _, base := range r.ImplementedTypes {
+		cls.Implements = append(cls.Implements, base.String())
+	}
+	switch r.kind() {
+	case typeDocInterface:
+		cls.Kind = "interface"
+	case typeDocEnum:
+		cls.Kind = "enum"
+	}
+	for _, member := range r.Children {
+		if member.Flags.IsPrivate || member.InheritedFrom != nil {
+			continue
+		}
+		switch {
+		case cls.Kind == "enum":
+			// The older versions have the default values of the members
+			value := member.DefaultValue
+			if value == "" && member.Type != nil && member.Type.Type == "literal" {
+				value = member.Type.String()
+			}
+			cls.Values = append(cls.Values, EnumValue{
+				Name:        member.Name,
+				Description: member.Comment.description(),
+				Value:       value,
+			})
+		case member.kind() == typeDocConstructor:
+			for _, sig := range member.Signatures {
+				ctor := member.method("", sig)
+				ctor.Returns = Returns{}
+				cls.Constructors = append(cls.Constructors, ctor)
+			}
+		case member.kind() == typeDocMethod:
+			for _, sig := range member.Signatures {
+				method := member.method(member.Name, sig)
+				if r.kind() == typeDocInterface || member.Flags.IsAbstract {
+					method.Virtual = "virtual"
+				}
+				cls.Methods = append(cls.Methods, method)
+			}
+		case member.kind() == typeDocProperty || member.kind() == typeDocAccessor:
+			prop := Property{
+				Name:        member.Name,
+				Description: member.Comment.description(),
+				Access:      member.access(),
+				Type:        escapedHTML(member.Type.String()),
+			}
+			member.Comment.annotate(propertyAnnotations(&prop))
+			prop.File, prop.Line = member.source()
+			if member.GetSignature != nil {
+				// The signature object (or the list of them in the older versions)
+				var sigs []typeDocReflection
+				var sig typeDocReflection
+				if json.Unmarshal(member.GetSignature, &sig) != nil {
+					_ = json.Unmarshal(member.GetSignature, &sigs)
+					if len(sigs) > 0 {
+						sig = sigs[0]
+					}
+				}
+				prop.Type = escapedHTML(sig.Type.String())
+				if prop.Description == "" {
+					prop.Description = sig.Comment.description()
+				}
+				sig.Comment.annotate(propertyAnnotations(&prop))
+			}
+			cls.Properties = append(cls.Properties, prop)
+		}
+	}
+	return cls
+}
+
+// convert adds the declarations of the container (the project, module
+// or namespace) and its children
+func (r typeDocReflection) convert(ns string, result *AdxResult, namespaces *namespaceIndex) {
+	for _, child := range r.Children {
+		if child.Flags.IsPrivate {
+			continue
+		}
+		switch child.kind() {
+		case typeDocModule, typeDocNamespace:
+			name := strings.ReplaceAll(strings.Trim(child.Name, `"`), "/", "::")
+			child.convert(tsJoin(ns, name), result, namespaces)
+		case typeDocClass, typeDocInterface, typeDocEnum:
+			result.Classes = append(result.Classes, child.class(ns))
+		case typeDocFunction:
+			namespace := namespaces.get(ns)
+			for _, sig := range child.Signatures {
+				namespace.Functions = append(namespace.Functions, child.method(child.Name, sig))
+			}
+		case typeDocVariable:
+			namespace := namespaces.get(ns)
+			prop := Property{
+				Name:        child.Name,
+				Description: child.Comment.description(),
+				Type:        escapedHTML(child.Type.String()),
+			}
+			child.Comment.annotate(propertyAnnotations(&prop))
+			prop.File, prop.Line = child.source()
+			if child.Flags.IsConst {
+				namespace.Constants = append(namespace.Constants, prop)
+			} else {
+				namespace.Variables = append(namespace.Variables, prop)
+			}
+		}
+	}
+}
+
+// genTypeDoc converts the TypeDoc JSON output (the other JSON files are
+// skipped); the modules are the namespaces, the single-module projects
+// are named after the project
+func genTypeDoc(content []byte, result *AdxResult) error {
+	var project typeDocReflection
+	if err := json.Unmarshal(content, &project); err != nil {
+		// The JSON files may be the configurations
+		return nil
+	}
+	if project.kind() != typeDocProject && !(project.KindString == "" && project.Kind == 0 && project.Children != nil) {
+		return nil
+	}
+	ns := ""
+	for _, child := range project.Children {
+		if kind := child.kind(); kind != typeDocModule && kind != typeDocNamespace {
+			ns = strings.ReplaceAll(project.Name, "/", "::")
+			break
+		}
+	}
+	project.convert(ns, result, &namespaceIndex{result: result})
+	return nil
+}